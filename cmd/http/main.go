@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -28,6 +30,16 @@ func main() {
 
 	cfg := config.MustLoad()
 
+	if flag.Arg(0) == "migrate" {
+		err := app.Migrate(cfg, flag.Args()[1:], os.Stdout)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "migrate:", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	lgr := config.NewLogger(cfg.App.Env)
 	slog.SetDefault(lgr)
 
@@ -35,12 +47,23 @@ func main() {
 
 	application := app.New(ctx, lgr, cfg)
 
+	application.Health.Go(ctx, "sla", application.SLA.Run)
+	application.Health.Go(ctx, "scheduler", application.Scheduler.Run)
+	if application.Mailer != nil {
+		application.Health.Go(ctx, "mailer", application.Mailer.Run)
+	}
 	go application.Srv.MustRun(ctx)
+	go application.GRPC.MustRun(ctx)
 
 	<-ctx.Done()
 
 	lgr.Info("received stop signal")
 
+	// Реплика сначала перестаёт быть готовой и лишь затем закрывает подключения,
+	// чтобы балансировщик успел убрать её из ротации.
+	application.Health.Drain()
+	time.Sleep(cfg.HTTP.DrainDelay)
+
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
 
@@ -50,4 +73,18 @@ func main() {
 	} else {
 		lgr.Info("PR Reviewer application http_server stopped gracefully")
 	}
+
+	err = application.GRPC.Stop(shutdownCtx)
+	if err != nil {
+		lgr.Error("failed to stop grpc_server gracefully", "err", err)
+	} else {
+		lgr.Info("PR Reviewer application grpc_server stopped gracefully")
+	}
+
+	if application.Tracing != nil {
+		err = application.Tracing.Shutdown(shutdownCtx)
+		if err != nil {
+			lgr.Error("failed to flush traces", "err", err)
+		}
+	}
 }
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"avitotech-pr-reviewer/internal/cli"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	err := cli.New(os.Stdout).ExecuteContext(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "prctl:", err)
+		cancel()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,73 @@
+package gqlapi
+
+import (
+	"context"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type (
+	loadersKey struct{}
+	tokenKey   struct{}
+)
+
+// loaders — загрузчики одного GraphQL запроса. Кэш не переживает запрос, поэтому
+// изменения, сделанные мутациями, видны следующему запросу.
+type loaders struct {
+	users   *loader[string, domain.User]
+	reviews *loader[string, []domain.PullRequest]
+}
+
+func withLoaders(ctx context.Context, userSvc userService) context.Context {
+	l := &loaders{}
+
+	l.users = newLoader(func(ctx context.Context, ids []string) (map[string]domain.User, error) {
+		users, err := userSvc.GetByIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		res := make(map[string]domain.User, len(users))
+		for _, u := range users {
+			res[u.ID] = u
+		}
+
+		return res, nil
+	})
+
+	l.reviews = newLoader(func(ctx context.Context, ids []string) (map[string][]domain.PullRequest, error) {
+		reviews, err := userSvc.GetReviews(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		// Авторы и ревьюверы загруженных Pull Request'ов почти всегда запрашиваются
+		// следующим уровнем запроса, поэтому попадают в один пакет.
+		for _, pullRequests := range reviews {
+			for _, pr := range pullRequests {
+				l.users.Expect(pr.AuthorID)
+				l.users.Expect(pr.Reviewers...)
+			}
+		}
+
+		return reviews, nil
+	})
+
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersKey{}).(*loaders)
+
+	return l
+}
+
+func withToken(ctx context.Context, hasToken bool) context.Context {
+	return context.WithValue(ctx, tokenKey{}, hasToken)
+}
+
+func hasToken(ctx context.Context) bool {
+	ok, _ := ctx.Value(tokenKey{}).(bool)
+
+	return ok
+}
@@ -0,0 +1,65 @@
+package gqlapi
+
+import (
+	"context"
+	"net/http"
+
+	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+// gqlError — ошибка резолвера с кодом HTTP API в errors[].extensions.code.
+type gqlError struct {
+	code    response.ErrorCode
+	message string
+}
+
+func (e *gqlError) Error() string {
+	return e.message
+}
+
+func (e *gqlError) Extensions() map[string]any {
+	return map[string]any{"code": string(e.code)}
+}
+
+// toError переводит ошибку сервисного слоя в ошибку GraphQL. Текст внутренних ошибок
+// и прерванных запросов клиенту не передаётся.
+func toError(err error, message string) error {
+	code := response.CodeOf(err)
+	if response.Status(code) < http.StatusInternalServerError {
+		message = err.Error()
+	}
+
+	return &gqlError{code: code, message: message}
+}
+
+func badRequest(message string) error {
+	return &gqlError{code: response.BadRequest, message: message}
+}
+
+// requireUser пропускает администраторов и владельца токена, выданного пользователю userID.
+func requireUser(ctx context.Context, userID string) error {
+	principal, ok := tenant.PrincipalFrom(ctx)
+	if !ok || !hasToken(ctx) {
+		return &gqlError{code: response.Unauthorized, message: "user or admin token is required"}
+	}
+
+	if !principal.CanActAs(userID) {
+		return &gqlError{code: response.Forbidden, message: "only the user or an admin can change user settings"}
+	}
+
+	return nil
+}
+
+func requireAdmin(ctx context.Context) error {
+	principal, ok := tenant.PrincipalFrom(ctx)
+	if !ok || !hasToken(ctx) {
+		return &gqlError{code: response.Unauthorized, message: "admin token is required"}
+	}
+
+	if !principal.IsAdmin() {
+		return &gqlError{code: response.Forbidden, message: "admin rights are required"}
+	}
+
+	return nil
+}
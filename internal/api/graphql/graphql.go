@@ -0,0 +1,101 @@
+// Package gqlapi реализует GraphQL API поверх тех же сервисов, что и HTTP API.
+// Вложенные поля загружаются пакетно через loader, поэтому число запросов
+// к хранилищу не зависит от числа элементов в ответе.
+package gqlapi
+
+import (
+	"context"
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+
+	"avitotech-pr-reviewer/internal/api/middleware"
+	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// maxDepth ограничивает вложенность запроса, чтобы один запрос не мог развернуть
+// граф команда → ревью → ревьюверы → их ревью неограниченно глубоко.
+const maxDepth = 10
+
+type teamService interface {
+	CreateTeam(ctx context.Context, teamName string, members []domain.Member) (*domain.Team, error)
+	TeamWithMembers(ctx context.Context, teamName string) (*domain.Team, error)
+	SetSLA(ctx context.Context, teamName string, sla domain.TeamSLA) (*domain.Team, error)
+}
+
+type userService interface {
+	GetByIDs(ctx context.Context, userIDs []string) ([]domain.User, error)
+	GetReviews(ctx context.Context, userIDs []string) (map[string][]domain.PullRequest, error)
+	SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
+	SetReminderFrequency(
+		ctx context.Context,
+		userID string,
+		frequency domain.ReminderFrequency,
+	) (*domain.User, error)
+	SetEmail(ctx context.Context, userID, email string) (*domain.User, error)
+	LinkChat(ctx context.Context, userID, chatUserID string) (*domain.User, error)
+}
+
+type prService interface {
+	CreatePullRequest(
+		ctx context.Context,
+		repository, id, name, authorID string,
+		changedFiles []string,
+	) (*domain.PullRequest, error)
+	SetMerged(ctx context.Context, repository, prID string) (*domain.PullRequest, error)
+	ReassignReviewer(ctx context.Context, repository, prID, oldReviewerID string) (*domain.PullRequest, string, error)
+	SubmitReview(
+		ctx context.Context,
+		repository, prID, reviewerID string,
+		state domain.ReviewState,
+	) (*domain.Review, error)
+}
+
+type handler struct {
+	userSvc userService
+	schema  *graphql.Schema
+}
+
+func New(teamSvc teamService, userSvc userService, prSvc prService) *handler {
+	root := &resolver{
+		teamSvc: teamSvc,
+		userSvc: userSvc,
+		prSvc:   prSvc,
+	}
+
+	return &handler{
+		userSvc: userSvc,
+		schema:  graphql.MustParseSchema(schemaSDL, root, graphql.MaxDepth(maxDepth)),
+	}
+}
+
+func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/graphql", h.exec)
+}
+
+type request struct {
+	Query         string         `json:"query" binding:"required"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (h *handler) exec(c *gin.Context) {
+	var req request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	ctx := withLoaders(c.Request.Context(), h.userSvc)
+	ctx = withToken(ctx, middleware.HasToken(c))
+
+	resp := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	c.JSON(http.StatusOK, resp)
+}
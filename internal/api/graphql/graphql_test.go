@@ -0,0 +1,268 @@
+package gqlapi
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+type fakeTeamService struct {
+	teamService
+
+	teams map[string]*domain.Team
+}
+
+func (f *fakeTeamService) TeamWithMembers(_ context.Context, teamName string) (*domain.Team, error) {
+	team, ok := f.teams[teamName]
+	if !ok {
+		return nil, svcErr.ErrTeamNotFound
+	}
+
+	return team, nil
+}
+
+func (f *fakeTeamService) CreateTeam(_ context.Context, teamName string, members []domain.Member) (*domain.Team, error) {
+	return &domain.Team{Name: teamName, Members: members}, nil
+}
+
+// fakeUserService отвечает из памяти и считает обращения, которые в сервисе
+// стали бы запросами к хранилищу.
+type fakeUserService struct {
+	userService
+
+	users   map[string]domain.User
+	reviews map[string][]domain.PullRequest
+
+	mu             sync.Mutex
+	getByIDsCalls  int
+	getReviewCalls int
+}
+
+func (f *fakeUserService) GetByIDs(_ context.Context, userIDs []string) ([]domain.User, error) {
+	f.mu.Lock()
+	f.getByIDsCalls++
+	f.mu.Unlock()
+
+	var res []domain.User
+	for _, id := range userIDs {
+		if u, ok := f.users[id]; ok {
+			res = append(res, u)
+		}
+	}
+
+	return res, nil
+}
+
+func (f *fakeUserService) GetReviews(_ context.Context, userIDs []string) (map[string][]domain.PullRequest, error) {
+	f.mu.Lock()
+	f.getReviewCalls++
+	f.mu.Unlock()
+
+	res := make(map[string][]domain.PullRequest)
+	for _, id := range userIDs {
+		if prs, ok := f.reviews[id]; ok {
+			res[id] = prs
+		}
+	}
+
+	return res, nil
+}
+
+func (f *fakeUserService) SetReminderFrequency(
+	_ context.Context,
+	userID string,
+	frequency domain.ReminderFrequency,
+) (*domain.User, error) {
+	return &domain.User{ID: userID, Username: userID, ReminderFrequency: frequency}, nil
+}
+
+func exec(
+	t *testing.T,
+	h *handler,
+	role domain.Role,
+	withAdminToken bool,
+	query string,
+) *graphql.Response {
+	t.Helper()
+
+	ctx := tenant.WithPrincipal(context.Background(), domain.Principal{OrgID: "org-1", Role: role})
+	ctx = withLoaders(ctx, h.userSvc)
+	ctx = withToken(ctx, withAdminToken)
+
+	return h.schema.Exec(ctx, query, "", nil)
+}
+
+func TestHandler_NestedReadsAreBatched(t *testing.T) {
+	const teamSize = 20
+
+	team := &domain.Team{Name: "backend"}
+	users := map[string]domain.User{
+		"outsider": {ID: "outsider", Username: "outsider", TeamName: "frontend"},
+	}
+	reviews := make(map[string][]domain.PullRequest)
+
+	for i := range teamSize {
+		id := string(rune('a' + i))
+		team.Members = append(team.Members, domain.Member{ID: id, Username: id, IsActive: true})
+		users[id] = domain.User{ID: id, Username: id, IsActive: true, TeamName: "backend"}
+		// Каждый Pull Request автора outsider ревьюят участник команды и сам outsider,
+		// поэтому на втором уровне появляются пользователи, которых нет в команде.
+		reviews[id] = []domain.PullRequest{{
+			ID:        "pr-" + id,
+			Name:      "PR " + id,
+			AuthorID:  "outsider",
+			Status:    domain.PRStatusOpen,
+			Reviewers: []string{id, "outsider"},
+		}}
+	}
+
+	teamSvc := &fakeTeamService{teams: map[string]*domain.Team{"backend": team}}
+	userSvc := &fakeUserService{users: users, reviews: reviews}
+	h := New(teamSvc, userSvc, nil)
+
+	resp := exec(t, h, domain.RoleMember, false, `{
+		team(name: "backend") {
+			members {
+				id
+				reviews(status: OPEN) {
+					id
+					author { username }
+					reviewers { id teamName reviews { id } }
+				}
+			}
+		}
+	}`)
+
+	require.Empty(t, resp.Errors)
+	assert.Contains(t, string(resp.Data), `"author":{"username":"outsider"}`)
+	// Участники команды, затем пользователи из их ревью.
+	assert.Equal(t, 2, userSvc.getByIDsCalls)
+	// Ревью участников команды, затем ревью пользователей второго уровня.
+	assert.Equal(t, 2, userSvc.getReviewCalls)
+}
+
+func TestHandler_TeamNotFound(t *testing.T) {
+	h := New(&fakeTeamService{}, &fakeUserService{}, nil)
+
+	resp := exec(t, h, domain.RoleMember, false, `{ team(name: "unknown") { name } }`)
+
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"team":null}`, string(resp.Data))
+}
+
+func TestHandler_MutationsRequireAdmin(t *testing.T) {
+	const mutation = `mutation { addTeam(name: "backend", members: []) { name } }`
+
+	tests := []struct {
+		name           string
+		role           domain.Role
+		withToken      bool
+		expectedCode   string
+		expectedResult string
+	}{
+		{
+			name:           "success - admin",
+			role:           domain.RoleAdmin,
+			withToken:      true,
+			expectedResult: `{"addTeam":{"name":"backend"}}`,
+		},
+		{
+			name:         "error - no token",
+			role:         domain.RoleMember,
+			expectedCode: "UNAUTHORIZED",
+		},
+		{
+			name:         "error - member token",
+			role:         domain.RoleMember,
+			withToken:    true,
+			expectedCode: "FORBIDDEN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(&fakeTeamService{}, &fakeUserService{}, nil)
+
+			resp := exec(t, h, tt.role, tt.withToken, mutation)
+
+			if tt.expectedCode != "" {
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, tt.expectedCode, resp.Errors[0].Extensions["code"])
+
+				return
+			}
+
+			require.Empty(t, resp.Errors)
+			assert.JSONEq(t, tt.expectedResult, string(resp.Data))
+		})
+	}
+}
+
+func TestHandler_SetUserRemindersRequiresUser(t *testing.T) {
+	const mutation = `mutation { setUserReminders(userId: "u1", frequency: WEEKLY) { id } }`
+
+	tests := []struct {
+		name         string
+		principal    domain.Principal
+		withToken    bool
+		expectedCode string
+	}{
+		{
+			name:      "success - admin",
+			principal: domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin},
+			withToken: true,
+		},
+		{
+			name:      "success - token issued to the user",
+			principal: domain.Principal{OrgID: "org-1", Role: domain.RoleMember, UserID: "u1"},
+			withToken: true,
+		},
+		{
+			name:         "error - token issued to another user",
+			principal:    domain.Principal{OrgID: "org-1", Role: domain.RoleMember, UserID: "u2"},
+			withToken:    true,
+			expectedCode: "FORBIDDEN",
+		},
+		{
+			name:         "error - member token without user",
+			principal:    domain.Principal{OrgID: "org-1", Role: domain.RoleMember},
+			withToken:    true,
+			expectedCode: "FORBIDDEN",
+		},
+		{
+			name:         "error - no token",
+			principal:    domain.Principal{OrgID: "org-1", Role: domain.RoleMember},
+			expectedCode: "UNAUTHORIZED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(&fakeTeamService{}, &fakeUserService{}, nil)
+
+			ctx := tenant.WithPrincipal(context.Background(), tt.principal)
+			ctx = withLoaders(ctx, h.userSvc)
+			ctx = withToken(ctx, tt.withToken)
+
+			resp := h.schema.Exec(ctx, mutation, "", nil)
+
+			if tt.expectedCode != "" {
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, tt.expectedCode, resp.Errors[0].Extensions["code"])
+
+				return
+			}
+
+			require.Empty(t, resp.Errors)
+			assert.JSONEq(t, `{"setUserReminders":{"id":"u1"}}`, string(resp.Data))
+		})
+	}
+}
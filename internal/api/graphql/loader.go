@@ -0,0 +1,156 @@
+package gqlapi
+
+import (
+	"context"
+	"sync"
+)
+
+// loader объединяет чтения по ключам в пакетные запросы, чтобы вложенные поля
+// не порождали по запросу к хранилищу на каждый элемент списка (N+1).
+//
+// Резолвер списка заранее сообщает ключи своих элементов через Expect, и первый Load
+// любого из них загружает одним вызовом fetch все ожидаемые ключи, которых ещё нет
+// в кэше. Кэш живёт один GraphQL запрос; конкурентные Load одного ключа ждут
+// уже выполняющуюся загрузку.
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu       sync.Mutex
+	results  map[K]*loadResult[V]
+	expected map[K]struct{}
+}
+
+type loadResult[V any] struct {
+	done  chan struct{}
+	value V
+	found bool
+	err   error
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:    fetch,
+		results:  make(map[K]*loadResult[V]),
+		expected: make(map[K]struct{}),
+	}
+}
+
+// Expect отмечает ключи, которые скорее всего будут загружены, чтобы они попали
+// в ближайший пакетный запрос.
+func (l *loader[K, V]) Expect(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, key := range keys {
+		if _, ok := l.results[key]; !ok {
+			l.expected[key] = struct{}{}
+		}
+	}
+}
+
+// Load возвращает значение по ключу; found равен false, если fetch его не вернул.
+func (l *loader[K, V]) Load(ctx context.Context, key K) (V, bool, error) {
+	results, err := l.load(ctx, []K{key})
+	if err != nil {
+		var zero V
+		return zero, false, err
+	}
+
+	return results[0].value, results[0].found, nil
+}
+
+// LoadMany возвращает найденные значения по ключам в порядке ключей.
+func (l *loader[K, V]) LoadMany(ctx context.Context, keys []K) ([]V, error) {
+	results, err := l.load(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]V, 0, len(results))
+	for _, res := range results {
+		if res.found {
+			values = append(values, res.value)
+		}
+	}
+
+	return values, nil
+}
+
+func (l *loader[K, V]) load(ctx context.Context, keys []K) ([]*loadResult[V], error) {
+	results, batch := l.schedule(keys)
+
+	if len(batch) > 0 {
+		l.run(ctx, batch)
+	}
+
+	for _, res := range results {
+		select {
+		case <-res.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if res.err != nil {
+			return nil, res.err
+		}
+	}
+
+	return results, nil
+}
+
+// schedule возвращает результаты для keys и пакет ключей, которые должен загрузить
+// вызывающий: запрошенные и ожидаемые ключи, загрузка которых ещё не начата.
+func (l *loader[K, V]) schedule(keys []K) ([]*loadResult[V], map[K]*loadResult[V]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	batch := make(map[K]*loadResult[V])
+	add := func(key K) *loadResult[V] {
+		res, ok := l.results[key]
+		if !ok {
+			res = &loadResult[V]{done: make(chan struct{})}
+			l.results[key] = res
+			batch[key] = res
+		}
+
+		return res
+	}
+
+	results := make([]*loadResult[V], len(keys))
+	for i, key := range keys {
+		results[i] = add(key)
+	}
+
+	if len(batch) > 0 {
+		for key := range l.expected {
+			add(key)
+		}
+		clear(l.expected)
+	}
+
+	return results, batch
+}
+
+func (l *loader[K, V]) run(ctx context.Context, batch map[K]*loadResult[V]) {
+	keys := make([]K, 0, len(batch))
+	for key := range batch {
+		keys = append(keys, key)
+	}
+
+	values, err := l.fetch(ctx, keys)
+
+	if err != nil {
+		// Неудачная загрузка не кэшируется, чтобы повторный запрос мог её повторить.
+		l.mu.Lock()
+		for key := range batch {
+			delete(l.results, key)
+		}
+		l.mu.Unlock()
+	}
+
+	for key, res := range batch {
+		res.value, res.found = values[key]
+		res.err = err
+		close(res.done)
+	}
+}
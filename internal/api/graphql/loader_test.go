@@ -0,0 +1,118 @@
+package gqlapi
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+type fetchRecorder struct {
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (f *fetchRecorder) fetch(_ context.Context, keys []string) (map[string]int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	batch := append([]string(nil), keys...)
+	sort.Strings(batch)
+	f.batches = append(f.batches, batch)
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	res := make(map[string]int, len(keys))
+	for _, key := range keys {
+		if key != "missing" {
+			res[key] = len(key)
+		}
+	}
+
+	return res, nil
+}
+
+func TestLoader_ExpectedKeysBatched(t *testing.T) {
+	rec := &fetchRecorder{}
+	l := newLoader(rec.fetch)
+
+	l.Expect("a", "bb", "ccc")
+
+	value, found, err := l.Load(context.Background(), "a")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+
+	values, err := l.LoadMany(context.Background(), []string{"ccc", "missing", "bb"})
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 2}, values)
+
+	assert.Equal(t, [][]string{{"a", "bb", "ccc"}, {"missing"}}, rec.batches)
+}
+
+func TestLoader_CachesResults(t *testing.T) {
+	rec := &fetchRecorder{}
+	l := newLoader(rec.fetch)
+
+	_, _, err := l.Load(context.Background(), "a")
+	require.NoError(t, err)
+
+	_, found, err := l.Load(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	l.Expect("a", "missing")
+	_, _, err = l.Load(context.Background(), "a")
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{{"a"}, {"missing"}}, rec.batches)
+}
+
+func TestLoader_ConcurrentLoadsShareFetch(t *testing.T) {
+	rec := &fetchRecorder{}
+	l := newLoader(rec.fetch)
+
+	keys := []string{"a", "bb", "ccc", "dddd"}
+	l.Expect(keys...)
+
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			value, found, err := l.Load(context.Background(), key)
+			assert.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, len(key), value)
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, rec.batches, 1)
+}
+
+func TestLoader_ErrorNotCached(t *testing.T) {
+	rec := &fetchRecorder{err: errUnexpected}
+	l := newLoader(rec.fetch)
+
+	_, _, err := l.Load(context.Background(), "a")
+	require.ErrorIs(t, err, errUnexpected)
+
+	rec.err = nil
+
+	value, found, err := l.Load(context.Background(), "a")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+	assert.Len(t, rec.batches, 2)
+}
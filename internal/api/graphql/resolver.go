@@ -0,0 +1,287 @@
+package gqlapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+)
+
+// resolver — корневой резолвер запросов и мутаций.
+type resolver struct {
+	teamSvc teamService
+	userSvc userService
+	prSvc   prService
+}
+
+func (r *resolver) Team(ctx context.Context, args struct{ Name string }) (*teamResolver, error) {
+	team, err := r.teamSvc.TeamWithMembers(ctx, args.Name)
+	if errors.Is(err, svcErr.ErrTeamNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, toError(err, "could not retrieve team")
+	}
+
+	return &teamResolver{team: team}, nil
+}
+
+func (r *resolver) User(ctx context.Context, args struct{ ID graphql.ID }) (*userResolver, error) {
+	return loadUser(ctx, string(args.ID))
+}
+
+func (r *resolver) Users(ctx context.Context, args struct{ IDs []graphql.ID }) ([]*userResolver, error) {
+	ids := make([]string, len(args.IDs))
+	for i, id := range args.IDs {
+		ids[i] = string(id)
+	}
+
+	return loadUsers(ctx, ids)
+}
+
+type memberInput struct {
+	UserID   graphql.ID
+	Username string
+	IsActive bool
+	Email    *string
+}
+
+func (r *resolver) AddTeam(ctx context.Context, args struct {
+	Name    string
+	Members []memberInput
+}) (*teamResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Name == "" {
+		return nil, badRequest("name is required")
+	}
+
+	members := make([]domain.Member, len(args.Members))
+	for i, m := range args.Members {
+		members[i] = domain.Member{
+			ID:       string(m.UserID),
+			Username: m.Username,
+			IsActive: m.IsActive,
+			Email:    value(m.Email),
+		}
+	}
+
+	team, err := r.teamSvc.CreateTeam(ctx, args.Name, members)
+	if err != nil {
+		return nil, toError(err, "could not create team")
+	}
+
+	return &teamResolver{team: team}, nil
+}
+
+type slaInput struct {
+	ReviewSLAHours int32
+	Action         *string
+	LeadID         *graphql.ID
+}
+
+func (r *resolver) SetTeamSLA(ctx context.Context, args struct {
+	TeamName string
+	SLA      slaInput
+}) (*teamResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sla := domain.TeamSLA{
+		ReviewHours: int(args.SLA.ReviewSLAHours),
+		Action:      domain.SLAAction(value(args.SLA.Action)),
+	}
+	if args.SLA.LeadID != nil {
+		sla.LeadID = string(*args.SLA.LeadID)
+	}
+
+	team, err := r.teamSvc.SetSLA(ctx, args.TeamName, sla)
+	if err != nil {
+		return nil, toError(err, "could not update team SLA")
+	}
+
+	return &teamResolver{team: team}, nil
+}
+
+func (r *resolver) SetUserIsActive(ctx context.Context, args struct {
+	UserID   graphql.ID
+	IsActive bool
+}) (*userResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.userSvc.SetIsActive(ctx, string(args.UserID), args.IsActive)
+	if err != nil {
+		return nil, toError(err, "could not update user")
+	}
+
+	return &userResolver{user: *user}, nil
+}
+
+// SetUserReminders, как и соответствующий метод HTTP API, доступен не только администратору:
+// частоту напоминаний пользователь настраивает сам токеном, выданным ему.
+func (r *resolver) SetUserReminders(ctx context.Context, args struct {
+	UserID    graphql.ID
+	Frequency string
+}) (*userResolver, error) {
+	err := requireUser(ctx, string(args.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	frequency := domain.ReminderFrequency(strings.ToLower(args.Frequency))
+
+	user, err := r.userSvc.SetReminderFrequency(ctx, string(args.UserID), frequency)
+	if err != nil {
+		return nil, toError(err, "could not update reminder frequency")
+	}
+
+	return &userResolver{user: *user}, nil
+}
+
+func (r *resolver) SetUserEmail(ctx context.Context, args struct {
+	UserID graphql.ID
+	Email  string
+}) (*userResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.userSvc.SetEmail(ctx, string(args.UserID), args.Email)
+	if err != nil {
+		return nil, toError(err, "could not update email")
+	}
+
+	return &userResolver{user: *user}, nil
+}
+
+func (r *resolver) LinkUserChat(ctx context.Context, args struct {
+	UserID     graphql.ID
+	ChatUserID string
+}) (*userResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.userSvc.LinkChat(ctx, string(args.UserID), args.ChatUserID)
+	if err != nil {
+		return nil, toError(err, "could not link chat user")
+	}
+
+	return &userResolver{user: *user}, nil
+}
+
+type createPRInput struct {
+	Repository   *string
+	ID           graphql.ID
+	Name         string
+	AuthorID     graphql.ID
+	ChangedFiles *[]string
+}
+
+func (r *resolver) CreatePullRequest(ctx context.Context, args struct {
+	Input createPRInput
+}) (*prResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changedFiles []string
+	if args.Input.ChangedFiles != nil {
+		changedFiles = *args.Input.ChangedFiles
+	}
+
+	pr, err := r.prSvc.CreatePullRequest(
+		ctx,
+		value(args.Input.Repository),
+		string(args.Input.ID),
+		args.Input.Name,
+		string(args.Input.AuthorID),
+		changedFiles,
+	)
+	if err != nil {
+		return nil, toError(err, "could not create pull request")
+	}
+
+	return &prResolver{pr: *pr}, nil
+}
+
+func (r *resolver) MergePullRequest(ctx context.Context, args struct {
+	Repository *string
+	ID         graphql.ID
+}) (*prResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := r.prSvc.SetMerged(ctx, value(args.Repository), string(args.ID))
+	if err != nil {
+		return nil, toError(err, "could not merge pull request")
+	}
+
+	return &prResolver{pr: *pr}, nil
+}
+
+func (r *resolver) ReassignReviewer(ctx context.Context, args struct {
+	Repository    *string
+	ID            graphql.ID
+	OldReviewerID graphql.ID
+}) (*reassignResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, replacedBy, err := r.prSvc.ReassignReviewer(
+		ctx, value(args.Repository), string(args.ID), string(args.OldReviewerID),
+	)
+	if err != nil {
+		return nil, toError(err, "could not reassign reviewer")
+	}
+
+	return &reassignResolver{pr: pr, replacedBy: replacedBy}, nil
+}
+
+func (r *resolver) SubmitReview(ctx context.Context, args struct {
+	Repository *string
+	ID         graphql.ID
+	ReviewerID graphql.ID
+	State      string
+}) (*reviewResolver, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := r.prSvc.SubmitReview(
+		ctx, value(args.Repository), string(args.ID), string(args.ReviewerID), domain.ReviewState(args.State),
+	)
+	if err != nil {
+		return nil, toError(err, "could not submit review")
+	}
+
+	return &reviewResolver{review: review}, nil
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,238 @@
+package gqlapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type teamResolver struct {
+	team *domain.Team
+}
+
+func (r *teamResolver) Name() string {
+	return r.team.Name
+}
+
+func (r *teamResolver) Members(ctx context.Context) ([]*userResolver, error) {
+	ids := make([]string, len(r.team.Members))
+	for i, m := range r.team.Members {
+		ids[i] = m.ID
+	}
+
+	return loadUsers(ctx, ids)
+}
+
+func (r *teamResolver) SLA() *slaResolver {
+	if !r.team.SLA.Enabled() {
+		return nil
+	}
+
+	return &slaResolver{sla: r.team.SLA}
+}
+
+type slaResolver struct {
+	sla domain.TeamSLA
+}
+
+func (r *slaResolver) ReviewSLAHours() int32 {
+	return int32(r.sla.ReviewHours)
+}
+
+func (r *slaResolver) Action() string {
+	return string(r.sla.Action)
+}
+
+func (r *slaResolver) Lead(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.sla.LeadID)
+}
+
+type userResolver struct {
+	user domain.User
+}
+
+func (r *userResolver) ID() graphql.ID {
+	return graphql.ID(r.user.ID)
+}
+
+func (r *userResolver) Username() string {
+	return r.user.Username
+}
+
+func (r *userResolver) IsActive() bool {
+	return r.user.IsActive
+}
+
+func (r *userResolver) TeamName() string {
+	return r.user.TeamName
+}
+
+func (r *userResolver) Email() *string {
+	return optional(r.user.Email)
+}
+
+func (r *userResolver) ReminderFrequency() string {
+	return strings.ToUpper(string(r.user.ReminderFrequency))
+}
+
+func (r *userResolver) ChatUserID() *string {
+	return optional(r.user.ChatUserID)
+}
+
+func (r *userResolver) AwayUntil() *graphql.Time {
+	return optionalTime(r.user.AwayUntil)
+}
+
+func (r *userResolver) Reviews(ctx context.Context, args struct{ Status *string }) ([]*prResolver, error) {
+	pullRequests, _, err := loadersFrom(ctx).reviews.Load(ctx, r.user.ID)
+	if err != nil {
+		return nil, toError(err, "could not load reviews")
+	}
+
+	res := make([]*prResolver, 0, len(pullRequests))
+	for _, pr := range pullRequests {
+		if args.Status != nil && string(pr.Status) != *args.Status {
+			continue
+		}
+
+		res = append(res, &prResolver{pr: pr})
+	}
+
+	return res, nil
+}
+
+type prResolver struct {
+	pr domain.PullRequest
+}
+
+func (r *prResolver) Repository() string {
+	return r.pr.Repository
+}
+
+func (r *prResolver) ID() graphql.ID {
+	return graphql.ID(r.pr.ID)
+}
+
+func (r *prResolver) Name() string {
+	return r.pr.Name
+}
+
+func (r *prResolver) Status() string {
+	return string(r.pr.Status)
+}
+
+func (r *prResolver) CreatedAt() *graphql.Time {
+	if r.pr.CreatedAt.IsZero() {
+		return nil
+	}
+
+	return &graphql.Time{Time: r.pr.CreatedAt}
+}
+
+func (r *prResolver) MergedAt() *graphql.Time {
+	return optionalTime(r.pr.MergedAt)
+}
+
+func (r *prResolver) Author(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.pr.AuthorID)
+}
+
+func (r *prResolver) Reviewers(ctx context.Context) ([]*userResolver, error) {
+	return loadUsers(ctx, r.pr.Reviewers)
+}
+
+type reassignResolver struct {
+	pr         *domain.PullRequest
+	replacedBy string
+}
+
+func (r *reassignResolver) PullRequest() *prResolver {
+	return &prResolver{pr: *r.pr}
+}
+
+func (r *reassignResolver) ReplacedBy(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.replacedBy)
+}
+
+type reviewResolver struct {
+	review *domain.Review
+}
+
+func (r *reviewResolver) Repository() string {
+	return r.review.Repository
+}
+
+func (r *reviewResolver) PullRequestID() graphql.ID {
+	return graphql.ID(r.review.PullRequestID)
+}
+
+func (r *reviewResolver) Reviewer(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.review.ReviewerID)
+}
+
+func (r *reviewResolver) State() string {
+	return string(r.review.State)
+}
+
+func (r *reviewResolver) SubmittedAt() graphql.Time {
+	return graphql.Time{Time: r.review.SubmittedAt}
+}
+
+// loadUser загружает пользователя через загрузчик запроса; nil, если пользователь не найден.
+func loadUser(ctx context.Context, id string) (*userResolver, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	l := loadersFrom(ctx)
+	l.reviews.Expect(id)
+
+	user, found, err := l.users.Load(ctx, id)
+	if err != nil {
+		return nil, toError(err, "could not load user")
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return &userResolver{user: user}, nil
+}
+
+// loadUsers загружает найденных пользователей в порядке ids и отмечает их ревью
+// как ожидаемые, чтобы поле reviews всех пользователей списка загружалось одним пакетом.
+func loadUsers(ctx context.Context, ids []string) ([]*userResolver, error) {
+	l := loadersFrom(ctx)
+	l.reviews.Expect(ids...)
+
+	users, err := l.users.LoadMany(ctx, ids)
+	if err != nil {
+		return nil, toError(err, "could not load users")
+	}
+
+	res := make([]*userResolver, len(users))
+	for i, u := range users {
+		res[i] = &userResolver{user: u}
+	}
+
+	return res, nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+
+	return &graphql.Time{Time: *t}
+}
@@ -0,0 +1,112 @@
+package grpcapi
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"avitotech-pr-reviewer/internal/domain"
+	reviewerv1 "avitotech-pr-reviewer/pkg/api/reviewer/v1"
+)
+
+func toProtoTeam(t *domain.Team) *reviewerv1.Team {
+	members := make([]*reviewerv1.TeamMember, len(t.Members))
+	for i, m := range t.Members {
+		members[i] = &reviewerv1.TeamMember{
+			UserId:   m.ID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		}
+	}
+
+	var sla *reviewerv1.TeamSLA
+	if t.SLA.Enabled() {
+		sla = &reviewerv1.TeamSLA{
+			ReviewSlaHours: int32(t.SLA.ReviewHours),
+			Action:         string(t.SLA.Action),
+			LeadId:         t.SLA.LeadID,
+		}
+	}
+
+	return &reviewerv1.Team{
+		TeamName: t.Name,
+		Members:  members,
+		Sla:      sla,
+	}
+}
+
+func fromProtoMembers(members []*reviewerv1.TeamMember) []domain.Member {
+	res := make([]domain.Member, len(members))
+	for i, m := range members {
+		res[i] = domain.Member{
+			ID:       m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+			Email:    m.GetEmail(),
+		}
+	}
+
+	return res
+}
+
+func toProtoUser(u *domain.User) *reviewerv1.User {
+	return &reviewerv1.User{
+		UserId:            u.ID,
+		Username:          u.Username,
+		TeamName:          u.TeamName,
+		IsActive:          u.IsActive,
+		Email:             u.Email,
+		ReminderFrequency: string(u.ReminderFrequency),
+		ChatUserId:        u.ChatUserID,
+		AwayUntil:         toProtoTime(u.AwayUntil),
+	}
+}
+
+func toProtoPR(pr *domain.PullRequest) *reviewerv1.PullRequest {
+	return &reviewerv1.PullRequest{
+		Repository:        pr.Repository,
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            string(pr.Status),
+		AssignedReviewers: pr.Reviewers,
+		MergedAt:          toProtoTime(pr.MergedAt),
+	}
+}
+
+func toProtoReview(r *domain.Review) *reviewerv1.Review {
+	return &reviewerv1.Review{
+		PullRequestId: r.PullRequestID,
+		ReviewerId:    r.ReviewerID,
+		State:         string(r.State),
+		SubmittedAt:   timestamppb.New(r.SubmittedAt),
+	}
+}
+
+func toProtoOverdue(assignments []domain.ReviewAssignment) []*reviewerv1.OverdueAssignment {
+	res := make([]*reviewerv1.OverdueAssignment, len(assignments))
+	for i, a := range assignments {
+		res[i] = &reviewerv1.OverdueAssignment{
+			Repository:      a.Repository,
+			PullRequestId:   a.PullRequestID,
+			PullRequestName: a.PullRequestName,
+			AuthorId:        a.AuthorID,
+			ReviewerId:      a.ReviewerID,
+			TeamName:        a.TeamName,
+			AssignedAt:      timestamppb.New(a.AssignedAt),
+			OverdueAt:       toProtoTime(a.OverdueAt),
+			ReviewSlaHours:  int32(a.SLA.ReviewHours),
+			LeadId:          a.SLA.LeadID,
+		}
+	}
+
+	return res
+}
+
+func toProtoTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,70 @@
+package grpcapi
+
+import (
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"avitotech-pr-reviewer/internal/api/response"
+)
+
+// errorDomain — домен google.rpc.ErrorInfo, в поле reason которого передаётся код ошибки HTTP API.
+const errorDomain = "pr-reviewer"
+
+// toStatus переводит ошибку сервисного слоя в статус gRPC. Текст внутренних ошибок
+// и прерванных запросов клиенту не передаётся.
+func toStatus(err error, message string) error {
+	code := response.CodeOf(err)
+	if response.Status(code) < http.StatusInternalServerError {
+		message = err.Error()
+	}
+
+	return newStatus(grpcCode(code), code, message)
+}
+
+func invalidArgument(message string) error {
+	return newStatus(codes.InvalidArgument, response.BadRequest, message)
+}
+
+func newStatus(code codes.Code, errCode response.ErrorCode, message string) error {
+	st := status.New(code, message)
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(errCode),
+		Domain: errorDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// grpcCode возвращает статус gRPC для кода ошибки API.
+func grpcCode(code response.ErrorCode) codes.Code {
+	switch code {
+	case response.TeamExists, response.PrExists, response.RepositoryExists,
+		response.OrganizationExists, response.ChatUserLinked:
+		return codes.AlreadyExists
+	case response.PrMerged, response.NoCandidatesForNewReviewer:
+		return codes.FailedPrecondition
+	case response.NotFound:
+		return codes.NotFound
+	case response.BadRequest:
+		return codes.InvalidArgument
+	case response.Unauthorized:
+		return codes.Unauthenticated
+	case response.Forbidden:
+		return codes.PermissionDenied
+	case response.Timeout:
+		return codes.DeadlineExceeded
+	case response.Unavailable:
+		return codes.Unavailable
+	case response.InternalError:
+		return codes.Internal
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,99 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"avitotech-pr-reviewer/internal/api/response"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+)
+
+// TestToStatus_MatchesHTTP проверяет, что HTTP и gRPC сообщают об ошибке сервиса
+// одинаковым кодом и текстом.
+func TestToStatus_MatchesHTTP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode response.ErrorCode
+		expectedGRPC codes.Code
+	}{
+		{name: "team exists", err: svcErr.ErrTeamExists, expectedCode: response.TeamExists,
+			expectedGRPC: codes.AlreadyExists},
+		{name: "pr exists", err: svcErr.ErrPRExists, expectedCode: response.PrExists,
+			expectedGRPC: codes.AlreadyExists},
+		{name: "repository exists", err: svcErr.ErrRepositoryExists, expectedCode: response.RepositoryExists,
+			expectedGRPC: codes.AlreadyExists},
+		{name: "organization exists", err: svcErr.ErrOrganizationExists, expectedCode: response.OrganizationExists,
+			expectedGRPC: codes.AlreadyExists},
+		{name: "chat user linked", err: svcErr.ErrChatUserLinked, expectedCode: response.ChatUserLinked,
+			expectedGRPC: codes.AlreadyExists},
+		{name: "pr merged", err: svcErr.ErrPRAlreadyMerged, expectedCode: response.PrMerged,
+			expectedGRPC: codes.FailedPrecondition},
+		{name: "no candidates", err: svcErr.ErrPRNoCandidates, expectedCode: response.NoCandidatesForNewReviewer,
+			expectedGRPC: codes.FailedPrecondition},
+		{name: "team not found", err: svcErr.ErrTeamNotFound, expectedCode: response.NotFound,
+			expectedGRPC: codes.NotFound},
+		{name: "reassign team not found", err: fmt.Errorf("pullrequest.ReassignReviewer: %w", svcErr.ErrTeamNotFound),
+			expectedCode: response.NotFound, expectedGRPC: codes.NotFound},
+		{name: "user not found", err: svcErr.ErrUserNotFound, expectedCode: response.NotFound,
+			expectedGRPC: codes.NotFound},
+		{name: "pr not found", err: svcErr.ErrPRNotFound, expectedCode: response.NotFound,
+			expectedGRPC: codes.NotFound},
+		{name: "repository not found", err: svcErr.ErrRepositoryNotFound, expectedCode: response.NotFound,
+			expectedGRPC: codes.NotFound},
+		{name: "reviewer not assigned", err: svcErr.ErrReviewerNotAssigned, expectedCode: response.NotFound,
+			expectedGRPC: codes.NotFound},
+		{name: "chat user not linked", err: svcErr.ErrChatUserNotLinked, expectedCode: response.NotFound,
+			expectedGRPC: codes.NotFound},
+		{name: "invalid sla", err: svcErr.ErrInvalidSLA, expectedCode: response.BadRequest,
+			expectedGRPC: codes.InvalidArgument},
+		{name: "invalid team import", err: fmt.Errorf("%w: line 2", svcErr.ErrInvalidTeamImport),
+			expectedCode: response.BadRequest, expectedGRPC: codes.InvalidArgument},
+		{name: "invalid email", err: svcErr.ErrInvalidEmail, expectedCode: response.BadRequest,
+			expectedGRPC: codes.InvalidArgument},
+		{name: "invalid codeowners", err: svcErr.ErrInvalidCodeOwners, expectedCode: response.BadRequest,
+			expectedGRPC: codes.InvalidArgument},
+		{name: "invalid stats filter", err: svcErr.ErrInvalidStatsFilter, expectedCode: response.BadRequest,
+			expectedGRPC: codes.InvalidArgument},
+		{name: "invalid token", err: svcErr.ErrInvalidToken, expectedCode: response.Unauthorized,
+			expectedGRPC: codes.Unauthenticated},
+		{name: "deadline exceeded", err: fmt.Errorf("op: %w", context.DeadlineExceeded),
+			expectedCode: response.Timeout, expectedGRPC: codes.DeadlineExceeded},
+		{name: "canceled", err: fmt.Errorf("op: %w", context.Canceled), expectedCode: response.Unavailable,
+			expectedGRPC: codes.Unavailable},
+		{name: "unexpected", err: errUnexpected, expectedCode: response.InternalError,
+			expectedGRPC: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			response.NewServiceError(c, tt.err, "failed to handle request")
+
+			var body response.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedCode, body.Error.Code)
+			assert.Equal(t, response.Status(tt.expectedCode), rec.Code)
+
+			err := toStatus(tt.err, "failed to handle request")
+			assert.Equal(t, tt.expectedGRPC, status.Code(err))
+			assert.Equal(t, string(body.Error.Code), reasonOf(t, err))
+			if response.Status(tt.expectedCode) < http.StatusInternalServerError {
+				assert.Equal(t, body.Error.Message, status.Convert(err).Message())
+			}
+		})
+	}
+}
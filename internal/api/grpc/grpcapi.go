@@ -0,0 +1,137 @@
+// Package grpcapi реализует gRPC API сервиса (см. api/proto/reviewer/v1/reviewer.proto).
+// Серверы вызывают тот же сервисный слой, что и HTTP обработчики, а ошибки сервисов
+// переводятся в статусы gRPC по общей с HTTP таблице response.CodeOf.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+const (
+	adminTokenKey    = "x-admin-token"
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+)
+
+type Authenticator func(ctx context.Context, token string) (*domain.Principal, error)
+
+// UnaryAuth определяет субъекта запроса по токену из метаданных authorization (Bearer)
+// или x-admin-token и сохраняет его в контексте запроса так же, как middleware.Authenticate для HTTP.
+func UnaryAuth(authenticate Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		principal, err := authenticate(ctx, token(ctx))
+		if errors.Is(err, svcErr.ErrTokenRequired) {
+			return nil, newStatus(codes.Unauthenticated, response.Unauthorized, "token is required")
+		}
+		if errors.Is(err, svcErr.ErrInvalidToken) {
+			return nil, newStatus(codes.Unauthenticated, response.Unauthorized, "invalid token")
+		}
+		if err != nil {
+			return nil, newStatus(codes.Internal, response.InternalError, "failed to verify token")
+		}
+
+		return handler(tenant.WithPrincipal(ctx, *principal), req)
+	}
+}
+
+// UnaryLogger логирует каждый вызов с его статусом и длительностью и превращает панику
+// обработчика в статус Internal.
+func UnaryLogger(base *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp any, err error) {
+		lgr := base.WithGroup("grpc").With(slog.String("method", info.FullMethod))
+
+		start := time.Now()
+
+		defer func() {
+			if r := recover(); r != nil {
+				lgr.ErrorContext(ctx, "handler panic", slog.Any("panic", r))
+				err = newStatus(codes.Internal, response.InternalError, "internal error")
+			}
+
+			st := status.Convert(err)
+			lgr = lgr.With(
+				slog.String("code", st.Code().String()),
+				slog.Duration("latency", time.Since(start)),
+			)
+
+			switch st.Code() {
+			case codes.OK:
+				lgr.InfoContext(ctx, "request completed")
+			case codes.Internal, codes.Unknown:
+				lgr.ErrorContext(ctx, "request failed", slog.String("error", st.Message()))
+			default:
+				lgr.WarnContext(ctx, "request failed", slog.String("error", st.Message()))
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// requireUser пропускает администраторов и владельца токена, выданного пользователю userID.
+func requireUser(ctx context.Context, userID string) error {
+	principal, ok := tenant.PrincipalFrom(ctx)
+	if !ok || token(ctx) == "" {
+		return newStatus(codes.Unauthenticated, response.Unauthorized, "user or admin token is required")
+	}
+
+	if !principal.CanActAs(userID) {
+		return newStatus(codes.PermissionDenied, response.Forbidden, "only the user or an admin can change user settings")
+	}
+
+	return nil
+}
+
+// requireAdmin пропускает только запросы администраторов организации, как middleware.AdminAuth.
+func requireAdmin(ctx context.Context) error {
+	principal, ok := tenant.PrincipalFrom(ctx)
+	if !ok || token(ctx) == "" {
+		return newStatus(codes.Unauthenticated, response.Unauthorized, "admin token is required")
+	}
+
+	if !principal.IsAdmin() {
+		return newStatus(codes.PermissionDenied, response.Forbidden, "admin rights are required")
+	}
+
+	return nil
+}
+
+func token(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, v := range md.Get(authorizationKey) {
+		bearer, ok := strings.CutPrefix(v, bearerPrefix)
+		if ok {
+			return strings.TrimSpace(bearer)
+		}
+	}
+
+	tokens := md.Get(adminTokenKey)
+	if len(tokens) == 0 {
+		return ""
+	}
+
+	return tokens[0]
+}
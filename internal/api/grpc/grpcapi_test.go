@@ -0,0 +1,233 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+func reasonOf(t *testing.T, err error) string {
+	t.Helper()
+
+	st := status.Convert(err)
+	for _, d := range st.Details() {
+		info, ok := d.(*errdetails.ErrorInfo)
+		if ok {
+			return info.GetReason()
+		}
+	}
+
+	return ""
+}
+
+func TestUnaryAuth(t *testing.T) {
+	authenticate := func(_ context.Context, token string) (*domain.Principal, error) {
+		switch token {
+		case "":
+			return &domain.Principal{OrgID: domain.DefaultOrganizationID, Role: domain.RoleMember}, nil
+		case "admin":
+			return &domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin}, nil
+		case "broken":
+			return nil, errUnexpected
+		default:
+			return nil, svcErr.ErrInvalidToken
+		}
+	}
+
+	tests := []struct {
+		name              string
+		md                metadata.MD
+		expectedPrincipal domain.Principal
+		expectedAdminCode codes.Code
+		expectedCode      codes.Code
+	}{
+		{
+			name:              "success - bearer token",
+			md:                metadata.Pairs("authorization", "Bearer admin"),
+			expectedPrincipal: domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin},
+			expectedAdminCode: codes.OK,
+		},
+		{
+			name:              "success - admin token",
+			md:                metadata.Pairs("x-admin-token", "admin"),
+			expectedPrincipal: domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin},
+			expectedAdminCode: codes.OK,
+		},
+		{
+			name:              "success - no token reads default organization",
+			md:                metadata.MD{},
+			expectedPrincipal: domain.Principal{OrgID: domain.DefaultOrganizationID, Role: domain.RoleMember},
+			expectedAdminCode: codes.Unauthenticated,
+		},
+		{
+			name:         "error - invalid token",
+			md:           metadata.Pairs("authorization", "Bearer nope"),
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "error - authenticator failure",
+			md:           metadata.Pairs("x-admin-token", "broken"),
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			var (
+				principal domain.Principal
+				adminErr  error
+			)
+			handler := func(ctx context.Context, _ any) (any, error) {
+				principal, _ = tenant.PrincipalFrom(ctx)
+				adminErr = requireAdmin(ctx)
+
+				return "ok", nil
+			}
+
+			resp, err := UnaryAuth(authenticate)(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedCode, status.Code(err))
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "ok", resp)
+			assert.Equal(t, tt.expectedPrincipal, principal)
+			assert.Equal(t, tt.expectedAdminCode, status.Code(adminErr))
+		})
+	}
+}
+
+func TestRequireAdmin_Member(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-admin-token", "member"))
+	ctx = tenant.WithPrincipal(ctx, domain.Principal{OrgID: "org-1", Role: domain.RoleMember})
+
+	err := requireAdmin(ctx)
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "FORBIDDEN", reasonOf(t, err))
+}
+
+func TestRequireUser(t *testing.T) {
+	tests := []struct {
+		name         string
+		principal    domain.Principal
+		expectedCode codes.Code
+	}{
+		{
+			name:         "token issued to the user",
+			principal:    domain.Principal{OrgID: "org-1", Role: domain.RoleMember, UserID: "u1"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "admin",
+			principal:    domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "token issued to another user",
+			principal:    domain.Principal{OrgID: "org-1", Role: domain.RoleMember, UserID: "u2"},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-admin-token", "token"))
+			ctx = tenant.WithPrincipal(ctx, tt.principal)
+
+			err := requireUser(ctx, "u1")
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    codes.Code
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "already exists",
+			err:             svcErr.ErrTeamExists,
+			expectedCode:    codes.AlreadyExists,
+			expectedReason:  "TEAM_EXISTS",
+			expectedMessage: svcErr.ErrTeamExists.Error(),
+		},
+		{
+			name:            "not found",
+			err:             svcErr.ErrPRNotFound,
+			expectedCode:    codes.NotFound,
+			expectedReason:  "NOT_FOUND",
+			expectedMessage: svcErr.ErrPRNotFound.Error(),
+		},
+		{
+			name:            "no candidates",
+			err:             svcErr.ErrPRNoCandidates,
+			expectedCode:    codes.FailedPrecondition,
+			expectedReason:  "NO_CANDIDATES_FOR_NEW_REVIEWER",
+			expectedMessage: svcErr.ErrPRNoCandidates.Error(),
+		},
+		{
+			name:            "invalid argument",
+			err:             svcErr.ErrInvalidEmail,
+			expectedCode:    codes.InvalidArgument,
+			expectedReason:  "BAD_REQUEST",
+			expectedMessage: svcErr.ErrInvalidEmail.Error(),
+		},
+		{
+			name:            "internal error hides details",
+			err:             errUnexpected,
+			expectedCode:    codes.Internal,
+			expectedReason:  "INTERNAL_ERROR",
+			expectedMessage: "failed to do something",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := toStatus(tt.err, "failed to do something")
+
+			st := status.Convert(err)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMessage, st.Message())
+			assert.Equal(t, tt.expectedReason, reasonOf(t, err))
+		})
+	}
+}
+
+func TestUnaryLogger_RecoversPanic(t *testing.T) {
+	handler := func(context.Context, any) (any, error) {
+		panic("boom")
+	}
+
+	resp, err := UnaryLogger(slog.New(slog.DiscardHandler))(
+		context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/reviewer.v1.TeamService/GetTeam"}, handler,
+	)
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
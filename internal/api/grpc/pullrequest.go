@@ -0,0 +1,140 @@
+package grpcapi
+
+import (
+	"context"
+
+	"avitotech-pr-reviewer/internal/domain"
+	reviewerv1 "avitotech-pr-reviewer/pkg/api/reviewer/v1"
+)
+
+type prService interface {
+	CreatePullRequest(
+		ctx context.Context,
+		repository, id, name, authorID string,
+		changedFiles []string,
+	) (*domain.PullRequest, error)
+	SetMerged(ctx context.Context, repository, prID string) (*domain.PullRequest, error)
+	ReassignReviewer(ctx context.Context, repository, prID, oldReviewerID string) (*domain.PullRequest, string, error)
+	SubmitReview(
+		ctx context.Context,
+		repository, prID, reviewerID string,
+		state domain.ReviewState,
+	) (*domain.Review, error)
+	OverdueAssignments(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error)
+}
+
+type PullRequestServer struct {
+	reviewerv1.UnimplementedPullRequestServiceServer
+
+	prSvc prService
+}
+
+func NewPullRequestServer(prSvc prService) *PullRequestServer {
+	return &PullRequestServer{
+		prSvc: prSvc,
+	}
+}
+
+func (s *PullRequestServer) CreatePullRequest(
+	ctx context.Context,
+	req *reviewerv1.CreatePullRequestRequest,
+) (*reviewerv1.CreatePullRequestResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPullRequestId() == "" || req.GetPullRequestName() == "" || req.GetAuthorId() == "" {
+		return nil, invalidArgument("pull_request_id, pull_request_name and author_id are required")
+	}
+
+	pr, err := s.prSvc.CreatePullRequest(ctx, req.GetRepository(), req.GetPullRequestId(),
+		req.GetPullRequestName(), req.GetAuthorId(), req.GetChangedFiles())
+	if err != nil {
+		return nil, toStatus(err, "failed to create pull request")
+	}
+
+	return &reviewerv1.CreatePullRequestResponse{Pr: toProtoPR(pr)}, nil
+}
+
+func (s *PullRequestServer) MergePullRequest(
+	ctx context.Context,
+	req *reviewerv1.MergePullRequestRequest,
+) (*reviewerv1.MergePullRequestResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id is required")
+	}
+
+	pr, err := s.prSvc.SetMerged(ctx, req.GetRepository(), req.GetPullRequestId())
+	if err != nil {
+		return nil, toStatus(err, "failed to merge pull request")
+	}
+
+	return &reviewerv1.MergePullRequestResponse{Pr: toProtoPR(pr)}, nil
+}
+
+func (s *PullRequestServer) ReassignReviewer(
+	ctx context.Context,
+	req *reviewerv1.ReassignReviewerRequest,
+) (*reviewerv1.ReassignReviewerResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPullRequestId() == "" || req.GetOldReviewerId() == "" {
+		return nil, invalidArgument("pull_request_id and old_reviewer_id are required")
+	}
+
+	pr, replacedBy, err := s.prSvc.ReassignReviewer(ctx, req.GetRepository(), req.GetPullRequestId(),
+		req.GetOldReviewerId())
+	if err != nil {
+		return nil, toStatus(err, "failed to reassign reviewer")
+	}
+
+	return &reviewerv1.ReassignReviewerResponse{Pr: toProtoPR(pr), ReplacedBy: replacedBy}, nil
+}
+
+func (s *PullRequestServer) SubmitReview(
+	ctx context.Context,
+	req *reviewerv1.SubmitReviewRequest,
+) (*reviewerv1.SubmitReviewResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPullRequestId() == "" || req.GetReviewerId() == "" || req.GetState() == "" {
+		return nil, invalidArgument("pull_request_id, reviewer_id and state are required")
+	}
+
+	review, err := s.prSvc.SubmitReview(ctx, req.GetRepository(), req.GetPullRequestId(), req.GetReviewerId(),
+		domain.ReviewState(req.GetState()))
+	if err != nil {
+		return nil, toStatus(err, "failed to submit review")
+	}
+
+	return &reviewerv1.SubmitReviewResponse{Review: toProtoReview(review)}, nil
+}
+
+func (s *PullRequestServer) ListOverdue(
+	ctx context.Context,
+	req *reviewerv1.ListOverdueRequest,
+) (*reviewerv1.ListOverdueResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.prSvc.OverdueAssignments(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err, "failed to list overdue reviews")
+	}
+
+	return &reviewerv1.ListOverdueResponse{Assignments: toProtoOverdue(assignments)}, nil
+}
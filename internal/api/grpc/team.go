@@ -0,0 +1,84 @@
+package grpcapi
+
+import (
+	"context"
+
+	"avitotech-pr-reviewer/internal/domain"
+	reviewerv1 "avitotech-pr-reviewer/pkg/api/reviewer/v1"
+)
+
+type teamService interface {
+	CreateTeam(ctx context.Context, teamName string, members []domain.Member) (*domain.Team, error)
+	TeamWithMembers(ctx context.Context, teamName string) (*domain.Team, error)
+	SetSLA(ctx context.Context, teamName string, sla domain.TeamSLA) (*domain.Team, error)
+}
+
+type TeamServer struct {
+	reviewerv1.UnimplementedTeamServiceServer
+
+	teamSvc teamService
+}
+
+func NewTeamServer(teamSvc teamService) *TeamServer {
+	return &TeamServer{
+		teamSvc: teamSvc,
+	}
+}
+
+func (s *TeamServer) AddTeam(ctx context.Context, req *reviewerv1.AddTeamRequest) (*reviewerv1.AddTeamResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+
+	team, err := s.teamSvc.CreateTeam(ctx, req.GetTeamName(), fromProtoMembers(req.GetMembers()))
+	if err != nil {
+		return nil, toStatus(err, "could not create team")
+	}
+
+	return &reviewerv1.AddTeamResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *TeamServer) GetTeam(ctx context.Context, req *reviewerv1.GetTeamRequest) (*reviewerv1.GetTeamResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+
+	team, err := s.teamSvc.TeamWithMembers(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err, "could not retrieve team")
+	}
+
+	return &reviewerv1.GetTeamResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *TeamServer) SetTeamSLA(
+	ctx context.Context,
+	req *reviewerv1.SetTeamSLARequest,
+) (*reviewerv1.SetTeamSLAResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+
+	sla := domain.TeamSLA{
+		ReviewHours: int(req.GetSla().GetReviewSlaHours()),
+		Action:      domain.SLAAction(req.GetSla().GetAction()),
+		LeadID:      req.GetSla().GetLeadId(),
+	}
+
+	team, err := s.teamSvc.SetSLA(ctx, req.GetTeamName(), sla)
+	if err != nil {
+		return nil, toStatus(err, "could not update team SLA")
+	}
+
+	return &reviewerv1.SetTeamSLAResponse{Team: toProtoTeam(team)}, nil
+}
@@ -0,0 +1,133 @@
+package grpcapi
+
+import (
+	"context"
+
+	"avitotech-pr-reviewer/internal/domain"
+	reviewerv1 "avitotech-pr-reviewer/pkg/api/reviewer/v1"
+)
+
+type userService interface {
+	SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
+	SetReminderFrequency(ctx context.Context, userID string, frequency domain.ReminderFrequency) (*domain.User, error)
+	SetEmail(ctx context.Context, userID, email string) (*domain.User, error)
+	LinkChat(ctx context.Context, userID, chatUserID string) (*domain.User, error)
+	GetReview(ctx context.Context, userID string) ([]domain.PullRequest, error)
+}
+
+type UserServer struct {
+	reviewerv1.UnimplementedUserServiceServer
+
+	userSvc userService
+}
+
+func NewUserServer(userSvc userService) *UserServer {
+	return &UserServer{
+		userSvc: userSvc,
+	}
+}
+
+func (s *UserServer) SetIsActive(
+	ctx context.Context,
+	req *reviewerv1.SetIsActiveRequest,
+) (*reviewerv1.SetIsActiveResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	user, err := s.userSvc.SetIsActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, toStatus(err, "failed to set user active status")
+	}
+
+	return &reviewerv1.SetIsActiveResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *UserServer) SetReminders(
+	ctx context.Context,
+	req *reviewerv1.SetRemindersRequest,
+) (*reviewerv1.SetRemindersResponse, error) {
+	if req.GetUserId() == "" || req.GetFrequency() == "" {
+		return nil, invalidArgument("user_id and frequency are required")
+	}
+
+	err := requireUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userSvc.SetReminderFrequency(ctx, req.GetUserId(), domain.ReminderFrequency(req.GetFrequency()))
+	if err != nil {
+		return nil, toStatus(err, "failed to set user reminder frequency")
+	}
+
+	return &reviewerv1.SetRemindersResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *UserServer) SetEmail(ctx context.Context, req *reviewerv1.SetEmailRequest) (*reviewerv1.SetEmailResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	user, err := s.userSvc.SetEmail(ctx, req.GetUserId(), req.GetEmail())
+	if err != nil {
+		return nil, toStatus(err, "failed to set user email")
+	}
+
+	return &reviewerv1.SetEmailResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *UserServer) LinkChat(ctx context.Context, req *reviewerv1.LinkChatRequest) (*reviewerv1.LinkChatResponse, error) {
+	err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	user, err := s.userSvc.LinkChat(ctx, req.GetUserId(), req.GetChatUserId())
+	if err != nil {
+		return nil, toStatus(err, "failed to link chat user")
+	}
+
+	return &reviewerv1.LinkChatResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *UserServer) GetReview(
+	ctx context.Context,
+	req *reviewerv1.GetReviewRequest,
+) (*reviewerv1.GetReviewResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	pullRequests, err := s.userSvc.GetReview(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err, "failed to get user reviews")
+	}
+
+	short := make([]*reviewerv1.PullRequestShort, len(pullRequests))
+	for i, pr := range pullRequests {
+		short[i] = &reviewerv1.PullRequestShort{
+			Repository:      pr.Repository,
+			PullRequestId:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorId:        pr.AuthorID,
+			Status:          string(pr.Status),
+		}
+	}
+
+	return &reviewerv1.GetReviewResponse{UserId: req.GetUserId(), PullRequests: short}, nil
+}
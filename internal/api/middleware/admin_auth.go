@@ -1,36 +1,64 @@
 package middleware
 
 import (
-	"context"
-
 	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/tenant"
 
 	"github.com/gin-gonic/gin"
 )
 
-const adminTokenHeader = "X-Admin-Token"
-
-type AdminTokenVerifier func(ctx context.Context, token string) (bool, error)
-
-func AdminAuth(verifyFunc AdminTokenVerifier) gin.HandlerFunc {
+// AdminAuth пропускает только запросы администраторов организации.
+// Должен использоваться после Authenticate.
+func AdminAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		token := c.GetHeader(adminTokenHeader)
-		if token == "" {
+		principal, ok := tenant.PrincipalFrom(c.Request.Context())
+		if !ok || !HasToken(c) {
 			response.NewError(c, response.Unauthorized, "admin token is required", nil)
 			return
 		}
 
-		isValid, err := verifyFunc(c.Request.Context(), token)
-		if err != nil {
-			response.NewError(c, response.InternalError, "failed to verify admin token", err)
+		if !principal.IsAdmin() {
+			response.NewError(c, response.Forbidden, "admin rights are required", nil)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RootAuth пропускает только запросы с токеном из конфигурации сервиса.
+// Должен использоваться после Authenticate.
+func RootAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := tenant.PrincipalFrom(c.Request.Context())
+		if !ok || !HasToken(c) {
+			response.NewError(c, response.Unauthorized, "admin token is required", nil)
 			return
 		}
 
-		if !isValid {
-			response.NewError(c, response.Unauthorized, "invalid admin token", nil)
+		if !principal.IsRoot() {
+			response.NewError(c, response.Forbidden, "root rights are required", nil)
 			return
 		}
 
 		c.Next()
 	}
 }
+
+// AuthorizeUser проверяет, что субъект запроса может менять данные пользователя userID:
+// это администратор или владелец токена, выданного этому пользователю. Иначе отвечает ошибкой
+// и возвращает false. Используется обработчиками, которые узнают пользователя из тела запроса.
+func AuthorizeUser(c *gin.Context, userID string) bool {
+	principal, ok := tenant.PrincipalFrom(c.Request.Context())
+	if !ok || !HasToken(c) {
+		response.NewError(c, response.Unauthorized, "user or admin token is required", nil)
+		return false
+	}
+
+	if !principal.CanActAs(userID) {
+		response.NewError(c, response.Forbidden, "only the user or an admin can change user settings", nil)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/tenant"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	adminTokenHeader    = "X-Admin-Token"
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type Authenticator func(ctx context.Context, token string) (*domain.Principal, error)
+
+// Authenticate определяет субъекта запроса по токену из заголовка Authorization (Bearer)
+// или X-Admin-Token и сохраняет его в контексте запроса.
+// Организация субъекта ограничивает все последующие обращения к данным.
+func Authenticate(authenticate Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, err := authenticate(c.Request.Context(), token(c))
+		if err != nil {
+			response.NewServiceError(c, err, "failed to verify token")
+			return
+		}
+
+		c.Request = c.Request.WithContext(tenant.WithPrincipal(c.Request.Context(), *principal))
+
+		c.Next()
+	}
+}
+
+func token(c *gin.Context) string {
+	bearer, ok := strings.CutPrefix(c.GetHeader(authorizationHeader), bearerPrefix)
+	if ok {
+		return strings.TrimSpace(bearer)
+	}
+
+	return c.GetHeader(adminTokenHeader)
+}
+
+// HasToken сообщает, передан ли в запросе токен. Запросы без токена, если они разрешены, читают данные
+// организации по умолчанию, но не получают прав администратора.
+func HasToken(c *gin.Context) bool {
+	return token(c) != ""
+}
@@ -39,7 +39,7 @@ func Logger(base *slog.Logger) gin.HandlerFunc {
 		start := time.Now()
 		lgr = lgr.With(slog.Time("start_time", start))
 
-		lgr.Info("request started")
+		lgr.InfoContext(c.Request.Context(), "request started")
 
 		c.Next()
 
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute — маршрут в метриках для запросов, не попавших ни в один обработчик.
+const unmatchedRoute = "unmatched"
+
+// HTTPRecorder учитывает обработанные HTTP-запросы в метриках.
+type HTTPRecorder interface {
+	ObserveHTTPRequest(method, route string, status int, latency time.Duration)
+}
+
+// Metrics учитывает каждый запрос по шаблону маршрута, как он зарегистрирован в gin,
+// со статусом ответа и задержкой, которую измеряет сам. Middleware подключается снаружи
+// Timeout, чтобы учитывать ответ 504, который Timeout пишет после обработчика.
+// Запрос, обработчик которого паникует, учитывается со статусом 500, а паника
+// передаётся дальше, в Recovery.
+func Metrics(rec HTTPRecorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		defer func() {
+			status := c.Writer.Status()
+
+			p := recover()
+			if p != nil {
+				status = http.StatusInternalServerError
+			}
+
+			route := c.FullPath()
+			if route == "" {
+				route = unmatchedRoute
+			}
+
+			rec.ObserveHTTPRequest(c.Request.Method, route, status, time.Since(start))
+
+			if p != nil {
+				panic(p)
+			}
+		}()
+
+		c.Next()
+	}
+}
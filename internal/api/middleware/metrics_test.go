@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type observation struct {
+	method string
+	route  string
+	status int
+}
+
+type fakeRecorder struct {
+	observed []observation
+}
+
+func (r *fakeRecorder) ObserveHTTPRequest(method, route string, status int, latency time.Duration) {
+	r.observed = append(r.observed, observation{method: method, route: route, status: status})
+}
+
+func TestMetrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		path     string
+		handler  gin.HandlerFunc
+		expected observation
+	}{
+		{
+			name: "success",
+			path: "/team/get",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"status": "ok"})
+			},
+			expected: observation{method: http.MethodGet, route: "/team/get", status: http.StatusOK},
+		},
+		{
+			name: "timeout response written after handler",
+			path: "/team/get",
+			handler: func(c *gin.Context) {
+				<-c.Request.Context().Done()
+			},
+			expected: observation{method: http.MethodGet, route: "/team/get", status: http.StatusGatewayTimeout},
+		},
+		{
+			name: "panic",
+			path: "/team/get",
+			handler: func(c *gin.Context) {
+				panic("boom")
+			},
+			expected: observation{method: http.MethodGet, route: "/team/get", status: http.StatusInternalServerError},
+		},
+		{
+			name:     "unmatched route",
+			path:     "/nope",
+			expected: observation{method: http.MethodGet, route: unmatchedRoute, status: http.StatusNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &fakeRecorder{}
+
+			// Порядок middleware тот же, что и в приложении.
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(Metrics(rec))
+			router.Use(Timeout(20 * time.Millisecond))
+			if tt.handler != nil {
+				router.GET(tt.path, tt.handler)
+			}
+
+			resp := httptest.NewRecorder()
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, nil)
+			router.ServeHTTP(resp, req)
+
+			require.Len(t, rec.observed, 1)
+			assert.Equal(t, tt.expected, rec.observed[0])
+			assert.Equal(t, tt.expected.status, resp.Code)
+		})
+	}
+}
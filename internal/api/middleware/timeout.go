@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/response"
+)
+
+// Timeout ограничивает обработку запроса временем timeout. Срок записывается в контекст
+// http.Request, который обработчики передают в сервисы, поэтому его видят
+// и запросы к базе: по истечении срока они прерываются, а обработчик отвечает ошибкой TIMEOUT.
+// Маршруты из skip (шаблоны, как они зарегистрированы в gin) не ограничиваются —
+// это нужно долгоживущим потокам вроде /events/stream.
+func Timeout(timeout time.Duration, skip ...string) gin.HandlerFunc {
+	skipped := make(map[string]struct{}, len(skip))
+	for _, route := range skip {
+		skipped[route] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		if _, ok := skipped[c.FullPath()]; ok || timeout <= 0 {
+			c.Next()
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		// Обработчик мог завершиться, ничего не ответив, если прерванный вызов не вернул ошибку.
+		if !c.Writer.Written() && ctx.Err() != nil {
+			response.NewError(c, response.InternalError, "request interrupted", ctx.Err())
+		}
+	}
+}
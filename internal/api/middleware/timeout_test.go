@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/api/response"
+)
+
+func TestTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// waitForCtx имитирует сервис, который ждёт базу и прерывается вместе с контекстом.
+	waitForCtx := func(c *gin.Context) {
+		select {
+		case <-c.Request.Context().Done():
+			response.NewError(c, response.InternalError, "could not load team",
+				fmt.Errorf("team.TeamWithMembers: %w", c.Request.Context().Err()))
+		case <-time.After(time.Second):
+			c.JSON(http.StatusOK, gin.H{"status": "ok"})
+		}
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		handler    gin.HandlerFunc
+		wantStatus int
+		wantCode   response.ErrorCode
+	}{
+		{
+			name:       "deadline exceeded",
+			path:       "/team/get",
+			handler:    waitForCtx,
+			wantStatus: http.StatusGatewayTimeout,
+			wantCode:   response.Timeout,
+		},
+		{
+			name: "handler returned without response",
+			path: "/team/get",
+			handler: func(c *gin.Context) {
+				<-c.Request.Context().Done()
+			},
+			wantStatus: http.StatusGatewayTimeout,
+			wantCode:   response.Timeout,
+		},
+		{
+			name: "fast request",
+			path: "/team/get",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"status": "ok"})
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "limited route has deadline",
+			path: "/team/get",
+			handler: func(c *gin.Context) {
+				deadline, ok := c.Request.Context().Deadline()
+				assert.True(t, ok)
+				assert.WithinDuration(t, time.Now().Add(20*time.Millisecond), deadline, 20*time.Millisecond)
+
+				c.JSON(http.StatusOK, gin.H{"status": "ok"})
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "skipped route has no deadline",
+			path: "/events/stream",
+			handler: func(c *gin.Context) {
+				_, ok := c.Request.Context().Deadline()
+				assert.False(t, ok)
+
+				c.JSON(http.StatusOK, gin.H{"status": "ok"})
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(Timeout(20*time.Millisecond, "/events/stream"))
+			router.GET(tt.path, tt.handler)
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tt.path, nil)
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantCode == "" {
+				return
+			}
+
+			var body response.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.wantCode, body.Error.Code)
+			assert.Equal(t, "request timed out", body.Error.Message)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package response
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+)
+
+// CodeOf возвращает код ошибки API, соответствующий ошибке сервисного слоя.
+// Таблица общая для всех транспортов (HTTP, gRPC), чтобы они одинаково сообщали
+// клиентам об одних и тех же ошибках. Неизвестные ошибки считаются внутренними.
+func CodeOf(err error) ErrorCode {
+	switch {
+	case errors.Is(err, svcErr.ErrTeamExists):
+		return TeamExists
+	case errors.Is(err, svcErr.ErrPRExists):
+		return PrExists
+	case errors.Is(err, svcErr.ErrRepositoryExists):
+		return RepositoryExists
+	case errors.Is(err, svcErr.ErrOrganizationExists):
+		return OrganizationExists
+	case errors.Is(err, svcErr.ErrChatUserLinked):
+		return ChatUserLinked
+	case errors.Is(err, svcErr.ErrPRAlreadyMerged):
+		return PrMerged
+	case errors.Is(err, svcErr.ErrPRNoCandidates):
+		return NoCandidatesForNewReviewer
+	case errors.Is(err, svcErr.ErrInvalidToken), errors.Is(err, svcErr.ErrTokenRequired):
+		return Unauthorized
+	case errors.Is(err, svcErr.ErrTeamNotFound),
+		errors.Is(err, svcErr.ErrUserNotFound),
+		errors.Is(err, svcErr.ErrPRNotFound),
+		errors.Is(err, svcErr.ErrRepositoryNotFound),
+		errors.Is(err, svcErr.ErrReviewerNotAssigned),
+		errors.Is(err, svcErr.ErrChatUserNotLinked):
+		return NotFound
+	case errors.Is(err, svcErr.ErrInvalidSLA),
+		errors.Is(err, svcErr.ErrInvalidTeamImport),
+		errors.Is(err, svcErr.ErrInvalidReminderFrequency),
+		errors.Is(err, svcErr.ErrInvalidEmail),
+		errors.Is(err, svcErr.ErrInvalidReviewState),
+		errors.Is(err, svcErr.ErrInvalidCodeOwners),
+		errors.Is(err, svcErr.ErrInvalidRole),
+		errors.Is(err, svcErr.ErrInvalidStatsFilter):
+		return BadRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return Timeout
+	case errors.Is(err, context.Canceled):
+		return Unavailable
+	default:
+		return InternalError
+	}
+}
+
+// Status возвращает HTTP статус ответа с кодом ошибки code.
+func Status(code ErrorCode) int {
+	switch code {
+	case TeamExists, PrExists, PrMerged, RepositoryExists, OrganizationExists, ChatUserLinked:
+		return http.StatusConflict
+	case NotFound:
+		return http.StatusNotFound
+	case BadRequest:
+		return http.StatusBadRequest
+	case Unauthorized:
+		return http.StatusUnauthorized
+	case Forbidden:
+		return http.StatusForbidden
+	case NoCandidatesForNewReviewer:
+		return http.StatusUnprocessableEntity
+	case Timeout:
+		return http.StatusGatewayTimeout
+	case Unavailable:
+		return http.StatusServiceUnavailable
+	case InternalError:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,54 @@
+package response
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+)
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode ErrorCode
+		expectedHTTP int
+	}{
+		{name: "team exists", err: svcErr.ErrTeamExists, expectedCode: TeamExists, expectedHTTP: http.StatusConflict},
+		{name: "chat user linked", err: svcErr.ErrChatUserLinked, expectedCode: ChatUserLinked,
+			expectedHTTP: http.StatusConflict},
+		{name: "pr merged", err: svcErr.ErrPRAlreadyMerged, expectedCode: PrMerged, expectedHTTP: http.StatusConflict},
+		{name: "no candidates", err: svcErr.ErrPRNoCandidates, expectedCode: NoCandidatesForNewReviewer,
+			expectedHTTP: http.StatusUnprocessableEntity},
+		{name: "reviewer not assigned", err: svcErr.ErrReviewerNotAssigned, expectedCode: NotFound,
+			expectedHTTP: http.StatusNotFound},
+		{name: "wrapped not found", err: fmt.Errorf("op: %w", svcErr.ErrPRNotFound), expectedCode: NotFound,
+			expectedHTTP: http.StatusNotFound},
+		{name: "invalid review state", err: svcErr.ErrInvalidReviewState, expectedCode: BadRequest,
+			expectedHTTP: http.StatusBadRequest},
+		{name: "invalid team import", err: fmt.Errorf("%w: line 3", svcErr.ErrInvalidTeamImport),
+			expectedCode: BadRequest, expectedHTTP: http.StatusBadRequest},
+		{name: "invalid token", err: svcErr.ErrInvalidToken, expectedCode: Unauthorized,
+			expectedHTTP: http.StatusUnauthorized},
+		{name: "deadline exceeded", err: fmt.Errorf("op: %w", context.DeadlineExceeded), expectedCode: Timeout,
+			expectedHTTP: http.StatusGatewayTimeout},
+		{name: "canceled", err: fmt.Errorf("op: %w", context.Canceled), expectedCode: Unavailable,
+			expectedHTTP: http.StatusServiceUnavailable},
+		{name: "unknown error", err: errors.New("boom"), expectedCode: InternalError,
+			expectedHTTP: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := CodeOf(tt.err)
+
+			assert.Equal(t, tt.expectedCode, code)
+			assert.Equal(t, tt.expectedHTTP, Status(code))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package response
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,12 +13,20 @@ type ErrorCode string
 const (
 	TeamExists                 ErrorCode = "TEAM_EXISTS"
 	PrExists                   ErrorCode = "PR_EXISTS"
+	RepositoryExists           ErrorCode = "REPOSITORY_EXISTS"
+	OrganizationExists         ErrorCode = "ORGANIZATION_EXISTS"
+	ChatUserLinked             ErrorCode = "CHAT_USER_LINKED"
 	PrMerged                   ErrorCode = "PR_MERGED"
 	NotFound                   ErrorCode = "NOT_FOUND"
 	BadRequest                 ErrorCode = "BAD_REQUEST"
 	NoCandidatesForNewReviewer ErrorCode = "NO_CANDIDATES_FOR_NEW_REVIEWER"
 	Unauthorized               ErrorCode = "UNAUTHORIZED"
+	Forbidden                  ErrorCode = "FORBIDDEN"
 	InternalError              ErrorCode = "INTERNAL_ERROR"
+	// Timeout — запрос не уложился в отведённое время (http.gateway_timeout).
+	Timeout ErrorCode = "TIMEOUT"
+	// Unavailable — обработка прервана до ответа: клиент отключился или сервер останавливается.
+	Unavailable ErrorCode = "SERVICE_UNAVAILABLE"
 )
 
 type ErrorResponse struct {
@@ -37,39 +47,43 @@ func NewOK(c *gin.Context, data any) {
 }
 
 // NewError создает и отправляет JSON-ответ с ошибкой.
+// Ошибка, вызванная истёкшим или отменённым контекстом запроса,
+// отправляется с кодом TIMEOUT или SERVICE_UNAVAILABLE, чтобы клиент мог повторить запрос.
 func NewError(
 	c *gin.Context,
 	code ErrorCode,
 	message string,
 	err error,
 ) {
-	var status int
-
-	switch code {
-	case TeamExists, PrExists, PrMerged:
-		status = http.StatusConflict
-	case NotFound:
-		status = http.StatusNotFound
-	case BadRequest:
-		status = http.StatusBadRequest
-	case Unauthorized:
-		status = http.StatusUnauthorized
-	case NoCandidatesForNewReviewer:
-		status = http.StatusUnprocessableEntity
-	case InternalError:
-		status = http.StatusInternalServerError
-	default:
-		status = http.StatusInternalServerError
-	}
-
 	if err != nil {
 		_ = c.Error(err)
 	}
 
-	c.AbortWithStatusJSON(status, ErrorResponse{
+	if code == InternalError || code == Timeout || code == Unavailable {
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			code, message = Timeout, "request timed out"
+		case errors.Is(err, context.Canceled):
+			code, message = Unavailable, "request canceled"
+		}
+	}
+
+	c.AbortWithStatusJSON(Status(code), ErrorResponse{
 		Error: Error{
 			Code:    code,
 			Message: message,
 		},
 	})
 }
+
+// NewServiceError отправляет ответ с ошибкой сервисного слоя. Код ошибки определяется
+// по общей для всех транспортов таблице CodeOf. Клиенту передаётся текст ошибки,
+// если это ошибка запроса, и message, если внутренняя — так же, как в gRPC и GraphQL.
+func NewServiceError(c *gin.Context, err error, message string) {
+	code := CodeOf(err)
+	if Status(code) < http.StatusInternalServerError {
+		message = err.Error()
+	}
+
+	NewError(c, code, message, err)
+}
@@ -0,0 +1,62 @@
+package chat
+
+import (
+	"io"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/tenant"
+	"avitotech-pr-reviewer/pkg/slack"
+)
+
+const maxBodySize = 64 << 10
+
+func (h *handler) command(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxBodySize))
+	if err != nil {
+		response.NewError(c, response.BadRequest, "failed to read request body", err)
+		return
+	}
+
+	err = slack.Verify(
+		h.signingSecret,
+		c.GetHeader(slack.HeaderTimestamp),
+		body,
+		c.GetHeader(slack.HeaderSignature),
+		h.now(),
+	)
+	if err != nil {
+		response.NewError(c, response.Unauthorized, "invalid request signature", err)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid form body", err)
+		return
+	}
+
+	chatUserID := form.Get("user_id")
+	if chatUserID == "" {
+		response.NewError(c, response.BadRequest, "user_id is required", nil)
+		return
+	}
+
+	ctx := tenant.WithPrincipal(c.Request.Context(), domain.Principal{OrgID: h.orgID, Role: domain.RoleMember})
+
+	reply, err := h.chatSvc.Execute(ctx, chatUserID, form.Get("text"))
+	if err != nil {
+		_ = c.Error(err)
+		response.NewOK(c, commandResponse{
+			ResponseType: responseEphemeral,
+			Text:         "Something went wrong, please try again later.",
+		})
+
+		return
+	}
+
+	response.NewOK(c, toCommandResponse(reply))
+}
@@ -0,0 +1,26 @@
+package chat
+
+import chatService "avitotech-pr-reviewer/internal/service/chat"
+
+const (
+	responseEphemeral = "ephemeral"
+	responseInChannel = "in_channel"
+)
+
+// commandResponse — ответ на slash-команду в формате Slack и Mattermost.
+type commandResponse struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+func toCommandResponse(reply chatService.Reply) commandResponse {
+	responseType := responseEphemeral
+	if reply.InChannel {
+		responseType = responseInChannel
+	}
+
+	return commandResponse{
+		ResponseType: responseType,
+		Text:         reply.Text,
+	}
+}
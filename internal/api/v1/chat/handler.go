@@ -0,0 +1,40 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	chatService "avitotech-pr-reviewer/internal/service/chat"
+)
+
+type commandExecutor interface {
+	Execute(ctx context.Context, chatUserID, text string) (chatService.Reply, error)
+}
+
+type handler struct {
+	chatSvc commandExecutor
+
+	signingSecret string
+	orgID         string // организация, в которой выполняются команды
+	now           func() time.Time
+}
+
+func New(chatSvc commandExecutor, signingSecret, orgID string) *handler {
+	return &handler{
+		chatSvc:       chatSvc,
+		signingSecret: signingSecret,
+		orgID:         orgID,
+		now:           time.Now,
+	}
+}
+
+// RegisterRoutes регистрирует эндпоинт slash-команд. Запросы аутентифицируются
+// подписью чата, а не токеном API, поэтому группа не должна требовать токен.
+func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
+	chatGroup := router.Group("/chat")
+	{
+		chatGroup.POST("/command", h.command)
+	}
+}
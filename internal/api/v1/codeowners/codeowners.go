@@ -0,0 +1,49 @@
+package codeowners
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/response"
+)
+
+const repositoryQueryP = "repository"
+
+type uploadResponse struct {
+	CodeOwners codeOwnersDTO `json:"codeowners"`
+}
+
+func (h *handler) upload(c *gin.Context) {
+	var req uploadReq
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	rules, err := h.codeOwnersSvc.Upload(c.Request.Context(), req.Repository, req.Content)
+	if err != nil {
+		response.NewServiceError(c, err, "could not upload codeowners")
+		return
+	}
+
+	response.NewOK(c, uploadResponse{
+		CodeOwners: fromDomainRules(req.Repository, rules),
+	})
+}
+
+func (h *handler) get(c *gin.Context) {
+	repository := c.Query(repositoryQueryP)
+	if repository == "" {
+		response.NewError(c, response.BadRequest, "repository query parameter is required", nil)
+		return
+	}
+
+	rules, err := h.codeOwnersSvc.Rules(c.Request.Context(), repository)
+	if err != nil {
+		response.NewServiceError(c, err, "could not retrieve codeowners")
+		return
+	}
+
+	response.NewOK(c, fromDomainRules(repository, rules))
+}
@@ -0,0 +1,48 @@
+package codeowners
+
+import (
+	"avitotech-pr-reviewer/internal/domain"
+	codeownersPkg "avitotech-pr-reviewer/pkg/codeowners"
+)
+
+type uploadReq struct {
+	Repository string `json:"repository" binding:"required"`
+	Content    string `json:"content"`
+}
+
+type ruleResp struct {
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+}
+
+func fromDomainRule(r domain.CodeOwnersRule) ruleResp {
+	owners := make([]string, 0, len(r.UserIDs)+len(r.Teams))
+	for _, userID := range r.UserIDs {
+		owners = append(owners, codeownersPkg.Owner{Kind: codeownersPkg.OwnerUser, Name: userID}.String())
+	}
+	for _, team := range r.Teams {
+		owners = append(owners, codeownersPkg.Owner{Kind: codeownersPkg.OwnerTeam, Name: team.Name}.String())
+	}
+
+	return ruleResp{
+		Pattern: r.Pattern,
+		Owners:  owners,
+	}
+}
+
+type codeOwnersDTO struct {
+	Repository string     `json:"repository"`
+	Rules      []ruleResp `json:"rules"`
+}
+
+func fromDomainRules(repository string, rules []domain.CodeOwnersRule) codeOwnersDTO {
+	resp := make([]ruleResp, len(rules))
+	for i, rule := range rules {
+		resp[i] = fromDomainRule(rule)
+	}
+
+	return codeOwnersDTO{
+		Repository: repository,
+		Rules:      resp,
+	}
+}
@@ -0,0 +1,33 @@
+package codeowners
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/middleware"
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type codeOwnersService interface {
+	Upload(ctx context.Context, repository, content string) ([]domain.CodeOwnersRule, error)
+	Rules(ctx context.Context, repository string) ([]domain.CodeOwnersRule, error)
+}
+
+type handler struct {
+	codeOwnersSvc codeOwnersService
+}
+
+func New(codeOwnersSvc codeOwnersService) *handler {
+	return &handler{
+		codeOwnersSvc: codeOwnersSvc,
+	}
+}
+
+func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
+	codeOwnersGroup := router.Group("/codeowners")
+	{
+		codeOwnersGroup.POST("/upload", middleware.AdminAuth(), h.upload)
+		codeOwnersGroup.GET("/get", h.get)
+	}
+}
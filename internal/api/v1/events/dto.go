@@ -0,0 +1,47 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type streamQuery struct {
+	TeamName      string `form:"team_name"`
+	UserID        string `form:"user_id"`
+	Repository    string `form:"repository"`
+	PullRequestID string `form:"pull_request_id"`
+	// LastEventID заменяет заголовок Last-Event-ID для клиентов, которые не могут его передать.
+	LastEventID string `form:"last_event_id"`
+}
+
+func (q streamQuery) toFilter() domain.EventFilter {
+	return domain.EventFilter{
+		TeamName:      q.TeamName,
+		UserID:        q.UserID,
+		Repository:    q.Repository,
+		PullRequestID: q.PullRequestID,
+	}
+}
+
+// Event — данные SSE-сообщения.
+type Event struct {
+	ID            int64           `json:"event_id"`
+	Type          string          `json:"type"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Repository    string          `json:"repository,omitempty"`
+	PullRequestID string          `json:"pull_request_id,omitempty"`
+	Data          json.RawMessage `json:"data"`
+}
+
+func toEventFromDomain(r domain.EventRecord) Event {
+	return Event{
+		ID:            r.ID,
+		Type:          string(r.Type),
+		OccurredAt:    r.OccurredAt,
+		Repository:    r.Repository,
+		PullRequestID: r.PullRequestID,
+		Data:          r.Payload,
+	}
+}
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/response"
+)
+
+const lastEventIDHeader = "Last-Event-ID"
+
+func (h *handler) stream(c *gin.Context) {
+	var query streamQuery
+	err := c.ShouldBindQuery(&query)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid query parameters", err)
+		return
+	}
+
+	lastEventID, err := parseLastEventID(c.GetHeader(lastEventIDHeader), query.LastEventID)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "Last-Event-ID must be a non-negative integer", err)
+		return
+	}
+
+	stream, err := h.eventLogSvc.Subscribe(c.Request.Context(), query.toFilter(), lastEventID)
+	if err != nil {
+		response.NewServiceError(c, err, "failed to open event stream")
+		return
+	}
+	defer stream.Close()
+
+	// Поток живёт дольше общего тайм-аута записи сервера.
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case record, ok := <-stream.Events():
+			if !ok {
+				return
+			}
+
+			data, err := json.Marshal(toEventFromDomain(record))
+			if err != nil {
+				_ = c.Error(err)
+				return
+			}
+
+			_, err = fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", record.ID, record.Type, data)
+			if err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(c.Writer, ": ping\n\n")
+			if err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-c.Request.Context().Done():
+			return
+		case <-h.shutdown:
+			return
+		}
+	}
+}
+
+func parseLastEventID(header, query string) (int64, error) {
+	value := header
+	if value == "" {
+		value = query
+	}
+	if value == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if id < 0 {
+		return 0, strconv.ErrRange
+	}
+
+	return id, nil
+}
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/service/eventlog"
+)
+
+type eventLogService interface {
+	Subscribe(ctx context.Context, filter domain.EventFilter, lastEventID int64) (*eventlog.Stream, error)
+}
+
+// StreamRoute — маршрут потока событий. Поток открыт, пока подключён клиент,
+// поэтому общий тайм-аут запросов к нему не применяется.
+const StreamRoute = "/events/stream"
+
+// heartbeatInterval — период комментариев-пингов, не дающих прокси закрыть простаивающее соединение.
+const heartbeatInterval = 15 * time.Second
+
+type handler struct {
+	eventLogSvc eventLogService
+
+	// shutdown закрывается при остановке сервера и завершает открытые потоки.
+	shutdown <-chan struct{}
+}
+
+func New(eventLogSvc eventLogService, shutdown <-chan struct{}) *handler {
+	return &handler{
+		eventLogSvc: eventLogSvc,
+		shutdown:    shutdown,
+	}
+}
+
+func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
+	eventsGroup := router.Group("/events")
+	{
+		eventsGroup.GET("/stream", h.stream)
+	}
+}
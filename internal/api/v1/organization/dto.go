@@ -0,0 +1,24 @@
+package organization
+
+import "avitotech-pr-reviewer/internal/domain"
+
+type addReq struct {
+	OrganizationName string `json:"organization_name" binding:"required"`
+}
+
+type issueTokenReq struct {
+	Role   string `json:"role" binding:"required"`
+	UserID string `json:"user_id"`
+}
+
+type organizationDTO struct {
+	OrganizationID   string `json:"organization_id"`
+	OrganizationName string `json:"organization_name"`
+}
+
+func fromDomainOrganization(o *domain.Organization) organizationDTO {
+	return organizationDTO{
+		OrganizationID:   o.ID,
+		OrganizationName: o.Name,
+	}
+}
@@ -0,0 +1,33 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/middleware"
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type organizationService interface {
+	CreateOrganization(ctx context.Context, name string) (*domain.Organization, string, error)
+	IssueToken(ctx context.Context, role domain.Role, userID string) (string, error)
+}
+
+type handler struct {
+	orgSvc organizationService
+}
+
+func New(orgSvc organizationService) *handler {
+	return &handler{
+		orgSvc: orgSvc,
+	}
+}
+
+func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
+	orgGroup := router.Group("/organization")
+	{
+		orgGroup.POST("/add", middleware.RootAuth(), h.add)
+		orgGroup.POST("/issueToken", middleware.AdminAuth(), h.issueToken)
+	}
+}
@@ -0,0 +1,69 @@
+package organization
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+)
+
+type addResponse struct {
+	Organization organizationDTO `json:"organization"`
+	AdminToken   string          `json:"admin_token"`
+}
+
+func (h *handler) add(c *gin.Context) {
+	var req addReq
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	org, token, err := h.orgSvc.CreateOrganization(c.Request.Context(), req.OrganizationName)
+	if err != nil {
+		response.NewServiceError(c, err, "could not create organization")
+		return
+	}
+
+	response.NewCreated(c, addResponse{
+		Organization: fromDomainOrganization(org),
+		AdminToken:   token,
+	})
+}
+
+type issueTokenResponse struct {
+	Token  string `json:"token"`
+	Role   string `json:"role"`
+	UserID string `json:"user_id,omitempty"`
+}
+
+func (h *handler) issueToken(c *gin.Context) {
+	var req issueTokenReq
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	token, err := h.orgSvc.IssueToken(c.Request.Context(), domain.Role(req.Role), req.UserID)
+	if errors.Is(err, svcErr.ErrInvalidRole) {
+		response.NewError(c, response.BadRequest, "role must be admin or member, user tokens must be member", err)
+		return
+	}
+	if err != nil {
+		response.NewServiceError(c, err, "could not issue token")
+		return
+	}
+
+	response.NewCreated(c, issueTokenResponse{
+		Token:  token,
+		Role:   req.Role,
+		UserID: req.UserID,
+	})
+}
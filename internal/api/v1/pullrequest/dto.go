@@ -7,6 +7,7 @@ import (
 )
 
 type PullRequest struct {
+	Repository          string     `json:"repository"`
 	ID                  string     `json:"pull_request_id"`
 	Name                string     `json:"pull_request_name"`
 	AuthorID            string     `json:"author_id"`
@@ -17,6 +18,7 @@ type PullRequest struct {
 
 func FromDomainPR(pr *domain.PullRequest) *PullRequest {
 	return &PullRequest{
+		Repository:          pr.Repository,
 		ID:                  pr.ID,
 		Name:                pr.Name,
 		AuthorID:            pr.AuthorID,
@@ -27,7 +29,65 @@ func FromDomainPR(pr *domain.PullRequest) *PullRequest {
 }
 
 type CreatePullRequestRequest struct {
-	ID       string `json:"pull_request_id" binding:"required"`
-	Name     string `json:"pull_request_name" binding:"required"`
-	AuthorID string `json:"author_id" binding:"required"`
+	Repository   string   `json:"repository"`
+	ID           string   `json:"pull_request_id" binding:"required"`
+	Name         string   `json:"pull_request_name" binding:"required"`
+	AuthorID     string   `json:"author_id" binding:"required"`
+	ChangedFiles []string `json:"changed_files"`
+}
+
+type Review struct {
+	Repository    string    `json:"repository"`
+	PullRequestID string    `json:"pull_request_id"`
+	ReviewerID    string    `json:"reviewer_id"`
+	State         string    `json:"state"`
+	SubmittedAt   time.Time `json:"submitted_at"`
+}
+
+func FromDomainReview(r *domain.Review) *Review {
+	return &Review{
+		Repository:    r.Repository,
+		PullRequestID: r.PullRequestID,
+		ReviewerID:    r.ReviewerID,
+		State:         string(r.State),
+		SubmittedAt:   r.SubmittedAt,
+	}
+}
+
+type OverdueAssignment struct {
+	Repository      string    `json:"repository"`
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	ReviewerID      string    `json:"reviewer_id"`
+	TeamName        string    `json:"team_name"`
+	AssignedAt      time.Time `json:"assigned_at"`
+	OverdueAt       time.Time `json:"overdue_at"`
+	ReviewSLAHours  int       `json:"review_sla_hours"`
+	LeadID          string    `json:"lead_id,omitempty"`
+}
+
+func FromDomainOverdueAssignments(assignments []domain.ReviewAssignment) []OverdueAssignment {
+	res := make([]OverdueAssignment, 0, len(assignments))
+	for _, a := range assignments {
+		var overdueAt time.Time
+		if a.OverdueAt != nil {
+			overdueAt = *a.OverdueAt
+		}
+
+		res = append(res, OverdueAssignment{
+			Repository:      a.Repository,
+			PullRequestID:   a.PullRequestID,
+			PullRequestName: a.PullRequestName,
+			AuthorID:        a.AuthorID,
+			ReviewerID:      a.ReviewerID,
+			TeamName:        a.TeamName,
+			AssignedAt:      a.AssignedAt,
+			OverdueAt:       overdueAt,
+			ReviewSLAHours:  a.SLA.ReviewHours,
+			LeadID:          a.SLA.LeadID,
+		})
+	}
+
+	return res
 }
@@ -9,33 +9,39 @@ import (
 	"avitotech-pr-reviewer/internal/domain"
 )
 
-type adminVerifier interface {
-	VerifyAdminAccess(ctx context.Context, adminToken string) (bool, error)
-}
-
 type prService interface {
-	CreatePullRequest(ctx context.Context, id, name, authorID string) (*domain.PullRequest, error)
-	SetMerged(ctx context.Context, prID string) (*domain.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*domain.PullRequest, string, error)
+	CreatePullRequest(
+		ctx context.Context,
+		repository, id, name, authorID string,
+		changedFiles []string,
+	) (*domain.PullRequest, error)
+	SetMerged(ctx context.Context, repository, prID string) (*domain.PullRequest, error)
+	ReassignReviewer(ctx context.Context, repository, prID, oldReviewerID string) (*domain.PullRequest, string, error)
+	SubmitReview(
+		ctx context.Context,
+		repository, prID, reviewerID string,
+		state domain.ReviewState,
+	) (*domain.Review, error)
+	OverdueAssignments(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error)
 }
 
 type handler struct {
-	verifier adminVerifier
-	prSvc    prService
+	prSvc prService
 }
 
-func New(verifier adminVerifier, prSvc prService) *handler {
+func New(prSvc prService) *handler {
 	return &handler{
-		verifier: verifier,
-		prSvc:    prSvc,
+		prSvc: prSvc,
 	}
 }
 
 func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
-	prsGroup := router.Group("/pullRequest", middleware.AdminAuth(h.verifier.VerifyAdminAccess))
+	prsGroup := router.Group("/pullRequest", middleware.AdminAuth())
 	{
 		prsGroup.POST("/create", h.create)
 		prsGroup.POST("/merge", h.merge)
 		prsGroup.POST("/reassign", h.reassign)
+		prsGroup.POST("/review", h.review)
+		prsGroup.GET("/overdue", h.overdue)
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/domain"
 	svcErr "avitotech-pr-reviewer/internal/service/errors"
 )
 
@@ -21,17 +22,10 @@ func (h *handler) create(c *gin.Context) {
 		return
 	}
 
-	pr, err := h.prSvc.CreatePullRequest(c, req.ID, req.Name, req.AuthorID)
-	if errors.Is(err, svcErr.ErrUserNotFound) {
-		response.NewError(c, response.NotFound, "author not found", err)
-		return
-	}
-	if errors.Is(err, svcErr.ErrPRExists) {
-		response.NewError(c, response.PrExists, "pull request already exists", err)
-		return
-	}
+	ctx := c.Request.Context()
+	pr, err := h.prSvc.CreatePullRequest(ctx, req.Repository, req.ID, req.Name, req.AuthorID, req.ChangedFiles)
 	if err != nil {
-		response.NewError(c, response.InternalError, "failed to create pull request", err)
+		response.NewServiceError(c, err, "failed to create pull request")
 		return
 	}
 
@@ -39,6 +33,7 @@ func (h *handler) create(c *gin.Context) {
 }
 
 type mergePRRequest struct {
+	Repository    string `json:"repository"`
 	PullRequestID string `json:"pull_request_id" binding:"required"`
 }
 
@@ -50,13 +45,9 @@ func (h *handler) merge(c *gin.Context) {
 		return
 	}
 
-	pr, err := h.prSvc.SetMerged(c, req.PullRequestID)
-	if errors.Is(err, svcErr.ErrPRNotFound) {
-		response.NewError(c, response.NotFound, "pull request not found", err)
-		return
-	}
+	pr, err := h.prSvc.SetMerged(c.Request.Context(), req.Repository, req.PullRequestID)
 	if err != nil {
-		response.NewError(c, response.InternalError, "failed to merge pull request", err)
+		response.NewServiceError(c, err, "failed to merge pull request")
 		return
 	}
 
@@ -64,6 +55,7 @@ func (h *handler) merge(c *gin.Context) {
 }
 
 type reassignRequest struct {
+	Repository    string `json:"repository"`
 	PullRequestID string `json:"pull_request_id" binding:"required"`
 	OldReviewerID string `json:"old_reviewer_id" binding:"required"`
 }
@@ -81,29 +73,65 @@ func (h *handler) reassign(c *gin.Context) {
 		return
 	}
 
-	pr, replacedBy, err := h.prSvc.ReassignReviewer(c, req.PullRequestID, req.OldReviewerID)
-	if errors.Is(err, svcErr.ErrPRNoCandidates) {
-		response.NewError(c,
-			response.NoCandidatesForNewReviewer,
-			"no candidates available for new reviewer", err)
+	ctx := c.Request.Context()
+	pr, replacedBy, err := h.prSvc.ReassignReviewer(ctx, req.Repository, req.PullRequestID, req.OldReviewerID)
+	if err != nil {
+		response.NewServiceError(c, err, "failed to reassign reviewer")
+		return
+	}
+
+	response.NewOK(c, reassignResponse{
+		PR:           *FromDomainPR(pr),
+		ReplacedByID: replacedBy,
+	})
+}
+
+type reviewRequest struct {
+	Repository    string `json:"repository"`
+	PullRequestID string `json:"pull_request_id" binding:"required"`
+	ReviewerID    string `json:"reviewer_id" binding:"required"`
+	State         string `json:"state" binding:"required"`
+}
 
+type reviewResponse struct {
+	Review Review `json:"review"`
+}
+
+func (h *handler) review(c *gin.Context) {
+	var req reviewRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
 		return
 	}
-	if errors.Is(err, svcErr.ErrUserNotFound) || errors.Is(err, svcErr.ErrPRNotFound) {
-		response.NewError(c, response.NotFound, "resource not founed", err)
+
+	review, err := h.prSvc.SubmitReview(
+		c.Request.Context(), req.Repository, req.PullRequestID, req.ReviewerID, domain.ReviewState(req.State),
+	)
+	if errors.Is(err, svcErr.ErrInvalidReviewState) {
+		response.NewError(c, response.BadRequest, "state must be one of COMMENTED, CHANGES_REQUESTED, APPROVED", err)
 		return
 	}
-	if errors.Is(err, svcErr.ErrPRAlreadyMerged) {
-		response.NewError(c, response.PrMerged, "pull request already merged", err)
+	if err != nil {
+		response.NewServiceError(c, err, "failed to submit review")
 		return
 	}
+
+	response.NewCreated(c, reviewResponse{Review: *FromDomainReview(review)})
+}
+
+const teamNameQueryP = "team_name"
+
+type overdueResponse struct {
+	Assignments []OverdueAssignment `json:"assignments"`
+}
+
+func (h *handler) overdue(c *gin.Context) {
+	assignments, err := h.prSvc.OverdueAssignments(c.Request.Context(), c.Query(teamNameQueryP))
 	if err != nil {
-		response.NewError(c, response.InternalError, "failed to reassign reviewer", err)
+		response.NewServiceError(c, err, "failed to list overdue reviews")
 		return
 	}
 
-	response.NewOK(c, reassignResponse{
-		PR:           *FromDomainPR(pr),
-		ReplacedByID: replacedBy,
-	})
+	response.NewOK(c, overdueResponse{Assignments: FromDomainOverdueAssignments(assignments)})
 }
@@ -0,0 +1,40 @@
+package repository
+
+import "avitotech-pr-reviewer/internal/domain"
+
+type settingsDTO struct {
+	MaxReviewers *int `json:"max_reviewers"`
+}
+
+func (s settingsDTO) ToDomain() domain.RepositorySettings {
+	return domain.RepositorySettings{
+		MaxReviewers: s.MaxReviewers,
+	}
+}
+
+type addReq struct {
+	RepositoryName string      `json:"repository_name" binding:"required"`
+	TeamName       string      `json:"team_name"`
+	Settings       settingsDTO `json:"settings"`
+}
+
+type setSettingsReq struct {
+	RepositoryName string      `json:"repository_name" binding:"required"`
+	Settings       settingsDTO `json:"settings"`
+}
+
+type repositoryDTO struct {
+	RepositoryName string      `json:"repository_name"`
+	TeamName       string      `json:"team_name,omitempty"`
+	Settings       settingsDTO `json:"settings"`
+}
+
+func fromDomainRepository(r *domain.Repository) repositoryDTO {
+	return repositoryDTO{
+		RepositoryName: r.Name,
+		TeamName:       r.TeamName,
+		Settings: settingsDTO{
+			MaxReviewers: r.Settings.MaxReviewers,
+		},
+	}
+}
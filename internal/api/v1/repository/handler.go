@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/middleware"
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type repositoryService interface {
+	CreateRepository(
+		ctx context.Context,
+		name, teamName string,
+		settings domain.RepositorySettings,
+	) (*domain.Repository, error)
+	Repository(ctx context.Context, name string) (*domain.Repository, error)
+	UpdateSettings(ctx context.Context, name string, settings domain.RepositorySettings) (*domain.Repository, error)
+}
+
+type handler struct {
+	repositorySvc repositoryService
+}
+
+func New(repositorySvc repositoryService) *handler {
+	return &handler{
+		repositorySvc: repositorySvc,
+	}
+}
+
+func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
+	repositoryGroup := router.Group("/repository")
+	{
+		repositoryGroup.POST("/add", middleware.AdminAuth(), h.add)
+		repositoryGroup.GET("/get", h.get)
+		repositoryGroup.POST("/setSettings", middleware.AdminAuth(), h.setSettings)
+	}
+}
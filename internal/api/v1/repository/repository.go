@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/response"
+)
+
+const repositoryNameQueryP = "repository_name"
+
+type repositoryResponse struct {
+	Repository repositoryDTO `json:"repository"`
+}
+
+func (h *handler) add(c *gin.Context) {
+	var req addReq
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	if !validSettings(req.Settings) {
+		response.NewError(c, response.BadRequest, "max_reviewers must not be negative", nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	created, err := h.repositorySvc.CreateRepository(ctx, req.RepositoryName, req.TeamName, req.Settings.ToDomain())
+	if err != nil {
+		response.NewServiceError(c, err, "could not create repository")
+		return
+	}
+
+	response.NewCreated(c, repositoryResponse{
+		Repository: fromDomainRepository(created),
+	})
+}
+
+func (h *handler) get(c *gin.Context) {
+	name := c.Query(repositoryNameQueryP)
+	if name == "" {
+		response.NewError(c, response.BadRequest, "repository_name query parameter is required", nil)
+		return
+	}
+
+	repository, err := h.repositorySvc.Repository(c.Request.Context(), name)
+	if err != nil {
+		response.NewServiceError(c, err, "could not retrieve repository")
+		return
+	}
+
+	response.NewOK(c, fromDomainRepository(repository))
+}
+
+func (h *handler) setSettings(c *gin.Context) {
+	var req setSettingsReq
+
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	if !validSettings(req.Settings) {
+		response.NewError(c, response.BadRequest, "max_reviewers must not be negative", nil)
+		return
+	}
+
+	updated, err := h.repositorySvc.UpdateSettings(c.Request.Context(), req.RepositoryName, req.Settings.ToDomain())
+	if err != nil {
+		response.NewServiceError(c, err, "could not update repository settings")
+		return
+	}
+
+	response.NewOK(c, repositoryResponse{
+		Repository: fromDomainRepository(updated),
+	})
+}
+
+func validSettings(s settingsDTO) bool {
+	return s.MaxReviewers == nil || *s.MaxReviewers >= 0
+}
@@ -0,0 +1,108 @@
+package stats
+
+import (
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type reviewerStatsDTO struct {
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	TeamName    string `json:"team_name"`
+	IsActive    bool   `json:"is_active"`
+	Assignments int    `json:"assignments"`
+}
+
+type teamStatsDTO struct {
+	TeamName       string   `json:"team_name"`
+	Members        int      `json:"members"`
+	Assignments    int      `json:"assignments"`
+	MaxAssignments int      `json:"max_assignments"`
+	MinAssignments int      `json:"min_assignments"`
+	AvgAssignments float64  `json:"avg_assignments"`
+	MaxMinRatio    *float64 `json:"max_min_ratio"`
+	IdleMembers    int      `json:"idle_members"`
+}
+
+func fromDomainReviewerStats(stats []domain.ReviewerStats) []reviewerStatsDTO {
+	res := make([]reviewerStatsDTO, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, reviewerStatsDTO{
+			UserID:      s.UserID,
+			Username:    s.Username,
+			TeamName:    s.TeamName,
+			IsActive:    s.IsActive,
+			Assignments: s.Assignments,
+		})
+	}
+
+	return res
+}
+
+func fromDomainTeamStats(stats []domain.TeamStats) []teamStatsDTO {
+	res := make([]teamStatsDTO, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, teamStatsDTO{
+			TeamName:       s.TeamName,
+			Members:        s.Members,
+			Assignments:    s.Assignments,
+			MaxAssignments: s.MaxAssignments,
+			MinAssignments: s.MinAssignments,
+			AvgAssignments: s.AvgAssignments,
+			MaxMinRatio:    s.MaxMinRatio(),
+			IdleMembers:    s.IdleMembers,
+		})
+	}
+
+	return res
+}
+
+type durationStatsDTO struct {
+	Count         int      `json:"count"`
+	MedianSeconds *float64 `json:"median_seconds"`
+	P90Seconds    *float64 `json:"p90_seconds"`
+}
+
+type cycleTimeStatsDTO struct {
+	Group             string           `json:"group"`
+	WeekStart         string           `json:"week_start"`
+	PullRequests      int              `json:"pull_requests"`
+	TimeToFirstReview durationStatsDTO `json:"time_to_first_review"`
+	TimeToApproval    durationStatsDTO `json:"time_to_approval"`
+	TimeToMerge       durationStatsDTO `json:"time_to_merge"`
+}
+
+func fromDomainCycleTimeStats(stats []domain.CycleTimeStats) []cycleTimeStatsDTO {
+	res := make([]cycleTimeStatsDTO, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, cycleTimeStatsDTO{
+			Group:             s.Group,
+			WeekStart:         s.WeekStart.Format(dateLayout),
+			PullRequests:      s.PullRequests,
+			TimeToFirstReview: fromDomainDurationStats(s.TimeToFirstReview),
+			TimeToApproval:    fromDomainDurationStats(s.TimeToApproval),
+			TimeToMerge:       fromDomainDurationStats(s.TimeToMerge),
+		})
+	}
+
+	return res
+}
+
+func fromDomainDurationStats(d domain.DurationStats) durationStatsDTO {
+	return durationStatsDTO{
+		Count:         d.Count,
+		MedianSeconds: seconds(d.Median),
+		P90Seconds:    seconds(d.P90),
+	}
+}
+
+func seconds(d *time.Duration) *float64 {
+	if d == nil {
+		return nil
+	}
+
+	s := d.Seconds()
+
+	return &s
+}
@@ -0,0 +1,38 @@
+package stats
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type statsService interface {
+	ReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStats, error)
+	TeamStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error)
+	CycleTime(
+		ctx context.Context,
+		group domain.CycleTimeGroup,
+		filter domain.StatsFilter,
+	) ([]domain.CycleTimeStats, error)
+}
+
+type handler struct {
+	statsSvc statsService
+}
+
+func New(statsSvc statsService) *handler {
+	return &handler{
+		statsSvc: statsSvc,
+	}
+}
+
+func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
+	statsGroup := router.Group("/stats")
+	{
+		statsGroup.GET("/reviewers", h.reviewers)
+		statsGroup.GET("/teams", h.teams)
+		statsGroup.GET("/cycleTime", h.cycleTime)
+	}
+}
@@ -0,0 +1,138 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+const (
+	fromQueryP     = "from"
+	toQueryP       = "to"
+	statusQueryP   = "status"
+	teamNameQueryP = "team_name"
+	groupByQueryP  = "group_by"
+)
+
+// dateLayout — формат границы интервала без времени. Такая граница означает полночь UTC.
+const dateLayout = "2006-01-02"
+
+type reviewersResponse struct {
+	Reviewers []reviewerStatsDTO `json:"reviewers"`
+}
+
+type teamsResponse struct {
+	Teams []teamStatsDTO `json:"teams"`
+}
+
+func (h *handler) reviewers(c *gin.Context) {
+	filter, err := parseFilter(c)
+	if err != nil {
+		response.NewError(c, response.BadRequest, err.Error(), err)
+		return
+	}
+
+	stats, err := h.statsSvc.ReviewerStats(c.Request.Context(), filter)
+	if err != nil {
+		response.NewServiceError(c, err, "could not retrieve reviewer stats")
+		return
+	}
+
+	response.NewOK(c, reviewersResponse{
+		Reviewers: fromDomainReviewerStats(stats),
+	})
+}
+
+func (h *handler) teams(c *gin.Context) {
+	filter, err := parseFilter(c)
+	if err != nil {
+		response.NewError(c, response.BadRequest, err.Error(), err)
+		return
+	}
+
+	stats, err := h.statsSvc.TeamStats(c.Request.Context(), filter)
+	if err != nil {
+		response.NewServiceError(c, err, "could not retrieve team stats")
+		return
+	}
+
+	response.NewOK(c, teamsResponse{
+		Teams: fromDomainTeamStats(stats),
+	})
+}
+
+type cycleTimeResponse struct {
+	GroupBy string              `json:"group_by"`
+	Weeks   []cycleTimeStatsDTO `json:"weeks"`
+}
+
+func (h *handler) cycleTime(c *gin.Context) {
+	filter, err := parseFilter(c)
+	if err != nil {
+		response.NewError(c, response.BadRequest, err.Error(), err)
+		return
+	}
+
+	group := domain.CycleTimeGroup(c.DefaultQuery(groupByQueryP, string(domain.CycleTimeByTeam)))
+
+	stats, err := h.statsSvc.CycleTime(c.Request.Context(), group, filter)
+	if err != nil {
+		response.NewServiceError(c, err, "could not retrieve cycle time stats")
+		return
+	}
+
+	response.NewOK(c, cycleTimeResponse{
+		GroupBy: string(group),
+		Weeks:   fromDomainCycleTimeStats(stats),
+	})
+}
+
+func parseFilter(c *gin.Context) (domain.StatsFilter, error) {
+	filter := domain.StatsFilter{
+		TeamName: c.Query(teamNameQueryP),
+	}
+
+	from, err := parseTime(c.Query(fromQueryP))
+	if err != nil {
+		return domain.StatsFilter{}, fmt.Errorf("invalid from: %w", err)
+	}
+	filter.From = from
+
+	to, err := parseTime(c.Query(toQueryP))
+	if err != nil {
+		return domain.StatsFilter{}, fmt.Errorf("invalid to: %w", err)
+	}
+	filter.To = to
+
+	if status := c.Query(statusQueryP); status != "" {
+		s := domain.PRStatus(status)
+		filter.Status = &s
+	}
+
+	return filter, nil
+}
+
+// parseTime разбирает границу интервала в формате RFC 3339 или YYYY-MM-DD.
+// Для пустой строки возвращается nil.
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t, err = time.Parse(dateLayout, value)
+	}
+	if err != nil {
+		return nil, errors.New("expected RFC 3339 timestamp or YYYY-MM-DD date")
+	}
+
+	t = t.UTC()
+
+	return &t, nil
+}
@@ -6,6 +6,7 @@ type userReq struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
 	IsActive bool   `json:"is_active"`
+	Email    string `json:"email"`
 }
 
 func (u *userReq) ToDomain() domain.Member {
@@ -13,6 +14,7 @@ func (u *userReq) ToDomain() domain.Member {
 		ID:       u.UserID,
 		Username: u.Username,
 		IsActive: u.IsActive,
+		Email:    u.Email,
 	}
 }
 
@@ -52,9 +54,29 @@ func fromDomainMember(m domain.Member) userResp {
 	}
 }
 
+type slaDTO struct {
+	ReviewSLAHours int    `json:"review_sla_hours"`
+	Action         string `json:"action,omitempty"`
+	LeadID         string `json:"lead_id,omitempty"`
+}
+
+type setSLAReq struct {
+	TeamName string `json:"team_name" binding:"required"`
+	slaDTO
+}
+
+func (r *setSLAReq) ToDomainSLA() domain.TeamSLA {
+	return domain.TeamSLA{
+		ReviewHours: r.ReviewSLAHours,
+		Action:      domain.SLAAction(r.Action),
+		LeadID:      r.LeadID,
+	}
+}
+
 type teamDTO struct {
 	TeamName string     `json:"team_name"`
 	Members  []userResp `json:"members"`
+	SLA      *slaDTO    `json:"sla,omitempty"`
 }
 
 func fromDomainTeam(t *domain.Team) teamDTO {
@@ -63,8 +85,55 @@ func fromDomainTeam(t *domain.Team) teamDTO {
 		members[i] = fromDomainMember(member)
 	}
 
+	var sla *slaDTO
+	if t.SLA.Enabled() {
+		sla = &slaDTO{
+			ReviewSLAHours: t.SLA.ReviewHours,
+			Action:         string(t.SLA.Action),
+			LeadID:         t.SLA.LeadID,
+		}
+	}
+
 	return teamDTO{
 		TeamName: t.Name,
 		Members:  members,
+		SLA:      sla,
+	}
+}
+
+type changeDTO struct {
+	Action   string `json:"action"`
+	TeamName string `json:"team_name"`
+	FromTeam string `json:"from_team,omitempty"`
+	UserID   string `json:"user_id,omitempty"`
+	Username string `json:"username,omitempty"`
+	IsActive *bool  `json:"is_active,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+type importResponse struct {
+	Applied bool        `json:"applied"`
+	Changes []changeDTO `json:"changes"`
+}
+
+func fromDomainPlan(plan *domain.TeamPlan, applied bool) importResponse {
+	changes := make([]changeDTO, len(plan.Changes))
+	for i, ch := range plan.Changes {
+		changes[i] = changeDTO{
+			Action:   string(ch.Action),
+			TeamName: ch.TeamName,
+			FromTeam: ch.FromTeam,
+		}
+		if ch.Action != domain.TeamChangeCreateTeam {
+			changes[i].UserID = ch.Member.ID
+			changes[i].Username = ch.Member.Username
+			changes[i].IsActive = &ch.Member.IsActive
+			changes[i].Email = ch.Member.Email
+		}
+	}
+
+	return importResponse{
+		Applied: applied,
+		Changes: changes,
 	}
 }
@@ -12,28 +12,28 @@ import (
 type teamService interface {
 	CreateTeam(ctx context.Context, teamName string, members []domain.Member) (*domain.Team, error)
 	TeamWithMembers(ctx context.Context, teamName string) (*domain.Team, error)
-}
-
-type adminVerifier interface {
-	VerifyAdminAccess(ctx context.Context, adminToken string) (bool, error)
+	SetSLA(ctx context.Context, teamName string, sla domain.TeamSLA) (*domain.Team, error)
+	ImportTeams(ctx context.Context, desired []domain.Team, apply bool) (*domain.TeamPlan, error)
+	ExportTeams(ctx context.Context) ([]domain.Team, error)
 }
 
 type handler struct {
-	teamSvc  teamService
-	verifier adminVerifier
+	teamSvc teamService
 }
 
-func New(teamSvc teamService, verifier adminVerifier) *handler {
+func New(teamSvc teamService) *handler {
 	return &handler{
-		teamSvc:  teamSvc,
-		verifier: verifier,
+		teamSvc: teamSvc,
 	}
 }
 
 func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
 	teamGroup := router.Group("/team")
 	{
-		teamGroup.POST("/add", middleware.AdminAuth(h.verifier.VerifyAdminAccess), h.add)
+		teamGroup.POST("/add", middleware.AdminAuth(), h.add)
 		teamGroup.GET("/get", h.get)
+		teamGroup.POST("/setSla", middleware.AdminAuth(), h.setSLA)
+		teamGroup.POST("/import", middleware.AdminAuth(), h.importTeams)
+		teamGroup.GET("/export", middleware.AdminAuth(), h.exportTeams)
 	}
 }
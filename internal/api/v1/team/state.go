@@ -0,0 +1,262 @@
+package team
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+const (
+	formatYAML = "yaml"
+	formatCSV  = "csv"
+	formatJSON = "json"
+
+	contentTypeYAML = "application/yaml"
+	contentTypeCSV  = "text/csv"
+	contentTypeJSON = "application/json"
+)
+
+// csvHeader — колонки CSV-файла команд: одна строка на участника,
+// строка с пустым user_id объявляет команду без участников.
+var csvHeader = []string{"team_name", "user_id", "username", "is_active", "email"}
+
+var errUnsupportedFormat = errors.New("unsupported format")
+
+// stateDTO — желаемое состояние команд организации в YAML и JSON:
+//
+//	teams:
+//	  - team_name: backend
+//	    members:
+//	      - user_id: u1
+//	        username: alice
+//	        email: alice@example.com
+//	      - user_id: u2
+//	        username: bob
+//	        is_active: false
+type stateDTO struct {
+	Teams []stateTeamDTO `json:"teams" yaml:"teams"`
+}
+
+type stateTeamDTO struct {
+	TeamName string           `json:"team_name" yaml:"team_name"`
+	Members  []stateMemberDTO `json:"members" yaml:"members"`
+}
+
+type stateMemberDTO struct {
+	UserID   string `json:"user_id" yaml:"user_id"`
+	Username string `json:"username" yaml:"username"`
+	// IsActive по умолчанию true.
+	IsActive *bool  `json:"is_active,omitempty" yaml:"is_active,omitempty"`
+	Email    string `json:"email,omitempty" yaml:"email,omitempty"`
+}
+
+func (s stateDTO) toDomain() []domain.Team {
+	teams := make([]domain.Team, len(s.Teams))
+	for i, t := range s.Teams {
+		members := make([]domain.Member, len(t.Members))
+		for j, m := range t.Members {
+			members[j] = domain.Member{
+				ID:       m.UserID,
+				Username: m.Username,
+				IsActive: m.IsActive == nil || *m.IsActive,
+				Email:    m.Email,
+			}
+		}
+		teams[i] = domain.Team{Name: t.TeamName, Members: members}
+	}
+
+	return teams
+}
+
+func fromDomainState(teams []domain.Team) stateDTO {
+	state := stateDTO{Teams: make([]stateTeamDTO, len(teams))}
+	for i, t := range teams {
+		members := make([]stateMemberDTO, len(t.Members))
+		for j, m := range t.Members {
+			members[j] = stateMemberDTO{
+				UserID:   m.ID,
+				Username: m.Username,
+				IsActive: &m.IsActive,
+				Email:    m.Email,
+			}
+		}
+		state.Teams[i] = stateTeamDTO{TeamName: t.Name, Members: members}
+	}
+
+	return state
+}
+
+// formatOf возвращает формат тела запроса по его Content-Type.
+func formatOf(contentType string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", errUnsupportedFormat, contentType)
+	}
+
+	switch mediaType {
+	case contentTypeJSON:
+		return formatJSON, nil
+	case contentTypeYAML, "application/x-yaml", "text/yaml", "text/x-yaml":
+		return formatYAML, nil
+	case contentTypeCSV:
+		return formatCSV, nil
+	default:
+		return "", fmt.Errorf("%w: %q", errUnsupportedFormat, mediaType)
+	}
+}
+
+// decodeState разбирает желаемое состояние команд в формате format.
+func decodeState(r io.Reader, format string) ([]domain.Team, error) {
+	switch format {
+	case formatCSV:
+		return decodeCSV(r)
+	case formatJSON:
+		var state stateDTO
+		dec := json.NewDecoder(r)
+		dec.DisallowUnknownFields()
+
+		err := dec.Decode(&state)
+		if err != nil {
+			return nil, err
+		}
+
+		return state.toDomain(), nil
+	case formatYAML:
+		var state stateDTO
+		dec := yaml.NewDecoder(r)
+		dec.KnownFields(true)
+
+		err := dec.Decode(&state)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		return state.toDomain(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnsupportedFormat, format)
+	}
+}
+
+// encodeState сериализует команды в формате format и возвращает тело и его Content-Type.
+func encodeState(teams []domain.Team, format string) ([]byte, string, error) {
+	switch format {
+	case formatCSV:
+		body, err := encodeCSV(teams)
+
+		return body, contentTypeCSV, err
+	case formatJSON:
+		body, err := json.MarshalIndent(fromDomainState(teams), "", "  ")
+
+		return body, contentTypeJSON, err
+	case formatYAML:
+		body, err := yaml.Marshal(fromDomainState(teams))
+
+		return body, contentTypeYAML, err
+	default:
+		return nil, "", fmt.Errorf("%w: %q", errUnsupportedFormat, format)
+	}
+}
+
+func decodeCSV(r io.Reader) ([]domain.Team, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, name := range csvHeader[:3] {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return record[i]
+	}
+
+	var teams []domain.Team
+	index := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		teamName := field(record, "team_name")
+		i, ok := index[teamName]
+		if !ok {
+			i = len(teams)
+			index[teamName] = i
+			teams = append(teams, domain.Team{Name: teamName, Members: []domain.Member{}})
+		}
+
+		userID := field(record, "user_id")
+		if userID == "" {
+			continue
+		}
+
+		isActive := true
+		if v := field(record, "is_active"); v != "" {
+			isActive, err = strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid is_active %q", line, v)
+			}
+		}
+
+		teams[i].Members = append(teams[i].Members, domain.Member{
+			ID:       userID,
+			Username: field(record, "username"),
+			IsActive: isActive,
+			Email:    field(record, "email"),
+		})
+	}
+
+	return teams, nil
+}
+
+func encodeCSV(teams []domain.Team) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	_ = w.Write(csvHeader)
+	for _, t := range teams {
+		if len(t.Members) == 0 {
+			_ = w.Write([]string{t.Name, "", "", "", ""})
+		}
+		for _, m := range t.Members {
+			_ = w.Write([]string{t.Name, m.ID, m.Username, strconv.FormatBool(m.IsActive), m.Email})
+		}
+	}
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
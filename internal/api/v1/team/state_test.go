@@ -0,0 +1,119 @@
+package team
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+func TestDecodeState(t *testing.T) {
+	expected := []domain.Team{
+		{Name: "backend", Members: []domain.Member{
+			{ID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+			{ID: "u2", Username: "bob", IsActive: false},
+		}},
+		{Name: "infra", Members: []domain.Member{}},
+	}
+
+	tests := []struct {
+		name        string
+		format      string
+		body        string
+		expected    []domain.Team
+		expectedErr string
+	}{
+		{
+			name:   "success - yaml",
+			format: formatYAML,
+			body: `
+teams:
+  - team_name: backend
+    members:
+      - user_id: u1
+        username: alice
+        email: alice@example.com
+      - user_id: u2
+        username: bob
+        is_active: false
+  - team_name: infra
+`,
+			expected: expected,
+		},
+		{
+			name:   "success - csv",
+			format: formatCSV,
+			body: "team_name,user_id,username,is_active,email\n" +
+				"backend,u1,alice,,alice@example.com\n" +
+				"backend,u2,bob,false,\n" +
+				"infra,,,,\n",
+			expected: expected,
+		},
+		{
+			name:   "success - csv without optional columns",
+			format: formatCSV,
+			body:   "username,user_id,team_name\nalice,u1,backend\n",
+			expected: []domain.Team{
+				{Name: "backend", Members: []domain.Member{{ID: "u1", Username: "alice", IsActive: true}}},
+			},
+		},
+		{
+			name:        "error - yaml unknown field",
+			format:      formatYAML,
+			body:        "teams:\n  - name: backend\n",
+			expectedErr: "field name not found",
+		},
+		{
+			name:        "error - csv missing column",
+			format:      formatCSV,
+			body:        "team_name,user_id\nbackend,u1\n",
+			expectedErr: `missing column "username"`,
+		},
+		{
+			name:        "error - csv invalid is_active",
+			format:      formatCSV,
+			body:        "team_name,user_id,username,is_active\nbackend,u1,alice,maybe\n",
+			expectedErr: "line 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeState(strings.NewReader(tt.body), tt.format)
+
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestEncodeState_RoundTrip(t *testing.T) {
+	teams := []domain.Team{
+		{Name: "backend", Members: []domain.Member{
+			{ID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+			{ID: "u2", Username: "bob", IsActive: false},
+		}},
+		{Name: "infra", Members: []domain.Member{}},
+	}
+
+	for _, format := range []string{formatYAML, formatCSV, formatJSON} {
+		t.Run(format, func(t *testing.T) {
+			body, _, err := encodeState(teams, format)
+			require.NoError(t, err)
+
+			got, err := decodeState(bytes.NewReader(body), format)
+			require.NoError(t, err)
+			assert.Equal(t, teams, got)
+		})
+	}
+}
@@ -2,14 +2,22 @@ package team
 
 import (
 	"errors"
+	"io"
+	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"avitotech-pr-reviewer/internal/api/response"
-	svcErr "avitotech-pr-reviewer/internal/service/errors"
 )
 
-const teamNameQueryP = "team_name"
+const (
+	teamNameQueryP = "team_name"
+	applyQueryP    = "apply"
+	formatQueryP   = "format"
+
+	maxImportSize = 4 << 20
+)
 
 type addTeamResponse struct {
 	Team teamDTO `json:"team"`
@@ -24,13 +32,9 @@ func (h *handler) add(c *gin.Context) {
 		return
 	}
 
-	created, err := h.teamSvc.CreateTeam(c, req.TeamName, req.ToDomainMembers())
-	if errors.Is(err, svcErr.ErrTeamExists) {
-		response.NewError(c, response.TeamExists, "team_name already exists", err)
-		return
-	}
+	created, err := h.teamSvc.CreateTeam(c.Request.Context(), req.TeamName, req.ToDomainMembers())
 	if err != nil {
-		response.NewError(c, response.InternalError, "could not create team", err)
+		response.NewServiceError(c, err, "could not create team")
 		return
 	}
 
@@ -46,15 +50,84 @@ func (h *handler) get(c *gin.Context) {
 		return
 	}
 
-	team, err := h.teamSvc.TeamWithMembers(c, teamName)
-	if errors.Is(err, svcErr.ErrTeamNotFound) {
-		response.NewError(c, response.NotFound, "team not found", err)
+	team, err := h.teamSvc.TeamWithMembers(c.Request.Context(), teamName)
+	if err != nil {
+		response.NewServiceError(c, err, "could not retrieve team")
 		return
 	}
+
+	response.NewOK(c, fromDomainTeam(team))
+}
+
+func (h *handler) setSLA(c *gin.Context) {
+	var req setSLAReq
+
+	err := c.ShouldBindJSON(&req)
 	if err != nil {
-		response.NewError(c, response.InternalError, "could not retrieve team", err)
+		response.NewError(c, response.BadRequest, "invalid request body", err)
 		return
 	}
 
-	response.NewOK(c, fromDomainTeam(team))
+	team, err := h.teamSvc.SetSLA(c.Request.Context(), req.TeamName, req.ToDomainSLA())
+	if err != nil {
+		response.NewServiceError(c, err, "could not update team SLA")
+		return
+	}
+
+	response.NewOK(c, addTeamResponse{
+		Team: fromDomainTeam(team),
+	})
+}
+
+// importTeams принимает желаемое состояние команд в JSON, YAML или CSV
+// (по Content-Type) и возвращает план изменений. С apply=true план применяется.
+func (h *handler) importTeams(c *gin.Context) {
+	format, err := formatOf(c.ContentType())
+	if err != nil {
+		response.NewError(c, response.BadRequest, "content type must be application/json, application/yaml or text/csv", err)
+		return
+	}
+
+	apply, err := strconv.ParseBool(c.DefaultQuery(applyQueryP, "false"))
+	if err != nil {
+		response.NewError(c, response.BadRequest, "apply query parameter must be a boolean", err)
+		return
+	}
+
+	desired, err := decodeState(io.LimitReader(c.Request.Body, maxImportSize), format)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	plan, err := h.teamSvc.ImportTeams(c.Request.Context(), desired, apply)
+	if err != nil {
+		response.NewServiceError(c, err, "could not import teams")
+		return
+	}
+
+	response.NewOK(c, fromDomainPlan(plan, apply))
+}
+
+// exportTeams возвращает все команды организации в формате, который принимает importTeams.
+func (h *handler) exportTeams(c *gin.Context) {
+	format := c.DefaultQuery(formatQueryP, formatYAML)
+
+	teams, err := h.teamSvc.ExportTeams(c.Request.Context())
+	if err != nil {
+		response.NewServiceError(c, err, "could not export teams")
+		return
+	}
+
+	body, contentType, err := encodeState(teams, format)
+	if errors.Is(err, errUnsupportedFormat) {
+		response.NewError(c, response.BadRequest, "format must be yaml, csv or json", err)
+		return
+	}
+	if err != nil {
+		response.NewError(c, response.InternalError, "could not export teams", err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, body)
 }
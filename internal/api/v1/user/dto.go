@@ -1,20 +1,32 @@
 package user
 
-import "avitotech-pr-reviewer/internal/domain"
+import (
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
 
 type User struct {
-	ID       string `json:"user_id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	ID                string     `json:"user_id"`
+	Username          string     `json:"username"`
+	TeamName          string     `json:"team_name"`
+	IsActive          bool       `json:"is_active"`
+	Email             string     `json:"email,omitempty"`
+	ReminderFrequency string     `json:"reminder_frequency"`
+	ChatUserID        string     `json:"chat_user_id,omitempty"`
+	AwayUntil         *time.Time `json:"away_until,omitempty"`
 }
 
 func toUserFromDomain(u *domain.User) *User {
 	return &User{
-		ID:       u.ID,
-		Username: u.Username,
-		TeamName: u.TeamName,
-		IsActive: u.IsActive,
+		ID:                u.ID,
+		Username:          u.Username,
+		TeamName:          u.TeamName,
+		IsActive:          u.IsActive,
+		Email:             u.Email,
+		ReminderFrequency: string(u.ReminderFrequency),
+		ChatUserID:        u.ChatUserID,
+		AwayUntil:         u.AwayUntil,
 	}
 }
 
@@ -22,3 +34,49 @@ type setIsActiveRequest struct {
 	UserID   string `json:"user_id" binding:"required"`
 	IsActive *bool   `json:"is_active" binding:"required"`
 }
+
+type setRemindersRequest struct {
+	UserID    string `json:"user_id" binding:"required"`
+	Frequency string `json:"frequency" binding:"required"`
+}
+
+type setEmailRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+	Email  string `json:"email"`
+}
+
+type linkChatRequest struct {
+	UserID     string `json:"user_id" binding:"required"`
+	ChatUserID string `json:"chat_user_id"`
+}
+
+type pullRequestShort struct {
+	ID         string `json:"pull_request_id"`
+	Name       string `json:"pull_request_name"`
+	AuthorID   string `json:"author_id"`
+	Status     string `json:"status"`
+	Repository string `json:"repository"`
+}
+
+type getReviewResponse struct {
+	UserID       string             `json:"user_id"`
+	PullRequests []pullRequestShort `json:"pull_requests"`
+}
+
+func toGetReviewResponse(userID string, prs []domain.PullRequest) getReviewResponse {
+	pullRequests := make([]pullRequestShort, len(prs))
+	for i, pr := range prs {
+		pullRequests[i] = pullRequestShort{
+			ID:         pr.ID,
+			Name:       pr.Name,
+			AuthorID:   pr.AuthorID,
+			Status:     string(pr.Status),
+			Repository: pr.Repository,
+		}
+	}
+
+	return getReviewResponse{
+		UserID:       userID,
+		PullRequests: pullRequests,
+	}
+}
@@ -11,28 +11,29 @@ import (
 
 type userService interface {
 	SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
-}
-
-type adminVerifier interface {
-	VerifyAdminAccess(ctx context.Context, adminToken string) (bool, error)
+	SetReminderFrequency(ctx context.Context, userID string, frequency domain.ReminderFrequency) (*domain.User, error)
+	SetEmail(ctx context.Context, userID, email string) (*domain.User, error)
+	LinkChat(ctx context.Context, userID, chatUserID string) (*domain.User, error)
+	GetReview(ctx context.Context, userID string) ([]domain.PullRequest, error)
 }
 
 type handler struct {
-	userSvc  userService
-	verifier adminVerifier
+	userSvc userService
 }
 
-func New(userSvc userService, verifier adminVerifier) *handler {
+func New(userSvc userService) *handler {
 	return &handler{
-		userSvc:  userSvc,
-		verifier: verifier,
+		userSvc: userSvc,
 	}
 }
 
 func (h *handler) RegisterRoutes(router *gin.RouterGroup) {
 	usersGroup := router.Group("/users")
 	{
-		usersGroup.POST("/setIsActive", middleware.AdminAuth(h.verifier.VerifyAdminAccess), h.setIsActive)
+		usersGroup.POST("/setIsActive", middleware.AdminAuth(), h.setIsActive)
+		usersGroup.POST("/setReminders", h.setReminders)
+		usersGroup.POST("/setEmail", middleware.AdminAuth(), h.setEmail)
+		usersGroup.POST("/linkChat", middleware.AdminAuth(), h.linkChat)
 		usersGroup.GET("/getReview", h.getReview)
 	}
 }
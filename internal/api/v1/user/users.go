@@ -5,10 +5,14 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"avitotech-pr-reviewer/internal/api/middleware"
 	"avitotech-pr-reviewer/internal/api/response"
+	"avitotech-pr-reviewer/internal/domain"
 	svcErr "avitotech-pr-reviewer/internal/service/errors"
 )
 
+const userIDQueryP = "user_id"
+
 func (h *handler) setIsActive(c *gin.Context) {
 	var req setIsActiveRequest
 	err := c.ShouldBindJSON(&req)
@@ -17,21 +21,86 @@ func (h *handler) setIsActive(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userSvc.SetIsActive(c, req.UserID, *req.IsActive)
-	if errors.Is(err, svcErr.ErrUserNotFound) {
-		response.NewError(c, response.NotFound, "user not found", err)
+	user, err := h.userSvc.SetIsActive(c.Request.Context(), req.UserID, *req.IsActive)
+	if err != nil {
+		response.NewServiceError(c, err, "failed to set user active status")
 		return
 	}
-	if errors.Is(err, svcErr.ErrTeamNotFound) {
-		response.NewError(c, response.NotFound, "team not found for user", err)
+
+	response.NewOK(c, toUserFromDomain(user))
+}
+
+func (h *handler) setReminders(c *gin.Context) {
+	var req setRemindersRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	if !middleware.AuthorizeUser(c, req.UserID) {
+		return
+	}
+
+	user, err := h.userSvc.SetReminderFrequency(c.Request.Context(), req.UserID, domain.ReminderFrequency(req.Frequency))
+	if errors.Is(err, svcErr.ErrInvalidReminderFrequency) {
+		response.NewError(c, response.BadRequest, "frequency must be one of off, daily, weekly", err)
 		return
 	}
 	if err != nil {
-		response.NewError(c, response.InternalError, "failed to set user active status", err)
+		response.NewServiceError(c, err, "failed to set user reminder frequency")
 		return
 	}
 
 	response.NewOK(c, toUserFromDomain(user))
 }
 
-func (h *handler) getReview(c *gin.Context) {}
+func (h *handler) setEmail(c *gin.Context) {
+	var req setEmailRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	user, err := h.userSvc.SetEmail(c.Request.Context(), req.UserID, req.Email)
+	if err != nil {
+		response.NewServiceError(c, err, "failed to set user email")
+		return
+	}
+
+	response.NewOK(c, toUserFromDomain(user))
+}
+
+func (h *handler) linkChat(c *gin.Context) {
+	var req linkChatRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		response.NewError(c, response.BadRequest, "invalid request body", err)
+		return
+	}
+
+	user, err := h.userSvc.LinkChat(c.Request.Context(), req.UserID, req.ChatUserID)
+	if err != nil {
+		response.NewServiceError(c, err, "failed to link chat user")
+		return
+	}
+
+	response.NewOK(c, toUserFromDomain(user))
+}
+
+func (h *handler) getReview(c *gin.Context) {
+	userID := c.Query(userIDQueryP)
+	if userID == "" {
+		response.NewError(c, response.BadRequest, "user_id query parameter is required", nil)
+		return
+	}
+
+	pullRequests, err := h.userSvc.GetReview(c.Request.Context(), userID)
+	if err != nil {
+		response.NewServiceError(c, err, "failed to get user reviews")
+		return
+	}
+
+	response.NewOK(c, toGetReviewResponse(userID, pullRequests))
+}
@@ -5,20 +5,45 @@ package app
 import (
 	"context"
 	"log/slog"
+	"time"
 
+	grpcapp "avitotech-pr-reviewer/internal/app/grpc"
 	httpapp "avitotech-pr-reviewer/internal/app/http"
 	"avitotech-pr-reviewer/internal/config"
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/events"
+	"avitotech-pr-reviewer/internal/health"
+	"avitotech-pr-reviewer/internal/metrics"
+	"avitotech-pr-reviewer/internal/notify"
+	"avitotech-pr-reviewer/internal/scheduler"
+	chatService "avitotech-pr-reviewer/internal/service/chat"
+	codeOwnersService "avitotech-pr-reviewer/internal/service/codeowners"
+	eventLogService "avitotech-pr-reviewer/internal/service/eventlog"
+	orgService "avitotech-pr-reviewer/internal/service/organization"
 	prService "avitotech-pr-reviewer/internal/service/pullrequest"
+	reminderService "avitotech-pr-reviewer/internal/service/reminder"
+	repoService "avitotech-pr-reviewer/internal/service/repository"
+	slaService "avitotech-pr-reviewer/internal/service/sla"
+	statsService "avitotech-pr-reviewer/internal/service/stats"
 	teamService "avitotech-pr-reviewer/internal/service/team"
 	userService "avitotech-pr-reviewer/internal/service/user"
-	prRepository "avitotech-pr-reviewer/internal/storage/postgres/pullrequest"
-	teamRepository "avitotech-pr-reviewer/internal/storage/postgres/team"
-	userRepository "avitotech-pr-reviewer/internal/storage/postgres/user"
-	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type App struct {
-	Srv *httpapp.App
+	Srv       *httpapp.App
+	GRPC      *grpcapp.App
+	SLA       *slaService.Service
+	Scheduler *scheduler.Scheduler
+	// Mailer равен nil, если письма об изменениях назначений отключены.
+	Mailer *notify.Mailer
+	// Tracing равен nil, если экспорт трассировок отключён. При остановке сервиса
+	// Shutdown отправляет накопленные span'ы.
+	Tracing *sdktrace.TracerProvider
+	// Health отвечает на /readyz и следит за фоновыми обработчиками,
+	// которые запускаются через Health.Go.
+	Health *health.Checker
 }
 
 func New(
@@ -26,32 +51,217 @@ func New(
 	lgr *slog.Logger,
 	cfg *config.Config,
 ) *App {
-	pgPool, err := pgPkg.NewPool(ctx, cfg.PG.DSN(), pgPkg.WithMaxConns(cfg.PG.MaxConns))
-	if err != nil {
-		panic("failed to connect to postgres: " + err.Error())
+	tp := mustTracing(ctx, cfg.Tracing)
+
+	repos := mustRepositories(ctx, cfg)
+	teamRepo := repos.team
+	userRepo := repos.user
+	prRepo := repos.pr
+	codeOwnersRepo := repos.codeOwners
+	repoRepo := repos.repo
+	orgRepo := repos.org
+	statsRepo := repos.stats
+	eventRepo := repos.event
+
+	checker := health.New()
+	for name, check := range repos.checks {
+		checker.AddCheck(name, check)
 	}
 
-	teamRepo := teamRepository.New(pgPool)
-	userRepo := userRepository.New(pgPool)
-	prRepo := prRepository.New(pgPool)
+	bus := events.NewBus(lgr.WithGroup("events"))
+	mtr := metrics.New()
+	if repos.pgPool != nil {
+		mtr.RegisterPool(repos.pgPool)
+	}
 
 	teamSvc := teamService.New(lgr.WithGroup("service.team"), teamRepo, userRepo)
-	userSvc := userService.New(lgr.WithGroup("service.user"), userRepo, teamRepo, cfg.App.AdminToken)
+	userSvc := userService.New(lgr.WithGroup("service.user"), userRepo, teamRepo, prRepo)
+	// Сервисы публикуют события через журнал: он сохраняет событие и только потом передаёт его шине.
+	eventLogSvc := eventLogService.New(lgr.WithGroup("service.eventlog"),
+		eventRepo, teamRepo, userRepo, orgRepo, bus, repos.txManager, cfg.Events.Retention)
 	prSvc := prService.New(lgr.WithGroup("service.pullrequest"),
-		prRepo, userRepo, teamRepo, cfg.App.MaxReviewersPerPR)
+		prRepo, userRepo, teamRepo, codeOwnersRepo, repoRepo, eventLogSvc, mtr, repos.txManager,
+		cfg.App.MaxReviewersPerPR)
+	codeOwnersSvc := codeOwnersService.New(lgr.WithGroup("service.codeowners"),
+		codeOwnersRepo, repoRepo, teamRepo, userRepo)
+	repoSvc := repoService.New(lgr.WithGroup("service.repository"), repoRepo, teamRepo)
+	orgSvc := orgService.New(lgr.WithGroup("service.organization"), orgRepo,
+		cfg.App.AdminToken, cfg.App.AllowAnonymous)
+	statsSvc := statsService.New(lgr.WithGroup("service.stats"), statsRepo, teamRepo)
+	mtr.RegisterWorkload(lgr.WithGroup("metrics"), orgRepo, statsSvc)
+
+	// Проверку сроков, как и задачи планировщика, выполняет только реплика-лидер,
+	// иначе каждая реплика публиковала бы событие о том же просроченном назначении.
+	var slaOpts []slaService.Option
+	if repos.leader != nil {
+		slaOpts = append(slaOpts, slaService.WithLeader(repos.leader))
+	}
+	slaSvc := slaService.New(lgr.WithGroup("service.sla"), prRepo, userRepo, orgRepo, prSvc, eventLogSvc,
+		repos.txManager, mustWorkingHours(cfg.SLA), cfg.SLA.CheckInterval, slaOpts...)
+
+	templates, err := notify.LoadTemplates(cfg.SMTP.TemplatesDir)
+	if err != nil {
+		panic("failed to load email templates: " + err.Error())
+	}
+
+	var mailer *notify.Mailer
+	if cfg.SMTP.AssignmentEmails {
+		mailer = notify.NewMailer(lgr.WithGroup("notify.mailer"),
+			bus, userRepo, mustSender(cfg.SMTP), templates)
+	}
+
+	httpOpts := []httpapp.Option{
+		httpapp.WithPort(cfg.HTTP.Port),
+		httpapp.WithReadTimeout(cfg.HTTP.ReadTimeout),
+		httpapp.WithWriteTimeout(cfg.HTTP.WriteTimeout),
+		httpapp.WithRequestTimeout(cfg.HTTP.GatewayTimeout),
+		httpapp.WithMetrics(mtr),
+		httpapp.WithTracing(cfg.Tracing.ServiceName),
+		httpapp.WithHealth(checker),
+	}
+	if cfg.Chat.SigningSecret != "" {
+		httpOpts = append(httpOpts, mustChat(lgr.WithGroup("service.chat"), cfg.Chat, userSvc, prSvc))
+	}
+
+	reminderSvc := reminderService.New(lgr.WithGroup("service.reminder"), prRepo, orgRepo,
+		mustNotifier(lgr.WithGroup("notify"), cfg.Reminders.Notifier, cfg.SMTP, templates))
+	var schedOpts []scheduler.Option
+	if repos.leader != nil {
+		schedOpts = append(schedOpts, scheduler.WithLeader(repos.leader))
+	}
+	sched := mustScheduler(lgr.WithGroup("scheduler"), cfg.Reminders, reminderSvc, schedOpts...)
+	if cfg.Events.Retention > 0 {
+		err = sched.Add("events.prune", "@hourly", eventLogSvc.Prune)
+		if err != nil {
+			panic("failed to schedule event log pruning: " + err.Error())
+		}
+	}
 
 	srv := httpapp.New(
 		lgr,
 		teamSvc,
 		userSvc,
 		prSvc,
-		httpapp.WithPort(cfg.HTTP.Port),
-		httpapp.WithReadTimeout(cfg.HTTP.ReadTimeout),
-		httpapp.WithWriteTimeout(cfg.HTTP.WriteTimeout),
-		httpapp.WithRequestTimeout(cfg.HTTP.GatewayTimeout),
+		codeOwnersSvc,
+		repoSvc,
+		orgSvc,
+		statsSvc,
+		eventLogSvc,
+		httpOpts...,
 	)
 
+	grpcSrv := grpcapp.New(lgr, teamSvc, userSvc, prSvc, orgSvc, grpcapp.WithPort(cfg.GRPC.Port))
+
 	return &App{
-		Srv: srv,
+		Srv:       srv,
+		GRPC:      grpcSrv,
+		SLA:       slaSvc,
+		Scheduler: sched,
+		Mailer:    mailer,
+		Tracing:   tp,
+		Health:    checker,
+	}
+}
+
+func mustWorkingHours(cfg config.SLAConfig) slaService.WorkingHours {
+	if cfg.WorkdayStart < 0 || cfg.WorkdayEnd > 24 || cfg.WorkdayStart >= cfg.WorkdayEnd {
+		panic("invalid SLA working hours: workday_start must be less than workday_end within 0..24")
 	}
+
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		panic("invalid SLA timezone: " + err.Error())
+	}
+
+	return slaService.WorkingHours{
+		Start:    cfg.WorkdayStart,
+		End:      cfg.WorkdayEnd,
+		Location: loc,
+	}
+}
+
+func mustChat(
+	lgr *slog.Logger,
+	cfg config.ChatConfig,
+	userSvc *userService.Service,
+	prSvc *prService.Service,
+) httpapp.Option {
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		panic("invalid chat timezone: " + err.Error())
+	}
+
+	orgID := cfg.OrganizationID
+	if orgID == "" {
+		orgID = domain.DefaultOrganizationID
+	}
+
+	return httpapp.WithChat(chatService.New(lgr, userSvc, prSvc, loc), cfg.SigningSecret, orgID)
+}
+
+func mustNotifier(
+	lgr *slog.Logger,
+	cfg config.NotifierConfig,
+	smtpCfg config.SMTPConfig,
+	templates *notify.Templates,
+) reminderService.Notifier {
+	switch cfg.Type {
+	case "log":
+		return notify.NewLog(lgr)
+	case "webhook":
+		if cfg.WebhookURL == "" {
+			panic("notifier webhook_url is required for webhook notifier")
+		}
+
+		return notify.NewWebhook(cfg.WebhookURL, cfg.Timeout)
+	case "smtp":
+		return notify.NewSMTP(mustSender(smtpCfg), templates)
+	default:
+		panic("unknown notifier type: " + cfg.Type)
+	}
+}
+
+func mustSender(cfg config.SMTPConfig) *notify.Sender {
+	if cfg.Host == "" || cfg.From == "" {
+		panic("smtp host and from are required to send emails")
+	}
+
+	return notify.NewSender(cfg.Host, cfg.Port, cfg.Username, cfg.Password, cfg.From, cfg.Timeout)
+}
+
+func mustScheduler(
+	lgr *slog.Logger,
+	cfg config.RemindersConfig,
+	reminderSvc *reminderService.Service,
+	opts ...scheduler.Option,
+) *scheduler.Scheduler {
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		panic("invalid reminders timezone: " + err.Error())
+	}
+
+	sched := scheduler.New(lgr, loc, opts...)
+
+	schedules := []struct {
+		spec      string
+		frequency domain.ReminderFrequency
+	}{
+		{spec: cfg.Daily, frequency: domain.ReminderDaily},
+		{spec: cfg.Weekly, frequency: domain.ReminderWeekly},
+	}
+	for _, sc := range schedules {
+		if sc.spec == "" {
+			continue
+		}
+
+		frequency := sc.frequency
+		err = sched.Add("reminders."+string(frequency), sc.spec, func(ctx context.Context) error {
+			return reminderSvc.SendDigests(ctx, frequency)
+		})
+		if err != nil {
+			panic("invalid reminders schedule: " + err.Error())
+		}
+	}
+
+	return sched
 }
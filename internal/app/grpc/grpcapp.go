@@ -0,0 +1,158 @@
+// Package grpcapp реализует gRPC сервер с возможностью настройки параметров
+// через func options. Сервер работает рядом с HTTP сервером на своём порту
+// и использует те же сервисы.
+package grpcapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	grpcapi "avitotech-pr-reviewer/internal/api/grpc"
+	orgService "avitotech-pr-reviewer/internal/service/organization"
+	prService "avitotech-pr-reviewer/internal/service/pullrequest"
+	teamService "avitotech-pr-reviewer/internal/service/team"
+	userService "avitotech-pr-reviewer/internal/service/user"
+	reviewerv1 "avitotech-pr-reviewer/pkg/api/reviewer/v1"
+)
+
+const srvPortDefault = 9090
+
+type App struct {
+	lgr *slog.Logger
+
+	teamSvc *teamService.Service
+	userSvc *userService.Service
+	prSvc   *prService.Service
+	orgSvc  *orgService.Service
+
+	port int
+
+	mu     sync.Mutex
+	server *grpc.Server
+}
+
+type Option func(*App)
+
+func WithPort(port int) Option {
+	return func(a *App) {
+		if port <= 0 || port > 65535 {
+			a.lgr.Error("invalid port number, using default port", slog.Int("default_port", srvPortDefault))
+			a.port = srvPortDefault
+		} else {
+			a.port = port
+		}
+	}
+}
+
+// New создает новый экземпляр gRPC сервера с заданными опциями.
+func New(
+	lgr *slog.Logger,
+	teamSvc *teamService.Service,
+	userSvc *userService.Service,
+	prSvc *prService.Service,
+	orgSvc *orgService.Service,
+	opts ...Option,
+) *App {
+	app := &App{
+		lgr:     lgr,
+		teamSvc: teamSvc,
+		userSvc: userSvc,
+		prSvc:   prSvc,
+		orgSvc:  orgSvc,
+		port:    srvPortDefault,
+	}
+
+	for _, opt := range opts {
+		opt(app)
+	}
+
+	return app
+}
+
+// MustRun запускает gRPC сервер и паникует в случае ошибки.
+func (a *App) MustRun(ctx context.Context) {
+	err := a.Run(ctx)
+	if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		panic("failed to run gRPC server: " + err.Error())
+	}
+}
+
+// Run запускает gRPC сервер.
+func (a *App) Run(ctx context.Context) error {
+	const op = "grpcapp.Run"
+
+	lgr := a.lgr.With(
+		slog.String("op", op),
+		slog.Int("port", a.port),
+	)
+
+	lgr.InfoContext(ctx, "starting gRPC server")
+
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcapi.UnaryLogger(a.lgr),
+		grpcapi.UnaryAuth(a.orgSvc.Authenticate),
+	))
+
+	reviewerv1.RegisterTeamServiceServer(srv, grpcapi.NewTeamServer(a.teamSvc))
+	reviewerv1.RegisterUserServiceServer(srv, grpcapi.NewUserServer(a.userSvc))
+	reviewerv1.RegisterPullRequestServiceServer(srv, grpcapi.NewPullRequestServer(a.prSvc))
+	reflection.Register(srv)
+
+	var lc net.ListenConfig
+
+	lis, err := lc.Listen(ctx, "tcp", fmt.Sprintf(":%d", a.port))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	a.mu.Lock()
+	a.server = srv
+	a.mu.Unlock()
+
+	err = srv.Serve(lis)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Stop останавливает gRPC сервер, дожидаясь завершения текущих вызовов.
+// Если контекст истекает раньше, оставшиеся вызовы прерываются.
+func (a *App) Stop(ctx context.Context) error {
+	const op = "grpcapp.Stop"
+
+	lgr := a.lgr.With("op", op)
+
+	lgr.Info("stopping gRPC server")
+
+	a.mu.Lock()
+	server := a.server
+	a.mu.Unlock()
+
+	if server == nil {
+		return nil
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
@@ -11,15 +11,31 @@ import (
 	"sync"
 	"time"
 
+	gqlHandler "avitotech-pr-reviewer/internal/api/graphql"
 	"avitotech-pr-reviewer/internal/api/middleware"
+	chatHandler "avitotech-pr-reviewer/internal/api/v1/chat"
+	codeOwnersHandler "avitotech-pr-reviewer/internal/api/v1/codeowners"
+	eventsHandler "avitotech-pr-reviewer/internal/api/v1/events"
+	orgHandler "avitotech-pr-reviewer/internal/api/v1/organization"
 	prHandler "avitotech-pr-reviewer/internal/api/v1/pullrequest"
+	repoHandler "avitotech-pr-reviewer/internal/api/v1/repository"
+	statsHandler "avitotech-pr-reviewer/internal/api/v1/stats"
 	teamHandler "avitotech-pr-reviewer/internal/api/v1/team"
 	userHandler "avitotech-pr-reviewer/internal/api/v1/user"
+	"avitotech-pr-reviewer/internal/health"
+	"avitotech-pr-reviewer/internal/metrics"
+	chatService "avitotech-pr-reviewer/internal/service/chat"
+	codeOwnersService "avitotech-pr-reviewer/internal/service/codeowners"
+	eventLogService "avitotech-pr-reviewer/internal/service/eventlog"
+	orgService "avitotech-pr-reviewer/internal/service/organization"
 	prService "avitotech-pr-reviewer/internal/service/pullrequest"
+	repoService "avitotech-pr-reviewer/internal/service/repository"
+	statsService "avitotech-pr-reviewer/internal/service/stats"
 	teamService "avitotech-pr-reviewer/internal/service/team"
 	userService "avitotech-pr-reviewer/internal/service/user"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 const (
@@ -32,9 +48,24 @@ const (
 type App struct {
 	lgr *slog.Logger
 
-	teamSvc *teamService.Service
-	userSvc *userService.Service
-	prSvc   *prService.Service
+	teamSvc       *teamService.Service
+	userSvc       *userService.Service
+	prSvc         *prService.Service
+	codeOwnersSvc *codeOwnersService.Service
+	repoSvc       *repoService.Service
+	orgSvc        *orgService.Service
+	statsSvc      *statsService.Service
+	eventLogSvc   *eventLogService.Service
+
+	chatSvc           *chatService.Service
+	chatSigningSecret string
+	chatOrgID         string
+
+	metrics *metrics.Metrics
+
+	tracingService string
+
+	health *health.Checker
 
 	port           int
 	readTimeout    time.Duration
@@ -70,25 +101,69 @@ func WithWriteTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithRequestTimeout ограничивает время обработки одного запроса, кроме потока событий.
+// Запрос, не уложившийся в срок, завершается ошибкой TIMEOUT со статусом 504.
 func WithRequestTimeout(timeout time.Duration) Option {
 	return func(a *App) {
 		a.requestTimeout = timeout
 	}
 }
 
+// WithChat включает эндпоинт slash-команд чата. Подпись запросов проверяется секретом
+// signingSecret, а команды выполняются в организации orgID.
+func WithChat(chatSvc *chatService.Service, signingSecret, orgID string) Option {
+	return func(a *App) {
+		a.chatSvc = chatSvc
+		a.chatSigningSecret = signingSecret
+		a.chatOrgID = orgID
+	}
+}
+
+// WithMetrics учитывает HTTP-запросы в метриках и открывает эндпоинт /metrics.
+func WithMetrics(m *metrics.Metrics) Option {
+	return func(a *App) {
+		a.metrics = m
+	}
+}
+
+// WithTracing создаёт span на каждый запрос с именем сервиса serviceName и продолжает
+// трассировку из заголовка traceparent. Служебные эндпоинты и поток событий не трассируются.
+func WithTracing(serviceName string) Option {
+	return func(a *App) {
+		a.tracingService = serviceName
+	}
+}
+
+// WithHealth открывает эндпоинт /readyz с проверкой зависимостей и фоновых обработчиков.
+func WithHealth(h *health.Checker) Option {
+	return func(a *App) {
+		a.health = h
+	}
+}
+
 // New создает новый экземпляр HTTP сервера с заданными опциями.
 func New(
 	lgr *slog.Logger,
 	teamSvc *teamService.Service,
 	userSvc *userService.Service,
 	prService *prService.Service,
+	codeOwnersSvc *codeOwnersService.Service,
+	repoSvc *repoService.Service,
+	orgSvc *orgService.Service,
+	statsSvc *statsService.Service,
+	eventLogSvc *eventLogService.Service,
 	opts ...Option,
 ) *App {
 	app := &App{
-		lgr:     lgr,
-		teamSvc: teamSvc,
-		userSvc: userSvc,
-		prSvc:   prService,
+		lgr:           lgr,
+		teamSvc:       teamSvc,
+		userSvc:       userSvc,
+		prSvc:         prService,
+		codeOwnersSvc: codeOwnersSvc,
+		repoSvc:       repoSvc,
+		orgSvc:        orgSvc,
+		statsSvc:      statsSvc,
+		eventLogSvc:   eventLogSvc,
 
 		readTimeout:    srvReadTimeoutDefault,
 		writeTimeout:   srvWriteTimeoutDefault,
@@ -121,23 +196,71 @@ func (a *App) Run(ctx context.Context) error {
 
 	lgr.InfoContext(ctx, "starting HTTP http_server")
 
-	teamHlr := teamHandler.New(a.teamSvc, a.userSvc)
-	usersHlr := userHandler.New(a.userSvc, a.userSvc)
-	prHlr := prHandler.New(a.userSvc, a.prSvc)
+	teamHlr := teamHandler.New(a.teamSvc)
+	usersHlr := userHandler.New(a.userSvc)
+	prHlr := prHandler.New(a.prSvc)
+	codeOwnersHlr := codeOwnersHandler.New(a.codeOwnersSvc)
+	repoHlr := repoHandler.New(a.repoSvc)
+	orgHlr := orgHandler.New(a.orgSvc)
+	statsHlr := statsHandler.New(a.statsSvc)
+
+	// Потоки событий не завершаются сами, поэтому закрываются по сигналу остановки сервера,
+	// иначе Shutdown ждал бы их до истечения тайм-аута.
+	shutdown := make(chan struct{})
+	eventsHlr := eventsHandler.New(a.eventLogSvc, shutdown)
 
 	app := gin.New()
 	app.Use(gin.Recovery())
+	// Метрики подключаются снаружи Timeout, чтобы учитывать его ответ 504,
+	// и внутри Recovery, которая отвечает 500 на панику, пропущенную Metrics.
+	if a.metrics != nil {
+		app.Use(middleware.Metrics(a.metrics))
+	}
+	// Трассировка подключается до Logger, чтобы строки лога запроса содержали trace_id.
+	if a.tracingService != "" {
+		app.Use(otelgin.Middleware(a.tracingService, otelgin.WithFilter(isTraced)))
+	}
 	app.Use(middleware.Logger(lgr))
+	app.Use(middleware.Timeout(a.requestTimeout, eventsHandler.StreamRoute))
+	if a.metrics != nil {
+		app.GET("/metrics", gin.WrapH(a.metrics.Handler()))
+	}
 
-	app.GET("/health", func(c *gin.Context) {
+	// /livez отвечает, пока процесс способен обрабатывать запросы, и не проверяет зависимости:
+	// недоступная база не должна приводить к перезапуску реплики. /health оставлен для совместимости.
+	live := func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
-	})
+	}
+	app.GET("/livez", live)
+	app.GET("/health", live)
+	if a.health != nil {
+		app.GET("/readyz", func(c *gin.Context) {
+			report := a.health.Report(c.Request.Context())
+			if !report.Ready() {
+				c.JSON(http.StatusServiceUnavailable, report)
+
+				return
+			}
+
+			c.JSON(http.StatusOK, report)
+		})
+	}
+
+	if a.chatSvc != nil {
+		chatHandler.New(a.chatSvc, a.chatSigningSecret, a.chatOrgID).RegisterRoutes(app.Group("/"))
+	}
 
-	base := app.Group("/")
+	base := app.Group("/", middleware.Authenticate(a.orgSvc.Authenticate))
 
 	teamHlr.RegisterRoutes(base)
 	usersHlr.RegisterRoutes(base)
 	prHlr.RegisterRoutes(base)
+	codeOwnersHlr.RegisterRoutes(base)
+	repoHlr.RegisterRoutes(base)
+	orgHlr.RegisterRoutes(base)
+	statsHlr.RegisterRoutes(base)
+	eventsHlr.RegisterRoutes(base)
+	gqlHandler.New(a.teamSvc, a.userSvc, a.prSvc).RegisterRoutes(base)
 
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%d", a.port),
@@ -145,6 +268,9 @@ func (a *App) Run(ctx context.Context) error {
 		ReadTimeout:  a.readTimeout,
 		WriteTimeout: a.writeTimeout,
 	}
+	srv.RegisterOnShutdown(func() {
+		close(shutdown)
+	})
 
 	a.server = srv
 
@@ -173,3 +299,14 @@ func (a *App) Stop(ctx context.Context) error {
 
 	return nil
 }
+
+// isTraced исключает из трассировки проверки состояния, сбор метрик и поток событий:
+// первые создавали бы шум, а span потока длился бы всё время подключения.
+func isTraced(r *http.Request) bool {
+	switch r.URL.Path {
+	case "/health", "/livez", "/readyz", "/metrics", eventsHandler.StreamRoute:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"avitotech-pr-reviewer/internal/config"
+	"avitotech-pr-reviewer/migrations"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+var ErrMigrateUsage = errors.New("usage: migrate up | down [N] | status | force VERSION")
+
+// Migrate выполняет подкоманду migrate над базой PostgreSQL из конфигурации.
+// args — аргументы после слова migrate: up, down [N] (по умолчанию одна миграция),
+// status или force VERSION. Результат печатается в out.
+func Migrate(cfg *config.Config, args []string, out io.Writer) error {
+	if cfg.Storage != config.StoragePostgres {
+		return fmt.Errorf("migrate supports only %s storage, got %q", config.StoragePostgres, cfg.Storage)
+	}
+
+	action, err := parseMigrateArgs(args)
+	if err != nil {
+		return err
+	}
+
+	err = cfg.PG.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid postgres config: %w", err)
+	}
+
+	m, err := pgPkg.NewMigrator(cfg.PG.DSN(), migrations.FS, ".")
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	err = action(m)
+	if err != nil {
+		return err
+	}
+
+	version, dirty, err := m.Status()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "version: %d, dirty: %t\n", version, dirty)
+
+	return err
+}
+
+// parseMigrateArgs разбирает аргументы подкоманды до подключения к базе,
+// чтобы опечатка в команде не требовала доступной базы.
+func parseMigrateArgs(args []string) (func(m *pgPkg.Migrator) error, error) {
+	if len(args) == 0 {
+		return nil, ErrMigrateUsage
+	}
+
+	switch cmd, rest := args[0], args[1:]; {
+	case cmd == "up" && len(rest) == 0:
+		return (*pgPkg.Migrator).Up, nil
+	case cmd == "down" && len(rest) <= 1:
+		steps := 1
+		if len(rest) == 1 {
+			n, err := strconv.Atoi(rest[0])
+			if err != nil {
+				return nil, ErrMigrateUsage
+			}
+			steps = n
+		}
+
+		return func(m *pgPkg.Migrator) error { return m.Down(steps) }, nil
+	case cmd == "force" && len(rest) == 1:
+		version, err := strconv.Atoi(rest[0])
+		if err != nil {
+			return nil, ErrMigrateUsage
+		}
+
+		return func(m *pgPkg.Migrator) error { return m.Force(version) }, nil
+	case cmd == "status" && len(rest) == 0:
+		return func(*pgPkg.Migrator) error { return nil }, nil
+	default:
+		return nil, ErrMigrateUsage
+	}
+}
+
+// mustAutoMigrate применяет недостающие миграции при запуске, если это включено в конфигурации.
+// Одновременно запущенные реплики ждут друг друга на advisory lock внутри Migrator.
+func mustAutoMigrate(cfg *config.Config) {
+	if !cfg.PG.AutoMigrate {
+		return
+	}
+
+	m, err := pgPkg.NewMigrator(cfg.PG.DSN(), migrations.FS, ".")
+	if err != nil {
+		panic("failed to prepare migrations: " + err.Error())
+	}
+	defer m.Close()
+
+	err = m.Up()
+	if err != nil {
+		panic("failed to apply migrations: " + err.Error())
+	}
+}
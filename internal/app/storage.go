@@ -0,0 +1,219 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"avitotech-pr-reviewer/internal/config"
+	"avitotech-pr-reviewer/internal/health"
+	"avitotech-pr-reviewer/internal/notify"
+	"avitotech-pr-reviewer/internal/scheduler"
+	codeOwnersService "avitotech-pr-reviewer/internal/service/codeowners"
+	eventLogService "avitotech-pr-reviewer/internal/service/eventlog"
+	orgService "avitotech-pr-reviewer/internal/service/organization"
+	prService "avitotech-pr-reviewer/internal/service/pullrequest"
+	reminderService "avitotech-pr-reviewer/internal/service/reminder"
+	repoService "avitotech-pr-reviewer/internal/service/repository"
+	slaService "avitotech-pr-reviewer/internal/service/sla"
+	statsService "avitotech-pr-reviewer/internal/service/stats"
+	teamService "avitotech-pr-reviewer/internal/service/team"
+	userService "avitotech-pr-reviewer/internal/service/user"
+	"avitotech-pr-reviewer/internal/storage/memory"
+	codeOwnersRepository "avitotech-pr-reviewer/internal/storage/postgres/codeowners"
+	eventRepository "avitotech-pr-reviewer/internal/storage/postgres/event"
+	orgRepository "avitotech-pr-reviewer/internal/storage/postgres/organization"
+	prRepository "avitotech-pr-reviewer/internal/storage/postgres/pullrequest"
+	repoRepository "avitotech-pr-reviewer/internal/storage/postgres/repository"
+	statsRepository "avitotech-pr-reviewer/internal/storage/postgres/stats"
+	teamRepository "avitotech-pr-reviewer/internal/storage/postgres/team"
+	userRepository "avitotech-pr-reviewer/internal/storage/postgres/user"
+	"avitotech-pr-reviewer/internal/storage/sqlite"
+	"avitotech-pr-reviewer/migrations"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+// Интерфейсы ниже объединяют требования всех сервисов к хранилищу,
+// чтобы реализацию можно было выбрать в конфигурации.
+
+type teamRepo interface {
+	teamService.TeamRepository
+	userService.TeamRepository
+	prService.TeamRepository
+	codeOwnersService.TeamRepository
+	repoService.TeamRepository
+	statsService.TeamRepository
+	eventLogService.TeamRepository
+}
+
+type userRepo interface {
+	teamService.UserRepository
+	userService.UserRepository
+	prService.UserRepository
+	codeOwnersService.UserRepository
+	eventLogService.UserRepository
+	notify.UserRepository
+	slaService.UserRepository
+}
+
+type prRepo interface {
+	userService.PrRepository
+	prService.PrRepository
+	slaService.PrRepository
+	reminderService.PrRepository
+}
+
+type codeOwnersRepo interface {
+	prService.CodeOwnersRepository
+	codeOwnersService.CodeOwnersRepository
+}
+
+type repoRepo interface {
+	prService.RepoRepository
+	codeOwnersService.RepoRepository
+	repoService.RepoRepository
+}
+
+type orgRepo interface {
+	orgService.OrgRepository
+	eventLogService.OrgRepository
+	slaService.OrgRepository
+	reminderService.OrgRepository
+}
+
+type txManager interface {
+	prService.TxManager
+	slaService.TxManager
+	eventLogService.TxManager
+}
+
+// repositories — хранилища всех сервисов поверх одного бэкенда.
+type repositories struct {
+	team       teamRepo
+	user       userRepo
+	pr         prRepo
+	codeOwners codeOwnersRepo
+	repo       repoRepo
+	org        orgRepo
+	stats      statsService.StatsRepository
+	event      eventLogService.EventRepository
+	txManager  txManager
+	// pgPool — пул подключений PostgreSQL для метрик; nil для остальных бэкендов.
+	pgPool *pgxpool.Pool
+	// leader выбирает реплику, выполняющую задачи планировщика; nil для бэкендов,
+	// которые работают на одном узле.
+	leader scheduler.Leader
+	// checks — проверки готовности хранилища для /readyz.
+	checks map[string]health.Check
+}
+
+// mustRepositories создаёт хранилища выбранного в конфигурации бэкенда.
+func mustRepositories(ctx context.Context, cfg *config.Config) repositories {
+	switch cfg.Storage {
+	case config.StoragePostgres:
+		err := cfg.PG.Validate()
+		if err != nil {
+			panic("invalid postgres config: " + err.Error())
+		}
+
+		mustAutoMigrate(cfg)
+
+		pgPool, err := pgPkg.NewPool(ctx, cfg.PG.DSN(), pgPkg.WithMaxConns(cfg.PG.MaxConns),
+			pgPkg.WithTracing(), pgPkg.WithQueryCancel())
+		if err != nil {
+			panic("failed to connect to postgres: " + err.Error())
+		}
+
+		// Репозитории работают через txManager, чтобы участвовать в транзакциях WithinTx.
+		txManager := pgPkg.NewTxManager(pgPool)
+
+		return repositories{
+			team:       teamRepository.New(txManager),
+			user:       userRepository.New(txManager),
+			pr:         prRepository.New(txManager),
+			codeOwners: codeOwnersRepository.New(txManager),
+			repo:       repoRepository.New(txManager),
+			org:        orgRepository.New(txManager),
+			stats:      statsRepository.New(txManager),
+			event:      eventRepository.New(txManager),
+			txManager:  txManager,
+			pgPool:     pgPool,
+			leader:     pgPkg.NewLeaderLock(pgPool, schedulerLock),
+			checks: map[string]health.Check{
+				"postgres":   pgPool.Ping,
+				"migrations": schemaCheck(pgPool),
+			},
+		}
+	case config.StorageMemory:
+		store := memory.NewStore()
+
+		return repositories{
+			team:       memory.NewTeamRepository(store),
+			user:       memory.NewUserRepository(store),
+			pr:         memory.NewPullRequestRepository(store),
+			codeOwners: memory.NewCodeOwnersRepository(store),
+			repo:       memory.NewRepoRepository(store),
+			org:        memory.NewOrgRepository(store),
+			stats:      memory.NewStatsRepository(store),
+			event:      memory.NewEventRepository(store),
+			txManager:  memory.TxManager{},
+		}
+	case config.StorageSQLite:
+		db, err := sqlite.Open(ctx, cfg.SQLite.Path)
+		if err != nil {
+			panic("failed to open sqlite: " + err.Error())
+		}
+
+		txManager := sqlitePkg.NewTxManager(db)
+
+		return repositories{
+			team:       sqlite.NewTeamRepository(txManager),
+			user:       sqlite.NewUserRepository(txManager),
+			pr:         sqlite.NewPullRequestRepository(txManager),
+			codeOwners: sqlite.NewCodeOwnersRepository(txManager),
+			repo:       sqlite.NewRepoRepository(txManager),
+			org:        sqlite.NewOrgRepository(txManager),
+			stats:      sqlite.NewStatsRepository(txManager),
+			event:      sqlite.NewEventRepository(txManager),
+			txManager:  txManager,
+			checks: map[string]health.Check{
+				"sqlite": db.PingContext,
+				"migrations": func(ctx context.Context) error {
+					return sqlite.CheckMigrations(ctx, db)
+				},
+			},
+		}
+	default:
+		panic("unknown storage: " + cfg.Storage)
+	}
+}
+
+// schedulerLock — имя advisory-блокировки, которую держит реплика, выполняющая задачи планировщика.
+const schedulerLock = "pr-reviewer.scheduler"
+
+// schemaCheck проверяет, что к базе применены все встроенные миграции и последняя
+// не завершилась ошибкой. Более новая схема допустима: при поэтапном обновлении
+// её применяет первая запущенная реплика новой версии, а старые продолжают работать.
+func schemaCheck(pool *pgxpool.Pool) health.Check {
+	want, err := migrations.Latest()
+	if err != nil {
+		panic("failed to read embedded migrations: " + err.Error())
+	}
+
+	return func(ctx context.Context) error {
+		version, dirty, err := pgPkg.SchemaVersion(ctx, pool)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("schema version %d is dirty", version)
+		}
+		if version < want {
+			return fmt.Errorf("schema version %d is behind %d", version, want)
+		}
+
+		return nil
+	}
+}
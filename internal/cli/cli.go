@@ -0,0 +1,90 @@
+// Package cli реализует prctl — консольную утилиту для повседневных операций
+// с сервисом назначения ревьюверов. Команды обращаются к HTTP API через pkg/client.
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"avitotech-pr-reviewer/pkg/client"
+)
+
+// app — состояние одного запуска prctl, общее для всех команд.
+type app struct {
+	configPath string
+	profile    string
+	output     string
+	// overrides — значения флагов --url, --token и --admin-token.
+	overrides Config
+
+	client *client.Client
+	out    *printer
+}
+
+// New создаёт корневую команду prctl, которая пишет результаты в stdout.
+func New(stdout io.Writer) *cobra.Command {
+	a := &app{}
+
+	root := &cobra.Command{
+		Use:   "prctl",
+		Short: "Manage teams, users and pull requests of the PR reviewer service",
+		Long: `prctl wraps the PR reviewer HTTP API for day-to-day operations.
+
+Connection settings are read from a profile file (~/.config/prctl/config.yaml
+or --config), then from PRCTL_URL, PRCTL_TOKEN and PRCTL_ADMIN_TOKEN, then from flags.`,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PersistentPreRunE: a.init,
+	}
+
+	root.SetOut(stdout)
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&a.configPath, "config", "", "profile file (default $PRCTL_CONFIG or ~/.config/prctl/config.yaml)")
+	flags.StringVarP(&a.profile, "profile", "p", "", "profile name (default $PRCTL_PROFILE or current profile)")
+	flags.StringVarP(&a.output, "output", "o", outputTable, "output format: table or json")
+	flags.StringVar(&a.overrides.URL, "url", "", "service base URL")
+	flags.StringVar(&a.overrides.Token, "token", "", "organization token sent as Bearer")
+	flags.StringVar(&a.overrides.AdminToken, "admin-token", "", "admin token sent as X-Admin-Token")
+
+	root.AddCommand(
+		a.teamCommand(),
+		a.userCommand(),
+		a.prCommand(),
+		a.statsCommand(),
+	)
+
+	return root
+}
+
+func (a *app) init(cmd *cobra.Command, _ []string) error {
+	if a.output != outputTable && a.output != outputJSON {
+		return fmt.Errorf("unknown output format %q: expected table or json", a.output)
+	}
+
+	cfg, err := loadConfig(a.configPath, a.profile)
+	if err != nil {
+		return err
+	}
+
+	if a.overrides.URL != "" {
+		cfg.URL = a.overrides.URL
+	}
+	if a.overrides.Token != "" {
+		cfg.Token = a.overrides.Token
+	}
+	if a.overrides.AdminToken != "" {
+		cfg.AdminToken = a.overrides.AdminToken
+	}
+
+	a.client, err = client.New(cfg.URL, client.WithToken(cfg.Token), client.WithAdminToken(cfg.AdminToken))
+	if err != nil {
+		return err
+	}
+
+	a.out = &printer{w: cmd.OutOrStdout(), format: a.output}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/pkg/client"
+)
+
+func run(t *testing.T, srvURL string, args ...string) (string, error) {
+	t.Helper()
+
+	t.Setenv(envConfigPath, "")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	var out bytes.Buffer
+
+	cmd := New(&out)
+	cmd.SetArgs(append([]string{"--url", srvURL, "--admin-token", "admin"}, args...))
+
+	err := cmd.ExecuteContext(context.Background())
+
+	return out.String(), err
+}
+
+func TestParseTeamFile(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		expectedMembers []client.Member
+		expectedSLA     bool
+		expectedErr     string
+	}{
+		{
+			name: "success",
+			content: `
+team_name: backend
+members:
+  - user_id: u1
+    username: alice
+    email: alice@example.com
+  - user_id: u2
+    username: bob
+    is_active: false
+sla:
+  review_sla_hours: 24
+  action: escalate
+  lead_id: u1
+`,
+			expectedMembers: []client.Member{
+				{UserID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+				{UserID: "u2", Username: "bob", IsActive: false},
+			},
+			expectedSLA: true,
+		},
+		{
+			name:        "error - missing team name",
+			content:     "members: []",
+			expectedErr: "team_name is required",
+		},
+		{
+			name:        "error - member without id",
+			content:     "team_name: backend\nmembers:\n  - username: alice\n",
+			expectedErr: "members[0]",
+		},
+		{
+			name:        "error - unknown field",
+			content:     "team_name: backend\nteam: typo\n",
+			expectedErr: "field team not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parseTeamFile(strings.NewReader(tt.content))
+
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMembers, f.members())
+			assert.Equal(t, tt.expectedSLA, f.SLA != nil)
+		})
+	}
+}
+
+func TestUserDeactivate_Reassign(t *testing.T) {
+	var (
+		deactivated bool
+		reassigned  []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "admin", r.Header.Get("X-Admin-Token"))
+
+		switch r.URL.Path {
+		case "/users/setIsActive":
+			deactivated = true
+			_, _ = w.Write([]byte(`{"user_id":"u1","username":"alice","is_active":false}`))
+		case "/users/getReview":
+			assert.True(t, deactivated, "reviews must be reassigned after deactivation")
+			_, _ = w.Write([]byte(`{"user_id":"u1","pull_requests":[
+				{"pull_request_id":"pr-1","repository":"default","status":"OPEN"},
+				{"pull_request_id":"pr-2","repository":"default","status":"MERGED"},
+				{"pull_request_id":"pr-3","repository":"mobile","status":"OPEN"}]}`))
+		case "/pullRequest/reassign":
+			var req struct {
+				PullRequestID string `json:"pull_request_id"`
+				OldReviewerID string `json:"old_reviewer_id"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "u1", req.OldReviewerID)
+			reassigned = append(reassigned, req.PullRequestID)
+
+			if req.PullRequestID == "pr-3" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"error":{"code":"NO_CANDIDATES_FOR_NEW_REVIEWER","message":"no candidates"}}`))
+
+				return
+			}
+			_, _ = w.Write([]byte(`{"pr":{"pull_request_id":"pr-1"},"replaced_by":"u7"}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	out, err := run(t, srv.URL, "user", "deactivate", "u1", "--reassign", "-o", "json")
+
+	require.ErrorContains(t, err, "1 of 2 reviews were not reassigned")
+	assert.Equal(t, []string{"pr-1", "pr-3"}, reassigned)
+
+	var results []reassignment
+	require.NoError(t, json.Unmarshal([]byte(out), &results))
+	require.Len(t, results, 2)
+	assert.Equal(t, "u7", results[0].ReplacedBy)
+	assert.Empty(t, results[0].Error)
+	assert.Contains(t, results[1].Error, "NO_CANDIDATES_FOR_NEW_REVIEWER")
+}
+
+func TestTeamShow_Table(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "backend", r.URL.Query().Get("team_name"))
+		_, _ = w.Write([]byte(`{"team_name":"backend","members":[
+			{"user_id":"u1","username":"alice","is_active":true},
+			{"user_id":"u2","username":"bob","is_active":false}]}`))
+	}))
+	defer srv.Close()
+
+	out, err := run(t, srv.URL, "team", "show", "backend")
+
+	require.NoError(t, err)
+	assert.Equal(t, "TEAM  backend\n\nUSER ID  USERNAME  ACTIVE\nu1       alice     true\nu2       bob       false\n", out)
+}
+
+func TestTeamImport_Plan(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "org.csv")
+	require.NoError(t, os.WriteFile(path, []byte("team_name,user_id,username\nmobile,u1,alice\n"), 0o600))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/team/import", r.URL.Path)
+		assert.Equal(t, "false", r.URL.Query().Get("apply"))
+		assert.Equal(t, "text/csv", r.Header.Get("Content-Type"))
+
+		_, _ = w.Write([]byte(`{"applied":false,"changes":[
+			{"action":"create_team","team_name":"mobile"},
+			{"action":"move_user","team_name":"mobile","from_team":"backend","user_id":"u1","username":"alice","is_active":true}]}`))
+	}))
+	defer srv.Close()
+
+	out, err := run(t, srv.URL, "team", "import", "-f", path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "ACTION       TEAM    FROM TEAM  USER ID  USERNAME  ACTIVE  EMAIL\n"+
+		"create_team  mobile  -          -        -         -       -\n"+
+		"move_user    mobile  backend    u1       alice     true    -\n"+
+		"\n"+
+		"Plan: 2 changes, run with --apply to apply\n", out)
+}
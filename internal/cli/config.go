@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultURL     = "http://localhost:8080"
+	defaultProfile = "default"
+
+	envConfigPath = "PRCTL_CONFIG"
+	envProfile    = "PRCTL_PROFILE"
+	envURL        = "PRCTL_URL"
+	envToken      = "PRCTL_TOKEN"
+	envAdminToken = "PRCTL_ADMIN_TOKEN"
+)
+
+// Config — параметры подключения к сервису. Значения из профиля переопределяются
+// переменными окружения, а те — флагами командной строки.
+type Config struct {
+	URL        string `yaml:"url"`
+	Token      string `yaml:"token"`
+	AdminToken string `yaml:"admin_token"`
+}
+
+// profilesFile — файл профилей, например:
+//
+//	current: prod
+//	profiles:
+//	  prod:
+//	    url: https://reviewer.example.com
+//	    admin_token: ...
+//	  local:
+//	    url: http://localhost:8080
+type profilesFile struct {
+	Current  string            `yaml:"current"`
+	Profiles map[string]Config `yaml:"profiles"`
+}
+
+// loadConfig читает профиль profile из файла path и применяет переменные окружения.
+// Пустой path означает PRCTL_CONFIG или ~/.config/prctl/config.yaml; отсутствие файла
+// по умолчанию не считается ошибкой. Пустой profile означает PRCTL_PROFILE, затем
+// профиль current из файла.
+func loadConfig(path, profile string) (Config, error) {
+	explicitPath := path != "" || os.Getenv(envConfigPath) != ""
+	if path == "" {
+		path = os.Getenv(envConfigPath)
+	}
+	if path == "" {
+		path = defaultConfigPath()
+	}
+
+	file, err := readProfiles(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicitPath {
+		err = nil
+	}
+	if err != nil {
+		return Config{}, err
+	}
+
+	explicitProfile := profile != "" || os.Getenv(envProfile) != ""
+	if profile == "" {
+		profile = os.Getenv(envProfile)
+	}
+	if profile == "" {
+		profile = file.Current
+	}
+	if profile == "" {
+		profile = defaultProfile
+	}
+
+	cfg, ok := file.Profiles[profile]
+	if !ok && explicitProfile {
+		return Config{}, fmt.Errorf("profile %q not found in %s", profile, path)
+	}
+
+	// Пустые переменные окружения не сбрасывают значения профиля.
+	for env, value := range map[string]*string{envURL: &cfg.URL, envToken: &cfg.Token, envAdminToken: &cfg.AdminToken} {
+		if v := os.Getenv(env); v != "" {
+			*value = v
+		}
+	}
+
+	if cfg.URL == "" {
+		cfg.URL = defaultURL
+	}
+
+	return cfg, nil
+}
+
+func readProfiles(path string) (profilesFile, error) {
+	var file profilesFile
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return file, err
+	}
+
+	err = yaml.Unmarshal(data, &file)
+	if err != nil {
+		return file, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	return file, nil
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "prctl", "config.yaml")
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const profilesYAML = `
+current: prod
+profiles:
+  prod:
+    url: https://reviewer.example.com
+    admin_token: prod-admin
+  local:
+    url: http://localhost:8081
+    token: local-member
+`
+
+func writeProfiles(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(profilesYAML), 0o600))
+
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        func(t *testing.T) string
+		profile     string
+		env         map[string]string
+		expected    Config
+		expectedErr string
+	}{
+		{
+			name:     "success - current profile",
+			path:     writeProfiles,
+			expected: Config{URL: "https://reviewer.example.com", AdminToken: "prod-admin"},
+		},
+		{
+			name:     "success - profile flag",
+			path:     writeProfiles,
+			profile:  "local",
+			expected: Config{URL: "http://localhost:8081", Token: "local-member"},
+		},
+		{
+			name:     "success - profile from environment",
+			path:     writeProfiles,
+			env:      map[string]string{envProfile: "local"},
+			expected: Config{URL: "http://localhost:8081", Token: "local-member"},
+		},
+		{
+			name:     "success - environment overrides profile",
+			path:     writeProfiles,
+			env:      map[string]string{envAdminToken: "env-admin"},
+			expected: Config{URL: "https://reviewer.example.com", AdminToken: "env-admin"},
+		},
+		{
+			name: "success - no default file",
+			path: func(t *testing.T) string {
+				t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+				t.Setenv("HOME", t.TempDir())
+
+				return ""
+			},
+			env:      map[string]string{envToken: "env-token"},
+			expected: Config{URL: defaultURL, Token: "env-token"},
+		},
+		{
+			name: "error - explicit file missing",
+			path: func(t *testing.T) string {
+				return filepath.Join(t.TempDir(), "missing.yaml")
+			},
+			expectedErr: "no such file",
+		},
+		{
+			name:        "error - unknown profile",
+			path:        writeProfiles,
+			profile:     "staging",
+			expectedErr: `profile "staging" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{envConfigPath, envProfile, envURL, envToken, envAdminToken} {
+				t.Setenv(key, "")
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := loadConfig(tt.path(t), tt.profile)
+
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+// print выводит v в формате JSON или таблицей, которую заполняет table.
+func (p *printer) print(v any, table func(t *tableWriter)) error {
+	if p.format == outputJSON {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(v)
+	}
+
+	t := &tableWriter{tw: tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)}
+	table(t)
+
+	return t.tw.Flush()
+}
+
+type tableWriter struct {
+	tw *tabwriter.Writer
+}
+
+func (t *tableWriter) row(cells ...any) {
+	values := make([]string, len(cells))
+	for i, cell := range cells {
+		values[i] = cellString(cell)
+	}
+
+	fmt.Fprintln(t.tw, strings.Join(values, "\t"))
+}
+
+func cellString(v any) string {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return "-"
+		}
+
+		return v
+	case []string:
+		if len(v) == 0 {
+			return "-"
+		}
+
+		return strings.Join(v, ",")
+	case time.Time:
+		if v.IsZero() {
+			return "-"
+		}
+
+		return v.Local().Format(time.DateTime)
+	case *time.Time:
+		if v == nil {
+			return "-"
+		}
+
+		return cellString(*v)
+	case *float64:
+		if v == nil {
+			return "-"
+		}
+
+		return fmt.Sprintf("%.2f", *v)
+	case float64:
+		return fmt.Sprintf("%.2f", v)
+	case *bool:
+		if v == nil {
+			return "-"
+		}
+
+		return fmt.Sprint(*v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"avitotech-pr-reviewer/pkg/client"
+)
+
+func (a *app) prCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr",
+		Short: "Create, merge and reassign pull requests",
+	}
+
+	cmd.PersistentFlags().String("repo", "", "repository name (default repository if empty)")
+
+	cmd.AddCommand(
+		a.prCreateCommand(),
+		a.prMergeCommand(),
+		a.prReassignCommand(),
+		a.prOverdueCommand(),
+	)
+
+	return cmd
+}
+
+func (a *app) prCreateCommand() *cobra.Command {
+	var req client.CreatePullRequestRequest
+
+	cmd := &cobra.Command{
+		Use:   "create PR_ID --name NAME --author USER_ID",
+		Short: "Create a pull request and assign reviewers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req.PullRequestID = args[0]
+			req.Repository, _ = cmd.Flags().GetString("repo")
+
+			pr, err := a.client.CreatePullRequest(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return a.printPR(pr)
+		},
+	}
+
+	cmd.Flags().StringVar(&req.PullRequestName, "name", "", "pull request title")
+	cmd.Flags().StringVar(&req.AuthorID, "author", "", "author user ID")
+	cmd.Flags().StringSliceVar(&req.ChangedFiles, "file", nil, "changed file used to match CODEOWNERS (repeatable)")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("author")
+
+	return cmd
+}
+
+func (a *app) prMergeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "merge PR_ID",
+		Short: "Mark a pull request as merged",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repo, _ := cmd.Flags().GetString("repo")
+
+			pr, err := a.client.MergePullRequest(cmd.Context(), repo, args[0])
+			if err != nil {
+				return err
+			}
+
+			return a.printPR(pr)
+		},
+	}
+}
+
+func (a *app) prReassignCommand() *cobra.Command {
+	var oldReviewerID string
+
+	cmd := &cobra.Command{
+		Use:   "reassign PR_ID --reviewer USER_ID",
+		Short: "Replace a reviewer of a pull request",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repo, _ := cmd.Flags().GetString("repo")
+
+			pr, replacedBy, err := a.client.ReassignReviewer(cmd.Context(), repo, args[0], oldReviewerID)
+			if err != nil {
+				return err
+			}
+
+			resp := struct {
+				PR         *client.PullRequest `json:"pr"`
+				ReplacedBy string              `json:"replaced_by"`
+			}{
+				PR:         pr,
+				ReplacedBy: replacedBy,
+			}
+
+			return a.out.print(resp, func(t *tableWriter) {
+				prRows(t, pr)
+				t.row("REPLACED BY", replacedBy)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&oldReviewerID, "reviewer", "", "reviewer to replace")
+	_ = cmd.MarkFlagRequired("reviewer")
+
+	return cmd
+}
+
+func (a *app) prOverdueCommand() *cobra.Command {
+	var teamName string
+
+	cmd := &cobra.Command{
+		Use:   "overdue",
+		Short: "List review assignments past their team SLA",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			assignments, err := a.client.OverdueAssignments(cmd.Context(), teamName)
+			if err != nil {
+				return err
+			}
+
+			return a.out.print(assignments, func(t *tableWriter) {
+				t.row("REPOSITORY", "PULL REQUEST", "REVIEWER", "TEAM", "ASSIGNED", "OVERDUE SINCE")
+				for _, as := range assignments {
+					t.row(as.Repository, as.PullRequestID, as.ReviewerID, as.TeamName, as.AssignedAt, as.OverdueAt)
+				}
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&teamName, "team", "", "only assignments of this team")
+
+	return cmd
+}
+
+func (a *app) printPR(pr *client.PullRequest) error {
+	return a.out.print(pr, func(t *tableWriter) {
+		prRows(t, pr)
+	})
+}
+
+func prRows(t *tableWriter, pr *client.PullRequest) {
+	t.row("REPOSITORY", pr.Repository)
+	t.row("PULL REQUEST", pr.PullRequestID)
+	t.row("NAME", pr.PullRequestName)
+	t.row("AUTHOR", pr.AuthorID)
+	t.row("STATUS", pr.Status)
+	t.row("REVIEWERS", pr.AssignedReviewers)
+	if pr.MergedAt != nil {
+		t.row("MERGED AT", pr.MergedAt)
+	}
+}
@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"avitotech-pr-reviewer/pkg/client"
+)
+
+// statsFlags — общие фильтры команд stats.
+type statsFlags struct {
+	team   string
+	from   string
+	to     string
+	status string
+}
+
+func (f *statsFlags) register(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&f.team, "team", "", "only this team")
+	cmd.PersistentFlags().StringVar(&f.from, "from", "", "start of the interval, RFC 3339 or YYYY-MM-DD")
+	cmd.PersistentFlags().StringVar(&f.to, "to", "", "end of the interval, RFC 3339 or YYYY-MM-DD")
+	cmd.PersistentFlags().StringVar(&f.status, "status", "", "only pull requests with this status: OPEN or MERGED")
+}
+
+func (f *statsFlags) filter() (client.StatsFilter, error) {
+	filter := client.StatsFilter{
+		TeamName: f.team,
+		Status:   f.status,
+	}
+
+	var err error
+
+	filter.From, err = parseTime(f.from)
+	if err != nil {
+		return client.StatsFilter{}, fmt.Errorf("invalid --from: %w", err)
+	}
+
+	filter.To, err = parseTime(f.to)
+	if err != nil {
+		return client.StatsFilter{}, fmt.Errorf("invalid --to: %w", err)
+	}
+
+	return filter, nil
+}
+
+// parseTime разбирает границу интервала в формате RFC 3339 или YYYY-MM-DD (полночь UTC).
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+
+	t, err = time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected RFC 3339 timestamp or YYYY-MM-DD date, got %q", value)
+	}
+
+	return t, nil
+}
+
+func (a *app) statsCommand() *cobra.Command {
+	var flags statsFlags
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show review load and cycle time statistics",
+	}
+
+	flags.register(cmd)
+
+	cmd.AddCommand(
+		a.statsReviewersCommand(&flags),
+		a.statsTeamsCommand(&flags),
+		a.statsCycleTimeCommand(&flags),
+	)
+
+	return cmd
+}
+
+func (a *app) statsReviewersCommand(flags *statsFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reviewers",
+		Short: "Number of review assignments per reviewer",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			filter, err := flags.filter()
+			if err != nil {
+				return err
+			}
+
+			stats, err := a.client.ReviewerStats(cmd.Context(), filter)
+			if err != nil {
+				return err
+			}
+
+			return a.out.print(stats, func(t *tableWriter) {
+				t.row("USER ID", "USERNAME", "TEAM", "ACTIVE", "ASSIGNMENTS")
+				for _, s := range stats {
+					t.row(s.UserID, s.Username, s.TeamName, s.IsActive, s.Assignments)
+				}
+			})
+		},
+	}
+}
+
+func (a *app) statsTeamsCommand(flags *statsFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "teams",
+		Short: "Distribution of review assignments within teams",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			filter, err := flags.filter()
+			if err != nil {
+				return err
+			}
+
+			stats, err := a.client.TeamStats(cmd.Context(), filter)
+			if err != nil {
+				return err
+			}
+
+			return a.out.print(stats, func(t *tableWriter) {
+				t.row("TEAM", "MEMBERS", "ASSIGNMENTS", "MIN", "MAX", "AVG", "MAX/MIN", "IDLE")
+				for _, s := range stats {
+					t.row(s.TeamName, s.Members, s.Assignments, s.MinAssignments, s.MaxAssignments,
+						s.AvgAssignments, s.MaxMinRatio, s.IdleMembers)
+				}
+			})
+		},
+	}
+}
+
+func (a *app) statsCycleTimeCommand(flags *statsFlags) *cobra.Command {
+	var groupBy string
+
+	cmd := &cobra.Command{
+		Use:   "cycle-time",
+		Short: "Weekly time to first review, approval and merge",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			filter, err := flags.filter()
+			if err != nil {
+				return err
+			}
+
+			weeks, err := a.client.CycleTime(cmd.Context(), groupBy, filter)
+			if err != nil {
+				return err
+			}
+
+			return a.out.print(weeks, func(t *tableWriter) {
+				t.row("WEEK", "GROUP", "PULL REQUESTS", "FIRST REVIEW P50", "APPROVAL P50", "MERGE P50")
+				for _, w := range weeks {
+					t.row(w.WeekStart, w.Group, w.PullRequests,
+						duration(w.TimeToFirstReview.MedianSeconds),
+						duration(w.TimeToApproval.MedianSeconds),
+						duration(w.TimeToMerge.MedianSeconds))
+				}
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&groupBy, "group-by", client.CycleTimeByTeam, "group by team, repository or reviewer")
+
+	return cmd
+}
+
+func duration(seconds *float64) string {
+	if seconds == nil {
+		return "-"
+	}
+
+	return (time.Duration(*seconds) * time.Second).Round(time.Minute).String()
+}
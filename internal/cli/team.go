@@ -0,0 +1,288 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"avitotech-pr-reviewer/pkg/client"
+)
+
+// teamFile — описание команды для team create, например:
+//
+//	team_name: backend
+//	members:
+//	  - user_id: u1
+//	    username: alice
+//	    is_active: true
+//	    email: alice@example.com
+//	sla:
+//	  review_sla_hours: 24
+//	  action: escalate
+//	  lead_id: u1
+type teamFile struct {
+	TeamName string `yaml:"team_name"`
+	Members  []struct {
+		UserID   string `yaml:"user_id"`
+		Username string `yaml:"username"`
+		IsActive *bool  `yaml:"is_active"`
+		Email    string `yaml:"email"`
+	} `yaml:"members"`
+	SLA *struct {
+		ReviewSLAHours int    `yaml:"review_sla_hours"`
+		Action         string `yaml:"action"`
+		LeadID         string `yaml:"lead_id"`
+	} `yaml:"sla"`
+}
+
+func parseTeamFile(r io.Reader) (*teamFile, error) {
+	var f teamFile
+
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	err := dec.Decode(&f)
+	if err != nil {
+		return nil, fmt.Errorf("parse team file: %w", err)
+	}
+
+	if f.TeamName == "" {
+		return nil, errors.New("team file: team_name is required")
+	}
+
+	for i, m := range f.Members {
+		if m.UserID == "" || m.Username == "" {
+			return nil, fmt.Errorf("team file: members[%d]: user_id and username are required", i)
+		}
+	}
+
+	return &f, nil
+}
+
+// members возвращает участников команды; участники без is_active считаются активными.
+func (f *teamFile) members() []client.Member {
+	members := make([]client.Member, len(f.Members))
+	for i, m := range f.Members {
+		members[i] = client.Member{
+			UserID:   m.UserID,
+			Username: m.Username,
+			IsActive: m.IsActive == nil || *m.IsActive,
+			Email:    m.Email,
+		}
+	}
+
+	return members
+}
+
+func (a *app) teamCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team",
+		Short: "Create, inspect, import and export teams",
+	}
+
+	cmd.AddCommand(a.teamCreateCommand(), a.teamShowCommand(), a.teamImportCommand(), a.teamExportCommand())
+
+	return cmd
+}
+
+func (a *app) teamCreateCommand() *cobra.Command {
+	var path string
+
+	cmd := &cobra.Command{
+		Use:   "create -f team.yaml",
+		Short: "Create a team with members from a YAML file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			r := cmd.InOrStdin()
+			if path != "-" {
+				file, err := os.Open(path)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				r = file
+			}
+
+			f, err := parseTeamFile(r)
+			if err != nil {
+				return err
+			}
+
+			team, err := a.client.AddTeam(cmd.Context(), f.TeamName, f.members())
+			if err != nil {
+				return fmt.Errorf("create team: %w", err)
+			}
+
+			if f.SLA != nil {
+				team, err = a.client.SetTeamSLA(cmd.Context(), f.TeamName, client.TeamSLA{
+					ReviewSLAHours: f.SLA.ReviewSLAHours,
+					Action:         f.SLA.Action,
+					LeadID:         f.SLA.LeadID,
+				})
+				if err != nil {
+					return fmt.Errorf("team %s created, but setting SLA failed: %w", f.TeamName, err)
+				}
+			}
+
+			return a.printTeam(team)
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "file", "f", "", "team file, - for stdin")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func (a *app) teamShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show TEAM",
+		Short: "Show a team with its members and SLA",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			team, err := a.client.GetTeam(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			return a.printTeam(team)
+		},
+	}
+}
+
+func (a *app) printTeam(team *client.Team) error {
+	return a.out.print(team, func(t *tableWriter) {
+		t.row("TEAM", team.TeamName)
+		if team.SLA != nil {
+			t.row("SLA", fmt.Sprintf("%dh", team.SLA.ReviewSLAHours), team.SLA.Action, team.SLA.LeadID)
+		}
+		t.row()
+		t.row("USER ID", "USERNAME", "ACTIVE")
+		for _, m := range team.Members {
+			t.row(m.UserID, m.Username, m.IsActive)
+		}
+	})
+}
+
+func (a *app) teamImportCommand() *cobra.Command {
+	var (
+		path   string
+		format string
+		apply  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import -f org.yaml [--apply]",
+		Short: "Plan or apply the desired state of all teams from a YAML, CSV or JSON file",
+		Long: `Compares the desired teams and members with the current state and prints the plan:
+teams to create and users to add, move, update or deactivate. Teams missing from
+the file are kept, but their members are deactivated. With --apply the plan is
+applied in a single transaction.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if format == "" {
+				format = formatFromPath(path)
+			}
+
+			data, err := readInput(cmd.InOrStdin(), path)
+			if err != nil {
+				return err
+			}
+
+			plan, err := a.client.ImportTeams(cmd.Context(), data, format, apply)
+			if err != nil {
+				return fmt.Errorf("import teams: %w", err)
+			}
+
+			return a.out.print(plan, func(t *tableWriter) {
+				if len(plan.Changes) == 0 {
+					t.row("No changes")
+
+					return
+				}
+
+				t.row("ACTION", "TEAM", "FROM TEAM", "USER ID", "USERNAME", "ACTIVE", "EMAIL")
+				for _, ch := range plan.Changes {
+					t.row(ch.Action, ch.TeamName, ch.FromTeam, ch.UserID, ch.Username, ch.IsActive, ch.Email)
+				}
+				t.row()
+				if plan.Applied {
+					t.row(fmt.Sprintf("Applied %d changes", len(plan.Changes)))
+				} else {
+					t.row(fmt.Sprintf("Plan: %d changes, run with --apply to apply", len(plan.Changes)))
+				}
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "file", "f", "", "teams file, - for stdin")
+	cmd.Flags().StringVar(&format, "format", "", "file format: yaml, csv or json (default: by file extension)")
+	cmd.Flags().BoolVar(&apply, "apply", false, "apply the plan")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func (a *app) teamExportCommand() *cobra.Command {
+	var (
+		path   string
+		format string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export [-f org.yaml]",
+		Short: "Export all teams with members in the format accepted by team import",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if format == "" {
+				format = formatFromPath(path)
+			}
+
+			data, err := a.client.ExportTeams(cmd.Context(), format)
+			if err != nil {
+				return fmt.Errorf("export teams: %w", err)
+			}
+
+			if path == "" || path == "-" {
+				_, err = a.out.w.Write(data)
+
+				return err
+			}
+
+			return os.WriteFile(path, data, 0o644)
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "file", "f", "", "output file (default: stdout)")
+	cmd.Flags().StringVar(&format, "format", "", "file format: yaml, csv or json (default: by file extension, then yaml)")
+
+	return cmd
+}
+
+// formatFromPath определяет формат файла команд по расширению; по умолчанию YAML.
+func formatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return client.TeamsFormatCSV
+	case ".json":
+		return client.TeamsFormatJSON
+	default:
+		return client.TeamsFormatYAML
+	}
+}
+
+// readInput читает файл path или stdin, если path равен "-".
+func readInput(stdin io.Reader, path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(stdin)
+	}
+
+	return os.ReadFile(path)
+}
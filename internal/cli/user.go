@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"avitotech-pr-reviewer/pkg/client"
+)
+
+func (a *app) userCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage users",
+	}
+
+	cmd.AddCommand(a.userActivateCommand(), a.userDeactivateCommand(), a.userReviewsCommand())
+
+	return cmd
+}
+
+func (a *app) userActivateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "activate USER_ID",
+		Short: "Make a user available for review assignments again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			user, err := a.client.SetIsActive(cmd.Context(), args[0], true)
+			if err != nil {
+				return err
+			}
+
+			return a.printUser(user)
+		},
+	}
+}
+
+// reassignment — результат переназначения одного открытого ревью деактивированного пользователя.
+type reassignment struct {
+	Repository    string `json:"repository"`
+	PullRequestID string `json:"pull_request_id"`
+	ReplacedBy    string `json:"replaced_by,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+func (a *app) userDeactivateCommand() *cobra.Command {
+	var reassign bool
+
+	cmd := &cobra.Command{
+		Use:   "deactivate USER_ID",
+		Short: "Stop assigning a user as reviewer",
+		Long: `Deactivate a user so they are no longer assigned as reviewer.
+
+With --reassign the user's open reviews are handed over to other team members.
+Reviews that cannot be reassigned are reported and the command exits with an error.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userID := args[0]
+
+			user, err := a.client.SetIsActive(cmd.Context(), userID, false)
+			if err != nil {
+				return err
+			}
+
+			if !reassign {
+				return a.printUser(user)
+			}
+
+			// Пользователь деактивирован до переназначения, поэтому не может быть
+			// выбран кандидатом на собственные ревью.
+			reviews, err := a.client.GetReview(cmd.Context(), userID)
+			if err != nil {
+				return fmt.Errorf("user deactivated, but listing reviews failed: %w", err)
+			}
+
+			results := make([]reassignment, 0, len(reviews))
+			failed := 0
+			for _, pr := range reviews {
+				if pr.Status != client.StatusOpen {
+					continue
+				}
+
+				res := reassignment{Repository: pr.Repository, PullRequestID: pr.PullRequestID}
+
+				_, replacedBy, err := a.client.ReassignReviewer(cmd.Context(), pr.Repository, pr.PullRequestID, userID)
+				if err != nil {
+					res.Error = err.Error()
+					failed++
+				}
+				res.ReplacedBy = replacedBy
+
+				results = append(results, res)
+			}
+
+			err = a.out.print(results, func(t *tableWriter) {
+				t.row("REPOSITORY", "PULL REQUEST", "REPLACED BY", "ERROR")
+				for _, r := range results {
+					t.row(r.Repository, r.PullRequestID, r.ReplacedBy, r.Error)
+				}
+			})
+			if err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("user deactivated, but %d of %d reviews were not reassigned", failed, len(results))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&reassign, "reassign", false, "reassign the user's open reviews to other team members")
+
+	return cmd
+}
+
+func (a *app) userReviewsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reviews USER_ID",
+		Short: "List pull requests assigned to a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reviews, err := a.client.GetReview(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			return a.out.print(reviews, func(t *tableWriter) {
+				t.row("REPOSITORY", "PULL REQUEST", "NAME", "AUTHOR", "STATUS")
+				for _, pr := range reviews {
+					t.row(pr.Repository, pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status)
+				}
+			})
+		},
+	}
+}
+
+func (a *app) printUser(user *client.User) error {
+	return a.out.print(user, func(t *tableWriter) {
+		t.row("USER ID", "USERNAME", "TEAM", "ACTIVE", "EMAIL", "REMINDERS")
+		t.row(user.UserID, user.Username, user.TeamName, user.IsActive, user.Email, user.ReminderFrequency)
+	})
+}
@@ -15,16 +15,42 @@ const (
 	envTest  = "test"
 )
 
+// Хранилища, которые можно выбрать параметром storage.
+const (
+	StoragePostgres = "postgres"
+	// StorageMemory хранит данные в памяти процесса: они теряются при остановке сервиса.
+	// Подходит для локальной разработки и тестов.
+	StorageMemory = "memory"
+	// StorageSQLite хранит данные в файле SQLite. Подходит для установок на одном узле без PostgreSQL.
+	StorageSQLite = "sqlite"
+)
+
 type Config struct {
-	App  AppConfig  `yaml:"app" env-required:"true"`
-	HTTP HTTPConfig `yaml:"http" env-required:"true"`
-	PG   PGConfig   `yaml:"postgres" env-required:"true"`
+	App    AppConfig    `yaml:"app" env-required:"true"`
+	HTTP   HTTPConfig   `yaml:"http" env-required:"true"`
+	GRPC   GRPCConfig   `yaml:"grpc"`
+	PG     PGConfig     `yaml:"postgres"`
+	SQLite SQLiteConfig `yaml:"sqlite"`
+	SLA    SLAConfig    `yaml:"sla"`
+
+	// Storage выбирает хранилище: postgres, sqlite или memory. Настройки PG обязательны только для postgres.
+	Storage string `yaml:"storage" env:"STORAGE" env-default:"postgres"`
+
+	Reminders RemindersConfig `yaml:"reminders"`
+	SMTP      SMTPConfig      `yaml:"smtp"`
+	Chat      ChatConfig      `yaml:"chat"`
+	Events    EventsConfig    `yaml:"events"`
+	Tracing   TracingConfig   `yaml:"tracing"`
 }
 
 type AppConfig struct {
 	Env               string `yaml:"env" env:"APP_ENV" env-required:"true"`
 	AdminToken        string `yaml:"admin_token" env:"ADMIN_TOKEN" env-required:"true"`
 	MaxReviewersPerPR int    `yaml:"max_reviewers_per_pr" env:"REVIEWERS_PER_PR" env-required:"true"`
+
+	// AllowAnonymous разрешает запросы без токена: они читают данные организации по умолчанию
+	// с правами участника. По умолчанию выключено, и такие запросы получают 401.
+	AllowAnonymous bool `yaml:"allow_anonymous" env:"ALLOW_ANONYMOUS"`
 }
 
 type HTTPConfig struct {
@@ -32,17 +58,136 @@ type HTTPConfig struct {
 	ReadTimeout    time.Duration `yaml:"read_timeout" env:"HTTP_READ_TIMEOUT" env-required:"true"`
 	WriteTimeout   time.Duration `yaml:"write_timeout" env:"HTTP_WRITE_TIMEOUT" env-required:"true"`
 	GatewayTimeout time.Duration `yaml:"gateway_timeout" env:"HTTP_GATEWAY_TIMEOUT" env-required:"true"`
+
+	// DrainDelay — сколько после сигнала остановки /readyz отвечает 503, прежде чем сервер
+	// перестанет принимать подключения. Должна покрывать период проверки балансировщика:
+	// значение по умолчанию больше периода readiness-проверки Kubernetes по умолчанию (10s).
+	DrainDelay time.Duration `yaml:"drain_delay" env:"HTTP_DRAIN_DELAY" env-default:"15s"`
+}
+
+// GRPCConfig задаёт порт gRPC API, работающего рядом с HTTP API.
+type GRPCConfig struct {
+	Port int `yaml:"port" env:"GRPC_PORT" env-default:"9090"`
+}
+
+// SLAConfig задаёт периодичность проверки сроков ревью и рабочее время,
+// в котором отсчитывается SLA команд: будние дни с WorkdayStart до WorkdayEnd часов.
+type SLAConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval" env:"SLA_CHECK_INTERVAL" env-default:"5m"`
+	WorkdayStart  int           `yaml:"workday_start" env:"SLA_WORKDAY_START" env-default:"9"`
+	WorkdayEnd    int           `yaml:"workday_end" env:"SLA_WORKDAY_END" env-default:"18"`
+	Timezone      string        `yaml:"timezone" env:"SLA_TIMEZONE" env-default:"UTC"`
+}
+
+// RemindersConfig задаёт расписания рассылки дайджестов в формате cron для каждой частоты
+// напоминаний и способ их доставки. Пустое расписание отключает рассылку для этой частоты.
+type RemindersConfig struct {
+	Daily    string         `yaml:"daily" env:"REMINDERS_DAILY" env-default:"0 9 * * 1-5"`
+	Weekly   string         `yaml:"weekly" env:"REMINDERS_WEEKLY" env-default:"0 9 * * 1"`
+	Timezone string         `yaml:"timezone" env:"REMINDERS_TIMEZONE" env-default:"UTC"`
+	Notifier NotifierConfig `yaml:"notifier"`
+}
+
+// NotifierConfig выбирает способ доставки дайджестов: log, webhook или smtp.
+// Для smtp используются настройки из SMTPConfig.
+type NotifierConfig struct {
+	Type       string        `yaml:"type" env:"NOTIFIER_TYPE" env-default:"log"`
+	WebhookURL string        `yaml:"webhook_url" env:"NOTIFIER_WEBHOOK_URL"`
+	Timeout    time.Duration `yaml:"timeout" env:"NOTIFIER_TIMEOUT" env-default:"10s"`
+}
+
+// SMTPConfig задаёт SMTP-сервер для писем пользователям. Файлы <имя>.tmpl из TemplatesDir
+// заменяют встроенные шаблоны писем. AssignmentEmails включает письма об изменениях назначений.
+type SMTPConfig struct {
+	Host             string `yaml:"host" env:"SMTP_HOST"`
+	Port             int    `yaml:"port" env:"SMTP_PORT" env-default:"25"`
+	Username         string `yaml:"username" env:"SMTP_USERNAME"`
+	Password         string `yaml:"password" env:"SMTP_PASSWORD"`
+	From             string `yaml:"from" env:"SMTP_FROM"`
+	TemplatesDir     string `yaml:"templates_dir" env:"SMTP_TEMPLATES_DIR"`
+	AssignmentEmails bool   `yaml:"assignment_emails" env:"SMTP_ASSIGNMENT_EMAILS"`
+	// Timeout ограничивает отправку одного письма.
+	Timeout time.Duration `yaml:"timeout" env:"SMTP_TIMEOUT" env-default:"10s"`
+}
+
+// ChatConfig задаёт приём slash-команд из чата. Пустой SigningSecret отключает эндпоинт.
+// Команды выполняются в организации OrganizationID (по умолчанию — в организации по умолчанию),
+// Timezone используется для дат в командах.
+type ChatConfig struct {
+	SigningSecret  string `yaml:"signing_secret" env:"CHAT_SIGNING_SECRET"`
+	OrganizationID string `yaml:"organization_id" env:"CHAT_ORGANIZATION_ID"`
+	Timezone       string `yaml:"timezone" env:"CHAT_TIMEZONE" env-default:"UTC"`
+}
+
+// EventsConfig задаёт журнал событий, из которого /events/stream досылает пропущенные события.
+// События старше Retention удаляются раз в час; нулевое значение отключает удаление.
+type EventsConfig struct {
+	Retention time.Duration `yaml:"retention" env:"EVENTS_RETENTION" env-default:"168h"`
+}
+
+// Экспортёры трассировок, которые можно выбрать параметром tracing.exporter.
+const (
+	// TracingExporterNone не отправляет span'ы, но trace context из входящих запросов
+	// по-прежнему попадает в логи.
+	TracingExporterNone = "none"
+	// TracingExporterStdout печатает span'ы в stdout. Подходит для локальной разработки.
+	TracingExporterStdout = "stdout"
+	// TracingExporterOTLP отправляет span'ы по OTLP/gRPC в коллектор.
+	TracingExporterOTLP = "otlp"
+)
+
+// TracingConfig задаёт экспорт трассировок OpenTelemetry. Endpoint (host:port) используется
+// только для otlp; если он пуст, учитываются стандартные переменные OTEL_EXPORTER_OTLP_*.
+// SampleRatio — доля новых трассировок, которые записываются; решение вызывающего сервиса
+// из заголовка traceparent соблюдается.
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"none"`
+	Endpoint    string  `yaml:"endpoint" env:"TRACING_ENDPOINT"`
+	Insecure    bool    `yaml:"insecure" env:"TRACING_INSECURE"`
+	SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+	ServiceName string  `yaml:"service_name" env:"TRACING_SERVICE_NAME" env-default:"pr-reviewer"`
+}
+
+// SQLiteConfig — настройки хранилища SQLite, используются только при storage: sqlite.
+type SQLiteConfig struct {
+	// Path — путь к файлу базы. Файл создаётся при первом запуске.
+	Path string `env:"SQLITE_PATH" yaml:"path" env-default:"pr-reviewer.db"`
 }
 
 type PGConfig struct {
-	Host     string `env:"POSTGRES_HOST" yaml:"host" env-required:"true"`
-	Port     int    `env:"POSTGRES_PORT" yaml:"port" env-required:"true"`
-	Username string `env:"POSTGRES_USER" yaml:"user" env-required:"true"`
-	Password string `env:"POSTGRES_PASSWORD" yaml:"password" env-required:"true"`
-	Database string `env:"POSTGRES_DB" yaml:"database" env-required:"true"`
+	Host     string `env:"POSTGRES_HOST" yaml:"host"`
+	Port     int    `env:"POSTGRES_PORT" yaml:"port"`
+	Username string `env:"POSTGRES_USER" yaml:"user"`
+	Password string `env:"POSTGRES_PASSWORD" yaml:"password"`
+	Database string `env:"POSTGRES_DB" yaml:"database"`
 	SSLMode  string `env:"POSTGRES_SSLMODE" yaml:"sslmode" env-default:"disable"`
 
 	MaxConns int32 `env:"POSTGRES_MAX_CONNS" yaml:"max_conns"`
+
+	// AutoMigrate применяет недостающие миграции при запуске сервиса.
+	AutoMigrate bool `env:"POSTGRES_AUTO_MIGRATE" yaml:"auto_migrate"`
+}
+
+// Validate проверяет, что заданы все параметры подключения.
+// Они не помечены обязательными, потому что нужны только при storage: postgres.
+func (p PGConfig) Validate() error {
+	required := []struct {
+		name  string
+		empty bool
+	}{
+		{name: "POSTGRES_HOST", empty: p.Host == ""},
+		{name: "POSTGRES_PORT", empty: p.Port == 0},
+		{name: "POSTGRES_USER", empty: p.Username == ""},
+		{name: "POSTGRES_PASSWORD", empty: p.Password == ""},
+		{name: "POSTGRES_DB", empty: p.Database == ""},
+	}
+	for _, r := range required {
+		if r.empty {
+			return fmt.Errorf("%s is required", r.name)
+		}
+	}
+
+	return nil
 }
 
 func (p PGConfig) DSN() string {
@@ -3,6 +3,8 @@ package config
 import (
 	"log/slog"
 	"os"
+
+	"avitotech-pr-reviewer/internal/tracing"
 )
 
 func NewLogger(env string) *slog.Logger {
@@ -18,5 +20,5 @@ func NewLogger(env string) *slog.Logger {
 		handler = slog.NewTextHandler(w, &slog.HandlerOptions{Level: slog.LevelInfo})
 	}
 
-	return slog.New(handler)
+	return slog.New(tracing.NewLogHandler(handler))
 }
@@ -0,0 +1,9 @@
+package domain
+
+// CodeOwnersRule — правило CODEOWNERS репозитория с разрешёнными владельцами.
+// Правила упорядочены так же, как в исходном файле: последнее совпавшее правило имеет приоритет.
+type CodeOwnersRule struct {
+	Pattern string
+	UserIDs []string
+	Teams   []Team
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type EventType string
+
+const (
+	// EventReviewOverdue — назначение просрочено по SLA. Data — ReviewOverdueEvent.
+	EventReviewOverdue EventType = "review.overdue"
+	// EventReviewersAssigned — на Pull Request назначены ревьюверы. Data — ReviewersAssignedEvent.
+	EventReviewersAssigned EventType = "review.assigned"
+	// EventReviewerReassigned — ревьювер заменён другим. Data — ReviewerReassignedEvent.
+	EventReviewerReassigned EventType = "review.reassigned"
+	// EventPullRequestApproved — все ревьюверы одобрили Pull Request. Data — PullRequestEvent.
+	EventPullRequestApproved EventType = "pull_request.approved"
+	// EventPullRequestMerged — Pull Request помечен как merged. Data — PullRequestEvent.
+	EventPullRequestMerged EventType = "pull_request.merged"
+)
+
+// Event — событие предметной области внутри организации.
+type Event struct {
+	Type       EventType
+	OrgID      string
+	OccurredAt time.Time
+	Data       any
+}
+
+// ReviewOverdueEvent описывает просроченное назначение и принятое по нему действие.
+// ReassignedTo заполняется при переназначении, EscalatedTo — при эскалации лиду.
+type ReviewOverdueEvent struct {
+	Assignment   ReviewAssignment
+	Action       SLAAction
+	ReassignedTo string
+	EscalatedTo  string
+}
+
+type ReviewersAssignedEvent struct {
+	PullRequest PullRequest
+	ReviewerIDs []string
+}
+
+type ReviewerReassignedEvent struct {
+	PullRequest   PullRequest
+	OldReviewerID string
+	NewReviewerID string
+}
+
+type PullRequestEvent struct {
+	PullRequest PullRequest
+}
+
+// EventRecord — событие, сохранённое в журнале событий. ID возрастает в порядке записи
+// и позволяет клиентам потока событий продолжить чтение с места разрыва.
+type EventRecord struct {
+	ID            int64
+	OrgID         string
+	Type          EventType
+	OccurredAt    time.Time
+	Repository    string
+	PullRequestID string
+	// UserIDs — пользователи, которых касается событие: автор и ревьюверы Pull Request'а.
+	UserIDs []string
+	// Payload — данные события в JSON.
+	Payload json.RawMessage
+}
+
+// EventFilter отбирает события потока. Пустые поля не ограничивают выборку.
+type EventFilter struct {
+	TeamName      string
+	UserID        string
+	Repository    string
+	PullRequestID string
+}
@@ -4,4 +4,6 @@ type Member struct {
 	ID       string
 	Username string
 	IsActive bool
+	// Email — адрес для уведомлений. Пустой адрес при добавлении в команду не меняет сохранённый.
+	Email string
 }
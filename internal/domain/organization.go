@@ -0,0 +1,54 @@
+package domain
+
+// DefaultOrganizationID — организация, к которой относятся данные, созданные до появления организаций,
+// и запросы без токена, если анонимный доступ включён.
+const DefaultOrganizationID = "00000000-0000-0000-0000-000000000001"
+
+// Organization — арендатор сервиса. Команды, пользователи, репозитории и Pull Request'ы
+// разных организаций изолированы друг от друга.
+type Organization struct {
+	ID   string
+	Name string
+}
+
+// Role определяет права субъекта в пределах его организации.
+type Role string
+
+const (
+	RoleMember Role = "member"
+	RoleAdmin  Role = "admin"
+	// RoleRoot — администратор организации по умолчанию, который также управляет организациями.
+	RoleRoot Role = "root"
+)
+
+// IsValid сообщает, может ли роль быть выдана токену организации.
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleMember, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Principal — аутентифицированный субъект запроса.
+type Principal struct {
+	OrgID string
+	Role  Role
+	// UserID — пользователь, которому выдан токен участника; пусто, если токен к пользователю не привязан.
+	UserID string
+}
+
+func (p Principal) IsAdmin() bool {
+	return p.Role == RoleAdmin || p.Role == RoleRoot
+}
+
+func (p Principal) IsRoot() bool {
+	return p.Role == RoleRoot
+}
+
+// CanActAs сообщает, может ли субъект менять данные пользователя userID:
+// это администратор или владелец токена, выданного этому пользователю.
+func (p Principal) CanActAs(userID string) bool {
+	return p.IsAdmin() || (p.UserID != "" && p.UserID == userID)
+}
@@ -19,6 +19,8 @@ func (s PRStatus) IsValid() bool {
 }
 
 type PullRequest struct {
+	RepositoryID        string
+	Repository          string
 	ID                  string
 	Name                string
 	AuthorID            string
@@ -28,3 +30,31 @@ type PullRequest struct {
 	CreatedAt           time.Time
 	MergedAt            *time.Time
 }
+
+// ReviewState — итог ревью, оставленного ревьювером.
+type ReviewState string
+
+const (
+	ReviewStateCommented        ReviewState = "COMMENTED"
+	ReviewStateChangesRequested ReviewState = "CHANGES_REQUESTED"
+	ReviewStateApproved         ReviewState = "APPROVED"
+)
+
+func (s ReviewState) IsValid() bool {
+	switch s {
+	case ReviewStateCommented, ReviewStateChangesRequested, ReviewStateApproved:
+		return true
+	default:
+		return false
+	}
+}
+
+// Review — ревью Pull Request'а, оставленное назначенным ревьювером.
+type Review struct {
+	RepositoryID  string
+	Repository    string
+	PullRequestID string
+	ReviewerID    string
+	State         ReviewState
+	SubmittedAt   time.Time
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// ReminderFrequency — как часто пользователь получает дайджест ожидающих его ревью.
+type ReminderFrequency string
+
+const (
+	ReminderOff    ReminderFrequency = "off"
+	ReminderDaily  ReminderFrequency = "daily"
+	ReminderWeekly ReminderFrequency = "weekly"
+)
+
+func (f ReminderFrequency) IsValid() bool {
+	switch f {
+	case ReminderOff, ReminderDaily, ReminderWeekly:
+		return true
+	default:
+		return false
+	}
+}
+
+// PendingReview — открытый Pull Request, по которому ревьювер ещё не оставил ревью после назначения.
+type PendingReview struct {
+	ReviewerID      string
+	ReviewerName    string
+	ReviewerEmail   string
+	Repository      string
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	CreatedAt       time.Time
+	AssignedAt      time.Time
+}
+
+// Digest — напоминание пользователю о Pull Request'ах, ожидающих его ревью.
+type Digest struct {
+	OrgID       string
+	UserID      string
+	Username    string
+	Email       string
+	GeneratedAt time.Time
+	Items       []DigestItem
+}
+
+// DigestItem — Pull Request в дайджесте. Age — время с момента создания Pull Request'а.
+type DigestItem struct {
+	Repository      string
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	AssignedAt      time.Time
+	Age             time.Duration
+}
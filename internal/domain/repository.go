@@ -0,0 +1,18 @@
+package domain
+
+// DefaultRepositoryName — репозиторий, в который попадают Pull Request'ы без явно указанного репозитория.
+const DefaultRepositoryName = "default"
+
+type Repository struct {
+	ID       string
+	Name     string
+	TeamID   string
+	TeamName string
+	Settings RepositorySettings
+}
+
+// RepositorySettings — настройки назначения ревьюверов в репозитории.
+// Незаданные значения берутся из общей конфигурации сервиса.
+type RepositorySettings struct {
+	MaxReviewers *int
+}
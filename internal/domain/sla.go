@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// SLAAction — действие, выполняемое с просроченным назначением.
+type SLAAction string
+
+const (
+	// SLAActionNone только помечает назначение просроченным.
+	SLAActionNone SLAAction = "none"
+	// SLAActionReassign переназначает ревью другому участнику команды автора.
+	SLAActionReassign SLAAction = "reassign"
+	// SLAActionEscalate добавляет лида команды ревьювером Pull Request'а.
+	SLAActionEscalate SLAAction = "escalate"
+)
+
+func (a SLAAction) IsValid() bool {
+	switch a {
+	case SLAActionNone, SLAActionReassign, SLAActionEscalate:
+		return true
+	default:
+		return false
+	}
+}
+
+// TeamSLA — срок ревью для участников команды в рабочих часах.
+// Нулевой ReviewHours означает, что SLA для команды не задан.
+type TeamSLA struct {
+	ReviewHours int
+	Action      SLAAction
+	LeadID      string
+}
+
+func (s TeamSLA) Enabled() bool {
+	return s.ReviewHours > 0
+}
+
+// ReviewAssignment — назначение ревьювера на открытый Pull Request,
+// по которому ревьювер ещё не оставил ревью.
+type ReviewAssignment struct {
+	RepositoryID    string
+	Repository      string
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	ReviewerID      string
+	TeamName        string
+	AssignedAt      time.Time
+	OverdueAt       *time.Time
+	// SLA — SLA команды ревьювера.
+	SLA TeamSLA
+}
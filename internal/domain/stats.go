@@ -0,0 +1,103 @@
+package domain
+
+import "time"
+
+// StatsFilter ограничивает Pull Request'ы, по которым считается статистика.
+// Незаданные поля не ограничивают выборку. Интервал полуоткрытый: [From, To).
+type StatsFilter struct {
+	From     *time.Time
+	To       *time.Time
+	Status   *PRStatus
+	TeamName string
+}
+
+// ReviewerStats — число назначений пользователя ревьювером.
+type ReviewerStats struct {
+	UserID      string
+	Username    string
+	TeamName    string
+	IsActive    bool
+	Assignments int
+}
+
+// TeamStats — распределение назначений между участниками команды.
+type TeamStats struct {
+	TeamName       string
+	Members        int
+	Assignments    int
+	MaxAssignments int
+	MinAssignments int
+	AvgAssignments float64
+	// IdleMembers — число участников без единого назначения. Остаётся показателем
+	// неравномерности, когда MaxMinRatio не определено.
+	IdleMembers int
+}
+
+// MaxMinRatio возвращает отношение максимального числа назначений участника к минимальному.
+// Чем ближе значение к 1, тем равномернее распределена нагрузка.
+// Если кто-то из участников не получил ни одного назначения, отношение не определено и возвращается nil;
+// насколько распределение неравномерно, тогда показывает IdleMembers.
+func (t TeamStats) MaxMinRatio() *float64 {
+	if t.MinAssignments == 0 {
+		return nil
+	}
+
+	ratio := float64(t.MaxAssignments) / float64(t.MinAssignments)
+
+	return &ratio
+}
+
+// PullRequestCounts — текущее число открытых Pull Request'ов организации
+// и тех из них, на которые не назначено ни одного ревьювера.
+type PullRequestCounts struct {
+	Open             int
+	WithoutReviewers int
+}
+
+// Workload — текущая нагрузка организации: открытые Pull Request'ы
+// и назначения на них по командам.
+type Workload struct {
+	PullRequests PullRequestCounts
+	Teams        []TeamStats
+}
+
+// CycleTimeGroup — разрез, в котором считается время прохождения ревью.
+type CycleTimeGroup string
+
+const (
+	// CycleTimeByTeam группирует Pull Request'ы по команде автора.
+	CycleTimeByTeam CycleTimeGroup = "team"
+	// CycleTimeByRepository группирует Pull Request'ы по репозиторию.
+	CycleTimeByRepository CycleTimeGroup = "repository"
+	// CycleTimeByReviewer группирует назначения по ревьюверу. Время до первого ревью
+	// и до одобрения отсчитывается от момента назначения, а не от создания Pull Request'а.
+	CycleTimeByReviewer CycleTimeGroup = "reviewer"
+)
+
+func (g CycleTimeGroup) IsValid() bool {
+	switch g {
+	case CycleTimeByTeam, CycleTimeByRepository, CycleTimeByReviewer:
+		return true
+	default:
+		return false
+	}
+}
+
+// DurationStats — медиана и 90-й перцентиль длительности.
+// Count — число наблюдений; если их нет, Median и P90 равны nil.
+type DurationStats struct {
+	Count  int
+	Median *time.Duration
+	P90    *time.Duration
+}
+
+// CycleTimeStats — показатели прохождения ревью для одной группы за одну неделю.
+type CycleTimeStats struct {
+	Group string
+	// WeekStart — понедельник недели, в которую были созданы Pull Request'ы, 00:00 UTC.
+	WeekStart         time.Time
+	PullRequests      int
+	TimeToFirstReview DurationStats
+	TimeToApproval    DurationStats
+	TimeToMerge       DurationStats
+}
@@ -1,7 +1,8 @@
 package domain
 
 type Team struct {
-	ID string
-	Name string
+	ID      string
+	Name    string
 	Members []Member
+	SLA     TeamSLA
 }
@@ -0,0 +1,38 @@
+package domain
+
+// TeamChangeAction — вид изменения при приведении команд организации к желаемому состоянию.
+type TeamChangeAction string
+
+const (
+	// TeamChangeCreateTeam создаёт команду.
+	TeamChangeCreateTeam TeamChangeAction = "create_team"
+	// TeamChangeAddUser создаёт пользователя в команде.
+	TeamChangeAddUser TeamChangeAction = "add_user"
+	// TeamChangeMoveUser переводит пользователя в другую команду и обновляет его данные.
+	TeamChangeMoveUser TeamChangeAction = "move_user"
+	// TeamChangeUpdateUser обновляет имя, активность или email пользователя.
+	TeamChangeUpdateUser TeamChangeAction = "update_user"
+	// TeamChangeDeactivateUser деактивирует пользователя, отсутствующего в желаемом состоянии.
+	TeamChangeDeactivateUser TeamChangeAction = "deactivate_user"
+)
+
+// TeamChange — одно изменение плана импорта команд.
+type TeamChange struct {
+	Action   TeamChangeAction
+	TeamName string
+	// FromTeam — текущая команда пользователя при TeamChangeMoveUser.
+	FromTeam string
+	// Member — желаемое состояние пользователя; для TeamChangeDeactivateUser — текущее.
+	// Пуст для TeamChangeCreateTeam.
+	Member Member
+}
+
+// TeamPlan — упорядоченный список изменений, приводящих команды организации
+// к желаемому состоянию. Команды создаются раньше, чем в них добавляются пользователи.
+type TeamPlan struct {
+	Changes []TeamChange
+}
+
+func (p TeamPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
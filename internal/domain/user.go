@@ -1,9 +1,32 @@
 package domain
 
+import (
+	"net/mail"
+	"time"
+)
+
 type User struct {
-	ID       string
-	Username string
-	IsActive bool
-	TeamID string
-	TeamName string
+	ID                string
+	Username          string
+	IsActive          bool
+	TeamID            string
+	TeamName          string
+	Email             string
+	ReminderFrequency ReminderFrequency
+	// ChatUserID — идентификатор пользователя в чате, из которого приходят slash-команды.
+	ChatUserID string
+	// AwayUntil — время, до которого пользователь отсутствует и не назначается ревьювером.
+	AwayUntil *time.Time
+}
+
+// IsAway сообщает, отсутствует ли пользователь в момент now.
+func (u User) IsAway(now time.Time) bool {
+	return u.AwayUntil != nil && now.Before(*u.AwayUntil)
+}
+
+// IsValidEmail сообщает, является ли строка одиночным адресом без отображаемого имени.
+func IsValidEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+
+	return err == nil && addr.Address == email
 }
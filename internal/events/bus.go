@@ -0,0 +1,73 @@
+// Package events реализует шину событий предметной области внутри процесса.
+// Издатели не ждут подписчиков: если буфер подписчика заполнен, событие
+// для него отбрасывается, чтобы медленный подписчик не тормозил остальных.
+// Поэтому шина подходит только для побочных действий вроде писем; события,
+// которые нельзя терять, читаются из журнала eventlog, куда они попадают до шины.
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type Bus struct {
+	lgr *slog.Logger
+
+	mu          sync.RWMutex
+	subscribers map[chan domain.Event]struct{}
+}
+
+func NewBus(lgr *slog.Logger) *Bus {
+	return &Bus{
+		lgr:         lgr,
+		subscribers: make(map[chan domain.Event]struct{}),
+	}
+}
+
+// Publish рассылает событие всем подписчикам.
+func (b *Bus) Publish(ctx context.Context, event domain.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	b.lgr.DebugContext(ctx, "publishing event",
+		slog.String("type", string(event.Type)),
+		slog.String("orgID", event.OrgID),
+	)
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			b.lgr.WarnContext(ctx, "subscriber buffer is full, event dropped",
+				slog.String("type", string(event.Type)),
+				slog.String("orgID", event.OrgID),
+			)
+		}
+	}
+}
+
+// Subscribe подписывается на все события. Возвращает канал событий с буфером
+// указанного размера и функцию отписки, которая закрывает канал.
+func (b *Bus) Subscribe(buffer int) (<-chan domain.Event, func()) {
+	ch := make(chan domain.Event, buffer)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+func TestBus_PublishSubscribe(t *testing.T) {
+	bus := NewBus(slog.New(slog.DiscardHandler))
+
+	first, unsubscribeFirst := bus.Subscribe(1)
+	second, unsubscribeSecond := bus.Subscribe(1)
+	defer unsubscribeSecond()
+
+	event := domain.Event{Type: domain.EventReviewOverdue, OrgID: "org-1"}
+	bus.Publish(context.Background(), event)
+
+	assert.Equal(t, event, <-first)
+	assert.Equal(t, event, <-second)
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+
+	_, ok := <-first
+	assert.False(t, ok, "channel must be closed after unsubscribe")
+
+	bus.Publish(context.Background(), event)
+	assert.Equal(t, event, <-second)
+}
+
+func TestBus_DropsEventsForFullSubscriber(t *testing.T) {
+	bus := NewBus(slog.New(slog.DiscardHandler))
+
+	ch, unsubscribe := bus.Subscribe(1)
+	defer unsubscribe()
+
+	bus.Publish(context.Background(), domain.Event{Type: domain.EventReviewOverdue, OrgID: "org-1"})
+	bus.Publish(context.Background(), domain.Event{Type: domain.EventReviewOverdue, OrgID: "org-2"})
+
+	require.Len(t, ch, 1)
+	assert.Equal(t, "org-1", (<-ch).OrgID)
+}
@@ -0,0 +1,145 @@
+// Package health собирает состояние зависимостей и фоновых обработчиков сервиса
+// для проверки готовности /readyz.
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// checkTimeout ограничивает одну проверку готовности, чтобы зависшая зависимость
+// не задерживала ответ дольше тайм-аута проверки балансировщика.
+const checkTimeout = 2 * time.Second
+
+// Состояния в отчёте о готовности.
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+	// StatusDraining — сервис останавливается и больше не принимает новые запросы.
+	StatusDraining = "draining"
+
+	WorkerRunning = "running"
+	WorkerStopped = "stopped"
+)
+
+// Check проверяет одну зависимость и возвращает ошибку, если она недоступна.
+type Check func(ctx context.Context) error
+
+// Report — результат проверки готовности. Checks содержит "ok" или текст ошибки
+// для каждой зависимости, Workers — состояние каждого фонового обработчика.
+type Report struct {
+	Status  string            `json:"status"`
+	Checks  map[string]string `json:"checks"`
+	Workers map[string]string `json:"workers"`
+}
+
+// Ready сообщает, можно ли направлять в сервис запросы.
+func (r Report) Ready() bool {
+	return r.Status == StatusOK
+}
+
+// Checker хранит проверки зависимостей и состояние фоновых обработчиков.
+// Проверки добавляются при сборке приложения, до запуска HTTP сервера.
+type Checker struct {
+	checks map[string]Check
+
+	mu      sync.Mutex
+	workers map[string]bool
+
+	draining atomic.Bool
+}
+
+func New() *Checker {
+	return &Checker{
+		checks:  make(map[string]Check),
+		workers: make(map[string]bool),
+	}
+}
+
+// AddCheck добавляет проверку зависимости name.
+func (c *Checker) AddCheck(name string, check Check) {
+	c.checks[name] = check
+}
+
+// Go запускает фоновый обработчик run в отдельной горутине. Пока run не вернёт управление,
+// обработчик считается работающим; остановившийся обработчик делает сервис неготовым.
+func (c *Checker) Go(ctx context.Context, name string, run func(ctx context.Context)) {
+	c.setWorker(name, true)
+
+	go func() {
+		defer c.setWorker(name, false)
+
+		run(ctx)
+	}()
+}
+
+// Drain переводит сервис в состояние остановки: с этого момента проверка готовности
+// не проходит, и балансировщик перестаёт направлять в реплику новые запросы.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// Report выполняет все проверки параллельно и собирает отчёт о готовности.
+func (c *Checker) Report(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	report := Report{
+		Status:  StatusOK,
+		Checks:  make(map[string]string, len(c.checks)),
+		Workers: make(map[string]string),
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result := StatusOK
+			err := check(ctx)
+			if err != nil {
+				result = err.Error()
+			}
+
+			mu.Lock()
+			report.Checks[name] = result
+			if err != nil {
+				report.Status = StatusUnavailable
+			}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	for name, running := range c.workers {
+		if !running {
+			report.Workers[name] = WorkerStopped
+			report.Status = StatusUnavailable
+
+			continue
+		}
+
+		report.Workers[name] = WorkerRunning
+	}
+	c.mu.Unlock()
+
+	if c.draining.Load() {
+		report.Status = StatusDraining
+	}
+
+	return report
+}
+
+func (c *Checker) setWorker(name string, running bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.workers[name] = running
+}
@@ -0,0 +1,108 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker_Report(t *testing.T) {
+	okCheck := func(context.Context) error { return nil }
+	failingCheck := func(context.Context) error { return errors.New("connection refused") }
+
+	tests := []struct {
+		name        string
+		checks      map[string]Check
+		drain       bool
+		wantStatus  string
+		wantChecks  map[string]string
+		wantReady   bool
+		stopWorkers bool
+	}{
+		{
+			name:       "all checks pass",
+			checks:     map[string]Check{"postgres": okCheck, "migrations": okCheck},
+			wantStatus: StatusOK,
+			wantChecks: map[string]string{"postgres": StatusOK, "migrations": StatusOK},
+			wantReady:  true,
+		},
+		{
+			name:       "failing check",
+			checks:     map[string]Check{"postgres": failingCheck, "migrations": okCheck},
+			wantStatus: StatusUnavailable,
+			wantChecks: map[string]string{"postgres": "connection refused", "migrations": StatusOK},
+		},
+		{
+			name:       "draining",
+			checks:     map[string]Check{"postgres": okCheck},
+			drain:      true,
+			wantStatus: StatusDraining,
+			wantChecks: map[string]string{"postgres": StatusOK},
+		},
+		{
+			name:        "stopped worker",
+			checks:      map[string]Check{},
+			stopWorkers: true,
+			wantStatus:  StatusUnavailable,
+			wantChecks:  map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			for name, check := range tt.checks {
+				c.AddCheck(name, check)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stopped := make(chan struct{})
+			c.Go(ctx, "sla", func(ctx context.Context) {
+				<-ctx.Done()
+				close(stopped)
+			})
+
+			wantWorker := WorkerRunning
+			if tt.stopWorkers {
+				cancel()
+				<-stopped
+				require.Eventually(t, func() bool {
+					return c.Report(context.Background()).Workers["sla"] == WorkerStopped
+				}, time.Second, time.Millisecond)
+				wantWorker = WorkerStopped
+			}
+			if tt.drain {
+				c.Drain()
+			}
+
+			report := c.Report(context.Background())
+
+			assert.Equal(t, tt.wantStatus, report.Status)
+			assert.Equal(t, tt.wantChecks, report.Checks)
+			assert.Equal(t, map[string]string{"sla": wantWorker}, report.Workers)
+			assert.Equal(t, tt.wantReady, report.Ready())
+		})
+	}
+}
+
+func TestChecker_Report_Timeout(t *testing.T) {
+	c := New()
+	c.AddCheck("postgres", func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	report := c.Report(ctx)
+
+	assert.Equal(t, StatusUnavailable, report.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["postgres"])
+}
@@ -0,0 +1,102 @@
+// Package metrics собирает метрики сервиса в формате Prometheus: HTTP-запросы,
+// пул подключений к PostgreSQL и показатели ревью. Метрики отдаются обработчиком Handler.
+package metrics
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "pr_reviewer"
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	reassignments prometheus.Counter
+	noCandidates  prometheus.Counter
+}
+
+// New создаёт метрики HTTP-запросов и переназначений, а также стандартные метрики
+// среды выполнения Go и процесса.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of handled HTTP requests by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method, route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		reassignments: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_reassignments_total",
+			Help:      "Number of successful reviewer reassignments.",
+		}),
+		noCandidates: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reassign_no_candidates_total",
+			Help:      "Number of reassignments that failed because no candidate was available.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.reassignments,
+		m.noCandidates,
+	)
+
+	return m
+}
+
+// Handler отдаёт все зарегистрированные метрики.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveHTTPRequest учитывает обработанный HTTP-запрос. route — шаблон маршрута,
+// а не путь запроса, чтобы идентификаторы в пути не порождали новые ряды.
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, latency time.Duration) {
+	code := strconv.Itoa(status)
+
+	m.httpRequests.WithLabelValues(method, route, code).Inc()
+	m.httpDuration.WithLabelValues(method, route, code).Observe(latency.Seconds())
+}
+
+// ReviewerReassigned учитывает успешное переназначение ревьювера.
+func (m *Metrics) ReviewerReassigned() {
+	m.reassignments.Inc()
+}
+
+// NoCandidates учитывает переназначение, для которого не нашлось кандидата.
+func (m *Metrics) NoCandidates() {
+	m.noCandidates.Inc()
+}
+
+// RegisterPool добавляет метрики пула подключений к PostgreSQL.
+func (m *Metrics) RegisterPool(pool *pgxpool.Pool) {
+	m.registry.MustRegister(newPoolCollector(pool))
+}
+
+// RegisterWorkload добавляет показатели нагрузки на ревью по всем организациям.
+// Они считаются при каждом сборе метрик запросами к хранилищу.
+func (m *Metrics) RegisterWorkload(lgr *slog.Logger, orgRepo OrgRepository, source WorkloadSource) {
+	m.registry.MustRegister(newWorkloadCollector(lgr, orgRepo, source))
+}
@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+var errUnexpected = errors.New("unexpected")
+
+type fakeOrgRepo struct {
+	ids []string
+	err error
+}
+
+func (r fakeOrgRepo) ListIDs(context.Context) ([]string, error) {
+	return r.ids, r.err
+}
+
+// fakeWorkload возвращает нагрузку организации из контекста.
+type fakeWorkload map[string]*domain.Workload
+
+func (f fakeWorkload) Workload(ctx context.Context) (*domain.Workload, error) {
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	w, ok := f[orgID]
+	if !ok {
+		return nil, errUnexpected
+	}
+
+	return w, nil
+}
+
+func TestWorkloadCollector(t *testing.T) {
+	source := fakeWorkload{
+		"org-a": {
+			PullRequests: domain.PullRequestCounts{Open: 3, WithoutReviewers: 1},
+			Teams:        []domain.TeamStats{{TeamName: "backend", Assignments: 4}, {TeamName: "mobile"}},
+		},
+		"org-b": {PullRequests: domain.PullRequestCounts{Open: 1}},
+	}
+
+	tests := []struct {
+		name     string
+		orgRepo  fakeOrgRepo
+		expected string
+	}{
+		{
+			name:    "success - gauges per organization and team",
+			orgRepo: fakeOrgRepo{ids: []string{"org-a", "org-b"}},
+			expected: `
+# HELP pr_reviewer_open_pull_requests Number of open pull requests.
+# TYPE pr_reviewer_open_pull_requests gauge
+pr_reviewer_open_pull_requests{org="org-a"} 3
+pr_reviewer_open_pull_requests{org="org-b"} 1
+# HELP pr_reviewer_pull_requests_need_reviewers Number of open pull requests without any assigned reviewer.
+# TYPE pr_reviewer_pull_requests_need_reviewers gauge
+pr_reviewer_pull_requests_need_reviewers{org="org-a"} 1
+pr_reviewer_pull_requests_need_reviewers{org="org-b"} 0
+# HELP pr_reviewer_team_open_assignments Number of reviewer assignments on open pull requests by reviewer team.
+# TYPE pr_reviewer_team_open_assignments gauge
+pr_reviewer_team_open_assignments{org="org-a",team="backend"} 4
+pr_reviewer_team_open_assignments{org="org-a",team="mobile"} 0
+`,
+		},
+		{
+			name:    "success - failed organization is skipped",
+			orgRepo: fakeOrgRepo{ids: []string{"org-broken", "org-b"}},
+			expected: `
+# HELP pr_reviewer_open_pull_requests Number of open pull requests.
+# TYPE pr_reviewer_open_pull_requests gauge
+pr_reviewer_open_pull_requests{org="org-b"} 1
+# HELP pr_reviewer_pull_requests_need_reviewers Number of open pull requests without any assigned reviewer.
+# TYPE pr_reviewer_pull_requests_need_reviewers gauge
+pr_reviewer_pull_requests_need_reviewers{org="org-b"} 0
+`,
+		},
+		{
+			name:    "success - nothing collected when organizations are unavailable",
+			orgRepo: fakeOrgRepo{err: errUnexpected},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newWorkloadCollector(slog.New(slog.DiscardHandler), tt.orgRepo, source)
+
+			err := testutil.CollectAndCompare(c, strings.NewReader(tt.expected))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMetrics_Counters(t *testing.T) {
+	m := New()
+
+	m.ObserveHTTPRequest("POST", "/pullRequest/reassign", 200, 30*time.Millisecond)
+	m.ObserveHTTPRequest("POST", "/pullRequest/reassign", 409, 10*time.Millisecond)
+	m.ObserveHTTPRequest("POST", "/pullRequest/reassign", 200, 20*time.Millisecond)
+	m.ReviewerReassigned()
+	m.ReviewerReassigned()
+	m.NoCandidates()
+
+	assert.InDelta(t, 2, testutil.ToFloat64(m.httpRequests.WithLabelValues("POST", "/pullRequest/reassign", "200")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(m.httpRequests.WithLabelValues("POST", "/pullRequest/reassign", "409")), 0)
+	assert.Equal(t, 2, testutil.CollectAndCount(m.httpDuration))
+	assert.InDelta(t, 2, testutil.ToFloat64(m.reassignments), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(m.noCandidates), 0)
+}
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector снимает статистику пула pgxpool в момент сбора метрик.
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns    *prometheus.Desc
+	idleConns        *prometheus.Desc
+	totalConns       *prometheus.Desc
+	maxConns         *prometheus.Desc
+	acquires         *prometheus.Desc
+	acquireDuration  *prometheus.Desc
+	canceledAcquires *prometheus.Desc
+	emptyAcquires    *prometheus.Desc
+	newConns         *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "pgxpool", name), help, nil, nil)
+	}
+
+	return &poolCollector{
+		pool:             pool,
+		acquiredConns:    desc("acquired_conns", "Number of connections currently acquired from the pool."),
+		idleConns:        desc("idle_conns", "Number of idle connections in the pool."),
+		totalConns:       desc("total_conns", "Total number of connections in the pool."),
+		maxConns:         desc("max_conns", "Maximum size of the pool."),
+		acquires:         desc("acquires_total", "Number of successful connection acquires."),
+		acquireDuration:  desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		canceledAcquires: desc("canceled_acquires_total", "Number of acquires canceled by context."),
+		emptyAcquires:    desc("empty_acquires_total", "Number of acquires that waited for a free connection."),
+		newConns:         desc("new_conns_total", "Number of connections opened by the pool."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquires
+	ch <- c.acquireDuration
+	ch <- c.canceledAcquires
+	ch <- c.emptyAcquires
+	ch <- c.newConns
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, s.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquires, prometheus.CounterValue,
+		float64(s.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.newConns, prometheus.CounterValue, float64(s.NewConnsCount()))
+}
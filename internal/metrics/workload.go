@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+// workloadTimeout ограничивает запросы к хранилищу при одном сборе метрик.
+const workloadTimeout = 10 * time.Second
+
+type OrgRepository interface {
+	ListIDs(ctx context.Context) ([]string, error)
+}
+
+// WorkloadSource возвращает текущую нагрузку организации из контекста. Реализуется сервисом статистики.
+type WorkloadSource interface {
+	Workload(ctx context.Context) (*domain.Workload, error)
+}
+
+// workloadCollector считает нагрузку на ревью по каждой организации в момент сбора метрик,
+// поэтому значения не теряются при перезапуске и совпадают у всех реплик.
+type workloadCollector struct {
+	lgr     *slog.Logger
+	orgRepo OrgRepository
+	source  WorkloadSource
+
+	openPullRequests *prometheus.Desc
+	withoutReviewers *prometheus.Desc
+	teamAssignments  *prometheus.Desc
+}
+
+func newWorkloadCollector(lgr *slog.Logger, orgRepo OrgRepository, source WorkloadSource) *workloadCollector {
+	return &workloadCollector{
+		lgr:     lgr,
+		orgRepo: orgRepo,
+		source:  source,
+		openPullRequests: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "open_pull_requests"),
+			"Number of open pull requests.", []string{"org"}, nil),
+		withoutReviewers: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "pull_requests_need_reviewers"),
+			"Number of open pull requests without any assigned reviewer.", []string{"org"}, nil),
+		teamAssignments: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "team_open_assignments"),
+			"Number of reviewer assignments on open pull requests by reviewer team.", []string{"org", "team"}, nil),
+	}
+}
+
+func (c *workloadCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.openPullRequests
+	ch <- c.withoutReviewers
+	ch <- c.teamAssignments
+}
+
+// Collect обходит все организации. Ошибка в одной организации записывается в лог
+// и не мешает отдать показатели остальных.
+func (c *workloadCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), workloadTimeout)
+	defer cancel()
+
+	orgIDs, err := c.orgRepo.ListIDs(ctx)
+	if err != nil {
+		c.lgr.ErrorContext(ctx, "failed to list organizations for metrics", slog.Any("error", err))
+
+		return
+	}
+
+	for _, orgID := range orgIDs {
+		orgCtx := tenant.WithPrincipal(ctx, domain.Principal{OrgID: orgID, Role: domain.RoleAdmin})
+
+		w, err := c.source.Workload(orgCtx)
+		if err != nil {
+			c.lgr.ErrorContext(ctx, "failed to collect workload metrics",
+				slog.String("orgID", orgID), slog.Any("error", err))
+
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.openPullRequests, prometheus.GaugeValue,
+			float64(w.PullRequests.Open), orgID)
+		ch <- prometheus.MustNewConstMetric(c.withoutReviewers, prometheus.GaugeValue,
+			float64(w.PullRequests.WithoutReviewers), orgID)
+		for _, t := range w.Teams {
+			ch <- prometheus.MustNewConstMetric(c.teamAssignments, prometheus.GaugeValue,
+				float64(t.Assignments), orgID, t.TeamName)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+// Log пишет дайджесты в лог. Подходит для локального запуска и отладки.
+type Log struct {
+	lgr *slog.Logger
+}
+
+func NewLog(lgr *slog.Logger) *Log {
+	return &Log{lgr: lgr}
+}
+
+func (l *Log) SendDigest(ctx context.Context, digest domain.Digest) error {
+	pullRequests := make([]string, len(digest.Items))
+	for i, item := range digest.Items {
+		pullRequests[i] = item.Repository + "/" + item.PullRequestID
+	}
+
+	l.lgr.InfoContext(ctx, "review digest",
+		slog.String("orgID", digest.OrgID),
+		slog.String("userID", digest.UserID),
+		slog.Any("pullRequests", pullRequests),
+	)
+
+	return nil
+}
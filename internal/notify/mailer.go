@@ -0,0 +1,208 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+type UserRepository interface {
+	GetByID(ctx context.Context, userID string) (*domain.User, error)
+}
+
+type Subscriber interface {
+	Subscribe(buffer int) (<-chan domain.Event, func())
+}
+
+// mailerBuffer — размер буфера подписки. Письма отправляются последовательно,
+// поэтому буфер сглаживает всплески событий, пока SMTP-сервер отвечает медленно.
+const mailerBuffer = 256
+
+// Mailer отправляет письма об изменениях назначений: ревьюверу — о назначении,
+// снятии и переназначении, автору — об одобрении всеми ревьюверами и о слиянии.
+// Пользователям без email письма не отправляются.
+type Mailer struct {
+	lgr *slog.Logger
+
+	subscriber Subscriber
+	users      UserRepository
+	sender     *Sender
+	templates  *Templates
+}
+
+func NewMailer(
+	lgr *slog.Logger,
+	subscriber Subscriber,
+	users UserRepository,
+	sender *Sender,
+	templates *Templates,
+) *Mailer {
+	return &Mailer{
+		lgr:        lgr,
+		subscriber: subscriber,
+		users:      users,
+		sender:     sender,
+		templates:  templates,
+	}
+}
+
+// MessageData — данные, доступные в шаблонах писем об изменениях назначений.
+type MessageData struct {
+	Recipient     domain.User
+	PullRequest   domain.PullRequest
+	OldReviewerID string
+	NewReviewerID string
+}
+
+type message struct {
+	recipientID string
+	template    string
+	data        MessageData
+}
+
+// Run подписывается на события и отправляет письма, пока не будет отменён контекст.
+func (m *Mailer) Run(ctx context.Context) {
+	const op = "notify.Mailer.Run"
+
+	lgr := m.lgr.With(slog.String("op", op))
+
+	events, unsubscribe := m.subscriber.Subscribe(mailerBuffer)
+	defer unsubscribe()
+
+	lgr.InfoContext(ctx, "starting mailer")
+
+	for {
+		select {
+		case <-ctx.Done():
+			lgr.InfoContext(ctx, "mailer stopped")
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			err := m.Handle(ctx, event)
+			if err != nil {
+				lgr.ErrorContext(ctx, "failed to send notifications",
+					slog.String("event", string(event.Type)), slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// Handle отправляет письма по событию. События, о которых не пишут, игнорируются.
+// Ошибка отправки одному получателю не мешает отправке остальным.
+func (m *Mailer) Handle(ctx context.Context, event domain.Event) error {
+	const op = "notify.Mailer.Handle"
+
+	messages := messagesFor(event)
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ctx = tenant.WithPrincipal(ctx, domain.Principal{OrgID: event.OrgID, Role: domain.RoleAdmin})
+
+	var errs []error
+	for _, msg := range messages {
+		err := m.send(ctx, msg)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s to %s: %w", msg.template, msg.recipientID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", op, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func (m *Mailer) send(ctx context.Context, msg message) error {
+	user, err := m.users.GetByID(ctx, msg.recipientID)
+	if err != nil {
+		return fmt.Errorf("get recipient: %w", err)
+	}
+
+	if user.Email == "" {
+		m.lgr.DebugContext(ctx, "recipient has no email, notification skipped",
+			slog.String("userID", user.ID), slog.String("template", msg.template))
+
+		return nil
+	}
+
+	msg.data.Recipient = *user
+
+	subject, body, err := m.templates.Render(msg.template, msg.data)
+	if err != nil {
+		return err
+	}
+
+	return m.sender.Send(ctx, user.Email, subject, body)
+}
+
+func messagesFor(event domain.Event) []message {
+	switch data := event.Data.(type) {
+	case domain.ReviewersAssignedEvent:
+		messages := make([]message, len(data.ReviewerIDs))
+		for i, reviewerID := range data.ReviewerIDs {
+			messages[i] = message{
+				recipientID: reviewerID,
+				template:    TemplateAssigned,
+				data:        MessageData{PullRequest: data.PullRequest},
+			}
+		}
+
+		return messages
+	case domain.ReviewerReassignedEvent:
+		msgData := MessageData{
+			PullRequest:   data.PullRequest,
+			OldReviewerID: data.OldReviewerID,
+			NewReviewerID: data.NewReviewerID,
+		}
+
+		return []message{
+			{recipientID: data.OldReviewerID, template: TemplateUnassigned, data: msgData},
+			{recipientID: data.NewReviewerID, template: TemplateReassigned, data: msgData},
+		}
+	case domain.ReviewOverdueEvent:
+		// Переназначение по SLA публикует собственное событие, здесь пишем только лиду при эскалации.
+		if data.EscalatedTo == "" {
+			return nil
+		}
+
+		a := data.Assignment
+
+		return []message{{
+			recipientID: data.EscalatedTo,
+			template:    TemplateAssigned,
+			data: MessageData{PullRequest: domain.PullRequest{
+				RepositoryID: a.RepositoryID,
+				Repository:   a.Repository,
+				ID:           a.PullRequestID,
+				Name:         a.PullRequestName,
+				AuthorID:     a.AuthorID,
+			}},
+		}}
+	case domain.PullRequestEvent:
+		switch event.Type {
+		case domain.EventPullRequestApproved:
+			return []message{{
+				recipientID: data.PullRequest.AuthorID,
+				template:    TemplateApproved,
+				data:        MessageData{PullRequest: data.PullRequest},
+			}}
+		case domain.EventPullRequestMerged:
+			return []message{{
+				recipientID: data.PullRequest.AuthorID,
+				template:    TemplateMerged,
+				data:        MessageData{PullRequest: data.PullRequest},
+			}}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/events"
+	"avitotech-pr-reviewer/internal/notify/smtptest"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+// usersStub отдаёт пользователей организации org-1.
+type usersStub map[string]domain.User
+
+func (u usersStub) GetByID(ctx context.Context, userID string) (*domain.User, error) {
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if orgID != "org-1" {
+		return nil, repoErr.ErrUserNotFound
+	}
+
+	user, ok := u[userID]
+	if !ok {
+		return nil, repoErr.ErrUserNotFound
+	}
+
+	return &user, nil
+}
+
+func newTestMailer(t *testing.T, subscriber Subscriber) (*Mailer, *smtptest.Server) {
+	t.Helper()
+
+	srv := smtptest.NewServer(t)
+
+	templates, err := LoadTemplates("")
+	require.NoError(t, err)
+
+	users := usersStub{
+		"u1": {ID: "u1", Username: "alice", Email: "alice@example.com"},
+		"u2": {ID: "u2", Username: "bob", Email: "bob@example.com"},
+		"u3": {ID: "u3", Username: "carol", Email: "carol@example.com"},
+		"u4": {ID: "u4", Username: "dave"},
+	}
+
+	sender := NewSender(srv.Host(), srv.Port(), "", "", "reviewer@example.com", time.Second)
+
+	return NewMailer(slog.New(slog.DiscardHandler), subscriber, users, sender, templates), srv
+}
+
+func TestMailer_Handle(t *testing.T) {
+	pr := domain.PullRequest{
+		Repository: "backend",
+		ID:         "pr-1",
+		Name:       "Add search",
+		AuthorID:   "u1",
+		Reviewers:  []string{"u2", "u3"},
+	}
+
+	type mail struct {
+		to      string
+		subject string
+		body    string
+	}
+
+	tests := []struct {
+		name     string
+		event    domain.Event
+		expected []mail
+	}{
+		{
+			name: "assigned - every reviewer with email",
+			event: domain.Event{
+				Type: domain.EventReviewersAssigned,
+				Data: domain.ReviewersAssignedEvent{PullRequest: pr, ReviewerIDs: []string{"u2", "u4"}},
+			},
+			expected: []mail{
+				{to: "bob@example.com", subject: "[backend] Review requested: Add search", body: "Hello, bob!"},
+			},
+		},
+		{
+			name: "reassigned - old and new reviewer",
+			event: domain.Event{
+				Type: domain.EventReviewerReassigned,
+				Data: domain.ReviewerReassignedEvent{PullRequest: pr, OldReviewerID: "u2", NewReviewerID: "u3"},
+			},
+			expected: []mail{
+				{
+					to:      "bob@example.com",
+					subject: "[backend] Review no longer needed: Add search",
+					body:    "u3 will review it instead.",
+				},
+				{
+					to:      "carol@example.com",
+					subject: "[backend] Review reassigned to you: Add search",
+					body:    "has been reassigned to you from u2.",
+				},
+			},
+		},
+		{
+			name: "approved - author",
+			event: domain.Event{
+				Type: domain.EventPullRequestApproved,
+				Data: domain.PullRequestEvent{PullRequest: pr},
+			},
+			expected: []mail{
+				{to: "alice@example.com", subject: "[backend] Approved: Add search", body: "approved by all reviewers: u2, u3."},
+			},
+		},
+		{
+			name: "merged - author",
+			event: domain.Event{
+				Type: domain.EventPullRequestMerged,
+				Data: domain.PullRequestEvent{PullRequest: pr},
+			},
+			expected: []mail{
+				{to: "alice@example.com", subject: "[backend] Merged: Add search", body: "has been merged."},
+			},
+		},
+		{
+			name: "overdue escalated - lead",
+			event: domain.Event{
+				Type: domain.EventReviewOverdue,
+				Data: domain.ReviewOverdueEvent{
+					Assignment: domain.ReviewAssignment{
+						Repository:      "backend",
+						PullRequestID:   "pr-1",
+						PullRequestName: "Add search",
+						AuthorID:        "u1",
+						ReviewerID:      "u2",
+					},
+					Action:      domain.SLAActionEscalate,
+					EscalatedTo: "u3",
+				},
+			},
+			expected: []mail{
+				{to: "carol@example.com", subject: "[backend] Review requested: Add search", body: "Hello, carol!"},
+			},
+		},
+		{
+			name: "overdue without escalation - nothing",
+			event: domain.Event{
+				Type: domain.EventReviewOverdue,
+				Data: domain.ReviewOverdueEvent{Action: domain.SLAActionNone},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mailer, srv := newTestMailer(t, events.NewBus(slog.New(slog.DiscardHandler)))
+
+			tt.event.OrgID = "org-1"
+			err := mailer.Handle(context.Background(), tt.event)
+			require.NoError(t, err)
+
+			messages := srv.Messages()
+			require.Len(t, messages, len(tt.expected))
+			for i, want := range tt.expected {
+				assert.Equal(t, []string{want.to}, messages[i].To)
+				assert.Contains(t, messages[i].Data, "Subject: "+want.subject+"\n")
+				assert.Contains(t, strings.ReplaceAll(messages[i].Data, "\n", " "), want.body)
+			}
+		})
+	}
+}
+
+func TestMailer_Handle_UnknownRecipient(t *testing.T) {
+	mailer, srv := newTestMailer(t, events.NewBus(slog.New(slog.DiscardHandler)))
+
+	err := mailer.Handle(context.Background(), domain.Event{
+		Type:  domain.EventReviewersAssigned,
+		OrgID: "org-1",
+		Data: domain.ReviewersAssignedEvent{
+			PullRequest: domain.PullRequest{Repository: "backend", ID: "pr-1", Name: "Add search"},
+			ReviewerIDs: []string{"u9", "u2"},
+		},
+	})
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	assert.Len(t, srv.Messages(), 1)
+}
+
+func TestMailer_Run(t *testing.T) {
+	bus := events.NewBus(slog.New(slog.DiscardHandler))
+	mailer, srv := newTestMailer(t, bus)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		mailer.Run(ctx)
+		close(done)
+	}()
+
+	// Подписка оформляется в Run, поэтому публикуем, пока письмо не дойдёт.
+	require.Eventually(t, func() bool {
+		if len(srv.Messages()) > 0 {
+			return true
+		}
+
+		bus.Publish(ctx, domain.Event{
+			Type:  domain.EventPullRequestMerged,
+			OrgID: "org-1",
+			Data: domain.PullRequestEvent{PullRequest: domain.PullRequest{
+				Repository: "backend", ID: "pr-1", Name: "Add search", AuthorID: "u1",
+			}},
+		})
+
+		return false
+	}, 2*time.Second, 50*time.Millisecond)
+
+	cancel()
+	<-done
+
+	assert.Equal(t, []string{"alice@example.com"}, srv.Messages()[0].To)
+}
@@ -0,0 +1,3 @@
+// Package notify содержит способы доставки уведомлений: дайджестов ревьюверам
+// в лог, на webhook или письмом по SMTP, а также писем об изменениях назначений.
+package notify
@@ -0,0 +1,214 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/notify/smtptest"
+)
+
+func testDigest() domain.Digest {
+	generatedAt := time.Date(2025, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	return domain.Digest{
+		OrgID:       "org-1",
+		UserID:      "u2",
+		Username:    "bob",
+		Email:       "bob@example.com",
+		GeneratedAt: generatedAt,
+		Items: []domain.DigestItem{
+			{
+				Repository:      "backend",
+				PullRequestID:   "pr-1",
+				PullRequestName: "Add search",
+				AuthorID:        "u1",
+				AssignedAt:      generatedAt.Add(-50 * time.Hour),
+				Age:             50 * time.Hour,
+			},
+			{
+				Repository:      "frontend",
+				PullRequestID:   "pr-7",
+				PullRequestName: "Fix layout",
+				AuthorID:        "u3",
+				AssignedAt:      generatedAt.Add(-3 * time.Hour),
+				Age:             3 * time.Hour,
+			},
+		},
+	}
+}
+
+func TestTemplates_RenderDigest(t *testing.T) {
+	templates, err := LoadTemplates("")
+	require.NoError(t, err)
+
+	subject, body, err := templates.Render(TemplateDigest, testDigest())
+	require.NoError(t, err)
+
+	assert.Equal(t, "Pull requests waiting for your review", subject)
+
+	want := `Hello, bob!
+
+2 pull request(s) are waiting for your review:
+
+- [backend] pr-1 Add search by u1, open for 2d
+- [frontend] pr-7 Fix layout by u3, open for 3h
+`
+	assert.Equal(t, want, body)
+}
+
+func TestLoadTemplates_Override(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, TemplateDigest+".tmpl"),
+		[]byte(`{{define "subject"}}Reviews for {{.Username}}{{end}}{{define "body"}}{{len .Items}} open{{end}}`), 0o600)
+	require.NoError(t, err)
+
+	templates, err := LoadTemplates(dir)
+	require.NoError(t, err)
+
+	subject, body, err := templates.Render(TemplateDigest, testDigest())
+	require.NoError(t, err)
+	assert.Equal(t, "Reviews for bob", subject)
+	assert.Equal(t, "2 open", body)
+
+	// Шаблоны, которых нет в каталоге, остаются встроенными.
+	subject, _, err = templates.Render(TemplateMerged, MessageData{
+		PullRequest: domain.PullRequest{Repository: "backend", Name: "Add search"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "[backend] Merged: Add search", subject)
+}
+
+func TestLoadTemplates_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, TemplateAssigned+".tmpl"), []byte(`{{define "body"}}no subject{{end}}`), 0o600)
+	require.NoError(t, err)
+
+	_, err = LoadTemplates(dir)
+	require.Error(t, err)
+}
+
+func TestLog_SendDigest(t *testing.T) {
+	err := NewLog(slog.New(slog.DiscardHandler)).SendDigest(context.Background(), testDigest())
+	require.NoError(t, err)
+}
+
+func TestWebhook_SendDigest(t *testing.T) {
+	var received webhookDigest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	err := NewWebhook(srv.URL, time.Second).SendDigest(context.Background(), testDigest())
+	require.NoError(t, err)
+
+	assert.Equal(t, "u2", received.UserID)
+	require.Len(t, received.PullRequests, 2)
+	assert.Equal(t, "pr-1", received.PullRequests[0].PullRequestID)
+	assert.Equal(t, int64(50*60*60), received.PullRequests[0].AgeSeconds)
+}
+
+func TestWebhook_SendDigest_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	err := NewWebhook(srv.URL, time.Second).SendDigest(context.Background(), testDigest())
+	require.Error(t, err)
+}
+
+func TestSMTP_SendDigest(t *testing.T) {
+	srv := smtptest.NewServer(t)
+
+	templates, err := LoadTemplates("")
+	require.NoError(t, err)
+
+	notifier := NewSMTP(NewSender(srv.Host(), srv.Port(), "", "", "reviewer@example.com", time.Second), templates)
+
+	err = notifier.SendDigest(context.Background(), testDigest())
+	require.NoError(t, err)
+
+	// Пользователям без email дайджест не отправляется.
+	noEmail := testDigest()
+	noEmail.Email = ""
+	err = notifier.SendDigest(context.Background(), noEmail)
+	require.NoError(t, err)
+
+	messages := srv.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "reviewer@example.com", messages[0].From)
+	assert.Equal(t, []string{"bob@example.com"}, messages[0].To)
+	assert.Contains(t, messages[0].Data, "Subject: Pull requests waiting for your review")
+	assert.Contains(t, messages[0].Data, "- [backend] pr-1 Add search by u1, open for 2d")
+}
+
+func TestBuildMessage_Subject(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  string
+		expected string
+	}{
+		{
+			name:     "ascii subject is kept",
+			subject:  "[backend] Review requested: Add search",
+			expected: "Subject: [backend] Review requested: Add search\r\n",
+		},
+		{
+			name:     "line breaks cannot add headers",
+			subject:  "Review requested: Fix\r\nBcc: attacker@example.com",
+			expected: "Subject: Review requested: Fix Bcc: attacker@example.com\r\n",
+		},
+		{
+			name:     "non-ascii subject is encoded",
+			subject:  "Ревью: поиск",
+			expected: "Subject: =?utf-8?q?=D0=A0=D0=B5=D0=B2=D1=8C=D1=8E:_=D0=BF=D0=BE=D0=B8=D1=81=D0=BA?=\r\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := string(buildMessage("reviewer@example.com", "bob@example.com", tt.subject, "body"))
+
+			assert.Contains(t, msg, tt.expected)
+			assert.NotContains(t, msg, "\r\nBcc:")
+		})
+	}
+}
+
+func TestSender_Send_Timeout(t *testing.T) {
+	// Сервер принимает подключение, но не отвечает приветствием.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			t.Cleanup(func() { _ = conn.Close() })
+		}
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	sender := NewSender(addr.IP.String(), addr.Port, "", "", "reviewer@example.com", 50*time.Millisecond)
+
+	start := time.Now()
+	err = sender.Send(context.Background(), "bob@example.com", "subject", "body")
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,179 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+// Sender отправляет текстовые письма через SMTP-сервер.
+type Sender struct {
+	host    string
+	addr    string
+	from    string
+	auth    smtp.Auth
+	timeout time.Duration
+}
+
+// NewSender создаёт отправителя. Если username пуст, аутентификация не используется.
+// timeout ограничивает отправку одного письма: подключение, обмен командами и передачу данных.
+func NewSender(host string, port int, username, password, from string, timeout time.Duration) *Sender {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &Sender{
+		host:    host,
+		addr:    net.JoinHostPort(host, strconv.Itoa(port)),
+		from:    from,
+		auth:    auth,
+		timeout: timeout,
+	}
+}
+
+// Send отправляет письмо, как smtp.SendMail, но не дольше timeout отправителя и отмены ctx:
+// зависший сервер не задерживает рассылку и остановку сервиса.
+func (s *Sender) Send(ctx context.Context, to, subject, body string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	deadline, _ := ctx.Deadline()
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		_ = conn.Close()
+
+		return err
+	}
+
+	// Закрытие подключения прерывает обмен, если ctx отменён раньше срока.
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	err = s.send(conn, to, buildMessage(s.from, to, subject, body))
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("%w: %w", ctx.Err(), err)
+	}
+
+	return err
+}
+
+func (s *Sender) send(conn net.Conn, to string, msg []byte) error {
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err := c.StartTLS(&tls.Config{ServerName: s.host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if ok, _ := c.Extension("AUTH"); ok && s.auth != nil {
+		err := c.Auth(s.auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.Mail(s.from)
+	if err != nil {
+		return err
+	}
+
+	err = c.Rcpt(to)
+	if err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// subjectReplacer убирает переводы строк из темы, чтобы данные шаблона не могли
+// завершить заголовок и добавить к письму свои заголовки.
+var subjectReplacer = strings.NewReplacer("\r", "", "\n", " ")
+
+func buildMessage(from, to, subject, body string) []byte {
+	var msg strings.Builder
+
+	subject = mime.QEncoding.Encode("utf-8", subjectReplacer.Replace(subject))
+
+	msg.WriteString("From: " + from + "\r\n")
+	msg.WriteString("To: " + to + "\r\n")
+	msg.WriteString("Subject: " + subject + "\r\n")
+	msg.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	return []byte(msg.String())
+}
+
+// SMTP отправляет дайджест письмом на email пользователя.
+// Пользователям без email дайджест не отправляется.
+type SMTP struct {
+	sender    *Sender
+	templates *Templates
+}
+
+func NewSMTP(sender *Sender, templates *Templates) *SMTP {
+	return &SMTP{
+		sender:    sender,
+		templates: templates,
+	}
+}
+
+func (s *SMTP) SendDigest(ctx context.Context, digest domain.Digest) error {
+	const op = "notify.SMTP.SendDigest"
+
+	if digest.Email == "" {
+		return nil
+	}
+
+	subject, body, err := s.templates.Render(TemplateDigest, digest)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.sender.Send(ctx, digest.Email, subject, body)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
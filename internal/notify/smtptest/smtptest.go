@@ -0,0 +1,159 @@
+// Package smtptest содержит SMTP-сервер для тестов, который принимает письма
+// без аутентификации и TLS и сохраняет их в памяти.
+package smtptest
+
+import (
+	"bufio"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Message — принятое сервером письмо.
+type Message struct {
+	From string
+	To   []string
+	Data string
+}
+
+type Server struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	messages []Message
+
+	wg sync.WaitGroup
+}
+
+// NewServer запускает сервер на случайном локальном порту и останавливает его по завершении теста.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("smtptest: listen: %v", err)
+	}
+
+	s := &Server{listener: listener}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Host и Port — адрес, на котором слушает сервер.
+func (s *Server) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+func (s *Server) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Messages возвращает копию принятых писем.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
+
+func (s *Server) Close() {
+	_ = s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	reply := func(line string) bool {
+		return tp.PrintfLine("%s", line) == nil
+	}
+
+	if !reply("220 smtptest ready") {
+		return
+	}
+
+	var msg Message
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(cmd) {
+		case "HELO", "EHLO":
+			reply("250 smtptest")
+		case "MAIL":
+			msg = Message{From: trimAddress(arg)}
+			reply("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, trimAddress(arg))
+			reply("250 OK")
+		case "DATA":
+			if !reply("354 end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+
+			data, err := readData(tp.R)
+			if err != nil {
+				return
+			}
+			msg.Data = data
+
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+
+			reply("250 OK")
+		case "RSET", "NOOP":
+			reply("250 OK")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+func readData(r *bufio.Reader) (string, error) {
+	data, err := textproto.NewReader(r).ReadDotLines()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(data, "\n"), nil
+}
+
+// trimAddress извлекает адрес из аргумента вида "FROM:<user@example.com>".
+func trimAddress(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr, _, _ = strings.Cut(strings.TrimSpace(addr), " ")
+
+	return strings.Trim(addr, "<>")
+}
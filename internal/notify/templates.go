@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Имена шаблонов писем. Каждый шаблон — файл <имя>.tmpl, определяющий шаблоны subject и body.
+const (
+	TemplateDigest     = "digest"
+	TemplateAssigned   = "assigned"
+	TemplateUnassigned = "unassigned"
+	TemplateReassigned = "reassigned"
+	TemplateApproved   = "approved"
+	TemplateMerged     = "merged"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// Templates — набор шаблонов писем.
+type Templates struct {
+	byName map[string]*template.Template
+}
+
+// LoadTemplates загружает встроенные шаблоны. Если dir не пуст, файлы <имя>.tmpl
+// из этого каталога заменяют встроенные шаблоны с тем же именем.
+func LoadTemplates(dir string) (*Templates, error) {
+	names := []string{
+		TemplateDigest,
+		TemplateAssigned,
+		TemplateUnassigned,
+		TemplateReassigned,
+		TemplateApproved,
+		TemplateMerged,
+	}
+
+	t := &Templates{byName: make(map[string]*template.Template, len(names))}
+	for _, name := range names {
+		text, err := templateText(dir, name)
+		if err != nil {
+			return nil, err
+		}
+
+		tmpl, err := template.New(name).Funcs(template.FuncMap{
+			"age":  formatAge,
+			"join": strings.Join,
+		}).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("parse template %s: %w", name, err)
+		}
+
+		for _, part := range []string{"subject", "body"} {
+			if tmpl.Lookup(part) == nil {
+				return nil, fmt.Errorf("template %s: %q is not defined", name, part)
+			}
+		}
+
+		t.byName[name] = tmpl
+	}
+
+	return t, nil
+}
+
+func templateText(dir, name string) (string, error) {
+	file := name + ".tmpl"
+
+	if dir != "" {
+		text, err := os.ReadFile(filepath.Join(dir, file))
+		if err == nil {
+			return string(text), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("read template %s: %w", name, err)
+		}
+	}
+
+	text, err := defaultTemplates.ReadFile("templates/" + file)
+	if err != nil {
+		return "", fmt.Errorf("read default template %s: %w", name, err)
+	}
+
+	return string(text), nil
+}
+
+// Render возвращает тему и текст письма по шаблону name.
+func (t *Templates) Render(name string, data any) (string, string, error) {
+	tmpl, ok := t.byName[name]
+	if !ok {
+		return "", "", fmt.Errorf("unknown template %s", name)
+	}
+
+	var subject, body bytes.Buffer
+
+	err := tmpl.ExecuteTemplate(&subject, "subject", data)
+	if err != nil {
+		return "", "", fmt.Errorf("render template %s subject: %w", name, err)
+	}
+
+	err = tmpl.ExecuteTemplate(&body, "body", data)
+	if err != nil {
+		return "", "", fmt.Errorf("render template %s body: %w", name, err)
+	}
+
+	return strings.TrimSpace(subject.String()), body.String(), nil
+}
+
+// formatAge округляет возраст Pull Request'а до дней, а если он младше суток — до часов.
+func formatAge(d time.Duration) string {
+	const day = 24 * time.Hour
+
+	if d >= day {
+		return fmt.Sprintf("%dd", d/day)
+	}
+
+	return fmt.Sprintf("%dh", d/time.Hour)
+}
@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+// Webhook отправляет дайджест POST-запросом с JSON-телом на заданный URL.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhook(url string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+type webhookDigest struct {
+	OrgID        string              `json:"org_id"`
+	UserID       string              `json:"user_id"`
+	Username     string              `json:"username"`
+	GeneratedAt  time.Time           `json:"generated_at"`
+	PullRequests []webhookDigestItem `json:"pull_requests"`
+}
+
+type webhookDigestItem struct {
+	Repository      string    `json:"repository"`
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	AssignedAt      time.Time `json:"assigned_at"`
+	AgeSeconds      int64     `json:"age_seconds"`
+}
+
+func (w *Webhook) SendDigest(ctx context.Context, digest domain.Digest) error {
+	const op = "notify.Webhook.SendDigest"
+
+	payload := webhookDigest{
+		OrgID:        digest.OrgID,
+		UserID:       digest.UserID,
+		Username:     digest.Username,
+		GeneratedAt:  digest.GeneratedAt,
+		PullRequests: make([]webhookDigestItem, len(digest.Items)),
+	}
+	for i, item := range digest.Items {
+		payload.PullRequests[i] = webhookDigestItem{
+			Repository:      item.Repository,
+			PullRequestID:   item.PullRequestID,
+			PullRequestName: item.PullRequestName,
+			AuthorID:        item.AuthorID,
+			AssignedAt:      item.AssignedAt,
+			AgeSeconds:      int64(item.Age.Seconds()),
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("%s: marshal payload: %w", op, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: build request: %w", op, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s: unexpected status %d", op, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Package scheduler запускает фоновые задачи по cron-выражениям.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Job — задача планировщика. Контекст отменяется при остановке планировщика.
+type Job func(ctx context.Context) error
+
+// Leader выбирает одну реплику, которая выполняет задачи, когда сервис запущен в нескольких репликах.
+type Leader interface {
+	// IsLeader сообщает, является ли реплика лидером, и пытается стать им, если лидера нет.
+	IsLeader(ctx context.Context) (bool, error)
+	// Resign отказывается от лидерства при остановке планировщика.
+	Resign(ctx context.Context)
+}
+
+type Scheduler struct {
+	lgr    *slog.Logger
+	cron   *cron.Cron
+	jobs   []job
+	leader Leader
+}
+
+type Option func(*Scheduler)
+
+// WithLeader выполняет задачи, только пока реплика является лидером. Без него
+// задачи выполняются в каждой реплике, что подходит для установки на одном узле.
+func WithLeader(leader Leader) Option {
+	return func(s *Scheduler) {
+		s.leader = leader
+	}
+}
+
+type job struct {
+	name string
+	spec string
+	fn   Job
+}
+
+// New создаёт планировщик, вычисляющий расписания в часовом поясе loc.
+// Выражения имеют стандартный формат из пяти полей, также поддерживаются
+// дескрипторы вида @daily и @every 1h.
+func New(lgr *slog.Logger, loc *time.Location, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		lgr:  lgr,
+		cron: cron.New(cron.WithLocation(loc)),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Add проверяет выражение spec и регистрирует задачу. Задачи начинают выполняться после Run.
+func (s *Scheduler) Add(name, spec string, fn Job) error {
+	_, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("scheduler: job %s: invalid schedule %q: %w", name, spec, err)
+	}
+
+	s.jobs = append(s.jobs, job{name: name, spec: spec, fn: fn})
+
+	return nil
+}
+
+// Run выполняет задачи по расписанию, пока не будет отменён контекст,
+// и дожидается завершения уже запущенных задач.
+func (s *Scheduler) Run(ctx context.Context) {
+	const op = "scheduler.Run"
+
+	lgr := s.lgr.With(slog.String("op", op))
+
+	for _, j := range s.jobs {
+		_, err := s.cron.AddFunc(j.spec, s.wrap(ctx, j))
+		if err != nil {
+			lgr.ErrorContext(ctx, "failed to schedule job", slog.String("job", j.name), slog.Any("error", err))
+			continue
+		}
+
+		lgr.InfoContext(ctx, "job scheduled", slog.String("job", j.name), slog.String("spec", j.spec))
+	}
+
+	s.cron.Start()
+
+	<-ctx.Done()
+
+	<-s.cron.Stop().Done()
+
+	if s.leader != nil {
+		s.leader.Resign(context.WithoutCancel(ctx))
+	}
+
+	lgr.InfoContext(ctx, "scheduler stopped")
+}
+
+func (s *Scheduler) wrap(ctx context.Context, j job) func() {
+	lgr := s.lgr.With(slog.String("job", j.name))
+
+	return func() {
+		if s.leader != nil {
+			leader, err := s.leader.IsLeader(ctx)
+			if err != nil {
+				lgr.ErrorContext(ctx, "failed to check leadership, job skipped", slog.Any("error", err))
+				return
+			}
+			if !leader {
+				lgr.DebugContext(ctx, "not the leader, job skipped")
+				return
+			}
+		}
+
+		start := time.Now()
+
+		err := j.fn(ctx)
+		if err != nil {
+			lgr.ErrorContext(ctx, "job failed", slog.Any("error", err))
+			return
+		}
+
+		lgr.DebugContext(ctx, "job finished", slog.Duration("duration", time.Since(start)))
+	}
+}
@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Add(t *testing.T) {
+	s := New(slog.New(slog.DiscardHandler), time.UTC)
+
+	noop := func(context.Context) error { return nil }
+
+	require.NoError(t, s.Add("daily", "0 9 * * 1-5", noop))
+	require.NoError(t, s.Add("descriptor", "@weekly", noop))
+	require.Error(t, s.Add("invalid", "every monday", noop))
+	require.Error(t, s.Add("seconds", "0 0 9 * * 1", noop))
+}
+
+func TestScheduler_Run(t *testing.T) {
+	s := New(slog.New(slog.DiscardHandler), time.UTC)
+
+	ran := make(chan struct{}, 1)
+	err := s.Add("every-second", "@every 1s", func(context.Context) error {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-ran:
+	case <-time.After(3 * time.Second):
+		t.Fatal("job did not run")
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop")
+	}
+}
+
+type fakeLeader struct {
+	leader bool
+	err    error
+}
+
+func (l *fakeLeader) IsLeader(context.Context) (bool, error) {
+	return l.leader, l.err
+}
+
+func (l *fakeLeader) Resign(context.Context) {}
+
+func TestScheduler_Leader(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantRun bool
+	}{
+		{
+			name:    "no leader election",
+			wantRun: true,
+		},
+		{
+			name:    "leader",
+			opts:    []Option{WithLeader(&fakeLeader{leader: true})},
+			wantRun: true,
+		},
+		{
+			name: "not the leader",
+			opts: []Option{WithLeader(&fakeLeader{leader: false})},
+		},
+		{
+			name: "leadership check failed",
+			opts: []Option{WithLeader(&fakeLeader{err: errors.New("connection refused")})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(slog.New(slog.DiscardHandler), time.UTC, tt.opts...)
+
+			ran := false
+			s.wrap(context.Background(), job{name: "reminders.daily", fn: func(context.Context) error {
+				ran = true
+
+				return nil
+			}})()
+
+			assert.Equal(t, tt.wantRun, ran)
+		})
+	}
+}
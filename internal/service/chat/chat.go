@@ -0,0 +1,284 @@
+// Package chat выполняет slash-команды чат-ботов, совместимых со Slack и Mattermost.
+//
+// Поддерживаемые команды:
+//
+//	/review mine                       — открытые Pull Request'ы, ожидающие ревью пользователя;
+//	/review reassign <pr> [<user>]     — передать своё ревью Pull Request'а другому ревьюверу;
+//	/review merge <pr>                 — пометить свой Pull Request как merged;
+//	/review away until <YYYY-MM-DD>    — не назначать пользователя ревьювером до указанной даты;
+//	/review away off                   — снять отметку об отсутствии.
+//
+// Pull Request указывается как "<репозиторий>/<id>" или просто "<id>" для репозитория по умолчанию.
+// Пользователь указывается идентификатором сервиса или упоминанием чата вида <@U123|name>.
+//
+// Команды выполняются с правами участника, поэтому меняют только то, что принадлежит
+// автору команды: переназначить можно только своё ревью, смерджить — только свой Pull Request.
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/tracing"
+)
+
+const dateLayout = "2006-01-02"
+
+type UserService interface {
+	GetByChatID(ctx context.Context, chatUserID string) (*domain.User, error)
+	SetAwayUntil(ctx context.Context, userID string, until *time.Time) (*domain.User, error)
+	GetReview(ctx context.Context, userID string) ([]domain.PullRequest, error)
+}
+
+type PullRequestService interface {
+	GetPullRequest(ctx context.Context, repository, prID string) (*domain.PullRequest, error)
+	SetMerged(ctx context.Context, repository, prID string) (*domain.PullRequest, error)
+	ReassignReviewer(ctx context.Context, repository, prID, oldReviewerID string) (*domain.PullRequest, string, error)
+}
+
+// Reply — ответ на команду. InChannel означает, что ответ виден всему каналу,
+// иначе его видит только автор команды.
+type Reply struct {
+	Text      string
+	InChannel bool
+}
+
+type Service struct {
+	lgr *slog.Logger
+
+	userSvc UserService
+	prSvc   PullRequestService
+
+	loc *time.Location // часовой пояс, в котором разбираются даты команды away
+}
+
+func New(lgr *slog.Logger, userSvc UserService, prSvc PullRequestService, loc *time.Location) *Service {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return &Service{
+		lgr:     lgr,
+		userSvc: userSvc,
+		prSvc:   prSvc,
+		loc:     loc,
+	}
+}
+
+// Execute выполняет команду text от имени пользователя чата chatUserID.
+// Ошибки, понятные пользователю (неизвестная команда, Pull Request не найден и т.п.),
+// возвращаются текстом ответа; ошибка возвращается только при непредвиденном сбое.
+func (s *Service) Execute(ctx context.Context, chatUserID, text string) (Reply, error) {
+	const op = "chat.Execute"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("chatUserID", chatUserID),
+		slog.String("text", text),
+	)
+
+	args := strings.Fields(text)
+	if len(args) == 0 || args[0] == "help" {
+		return Reply{Text: usage()}, nil
+	}
+
+	user, err := s.userSvc.GetByChatID(ctx, chatUserID)
+	if errors.Is(err, svcErr.ErrChatUserNotLinked) {
+		return Reply{Text: "Your chat account is not linked to a reviewer. Ask an admin to link it."}, nil
+	}
+	if err != nil {
+		return Reply{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var reply Reply
+	switch {
+	case args[0] == "mine" && len(args) == 1:
+		reply, err = s.mine(ctx, user)
+	case args[0] == "reassign" && len(args) == 2:
+		reply, err = s.reassign(ctx, user, args[1], user.ID)
+	case args[0] == "reassign" && len(args) == 3:
+		reply, err = s.reassign(ctx, user, args[1], args[2])
+	case args[0] == "merge" && len(args) == 2:
+		reply, err = s.merge(ctx, user, args[1])
+	case args[0] == "away" && len(args) == 3 && args[1] == "until":
+		reply, err = s.awayUntil(ctx, user, args[2])
+	case args[0] == "away" && len(args) == 2 && args[1] == "off":
+		reply, err = s.awayOff(ctx, user)
+	default:
+		return Reply{Text: "Unknown command.\n" + usage()}, nil
+	}
+
+	if text, ok := errorText(err); ok {
+		lgr.DebugContext(ctx, "command rejected", slog.Any("error", err))
+
+		return Reply{Text: text}, nil
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to execute command", slog.Any("error", err))
+
+		return Reply{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reply, nil
+}
+
+func (s *Service) mine(ctx context.Context, user *domain.User) (Reply, error) {
+	pullRequests, err := s.userSvc.GetReview(ctx, user.ID)
+	if err != nil {
+		return Reply{}, err
+	}
+
+	var b strings.Builder
+	for _, pr := range pullRequests {
+		if pr.Status != domain.PRStatusOpen {
+			continue
+		}
+		fmt.Fprintf(&b, "• `%s` %s (by %s)\n", prRef(pr), pr.Name, pr.AuthorID)
+	}
+
+	if b.Len() == 0 {
+		return Reply{Text: "No pull requests are waiting for your review."}, nil
+	}
+
+	return Reply{Text: "*Waiting for your review:*\n" + b.String()}, nil
+}
+
+func (s *Service) reassign(ctx context.Context, user *domain.User, ref, userRef string) (Reply, error) {
+	repository, prID := parsePRRef(ref)
+
+	oldReviewerID, err := s.resolveUser(ctx, userRef)
+	if err != nil {
+		return Reply{}, err
+	}
+	if oldReviewerID != user.ID {
+		return Reply{Text: "You can only hand over your own reviews."}, nil
+	}
+
+	pr, newReviewerID, err := s.prSvc.ReassignReviewer(ctx, repository, prID, oldReviewerID)
+	if err != nil {
+		return Reply{}, err
+	}
+
+	return Reply{
+		Text:      fmt.Sprintf("Reviewer `%s` on `%s` was replaced by `%s`.", oldReviewerID, prRef(*pr), newReviewerID),
+		InChannel: true,
+	}, nil
+}
+
+func (s *Service) merge(ctx context.Context, user *domain.User, ref string) (Reply, error) {
+	repository, prID := parsePRRef(ref)
+
+	pr, err := s.prSvc.GetPullRequest(ctx, repository, prID)
+	if err != nil {
+		return Reply{}, err
+	}
+	if pr.AuthorID != user.ID {
+		return Reply{Text: "Only the author can merge this pull request."}, nil
+	}
+
+	pr, err = s.prSvc.SetMerged(ctx, repository, prID)
+	if err != nil {
+		return Reply{}, err
+	}
+
+	return Reply{Text: fmt.Sprintf("`%s` %s is merged.", prRef(*pr), pr.Name), InChannel: true}, nil
+}
+
+func (s *Service) awayUntil(ctx context.Context, user *domain.User, date string) (Reply, error) {
+	until, err := time.ParseInLocation(dateLayout, date, s.loc)
+	if err != nil {
+		return Reply{Text: "Invalid date, expected YYYY-MM-DD."}, nil
+	}
+
+	_, err = s.userSvc.SetAwayUntil(ctx, user.ID, &until)
+	if err != nil {
+		return Reply{}, err
+	}
+
+	return Reply{Text: fmt.Sprintf("You will not be assigned as a reviewer until %s.", date)}, nil
+}
+
+func (s *Service) awayOff(ctx context.Context, user *domain.User) (Reply, error) {
+	_, err := s.userSvc.SetAwayUntil(ctx, user.ID, nil)
+	if err != nil {
+		return Reply{}, err
+	}
+
+	return Reply{Text: "Welcome back! You can be assigned as a reviewer again."}, nil
+}
+
+// resolveUser возвращает идентификатор пользователя сервиса по упоминанию чата
+// или по идентификатору сервиса, если упоминания нет.
+func (s *Service) resolveUser(ctx context.Context, ref string) (string, error) {
+	if !strings.HasPrefix(ref, "<@") || !strings.HasSuffix(ref, ">") {
+		return ref, nil
+	}
+
+	chatUserID := strings.TrimSuffix(strings.TrimPrefix(ref, "<@"), ">")
+	chatUserID, _, _ = strings.Cut(chatUserID, "|")
+
+	user, err := s.userSvc.GetByChatID(ctx, chatUserID)
+	if err != nil {
+		return "", err
+	}
+
+	return user.ID, nil
+}
+
+// parsePRRef разбирает ссылку на Pull Request вида "<репозиторий>/<id>" или "<id>".
+// Пустое имя репозитория означает репозиторий по умолчанию.
+func parsePRRef(ref string) (string, string) {
+	repository, prID, found := strings.Cut(ref, "/")
+	if !found {
+		return "", ref
+	}
+
+	return repository, prID
+}
+
+func prRef(pr domain.PullRequest) string {
+	if pr.Repository == "" || pr.Repository == domain.DefaultRepositoryName {
+		return pr.ID
+	}
+
+	return pr.Repository + "/" + pr.ID
+}
+
+func errorText(err error) (string, bool) {
+	switch {
+	case errors.Is(err, svcErr.ErrPRNotFound):
+		return "Pull request not found.", true
+	case errors.Is(err, svcErr.ErrRepositoryNotFound):
+		return "Repository not found.", true
+	case errors.Is(err, svcErr.ErrPRAlreadyMerged):
+		return "Pull request is already merged.", true
+	case errors.Is(err, svcErr.ErrReviewerNotAssigned):
+		return "User is not assigned as a reviewer of this pull request.", true
+	case errors.Is(err, svcErr.ErrPRNoCandidates):
+		return "No candidates are available to take over the review.", true
+	case errors.Is(err, svcErr.ErrChatUserNotLinked):
+		return "Mentioned chat user is not linked to a reviewer.", true
+	case errors.Is(err, svcErr.ErrUserNotFound):
+		return "User not found.", true
+	default:
+		return "", false
+	}
+}
+
+func usage() string {
+	return "Usage:\n" +
+		"• `/review mine` — pull requests waiting for your review\n" +
+		"• `/review reassign <pr>` — hand over your review to another reviewer\n" +
+		"• `/review merge <pr>` — mark your pull request as merged\n" +
+		"• `/review away until <YYYY-MM-DD>` — pause review assignments\n" +
+		"• `/review away off` — resume review assignments"
+}
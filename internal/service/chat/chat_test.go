@@ -0,0 +1,209 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	chatmocks "avitotech-pr-reviewer/internal/service/chat/mocks"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+func TestService_Execute(t *testing.T) {
+	alice := &domain.User{ID: "u1", Username: "Alice", ChatUserID: "UALICE"}
+	until := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		text          string
+		setupMocks    func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService)
+		expectedReply Reply
+		expectedError error
+	}{
+		{
+			name:          "success - empty text shows usage",
+			text:          "  ",
+			setupMocks:    func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {},
+			expectedReply: Reply{Text: usage()},
+		},
+		{
+			name: "success - chat user not linked",
+			text: "mine",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return((*domain.User)(nil), svcErr.ErrChatUserNotLinked)
+			},
+			expectedReply: Reply{Text: "Your chat account is not linked to a reviewer. Ask an admin to link it."},
+		},
+		{
+			name: "success - unknown command",
+			text: "approve pr-1",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+			},
+			expectedReply: Reply{Text: "Unknown command.\n" + usage()},
+		},
+		{
+			name: "success - mine lists open pull requests",
+			text: "mine",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				u.On("GetReview", mock.Anything, "u1").Return([]domain.PullRequest{
+					{Repository: domain.DefaultRepositoryName, ID: "pr-1", Name: "Add search", AuthorID: "u2",
+						Status: domain.PRStatusOpen},
+					{Repository: "backend", ID: "pr-2", Name: "Fix login", AuthorID: "u3", Status: domain.PRStatusOpen},
+					{Repository: "backend", ID: "pr-3", Name: "Old", AuthorID: "u3", Status: domain.PRStatusMerged},
+				}, nil)
+			},
+			expectedReply: Reply{
+				Text: "*Waiting for your review:*\n" +
+					"• `pr-1` Add search (by u2)\n" +
+					"• `backend/pr-2` Fix login (by u3)\n",
+			},
+		},
+		{
+			name: "success - mine without pull requests",
+			text: "mine",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				u.On("GetReview", mock.Anything, "u1").Return([]domain.PullRequest{}, nil)
+			},
+			expectedReply: Reply{Text: "No pull requests are waiting for your review."},
+		},
+		{
+			name: "success - reassign own review",
+			text: "reassign backend/pr-2",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				p.On("ReassignReviewer", mock.Anything, "backend", "pr-2", "u1").
+					Return(&domain.PullRequest{Repository: "backend", ID: "pr-2"}, "u3", nil)
+			},
+			expectedReply: Reply{Text: "Reviewer `u1` on `backend/pr-2` was replaced by `u3`.", InChannel: true},
+		},
+		{
+			name: "success - reassign own review by chat mention",
+			text: "reassign backend/pr-2 <@UALICE|alice>",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				p.On("ReassignReviewer", mock.Anything, "backend", "pr-2", "u1").
+					Return(&domain.PullRequest{Repository: "backend", ID: "pr-2"}, "u3", nil)
+			},
+			expectedReply: Reply{Text: "Reviewer `u1` on `backend/pr-2` was replaced by `u3`.", InChannel: true},
+		},
+		{
+			name: "success - reassign of another reviewer is rejected",
+			text: "reassign backend/pr-2 <@UBOB|bob>",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				u.On("GetByChatID", mock.Anything, "UBOB").Return(&domain.User{ID: "u2"}, nil)
+			},
+			expectedReply: Reply{Text: "You can only hand over your own reviews."},
+		},
+		{
+			name: "success - reassign without candidates",
+			text: "reassign pr-1 u1",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				p.On("ReassignReviewer", mock.Anything, "", "pr-1", "u1").
+					Return((*domain.PullRequest)(nil), "", svcErr.ErrPRNoCandidates)
+			},
+			expectedReply: Reply{Text: "No candidates are available to take over the review."},
+		},
+		{
+			name: "success - merge in default repository",
+			text: "merge pr-1",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				p.On("GetPullRequest", mock.Anything, "", "pr-1").
+					Return(&domain.PullRequest{ID: "pr-1", AuthorID: "u1"}, nil)
+				p.On("SetMerged", mock.Anything, "", "pr-1").Return(&domain.PullRequest{
+					Repository: domain.DefaultRepositoryName, ID: "pr-1", Name: "Add search",
+				}, nil)
+			},
+			expectedReply: Reply{Text: "`pr-1` Add search is merged.", InChannel: true},
+		},
+		{
+			name: "success - merge of another author's pull request is rejected",
+			text: "merge backend/pr-2",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				p.On("GetPullRequest", mock.Anything, "backend", "pr-2").
+					Return(&domain.PullRequest{Repository: "backend", ID: "pr-2", AuthorID: "u2"}, nil)
+			},
+			expectedReply: Reply{Text: "Only the author can merge this pull request."},
+		},
+		{
+			name: "success - merge unknown pull request",
+			text: "merge pr-404",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				p.On("GetPullRequest", mock.Anything, "", "pr-404").Return((*domain.PullRequest)(nil), svcErr.ErrPRNotFound)
+			},
+			expectedReply: Reply{Text: "Pull request not found."},
+		},
+		{
+			name: "success - away until date",
+			text: "away until 2025-01-10",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				u.On("SetAwayUntil", mock.Anything, "u1", &until).Return(alice, nil)
+			},
+			expectedReply: Reply{Text: "You will not be assigned as a reviewer until 2025-01-10."},
+		},
+		{
+			name: "success - away with invalid date",
+			text: "away until tomorrow",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+			},
+			expectedReply: Reply{Text: "Invalid date, expected YYYY-MM-DD."},
+		},
+		{
+			name: "success - away off",
+			text: "away off",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				u.On("SetAwayUntil", mock.Anything, "u1", (*time.Time)(nil)).Return(alice, nil)
+			},
+			expectedReply: Reply{Text: "Welcome back! You can be assigned as a reviewer again."},
+		},
+		{
+			name: "error - unexpected from service",
+			text: "merge pr-1",
+			setupMocks: func(u *chatmocks.MockUserService, p *chatmocks.MockPullRequestService) {
+				u.On("GetByChatID", mock.Anything, "UALICE").Return(alice, nil)
+				p.On("GetPullRequest", mock.Anything, "", "pr-1").
+					Return(&domain.PullRequest{ID: "pr-1", AuthorID: "u1"}, nil)
+				p.On("SetMerged", mock.Anything, "", "pr-1").Return((*domain.PullRequest)(nil), errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			us := chatmocks.NewMockUserService(t)
+			ps := chatmocks.NewMockPullRequestService(t)
+			tt.setupMocks(us, ps)
+
+			svc := New(slog.New(slog.DiscardHandler), us, ps, time.UTC)
+
+			got, err := svc.Execute(context.Background(), "UALICE", tt.text)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedReply, got)
+			}
+		})
+	}
+}
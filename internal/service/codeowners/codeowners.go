@@ -0,0 +1,198 @@
+package codeowners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tracing"
+	codeownersPkg "avitotech-pr-reviewer/pkg/codeowners"
+)
+
+type CodeOwnersRepository interface {
+	Replace(ctx context.Context, repositoryID string, rules []domain.CodeOwnersRule) error
+	ListByRepository(ctx context.Context, repositoryID string) ([]domain.CodeOwnersRule, error)
+}
+
+type RepoRepository interface {
+	GetByName(ctx context.Context, name string) (*domain.Repository, error)
+}
+
+type TeamRepository interface {
+	GetByName(ctx context.Context, teamName string) (*domain.Team, error)
+}
+
+type UserRepository interface {
+	GetByID(ctx context.Context, userID string) (*domain.User, error)
+}
+
+type Service struct {
+	lgr *slog.Logger
+
+	codeOwnersRepo CodeOwnersRepository
+	repoRepo       RepoRepository
+	teamRepo       TeamRepository
+	userRepo       UserRepository
+}
+
+func New(
+	lgr *slog.Logger,
+	codeOwnersRepo CodeOwnersRepository,
+	repoRepo RepoRepository,
+	teamRepo TeamRepository,
+	userRepo UserRepository,
+) *Service {
+	return &Service{
+		lgr:            lgr,
+		codeOwnersRepo: codeOwnersRepo,
+		repoRepo:       repoRepo,
+		teamRepo:       teamRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// Upload разбирает содержимое CODEOWNERS и заменяет им правила репозитория.
+// Владельцы вида "@user_id" ссылаются на пользователей, "@org/team_name" — на команды.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
+// Если содержимое не удалось разобрать, возвращается ошибка svcErr.ErrInvalidCodeOwners.
+// Если владелец не найден, возвращается svcErr.ErrUserNotFound или svcErr.ErrTeamNotFound.
+func (s *Service) Upload(ctx context.Context, repository, content string) ([]domain.CodeOwnersRule, error) {
+	const op = "codeowners.Upload"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("repository", repository),
+	)
+
+	repo, err := s.repository(ctx, repository)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	parsed, err := codeownersPkg.Parse(content)
+	if err != nil {
+		lgr.DebugContext(ctx, "failed to parse codeowners", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%w: %s", svcErr.ErrInvalidCodeOwners, err.Error())
+	}
+
+	rules, err := s.resolveOwners(ctx, parsed)
+	if err != nil {
+		lgr.DebugContext(ctx, "failed to resolve codeowners", slog.Any("error", err))
+
+		return nil, err
+	}
+
+	err = s.codeOwnersRepo.Replace(ctx, repo.ID, rules)
+	if errors.Is(err, repoErr.ErrRepositoryNotFound) {
+		return nil, svcErr.ErrRepositoryNotFound
+	}
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		return nil, svcErr.ErrUserNotFound
+	}
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to replace codeowners rules", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.InfoContext(ctx, "codeowners uploaded", slog.Int("rulesCount", len(rules)))
+
+	return rules, nil
+}
+
+// Rules возвращает правила CODEOWNERS репозитория.
+// Если правила не загружены, возвращается пустой слайс.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
+func (s *Service) Rules(ctx context.Context, repository string) ([]domain.CodeOwnersRule, error) {
+	const op = "codeowners.Rules"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("repository", repository),
+	)
+
+	repo, err := s.repository(ctx, repository)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rules, err := s.codeOwnersRepo.ListByRepository(ctx, repo.ID)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to list codeowners rules", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rules, nil
+}
+
+func (s *Service) repository(ctx context.Context, name string) (*domain.Repository, error) {
+	repo, err := s.repoRepo.GetByName(ctx, name)
+	if errors.Is(err, repoErr.ErrRepositoryNotFound) {
+		return nil, svcErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+func (s *Service) resolveOwners(ctx context.Context, parsed []codeownersPkg.Rule) ([]domain.CodeOwnersRule, error) {
+	teams := make(map[string]domain.Team)
+
+	rules := make([]domain.CodeOwnersRule, 0, len(parsed))
+	for _, p := range parsed {
+		rule := domain.CodeOwnersRule{Pattern: p.Pattern}
+
+		for _, owner := range p.Owners {
+			if owner.Kind == codeownersPkg.OwnerUser {
+				_, err := s.userRepo.GetByID(ctx, owner.Name)
+				if errors.Is(err, repoErr.ErrUserNotFound) {
+					return nil, fmt.Errorf("%w: %s", svcErr.ErrUserNotFound, owner.Name)
+				}
+				if err != nil {
+					return nil, err
+				}
+
+				rule.UserIDs = append(rule.UserIDs, owner.Name)
+
+				continue
+			}
+
+			team, ok := teams[owner.Name]
+			if !ok {
+				found, err := s.teamRepo.GetByName(ctx, owner.Name)
+				if errors.Is(err, repoErr.ErrTeamNotFound) {
+					return nil, fmt.Errorf("%w: %s", svcErr.ErrTeamNotFound, owner.Name)
+				}
+				if err != nil {
+					return nil, err
+				}
+
+				team = domain.Team{ID: found.ID, Name: found.Name}
+				teams[owner.Name] = team
+			}
+
+			rule.Teams = append(rule.Teams, team)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
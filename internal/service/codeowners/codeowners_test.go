@@ -0,0 +1,169 @@
+package codeowners
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/service/codeowners/mocks"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+var ErrUnexpected = errors.New("unexpected error")
+
+func TestService_Upload(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		content    string
+		setupMock  func(
+			cm *mocks.MockCodeOwnersRepository,
+			rm *mocks.MockRepoRepository,
+			tm *mocks.MockTeamRepository,
+			um *mocks.MockUserRepository,
+		)
+		expectedRules []domain.CodeOwnersRule
+		expectedError error
+	}{
+		{
+			name:       "success - users and teams resolved",
+			repository: "backend",
+			content:    "* @u1\n/docs/ @acme/platform @u2\n*.go @acme/platform",
+			setupMock: func(
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+				tm *mocks.MockTeamRepository,
+				um *mocks.MockUserRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "backend").Return(&domain.Repository{ID: "repo-1", Name: "backend"}, nil)
+				um.On("GetByID", mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
+				um.On("GetByID", mock.Anything, "u2").Return(&domain.User{ID: "u2"}, nil)
+
+				tm.On("GetByName", mock.Anything, "platform").
+					Return(&domain.Team{ID: "team-1", Name: "platform"}, nil).Once()
+
+				cm.On("Replace", mock.Anything, "repo-1", []domain.CodeOwnersRule{
+					{Pattern: "*", UserIDs: []string{"u1"}},
+					{Pattern: "/docs/", UserIDs: []string{"u2"}, Teams: []domain.Team{{ID: "team-1", Name: "platform"}}},
+					{Pattern: "*.go", Teams: []domain.Team{{ID: "team-1", Name: "platform"}}},
+				}).Return(nil)
+			},
+			expectedRules: []domain.CodeOwnersRule{
+				{Pattern: "*", UserIDs: []string{"u1"}},
+				{Pattern: "/docs/", UserIDs: []string{"u2"}, Teams: []domain.Team{{ID: "team-1", Name: "platform"}}},
+				{Pattern: "*.go", Teams: []domain.Team{{ID: "team-1", Name: "platform"}}},
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "error - invalid content",
+			repository: "backend",
+			content:    "*.go u1",
+			setupMock: func(
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+				tm *mocks.MockTeamRepository,
+				um *mocks.MockUserRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "backend").Return(&domain.Repository{ID: "repo-1", Name: "backend"}, nil)
+			},
+			expectedRules: nil,
+			expectedError: svcErr.ErrInvalidCodeOwners,
+		},
+		{
+			name:       "error - user owner not found",
+			repository: "backend",
+			content:    "* @ghost",
+			setupMock: func(
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+				tm *mocks.MockTeamRepository,
+				um *mocks.MockUserRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "backend").Return(&domain.Repository{ID: "repo-1", Name: "backend"}, nil)
+				um.On("GetByID", mock.Anything, "ghost").Return(nil, repoErr.ErrUserNotFound)
+			},
+			expectedRules: nil,
+			expectedError: svcErr.ErrUserNotFound,
+		},
+		{
+			name:       "error - team owner not found",
+			repository: "backend",
+			content:    "* @acme/ghosts",
+			setupMock: func(
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+				tm *mocks.MockTeamRepository,
+				um *mocks.MockUserRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "backend").Return(&domain.Repository{ID: "repo-1", Name: "backend"}, nil)
+				tm.On("GetByName", mock.Anything, "ghosts").Return(nil, repoErr.ErrTeamNotFound)
+			},
+			expectedRules: nil,
+			expectedError: svcErr.ErrTeamNotFound,
+		},
+		{
+			name:       "error - repository failure",
+			repository: "backend",
+			content:    "* @u1",
+			setupMock: func(
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+				tm *mocks.MockTeamRepository,
+				um *mocks.MockUserRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "backend").Return(&domain.Repository{ID: "repo-1", Name: "backend"}, nil)
+				um.On("GetByID", mock.Anything, "u1").Return(&domain.User{ID: "u1"}, nil)
+
+				cm.On("Replace", mock.Anything, "repo-1", mock.Anything).Return(ErrUnexpected)
+			},
+			expectedRules: nil,
+			expectedError: ErrUnexpected,
+		},
+		{
+			name:       "error - repository not found",
+			repository: "ghost",
+			content:    "* @u1",
+			setupMock: func(
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+				tm *mocks.MockTeamRepository,
+				um *mocks.MockUserRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "ghost").Return(nil, repoErr.ErrRepositoryNotFound)
+			},
+			expectedRules: nil,
+			expectedError: svcErr.ErrRepositoryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCodeOwnersRepo := mocks.NewMockCodeOwnersRepository(t)
+			mockRepoRepo := mocks.NewMockRepoRepository(t)
+			mockTeamRepo := mocks.NewMockTeamRepository(t)
+			mockUserRepo := mocks.NewMockUserRepository(t)
+
+			tt.setupMock(mockCodeOwnersRepo, mockRepoRepo, mockTeamRepo, mockUserRepo)
+
+			svc := New(slog.New(slog.DiscardHandler), mockCodeOwnersRepo, mockRepoRepo, mockTeamRepo, mockUserRepo)
+
+			rules, err := svc.Upload(context.Background(), tt.repository, tt.content)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, rules)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedRules, rules)
+			}
+		})
+	}
+}
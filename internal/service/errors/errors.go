@@ -3,13 +3,34 @@ package svcErr
 import "errors"
 
 var (
-	ErrTeamExists   = errors.New("team already exists")
-	ErrTeamNotFound = errors.New("team not found")
+	ErrTeamExists        = errors.New("team already exists")
+	ErrTeamNotFound      = errors.New("team not found")
+	ErrInvalidSLA        = errors.New("invalid team SLA")
+	ErrInvalidTeamImport = errors.New("invalid team import")
 
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound             = errors.New("user not found")
+	ErrInvalidReminderFrequency = errors.New("invalid reminder frequency")
+	ErrInvalidEmail             = errors.New("invalid email")
+	ErrChatUserLinked           = errors.New("chat user is already linked to another user")
+	ErrChatUserNotLinked        = errors.New("chat user is not linked to any user")
 
 	ErrPRExists        = errors.New("pull request already exists")
 	ErrPRNotFound      = errors.New("pull request not found")
 	ErrPRAlreadyMerged = errors.New("pull request is already merged")
 	ErrPRNoCandidates  = errors.New("no candidates available for reviewer reassignment")
+
+	ErrReviewerNotAssigned = errors.New("reviewer is not assigned to pull request")
+	ErrInvalidReviewState  = errors.New("invalid review state")
+
+	ErrInvalidCodeOwners = errors.New("invalid codeowners file")
+
+	ErrRepositoryExists   = errors.New("repository already exists")
+	ErrRepositoryNotFound = errors.New("repository not found")
+
+	ErrOrganizationExists = errors.New("organization already exists")
+	ErrInvalidToken       = errors.New("invalid token")
+	ErrTokenRequired      = errors.New("token is required")
+	ErrInvalidRole        = errors.New("invalid role")
+
+	ErrInvalidStatsFilter = errors.New("invalid stats filter")
 )
@@ -0,0 +1,299 @@
+// Package eventlog ведёт журнал событий предметной области и раздаёт его потоками.
+// Событие сохраняется в журнале синхронно при публикации, в транзакции издателя, если она есть,
+// и только потом передаётся шине. Потоки читают журнал, а не шину: новые записи они
+// получают по ID, поэтому клиент, переподключившийся с ID последнего полученного события,
+// догоняет пропущенное без потерь и повторов, а события других реплик появляются
+// в потоке не позже чем через pollInterval.
+package eventlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	"avitotech-pr-reviewer/internal/tracing"
+)
+
+type EventRepository interface {
+	Append(ctx context.Context, record domain.EventRecord) (*domain.EventRecord, error)
+	ListAfter(ctx context.Context, afterID int64, limit int) ([]domain.EventRecord, error)
+	LastID(ctx context.Context) (int64, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type TeamRepository interface {
+	GetByName(ctx context.Context, teamName string) (*domain.Team, error)
+}
+
+type UserRepository interface {
+	ListByTeamID(ctx context.Context, teamID string) ([]domain.Member, error)
+}
+
+type OrgRepository interface {
+	ListIDs(ctx context.Context) ([]string, error)
+}
+
+// EventPublisher рассылает сохранённые события подписчикам внутри процесса.
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event)
+}
+
+// TxManager откладывает действия до фиксации транзакции издателя.
+type TxManager interface {
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
+}
+
+const (
+	// pageSize — размер страницы при чтении журнала потоком.
+	pageSize = 500
+	// defaultPollInterval — как часто поток перечитывает журнал, если в процессе не было
+	// новых записей; за это время до клиента доходят события, записанные другими репликами.
+	defaultPollInterval = time.Second
+)
+
+type Service struct {
+	lgr *slog.Logger
+
+	eventRepo EventRepository
+	teamRepo  TeamRepository
+	userRepo  UserRepository
+	orgRepo   OrgRepository
+	publisher EventPublisher
+	txManager TxManager
+
+	retention    time.Duration
+	pollInterval time.Duration
+	now          func() time.Time
+
+	mu sync.Mutex
+	// appended закрывается и заменяется новым после каждой записи в журнал,
+	// чтобы потоки процесса перечитали журнал, не дожидаясь pollInterval.
+	appended chan struct{}
+}
+
+func New(
+	lgr *slog.Logger,
+	eventRepo EventRepository,
+	teamRepo TeamRepository,
+	userRepo UserRepository,
+	orgRepo OrgRepository,
+	publisher EventPublisher,
+	txManager TxManager,
+	retention time.Duration,
+) *Service {
+	return &Service{
+		lgr:          lgr,
+		eventRepo:    eventRepo,
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		orgRepo:      orgRepo,
+		publisher:    publisher,
+		txManager:    txManager,
+		retention:    retention,
+		pollInterval: defaultPollInterval,
+		now:          time.Now,
+		appended:     make(chan struct{}),
+	}
+}
+
+// Publish сохраняет событие в журнале и передаёт его шине. Событие записывается в контексте
+// издателя: если в нём открыта транзакция, запись фиксируется или откатывается вместе с ней,
+// а шина и потоки процесса получают событие только после фиксации и только один раз.
+// Ошибка записи только логируется, чтобы не отменять уже выполненное действие.
+func (s *Service) Publish(ctx context.Context, event domain.Event) {
+	err := s.Record(ctx, event)
+	if err != nil {
+		s.lgr.ErrorContext(ctx, "failed to record event",
+			slog.String("type", string(event.Type)),
+			slog.String("orgID", event.OrgID),
+			slog.Any("error", err),
+		)
+	}
+
+	s.txManager.AfterCommit(ctx, func(ctx context.Context) {
+		s.wake()
+		s.publisher.Publish(ctx, event)
+	})
+}
+
+// Record сохраняет событие в журнале его организации.
+func (s *Service) Record(ctx context.Context, event domain.Event) error {
+	const op = "eventlog.Record"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	record, err := toRecord(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	orgCtx := tenant.WithPrincipal(ctx, domain.Principal{OrgID: event.OrgID, Role: domain.RoleAdmin})
+
+	_, err = s.eventRepo.Append(orgCtx, record)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Subscribe открывает поток событий организации субъекта запроса, подходящих под фильтр.
+// Если lastEventID больше нуля, поток начинается с событий журнала, записанных после него,
+// иначе — с событий, записанных после открытия потока. Медленный клиент отстаёт,
+// но не теряет события: поток читает журнал по мере того, как клиент их забирает.
+// Поток закрывается при отмене контекста, вызове Close или ошибке чтения журнала;
+// в последнем случае клиенту следует переподключиться с ID последнего полученного события.
+// Если команда из фильтра не найдена, возвращается svcErr.ErrTeamNotFound.
+func (s *Service) Subscribe(ctx context.Context, filter domain.EventFilter, lastEventID int64) (*Stream, error) {
+	const op = "eventlog.Subscribe"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("teamName", filter.TeamName),
+		slog.String("userID", filter.UserID),
+		slog.Int64("lastEventID", lastEventID),
+	)
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members, err := s.teamMembers(ctx, filter.TeamName)
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "team not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get team members", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	afterID := lastEventID
+	if afterID <= 0 {
+		afterID, err = s.eventRepo.LastID(ctx)
+		if err != nil {
+			lgr.ErrorContext(ctx, "failed to read event log", slog.Any("error", err))
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// Первая страница читается сразу, чтобы недоступность журнала вернулась клиенту ошибкой.
+	appended := s.awaitAppend()
+	records, err := s.eventRepo.ListAfter(ctx, afterID, pageSize)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to read event log", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stream := &Stream{
+		svc:     s,
+		lgr:     lgr,
+		orgID:   orgID,
+		filter:  filter,
+		members: members,
+		events:  make(chan domain.EventRecord),
+		stop:    make(chan struct{}),
+	}
+
+	go stream.forward(ctx, appended, records, afterID)
+
+	lgr.DebugContext(ctx, "event stream opened", slog.Int64("afterID", afterID))
+
+	return stream, nil
+}
+
+// Prune удаляет из журналов всех организаций события старше срока хранения.
+// Ошибка в одной организации не мешает очистке остальных.
+func (s *Service) Prune(ctx context.Context) error {
+	const op = "eventlog.Prune"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(slog.String("op", op))
+
+	orgIDs, err := s.orgRepo.ListIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	before := s.now().Add(-s.retention)
+
+	var errs []error
+	for _, orgID := range orgIDs {
+		orgCtx := tenant.WithPrincipal(ctx, domain.Principal{OrgID: orgID, Role: domain.RoleAdmin})
+
+		deleted, err := s.eventRepo.DeleteBefore(orgCtx, before)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("organization %s: %w", orgID, err))
+			continue
+		}
+
+		if deleted > 0 {
+			lgr.InfoContext(ctx, "old events pruned", slog.String("orgID", orgID), slog.Int64("deleted", deleted))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", op, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// teamMembers возвращает множество участников команды или nil, если команда не указана.
+func (s *Service) teamMembers(ctx context.Context, teamName string) (map[string]struct{}, error) {
+	if teamName == "" {
+		return nil, nil
+	}
+
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.userRepo.ListByTeamID(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		ids[m.ID] = struct{}{}
+	}
+
+	return ids, nil
+}
+
+// wake будит потоки, ожидающие новых записей журнала.
+func (s *Service) wake() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	close(s.appended)
+	s.appended = make(chan struct{})
+}
+
+// awaitAppend возвращает канал, который закроется после следующей записи в журнал.
+func (s *Service) awaitAppend() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.appended
+}
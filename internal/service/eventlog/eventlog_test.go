@@ -0,0 +1,414 @@
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/events"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/service/eventlog/mocks"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+const orgID = "org-1"
+
+var errUnexpected = errors.New("unexpected error")
+
+func orgContext() context.Context {
+	return tenant.WithPrincipal(context.Background(), domain.Principal{OrgID: orgID, Role: domain.RoleMember})
+}
+
+func newService(
+	t *testing.T,
+) (*Service, *mocks.MockEventRepository, *mocks.MockTeamRepository, *mocks.MockUserRepository) {
+	t.Helper()
+
+	er := mocks.NewMockEventRepository(t)
+	tr := mocks.NewMockTeamRepository(t)
+	ur := mocks.NewMockUserRepository(t)
+	svc := New(slog.New(slog.DiscardHandler), er, tr, ur, mocks.NewMockOrgRepository(t), nil, nil, time.Hour)
+
+	return svc, er, tr, ur
+}
+
+// newTxManager возвращает мок менеджера транзакций, который копит отложенные действия;
+// run выполняет их, как после фиксации транзакции.
+func newTxManager(t *testing.T) (tm *mocks.MockTxManager, run func()) {
+	t.Helper()
+
+	var hooks []func(ctx context.Context)
+
+	tm = mocks.NewMockTxManager(t)
+	tm.On("AfterCommit", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		hooks = append(hooks, args.Get(1).(func(ctx context.Context)))
+	})
+
+	return tm, func() {
+		for _, hook := range hooks {
+			hook(context.Background())
+		}
+	}
+}
+
+func record(id int64, prID string, userIDs ...string) domain.EventRecord {
+	return domain.EventRecord{
+		ID:            id,
+		OrgID:         orgID,
+		Type:          domain.EventReviewersAssigned,
+		Repository:    domain.DefaultRepositoryName,
+		PullRequestID: prID,
+		UserIDs:       userIDs,
+		Payload:       json.RawMessage(`{}`),
+	}
+}
+
+func receive(t *testing.T, stream *Stream) domain.EventRecord {
+	t.Helper()
+
+	select {
+	case rec, ok := <-stream.Events():
+		require.True(t, ok, "stream closed unexpectedly")
+		return rec
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return domain.EventRecord{}
+	}
+}
+
+func TestToRecord(t *testing.T) {
+	pr := domain.PullRequest{
+		Repository: "backend",
+		ID:         "pr-1",
+		Name:       "Add search",
+		AuthorID:   "u1",
+		Status:     domain.PRStatusOpen,
+		Reviewers:  []string{"u2", "u3"},
+		CreatedAt:  time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC),
+	}
+	prJSON := `{"repository":"backend","pull_request_id":"pr-1","pull_request_name":"Add search",` +
+		`"author_id":"u1","status":"OPEN","assigned_reviewers":["u2","u3"],"created_at":"2025-01-08T12:00:00Z"}`
+
+	tests := []struct {
+		name            string
+		event           domain.Event
+		expectedPR      string
+		expectedUsers   []string
+		expectedPayload string
+	}{
+		{
+			name: "reviewers assigned",
+			event: domain.Event{
+				Type: domain.EventReviewersAssigned,
+				Data: domain.ReviewersAssignedEvent{PullRequest: pr, ReviewerIDs: []string{"u2", "u3"}},
+			},
+			expectedPR:      "pr-1",
+			expectedUsers:   []string{"u1", "u2", "u3"},
+			expectedPayload: `{"pull_request":` + prJSON + `,"reviewer_ids":["u2","u3"]}`,
+		},
+		{
+			name: "reviewer reassigned includes old reviewer",
+			event: domain.Event{
+				Type: domain.EventReviewerReassigned,
+				Data: domain.ReviewerReassignedEvent{PullRequest: pr, OldReviewerID: "u4", NewReviewerID: "u3"},
+			},
+			expectedPR:      "pr-1",
+			expectedUsers:   []string{"u1", "u2", "u3", "u4"},
+			expectedPayload: `{"pull_request":` + prJSON + `,"old_reviewer_id":"u4","new_reviewer_id":"u3"}`,
+		},
+		{
+			name: "review overdue",
+			event: domain.Event{
+				Type: domain.EventReviewOverdue,
+				Data: domain.ReviewOverdueEvent{
+					Assignment: domain.ReviewAssignment{
+						Repository:    "backend",
+						PullRequestID: "pr-1",
+						AuthorID:      "u1",
+						ReviewerID:    "u2",
+						TeamName:      "backend",
+						AssignedAt:    time.Date(2025, 1, 7, 12, 0, 0, 0, time.UTC),
+					},
+					Action:      domain.SLAActionEscalate,
+					EscalatedTo: "lead",
+				},
+			},
+			expectedPR:    "pr-1",
+			expectedUsers: []string{"u1", "u2", "lead"},
+			expectedPayload: `{"repository":"backend","pull_request_id":"pr-1","pull_request_name":"",` +
+				`"author_id":"u1","reviewer_id":"u2","team_name":"backend","assigned_at":"2025-01-07T12:00:00Z",` +
+				`"action":"escalate","escalated_to":"lead"}`,
+		},
+		{
+			name:            "unknown data",
+			event:           domain.Event{Type: "custom", Data: 42},
+			expectedUsers:   nil,
+			expectedPayload: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.event.OrgID = orgID
+
+			got, err := toRecord(tt.event)
+
+			require.NoError(t, err)
+			assert.Equal(t, orgID, got.OrgID)
+			assert.Equal(t, tt.event.Type, got.Type)
+			assert.Equal(t, tt.expectedPR, got.PullRequestID)
+			assert.Equal(t, tt.expectedUsers, got.UserIDs)
+			assert.JSONEq(t, tt.expectedPayload, string(got.Payload))
+		})
+	}
+}
+
+func TestService_SubscribeReplaysAndFollows(t *testing.T) {
+	svc, er, _, _ := newService(t)
+	svc.pollInterval = 10 * time.Millisecond
+
+	er.On("ListAfter", mock.Anything, int64(5), pageSize).Return([]domain.EventRecord{
+		record(6, "pr-1", "u1"),
+		record(7, "pr-2", "u1"),
+	}, nil).Once()
+	otherPR := record(8, "pr-2", "u1")
+	er.On("ListAfter", mock.Anything, int64(7), pageSize).Return([]domain.EventRecord{
+		otherPR,
+		record(9, "pr-1", "u2"),
+	}, nil).Once()
+	er.On("ListAfter", mock.Anything, int64(9), pageSize).Return([]domain.EventRecord{}, nil).Maybe()
+
+	ctx, cancel := context.WithCancel(orgContext())
+	defer cancel()
+
+	stream, err := svc.Subscribe(ctx, domain.EventFilter{PullRequestID: "pr-1"}, 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(6), receive(t, stream).ID)
+	assert.Equal(t, int64(9), receive(t, stream).ID)
+
+	cancel()
+
+	_, ok := <-stream.Events()
+	assert.False(t, ok, "stream must be closed after context cancellation")
+}
+
+func TestService_SubscribeByTeamFromNow(t *testing.T) {
+	svc, er, tr, ur := newService(t)
+	svc.pollInterval = 10 * time.Millisecond
+
+	tr.On("GetByName", mock.Anything, "backend").Return(&domain.Team{ID: "t1", Name: "backend"}, nil)
+	ur.On("ListByTeamID", mock.Anything, "t1").Return([]domain.Member{{ID: "u2"}, {ID: "u3"}}, nil)
+	er.On("LastID", mock.Anything).Return(int64(4), nil)
+	er.On("ListAfter", mock.Anything, int64(4), pageSize).Return([]domain.EventRecord{}, nil).Once()
+	er.On("ListAfter", mock.Anything, int64(4), pageSize).Return([]domain.EventRecord{
+		record(5, "pr-1", "u1"),
+		record(6, "pr-2", "u1", "u3"),
+	}, nil).Once()
+	er.On("ListAfter", mock.Anything, int64(6), pageSize).Return([]domain.EventRecord{}, nil).Maybe()
+
+	stream, err := svc.Subscribe(orgContext(), domain.EventFilter{TeamName: "backend"}, 0)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	assert.Equal(t, int64(6), receive(t, stream).ID)
+}
+
+func TestService_SubscribeErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		filter        domain.EventFilter
+		lastEventID   int64
+		setupMocks    func(er *mocks.MockEventRepository, tr *mocks.MockTeamRepository)
+		expectedError error
+	}{
+		{
+			name:   "team not found",
+			filter: domain.EventFilter{TeamName: "nope"},
+			setupMocks: func(er *mocks.MockEventRepository, tr *mocks.MockTeamRepository) {
+				tr.On("GetByName", mock.Anything, "nope").Return((*domain.Team)(nil), repoErr.ErrTeamNotFound)
+			},
+			expectedError: svcErr.ErrTeamNotFound,
+		},
+		{
+			name: "last event id unavailable",
+			setupMocks: func(er *mocks.MockEventRepository, tr *mocks.MockTeamRepository) {
+				er.On("LastID", mock.Anything).Return(int64(0), errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+		{
+			name:        "event log unavailable",
+			lastEventID: 3,
+			setupMocks: func(er *mocks.MockEventRepository, tr *mocks.MockTeamRepository) {
+				er.On("ListAfter", mock.Anything, int64(3), pageSize).
+					Return(([]domain.EventRecord)(nil), errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, er, tr, _ := newService(t)
+			tt.setupMocks(er, tr)
+
+			stream, err := svc.Subscribe(orgContext(), tt.filter, tt.lastEventID)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			assert.Nil(t, stream)
+		})
+	}
+}
+
+func TestService_SlowStreamDoesNotLoseEvents(t *testing.T) {
+	svc, er, _, _ := newService(t)
+	svc.pollInterval = 10 * time.Millisecond
+
+	page := make([]domain.EventRecord, 0, pageSize)
+	for i := range pageSize {
+		page = append(page, record(int64(i+1), "pr-1", "u1"))
+	}
+	er.On("LastID", mock.Anything).Return(int64(0), nil)
+	er.On("ListAfter", mock.Anything, int64(0), pageSize).Return(page, nil).Once()
+	er.On("ListAfter", mock.Anything, int64(pageSize), pageSize).
+		Return([]domain.EventRecord{record(pageSize+1, "pr-1", "u1")}, nil).Once()
+	er.On("ListAfter", mock.Anything, int64(pageSize+1), pageSize).
+		Return(([]domain.EventRecord)(nil), errUnexpected)
+
+	stream, err := svc.Subscribe(orgContext(), domain.EventFilter{}, 0)
+	require.NoError(t, err)
+
+	// Клиент начинает читать не сразу: события ждут в журнале, а не в буфере потока.
+	time.Sleep(20 * time.Millisecond)
+
+	var ids []int64
+	for rec := range stream.Events() {
+		ids = append(ids, rec.ID)
+	}
+
+	require.Len(t, ids, pageSize+1)
+	assert.Equal(t, int64(pageSize+1), ids[pageSize])
+}
+
+func TestService_PublishRecordsBeforeBus(t *testing.T) {
+	bus := events.NewBus(slog.New(slog.DiscardHandler))
+	busEvents, unsubscribe := bus.Subscribe(1)
+	defer unsubscribe()
+
+	er := mocks.NewMockEventRepository(t)
+	tm, commit := newTxManager(t)
+	svc := New(slog.New(slog.DiscardHandler), er, nil, nil, nil, bus, tm, time.Hour)
+	svc.pollInterval = time.Hour
+
+	er.On("LastID", mock.Anything).Return(int64(0), nil)
+	er.On("ListAfter", mock.Anything, int64(0), pageSize).Return([]domain.EventRecord{}, nil).Once()
+
+	stream, err := svc.Subscribe(orgContext(), domain.EventFilter{}, 0)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	type ctxKey struct{}
+	publisherCtx := context.WithValue(context.Background(), ctxKey{}, "tx")
+
+	er.On("Append", mock.Anything, mock.MatchedBy(func(r domain.EventRecord) bool {
+		return r.OrgID == orgID && r.PullRequestID == "pr-1"
+	})).
+		Run(func(args mock.Arguments) {
+			ctx := args.Get(0).(context.Context)
+			principal, ok := tenant.PrincipalFrom(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, orgID, principal.OrgID)
+			assert.Equal(t, "tx", ctx.Value(ctxKey{}), "event must be recorded in the publisher context")
+			assert.Empty(t, busEvents, "event must be recorded before it reaches the bus")
+		}).
+		Return(func(_ context.Context, r domain.EventRecord) (*domain.EventRecord, error) {
+			r.ID = 1
+			return &r, nil
+		}).Once()
+	er.On("ListAfter", mock.Anything, int64(0), pageSize).Return([]domain.EventRecord{record(1, "pr-1", "u1")}, nil).Once()
+	er.On("ListAfter", mock.Anything, int64(1), pageSize).Return([]domain.EventRecord{}, nil).Maybe()
+
+	event := domain.Event{
+		Type:  domain.EventPullRequestMerged,
+		OrgID: orgID,
+		Data:  domain.PullRequestEvent{PullRequest: domain.PullRequest{ID: "pr-1", AuthorID: "u1"}},
+	}
+	svc.Publish(publisherCtx, event)
+
+	// До фиксации транзакции издателя событие не уходит ни в шину, ни в потоки.
+	assert.Empty(t, busEvents)
+	select {
+	case <-stream.Events():
+		t.Fatal("stream received an event before commit")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	commit()
+
+	// pollInterval не истекает: поток перечитывает журнал, потому что фиксация разбудила его.
+	assert.Equal(t, int64(1), receive(t, stream).ID)
+
+	select {
+	case got := <-busEvents:
+		assert.Equal(t, event, got)
+	default:
+		t.Fatal("event was not published to the bus")
+	}
+}
+
+func TestService_PublishForwardsUnrecordedEvent(t *testing.T) {
+	bus := events.NewBus(slog.New(slog.DiscardHandler))
+	busEvents, unsubscribe := bus.Subscribe(1)
+	defer unsubscribe()
+
+	er := mocks.NewMockEventRepository(t)
+	tm, commit := newTxManager(t)
+	svc := New(slog.New(slog.DiscardHandler), er, nil, nil, nil, bus, tm, time.Hour)
+
+	er.On("Append", mock.Anything, mock.Anything).Return((*domain.EventRecord)(nil), errUnexpected)
+
+	event := domain.Event{Type: domain.EventPullRequestMerged, OrgID: orgID}
+	svc.Publish(context.Background(), event)
+	commit()
+
+	select {
+	case got := <-busEvents:
+		assert.Equal(t, event, got)
+	default:
+		t.Fatal("event was not published to the bus")
+	}
+}
+
+func TestService_Prune(t *testing.T) {
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	er := mocks.NewMockEventRepository(t)
+	or := mocks.NewMockOrgRepository(t)
+	svc := New(slog.New(slog.DiscardHandler), er, nil, nil, or, nil, nil, 24*time.Hour)
+	svc.now = func() time.Time { return now }
+
+	or.On("ListIDs", mock.Anything).Return([]string{"org-1", "org-2"}, nil)
+	inOrg := func(id string) any {
+		return mock.MatchedBy(func(ctx context.Context) bool {
+			got, err := tenant.OrgID(ctx)
+			return err == nil && got == id
+		})
+	}
+	er.On("DeleteBefore", inOrg("org-1"), now.Add(-24*time.Hour)).Return(int64(0), errUnexpected)
+	er.On("DeleteBefore", inOrg("org-2"), now.Add(-24*time.Hour)).Return(int64(3), nil)
+
+	err := svc.Prune(context.Background())
+
+	require.ErrorIs(t, err, errUnexpected)
+	er.AssertNumberOfCalls(t, "DeleteBefore", 2)
+}
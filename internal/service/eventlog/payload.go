@@ -0,0 +1,142 @@
+package eventlog
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type pullRequestPayload struct {
+	Repository string     `json:"repository"`
+	ID         string     `json:"pull_request_id"`
+	Name       string     `json:"pull_request_name"`
+	AuthorID   string     `json:"author_id"`
+	Status     string     `json:"status"`
+	Reviewers  []string   `json:"assigned_reviewers"`
+	CreatedAt  time.Time  `json:"created_at"`
+	MergedAt   *time.Time `json:"merged_at,omitempty"`
+}
+
+type reviewersAssignedPayload struct {
+	PullRequest pullRequestPayload `json:"pull_request"`
+	ReviewerIDs []string           `json:"reviewer_ids"`
+}
+
+type reviewerReassignedPayload struct {
+	PullRequest   pullRequestPayload `json:"pull_request"`
+	OldReviewerID string             `json:"old_reviewer_id"`
+	NewReviewerID string             `json:"new_reviewer_id"`
+}
+
+type pullRequestEventPayload struct {
+	PullRequest pullRequestPayload `json:"pull_request"`
+}
+
+type reviewOverduePayload struct {
+	Repository      string    `json:"repository"`
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	ReviewerID      string    `json:"reviewer_id"`
+	TeamName        string    `json:"team_name"`
+	AssignedAt      time.Time `json:"assigned_at"`
+	Action          string    `json:"action"`
+	ReassignedTo    string    `json:"reassigned_to,omitempty"`
+	EscalatedTo     string    `json:"escalated_to,omitempty"`
+}
+
+// toRecord превращает событие шины в запись журнала: выделяет Pull Request
+// и затронутых пользователей для фильтрации и сериализует данные события.
+// Данные событий неизвестного вида сохраняются пустым объектом.
+func toRecord(event domain.Event) (domain.EventRecord, error) {
+	record := domain.EventRecord{
+		OrgID:      event.OrgID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+	}
+
+	var payload any
+	switch data := event.Data.(type) {
+	case domain.ReviewersAssignedEvent:
+		record.Repository, record.PullRequestID = data.PullRequest.Repository, data.PullRequest.ID
+		record.UserIDs = involved(data.PullRequest)
+		payload = reviewersAssignedPayload{
+			PullRequest: toPullRequestPayload(data.PullRequest),
+			ReviewerIDs: data.ReviewerIDs,
+		}
+	case domain.ReviewerReassignedEvent:
+		record.Repository, record.PullRequestID = data.PullRequest.Repository, data.PullRequest.ID
+		record.UserIDs = involved(data.PullRequest, data.OldReviewerID)
+		payload = reviewerReassignedPayload{
+			PullRequest:   toPullRequestPayload(data.PullRequest),
+			OldReviewerID: data.OldReviewerID,
+			NewReviewerID: data.NewReviewerID,
+		}
+	case domain.PullRequestEvent:
+		record.Repository, record.PullRequestID = data.PullRequest.Repository, data.PullRequest.ID
+		record.UserIDs = involved(data.PullRequest)
+		payload = pullRequestEventPayload{PullRequest: toPullRequestPayload(data.PullRequest)}
+	case domain.ReviewOverdueEvent:
+		a := data.Assignment
+		record.Repository, record.PullRequestID = a.Repository, a.PullRequestID
+		record.UserIDs = unique(a.AuthorID, a.ReviewerID, data.ReassignedTo, data.EscalatedTo)
+		payload = reviewOverduePayload{
+			Repository:      a.Repository,
+			PullRequestID:   a.PullRequestID,
+			PullRequestName: a.PullRequestName,
+			AuthorID:        a.AuthorID,
+			ReviewerID:      a.ReviewerID,
+			TeamName:        a.TeamName,
+			AssignedAt:      a.AssignedAt,
+			Action:          string(data.Action),
+			ReassignedTo:    data.ReassignedTo,
+			EscalatedTo:     data.EscalatedTo,
+		}
+	default:
+		payload = struct{}{}
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return domain.EventRecord{}, err
+	}
+	record.Payload = raw
+
+	return record, nil
+}
+
+func toPullRequestPayload(pr domain.PullRequest) pullRequestPayload {
+	reviewers := pr.Reviewers
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+
+	return pullRequestPayload{
+		Repository: pr.Repository,
+		ID:         pr.ID,
+		Name:       pr.Name,
+		AuthorID:   pr.AuthorID,
+		Status:     string(pr.Status),
+		Reviewers:  reviewers,
+		CreatedAt:  pr.CreatedAt,
+		MergedAt:   pr.MergedAt,
+	}
+}
+
+// involved возвращает автора и ревьюверов Pull Request'а вместе с дополнительными пользователями.
+func involved(pr domain.PullRequest, extra ...string) []string {
+	return unique(append(append([]string{pr.AuthorID}, pr.Reviewers...), extra...)...)
+}
+
+func unique(ids ...string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
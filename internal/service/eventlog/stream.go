@@ -0,0 +1,119 @@
+package eventlog
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+// Stream — поток событий одного клиента.
+type Stream struct {
+	svc *Service
+	lgr *slog.Logger
+
+	orgID   string
+	filter  domain.EventFilter
+	members map[string]struct{} // участники команды из фильтра; nil, если команда не указана
+
+	events   chan domain.EventRecord
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// Events возвращает канал событий потока. Канал закрывается, когда поток завершён.
+func (st *Stream) Events() <-chan domain.EventRecord {
+	return st.events
+}
+
+// Close завершает поток. Повторные вызовы безопасны.
+func (st *Stream) Close() {
+	st.stopOnce.Do(func() {
+		close(st.stop)
+	})
+}
+
+// forward отдаёт клиенту подходящие записи журнала после afterID, начиная с уже прочитанных
+// records, и дочитывает журнал после каждой записи в процессе или раз в pollInterval.
+// appended — канал Service.awaitAppend, полученный до чтения records.
+func (st *Stream) forward(
+	ctx context.Context,
+	appended <-chan struct{},
+	records []domain.EventRecord,
+	afterID int64,
+) {
+	defer close(st.events)
+	defer st.Close()
+
+	ticker := time.NewTicker(st.svc.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, record := range records {
+			afterID = record.ID
+			if !st.matches(record) {
+				continue
+			}
+
+			select {
+			case st.events <- record:
+			case <-ctx.Done():
+				return
+			case <-st.stop:
+				return
+			}
+		}
+
+		if len(records) < pageSize {
+			select {
+			case <-appended:
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			case <-st.stop:
+				return
+			}
+		}
+
+		appended = st.svc.awaitAppend()
+
+		var err error
+		records, err = st.svc.eventRepo.ListAfter(ctx, afterID, pageSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				st.lgr.ErrorContext(ctx, "failed to read event log, closing stream",
+					slog.Int64("afterID", afterID), slog.Any("error", err))
+			}
+
+			return
+		}
+	}
+}
+
+func (st *Stream) matches(record domain.EventRecord) bool {
+	if record.OrgID != st.orgID {
+		return false
+	}
+	if st.filter.Repository != "" && record.Repository != st.filter.Repository {
+		return false
+	}
+	if st.filter.PullRequestID != "" && record.PullRequestID != st.filter.PullRequestID {
+		return false
+	}
+	if st.filter.UserID != "" && !slices.Contains(record.UserIDs, st.filter.UserID) {
+		return false
+	}
+	if st.members != nil && !slices.ContainsFunc(record.UserIDs, st.isMember) {
+		return false
+	}
+
+	return true
+}
+
+func (st *Stream) isMember(userID string) bool {
+	_, ok := st.members[userID]
+	return ok
+}
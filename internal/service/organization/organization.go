@@ -0,0 +1,196 @@
+package organization
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	"avitotech-pr-reviewer/internal/tracing"
+)
+
+const tokenBytes = 32
+
+type OrgRepository interface {
+	Create(ctx context.Context, name, adminTokenHash string) (*domain.Organization, error)
+	AddToken(ctx context.Context, orgID, tokenHash string, role domain.Role, userID string) error
+	GetPrincipalByTokenHash(ctx context.Context, tokenHash string) (*domain.Principal, error)
+}
+
+type Service struct {
+	lgr *slog.Logger
+
+	orgRepo OrgRepository
+
+	rootToken string // Допущение: см. README.md
+
+	// allowAnonymous разрешает запросы без токена к данным организации по умолчанию.
+	allowAnonymous bool
+}
+
+func New(
+	lgr *slog.Logger,
+	orgRepo OrgRepository,
+	rootToken string,
+	allowAnonymous bool,
+) *Service {
+	return &Service{
+		lgr:            lgr,
+		orgRepo:        orgRepo,
+		rootToken:      rootToken,
+		allowAnonymous: allowAnonymous,
+	}
+}
+
+// Authenticate определяет субъекта запроса по токену.
+// Запрос без токена выполняется от имени участника организации по умолчанию, только если
+// анонимный доступ включён в конфигурации; иначе возвращается ошибка svcErr.ErrTokenRequired.
+// Токен из конфигурации сервиса даёт права RoleRoot в организации по умолчанию.
+// Если токен не выдан ни одной организации, возвращается ошибка svcErr.ErrInvalidToken.
+func (s *Service) Authenticate(ctx context.Context, token string) (*domain.Principal, error) {
+	const op = "organization.Authenticate"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+	)
+
+	if token == "" && !s.allowAnonymous {
+		return nil, svcErr.ErrTokenRequired
+	}
+	if token == "" {
+		return &domain.Principal{OrgID: domain.DefaultOrganizationID, Role: domain.RoleMember}, nil
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.rootToken)) == 1 {
+		return &domain.Principal{OrgID: domain.DefaultOrganizationID, Role: domain.RoleRoot}, nil
+	}
+
+	principal, err := s.orgRepo.GetPrincipalByTokenHash(ctx, hashToken(token))
+	if errors.Is(err, repoErr.ErrTokenNotFound) {
+		lgr.DebugContext(ctx, "token is invalid")
+
+		return nil, svcErr.ErrInvalidToken
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get principal by token", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return principal, nil
+}
+
+// CreateOrganization создаёт организацию и выдаёт её первый токен администратора.
+// Токен возвращается только один раз: сервис хранит лишь его хеш.
+// Если организация с таким именем уже существует, возвращается ошибка svcErr.ErrOrganizationExists.
+func (s *Service) CreateOrganization(ctx context.Context, name string) (*domain.Organization, string, error) {
+	const op = "organization.CreateOrganization"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("organization", name),
+	)
+
+	token, err := newToken()
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to generate token", slog.Any("error", err))
+
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	org, err := s.orgRepo.Create(ctx, name, hashToken(token))
+	if errors.Is(err, repoErr.ErrOrganizationExists) {
+		lgr.DebugContext(ctx, "organization already exists")
+
+		return nil, "", svcErr.ErrOrganizationExists
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to create organization", slog.Any("error", err))
+
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.InfoContext(ctx, "organization created", slog.String("orgID", org.ID))
+
+	return org, token, nil
+}
+
+// IssueToken выдаёт новый токен с указанной ролью в организации субъекта запроса.
+// Если userID не пуст, токен участника выдаётся этому пользователю: с ним пользователь
+// может менять собственные настройки.
+// Если роль не может быть выдана токену, возвращается ошибка svcErr.ErrInvalidRole,
+// если пользователь не найден — svcErr.ErrUserNotFound.
+func (s *Service) IssueToken(ctx context.Context, role domain.Role, userID string) (string, error) {
+	const op = "organization.IssueToken"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("role", string(role)),
+		slog.String("userID", userID),
+	)
+
+	if !role.IsValid() || (userID != "" && role != domain.RoleMember) {
+		return "", svcErr.ErrInvalidRole
+	}
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to generate token", slog.Any("error", err))
+
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.orgRepo.AddToken(ctx, orgID, hashToken(token), role, userID)
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "user not found")
+
+		return "", svcErr.ErrUserNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to save token", slog.Any("error", err))
+
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.InfoContext(ctx, "token issued", slog.String("orgID", orgID))
+
+	return token, nil
+}
+
+func newToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
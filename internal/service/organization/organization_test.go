@@ -0,0 +1,178 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/service/organization/mocks"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+func TestService_Authenticate(t *testing.T) {
+	tests := []struct {
+		name              string
+		token             string
+		allowAnonymous    bool
+		setupMocks        func(m *mocks.MockOrgRepository)
+		expectedPrincipal *domain.Principal
+		expectedError     error
+	}{
+		{
+			name:              "success - anonymous request belongs to default organization",
+			token:             "",
+			allowAnonymous:    true,
+			setupMocks:        func(m *mocks.MockOrgRepository) {},
+			expectedPrincipal: &domain.Principal{OrgID: domain.DefaultOrganizationID, Role: domain.RoleMember},
+		},
+		{
+			name:          "error - anonymous request when anonymous access is disabled",
+			token:         "",
+			setupMocks:    func(m *mocks.MockOrgRepository) {},
+			expectedError: svcErr.ErrTokenRequired,
+		},
+		{
+			name:              "success - root token",
+			token:             "root-secret",
+			setupMocks:        func(m *mocks.MockOrgRepository) {},
+			expectedPrincipal: &domain.Principal{OrgID: domain.DefaultOrganizationID, Role: domain.RoleRoot},
+		},
+		{
+			name:  "success - organization token",
+			token: "org-token",
+			setupMocks: func(m *mocks.MockOrgRepository) {
+				m.On("GetPrincipalByTokenHash", mock.Anything, hashToken("org-token")).
+					Return(&domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin}, nil)
+			},
+			expectedPrincipal: &domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin},
+		},
+		{
+			name:  "error - unknown token",
+			token: "wrong",
+			setupMocks: func(m *mocks.MockOrgRepository) {
+				m.On("GetPrincipalByTokenHash", mock.Anything, hashToken("wrong")).
+					Return(nil, repoErr.ErrTokenNotFound)
+			},
+			expectedError: svcErr.ErrInvalidToken,
+		},
+		{
+			name:  "error - unexpected from repo",
+			token: "org-token",
+			setupMocks: func(m *mocks.MockOrgRepository) {
+				m.On("GetPrincipalByTokenHash", mock.Anything, mock.Anything).Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks.NewMockOrgRepository(t)
+			tt.setupMocks(m)
+
+			svc := New(slog.New(slog.DiscardHandler), m, "root-secret", tt.allowAnonymous)
+
+			principal, err := svc.Authenticate(context.Background(), tt.token)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, principal)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPrincipal, principal)
+			}
+		})
+	}
+}
+
+func TestService_CreateOrganization(t *testing.T) {
+	m := mocks.NewMockOrgRepository(t)
+
+	var savedHash string
+	m.On("Create", mock.Anything, "payments", mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) {
+			savedHash = args.String(2)
+		}).
+		Return(&domain.Organization{ID: "org-1", Name: "payments"}, nil).Once()
+	m.On("Create", mock.Anything, "payments", mock.AnythingOfType("string")).
+		Return(nil, repoErr.ErrOrganizationExists).Once()
+
+	svc := New(slog.New(slog.DiscardHandler), m, "root-secret", false)
+
+	org, token, err := svc.CreateOrganization(context.Background(), "payments")
+	require.NoError(t, err)
+	assert.Equal(t, &domain.Organization{ID: "org-1", Name: "payments"}, org)
+	assert.Len(t, token, 2*tokenBytes)
+	assert.Equal(t, hashToken(token), savedHash, "only the token hash must be stored")
+
+	_, _, err = svc.CreateOrganization(context.Background(), "payments")
+	require.ErrorIs(t, err, svcErr.ErrOrganizationExists)
+}
+
+func TestService_IssueToken(t *testing.T) {
+	ctx := tenant.WithPrincipal(context.Background(), domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin})
+
+	t.Run("success - token issued in principal's organization", func(t *testing.T) {
+		m := mocks.NewMockOrgRepository(t)
+		m.On("AddToken", mock.Anything, "org-1", mock.AnythingOfType("string"), domain.RoleMember, "").Return(nil)
+
+		svc := New(slog.New(slog.DiscardHandler), m, "root-secret", false)
+
+		token, err := svc.IssueToken(ctx, domain.RoleMember, "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, token)
+	})
+
+	t.Run("success - member token issued to user", func(t *testing.T) {
+		m := mocks.NewMockOrgRepository(t)
+		m.On("AddToken", mock.Anything, "org-1", mock.AnythingOfType("string"), domain.RoleMember, "u1").Return(nil)
+
+		svc := New(slog.New(slog.DiscardHandler), m, "root-secret", false)
+
+		token, err := svc.IssueToken(ctx, domain.RoleMember, "u1")
+		require.NoError(t, err)
+		assert.NotEmpty(t, token)
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		m := mocks.NewMockOrgRepository(t)
+		m.On("AddToken", mock.Anything, "org-1", mock.AnythingOfType("string"), domain.RoleMember, "u404").
+			Return(repoErr.ErrUserNotFound)
+
+		svc := New(slog.New(slog.DiscardHandler), m, "root-secret", false)
+
+		_, err := svc.IssueToken(ctx, domain.RoleMember, "u404")
+		require.ErrorIs(t, err, svcErr.ErrUserNotFound)
+	})
+
+	t.Run("error - admin token cannot be issued to user", func(t *testing.T) {
+		svc := New(slog.New(slog.DiscardHandler), mocks.NewMockOrgRepository(t), "root-secret", false)
+
+		_, err := svc.IssueToken(ctx, domain.RoleAdmin, "u1")
+		require.ErrorIs(t, err, svcErr.ErrInvalidRole)
+	})
+
+	t.Run("error - root role cannot be issued", func(t *testing.T) {
+		svc := New(slog.New(slog.DiscardHandler), mocks.NewMockOrgRepository(t), "root-secret", false)
+
+		_, err := svc.IssueToken(ctx, domain.RoleRoot, "")
+		require.ErrorIs(t, err, svcErr.ErrInvalidRole)
+	})
+
+	t.Run("error - no principal in context", func(t *testing.T) {
+		svc := New(slog.New(slog.DiscardHandler), mocks.NewMockOrgRepository(t), "root-secret", false)
+
+		_, err := svc.IssueToken(context.Background(), domain.RoleAdmin, "")
+		require.ErrorIs(t, err, tenant.ErrNoPrincipal)
+	})
+}
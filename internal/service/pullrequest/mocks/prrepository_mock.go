@@ -107,8 +107,8 @@ func (_c *MockPrRepository_Create_Call) RunAndReturn(run func(ctx context.Contex
 }
 
 // GetByID provides a mock function for the type MockPrRepository
-func (_mock *MockPrRepository) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	ret := _mock.Called(ctx, prID)
+func (_mock *MockPrRepository) GetByID(ctx context.Context, repositoryID string, prID string) (*domain.PullRequest, error) {
+	ret := _mock.Called(ctx, repositoryID, prID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -116,18 +116,18 @@ func (_mock *MockPrRepository) GetByID(ctx context.Context, prID string) (*domai
 
 	var r0 *domain.PullRequest
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.PullRequest, error)); ok {
-		return returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.PullRequest, error)); ok {
+		return returnFunc(ctx, repositoryID, prID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.PullRequest); ok {
-		r0 = returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.PullRequest); ok {
+		r0 = returnFunc(ctx, repositoryID, prID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.PullRequest)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repositoryID, prID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -141,12 +141,13 @@ type MockPrRepository_GetByID_Call struct {
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - repositoryID string
 //   - prID string
-func (_e *MockPrRepository_Expecter) GetByID(ctx interface{}, prID interface{}) *MockPrRepository_GetByID_Call {
-	return &MockPrRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, prID)}
+func (_e *MockPrRepository_Expecter) GetByID(ctx interface{}, repositoryID interface{}, prID interface{}) *MockPrRepository_GetByID_Call {
+	return &MockPrRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, repositoryID, prID)}
 }
 
-func (_c *MockPrRepository_GetByID_Call) Run(run func(ctx context.Context, prID string)) *MockPrRepository_GetByID_Call {
+func (_c *MockPrRepository_GetByID_Call) Run(run func(ctx context.Context, repositoryID string, prID string)) *MockPrRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -156,9 +157,14 @@ func (_c *MockPrRepository_GetByID_Call) Run(run func(ctx context.Context, prID
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -169,14 +175,14 @@ func (_c *MockPrRepository_GetByID_Call) Return(pullRequest *domain.PullRequest,
 	return _c
 }
 
-func (_c *MockPrRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, prID string) (*domain.PullRequest, error)) *MockPrRepository_GetByID_Call {
+func (_c *MockPrRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, repositoryID string, prID string) (*domain.PullRequest, error)) *MockPrRepository_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetReviewerIDs provides a mock function for the type MockPrRepository
-func (_mock *MockPrRepository) GetReviewerIDs(ctx context.Context, prID string) ([]string, error) {
-	ret := _mock.Called(ctx, prID)
+func (_mock *MockPrRepository) GetReviewerIDs(ctx context.Context, repositoryID string, prID string) ([]string, error) {
+	ret := _mock.Called(ctx, repositoryID, prID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetReviewerIDs")
@@ -184,18 +190,18 @@ func (_mock *MockPrRepository) GetReviewerIDs(ctx context.Context, prID string)
 
 	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
-		return returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, repositoryID, prID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = returnFunc(ctx, repositoryID, prID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repositoryID, prID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -209,12 +215,13 @@ type MockPrRepository_GetReviewerIDs_Call struct {
 
 // GetReviewerIDs is a helper method to define mock.On call
 //   - ctx context.Context
+//   - repositoryID string
 //   - prID string
-func (_e *MockPrRepository_Expecter) GetReviewerIDs(ctx interface{}, prID interface{}) *MockPrRepository_GetReviewerIDs_Call {
-	return &MockPrRepository_GetReviewerIDs_Call{Call: _e.mock.On("GetReviewerIDs", ctx, prID)}
+func (_e *MockPrRepository_Expecter) GetReviewerIDs(ctx interface{}, repositoryID interface{}, prID interface{}) *MockPrRepository_GetReviewerIDs_Call {
+	return &MockPrRepository_GetReviewerIDs_Call{Call: _e.mock.On("GetReviewerIDs", ctx, repositoryID, prID)}
 }
 
-func (_c *MockPrRepository_GetReviewerIDs_Call) Run(run func(ctx context.Context, prID string)) *MockPrRepository_GetReviewerIDs_Call {
+func (_c *MockPrRepository_GetReviewerIDs_Call) Run(run func(ctx context.Context, repositoryID string, prID string)) *MockPrRepository_GetReviewerIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -224,9 +231,14 @@ func (_c *MockPrRepository_GetReviewerIDs_Call) Run(run func(ctx context.Context
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -237,14 +249,14 @@ func (_c *MockPrRepository_GetReviewerIDs_Call) Return(strings []string, err err
 	return _c
 }
 
-func (_c *MockPrRepository_GetReviewerIDs_Call) RunAndReturn(run func(ctx context.Context, prID string) ([]string, error)) *MockPrRepository_GetReviewerIDs_Call {
+func (_c *MockPrRepository_GetReviewerIDs_Call) RunAndReturn(run func(ctx context.Context, repositoryID string, prID string) ([]string, error)) *MockPrRepository_GetReviewerIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // SetMerged provides a mock function for the type MockPrRepository
-func (_mock *MockPrRepository) SetMerged(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	ret := _mock.Called(ctx, prID)
+func (_mock *MockPrRepository) SetMerged(ctx context.Context, repositoryID string, prID string) (*domain.PullRequest, error) {
+	ret := _mock.Called(ctx, repositoryID, prID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetMerged")
@@ -252,18 +264,18 @@ func (_mock *MockPrRepository) SetMerged(ctx context.Context, prID string) (*dom
 
 	var r0 *domain.PullRequest
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.PullRequest, error)); ok {
-		return returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.PullRequest, error)); ok {
+		return returnFunc(ctx, repositoryID, prID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.PullRequest); ok {
-		r0 = returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.PullRequest); ok {
+		r0 = returnFunc(ctx, repositoryID, prID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.PullRequest)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, prID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repositoryID, prID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -277,12 +289,13 @@ type MockPrRepository_SetMerged_Call struct {
 
 // SetMerged is a helper method to define mock.On call
 //   - ctx context.Context
+//   - repositoryID string
 //   - prID string
-func (_e *MockPrRepository_Expecter) SetMerged(ctx interface{}, prID interface{}) *MockPrRepository_SetMerged_Call {
-	return &MockPrRepository_SetMerged_Call{Call: _e.mock.On("SetMerged", ctx, prID)}
+func (_e *MockPrRepository_Expecter) SetMerged(ctx interface{}, repositoryID interface{}, prID interface{}) *MockPrRepository_SetMerged_Call {
+	return &MockPrRepository_SetMerged_Call{Call: _e.mock.On("SetMerged", ctx, repositoryID, prID)}
 }
 
-func (_c *MockPrRepository_SetMerged_Call) Run(run func(ctx context.Context, prID string)) *MockPrRepository_SetMerged_Call {
+func (_c *MockPrRepository_SetMerged_Call) Run(run func(ctx context.Context, repositoryID string, prID string)) *MockPrRepository_SetMerged_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -292,9 +305,14 @@ func (_c *MockPrRepository_SetMerged_Call) Run(run func(ctx context.Context, prI
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -305,54 +323,61 @@ func (_c *MockPrRepository_SetMerged_Call) Return(pullRequest *domain.PullReques
 	return _c
 }
 
-func (_c *MockPrRepository_SetMerged_Call) RunAndReturn(run func(ctx context.Context, prID string) (*domain.PullRequest, error)) *MockPrRepository_SetMerged_Call {
+func (_c *MockPrRepository_SetMerged_Call) RunAndReturn(run func(ctx context.Context, repositoryID string, prID string) (*domain.PullRequest, error)) *MockPrRepository_SetMerged_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateReviewer provides a mock function for the type MockPrRepository
-func (_mock *MockPrRepository) UpdateReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) (*domain.PullRequest, error) {
-	ret := _mock.Called(ctx, prID, oldReviewerID, newReviewerID)
+// ReassignReviewer provides a mock function for the type MockPrRepository
+func (_mock *MockPrRepository) ReassignReviewer(ctx context.Context, repositoryID string, prID string, oldReviewerID string, choose func(pr *domain.PullRequest) (string, error)) (*domain.PullRequest, string, error) {
+	ret := _mock.Called(ctx, repositoryID, prID, oldReviewerID, choose)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateReviewer")
+		panic("no return value specified for ReassignReviewer")
 	}
 
 	var r0 *domain.PullRequest
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.PullRequest, error)); ok {
-		return returnFunc(ctx, prID, oldReviewerID, newReviewerID)
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, func(pr *domain.PullRequest) (string, error)) (*domain.PullRequest, string, error)); ok {
+		return returnFunc(ctx, repositoryID, prID, oldReviewerID, choose)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.PullRequest); ok {
-		r0 = returnFunc(ctx, prID, oldReviewerID, newReviewerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, func(pr *domain.PullRequest) (string, error)) *domain.PullRequest); ok {
+		r0 = returnFunc(ctx, repositoryID, prID, oldReviewerID, choose)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.PullRequest)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = returnFunc(ctx, prID, oldReviewerID, newReviewerID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, func(pr *domain.PullRequest) (string, error)) string); ok {
+		r1 = returnFunc(ctx, repositoryID, prID, oldReviewerID, choose)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string, func(pr *domain.PullRequest) (string, error)) error); ok {
+		r2 = returnFunc(ctx, repositoryID, prID, oldReviewerID, choose)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockPrRepository_UpdateReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReviewer'
-type MockPrRepository_UpdateReviewer_Call struct {
+// MockPrRepository_ReassignReviewer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignReviewer'
+type MockPrRepository_ReassignReviewer_Call struct {
 	*mock.Call
 }
 
-// UpdateReviewer is a helper method to define mock.On call
+// ReassignReviewer is a helper method to define mock.On call
 //   - ctx context.Context
+//   - repositoryID string
 //   - prID string
 //   - oldReviewerID string
-//   - newReviewerID string
-func (_e *MockPrRepository_Expecter) UpdateReviewer(ctx interface{}, prID interface{}, oldReviewerID interface{}, newReviewerID interface{}) *MockPrRepository_UpdateReviewer_Call {
-	return &MockPrRepository_UpdateReviewer_Call{Call: _e.mock.On("UpdateReviewer", ctx, prID, oldReviewerID, newReviewerID)}
+//   - choose func(pr *domain.PullRequest) (string, error)
+func (_e *MockPrRepository_Expecter) ReassignReviewer(ctx interface{}, repositoryID interface{}, prID interface{}, oldReviewerID interface{}, choose interface{}) *MockPrRepository_ReassignReviewer_Call {
+	return &MockPrRepository_ReassignReviewer_Call{Call: _e.mock.On("ReassignReviewer", ctx, repositoryID, prID, oldReviewerID, choose)}
 }
 
-func (_c *MockPrRepository_UpdateReviewer_Call) Run(run func(ctx context.Context, prID string, oldReviewerID string, newReviewerID string)) *MockPrRepository_UpdateReviewer_Call {
+func (_c *MockPrRepository_ReassignReviewer_Call) Run(run func(ctx context.Context, repositoryID string, prID string, oldReviewerID string, choose func(pr *domain.PullRequest) (string, error))) *MockPrRepository_ReassignReviewer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -370,22 +395,235 @@ func (_c *MockPrRepository_UpdateReviewer_Call) Run(run func(ctx context.Context
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 func(pr *domain.PullRequest) (string, error)
+		if args[4] != nil {
+			arg4 = args[4].(func(pr *domain.PullRequest) (string, error))
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPrRepository_UpdateReviewer_Call) Return(pullRequest *domain.PullRequest, err error) *MockPrRepository_UpdateReviewer_Call {
-	_c.Call.Return(pullRequest, err)
+func (_c *MockPrRepository_ReassignReviewer_Call) Return(pullRequest *domain.PullRequest, s string, err error) *MockPrRepository_ReassignReviewer_Call {
+	_c.Call.Return(pullRequest, s, err)
+	return _c
+}
+
+func (_c *MockPrRepository_ReassignReviewer_Call) RunAndReturn(run func(ctx context.Context, repositoryID string, prID string, oldReviewerID string, choose func(pr *domain.PullRequest) (string, error)) (*domain.PullRequest, string, error)) *MockPrRepository_ReassignReviewer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddReview provides a mock function for the type MockPrRepository
+func (_mock *MockPrRepository) AddReview(ctx context.Context, review *domain.Review) (*domain.Review, error) {
+	ret := _mock.Called(ctx, review)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReview")
+	}
+
+	var r0 *domain.Review
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Review) (*domain.Review, error)); ok {
+		return returnFunc(ctx, review)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Review) *domain.Review); ok {
+		r0 = returnFunc(ctx, review)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Review)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Review) error); ok {
+		r1 = returnFunc(ctx, review)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPrRepository_AddReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReview'
+type MockPrRepository_AddReview_Call struct {
+	*mock.Call
+}
+
+// AddReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - review *domain.Review
+func (_e *MockPrRepository_Expecter) AddReview(ctx interface{}, review interface{}) *MockPrRepository_AddReview_Call {
+	return &MockPrRepository_AddReview_Call{Call: _e.mock.On("AddReview", ctx, review)}
+}
+
+func (_c *MockPrRepository_AddReview_Call) Run(run func(ctx context.Context, review *domain.Review)) *MockPrRepository_AddReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Review
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Review)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrRepository_AddReview_Call) Return(review *domain.Review, err error) *MockPrRepository_AddReview_Call {
+	_c.Call.Return(review, err)
+	return _c
+}
+
+func (_c *MockPrRepository_AddReview_Call) RunAndReturn(run func(ctx context.Context, review *domain.Review) (*domain.Review, error)) *MockPrRepository_AddReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsApproved provides a mock function for the type MockPrRepository
+func (_mock *MockPrRepository) IsApproved(ctx context.Context, repositoryID string, prID string) (bool, error) {
+	ret := _mock.Called(ctx, repositoryID, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsApproved")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, repositoryID, prID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, repositoryID, prID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repositoryID, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPrRepository_IsApproved_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsApproved'
+type MockPrRepository_IsApproved_Call struct {
+	*mock.Call
+}
+
+// IsApproved is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repositoryID string
+//   - prID string
+func (_e *MockPrRepository_Expecter) IsApproved(ctx interface{}, repositoryID interface{}, prID interface{}) *MockPrRepository_IsApproved_Call {
+	return &MockPrRepository_IsApproved_Call{Call: _e.mock.On("IsApproved", ctx, repositoryID, prID)}
+}
+
+func (_c *MockPrRepository_IsApproved_Call) Run(run func(ctx context.Context, repositoryID string, prID string)) *MockPrRepository_IsApproved_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrRepository_IsApproved_Call) Return(b bool, err error) *MockPrRepository_IsApproved_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPrRepository_IsApproved_Call) RunAndReturn(run func(ctx context.Context, repositoryID string, prID string) (bool, error)) *MockPrRepository_IsApproved_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOverdue provides a mock function for the type MockPrRepository
+func (_mock *MockPrRepository) ListOverdue(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error) {
+	ret := _mock.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOverdue")
+	}
+
+	var r0 []domain.ReviewAssignment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.ReviewAssignment, error)); ok {
+		return returnFunc(ctx, teamName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.ReviewAssignment); ok {
+		r0 = returnFunc(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReviewAssignment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPrRepository_ListOverdue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOverdue'
+type MockPrRepository_ListOverdue_Call struct {
+	*mock.Call
+}
+
+// ListOverdue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *MockPrRepository_Expecter) ListOverdue(ctx interface{}, teamName interface{}) *MockPrRepository_ListOverdue_Call {
+	return &MockPrRepository_ListOverdue_Call{Call: _e.mock.On("ListOverdue", ctx, teamName)}
+}
+
+func (_c *MockPrRepository_ListOverdue_Call) Run(run func(ctx context.Context, teamName string)) *MockPrRepository_ListOverdue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrRepository_ListOverdue_Call) Return(reviewAssignments []domain.ReviewAssignment, err error) *MockPrRepository_ListOverdue_Call {
+	_c.Call.Return(reviewAssignments, err)
 	return _c
 }
 
-func (_c *MockPrRepository_UpdateReviewer_Call) RunAndReturn(run func(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) (*domain.PullRequest, error)) *MockPrRepository_UpdateReviewer_Call {
+func (_c *MockPrRepository_ListOverdue_Call) RunAndReturn(run func(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error)) *MockPrRepository_ListOverdue_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -105,3 +105,71 @@ func (_c *MockTeamRepository_GetActiveMembersByTeamID_Call) RunAndReturn(run fun
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetByName provides a mock function for the type MockTeamRepository
+func (_mock *MockTeamRepository) GetByName(ctx context.Context, teamName string) (*domain.Team, error) {
+	ret := _mock.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 *domain.Team
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Team, error)); ok {
+		return returnFunc(ctx, teamName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Team); ok {
+		r0 = returnFunc(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Team)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTeamRepository_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type MockTeamRepository_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamName string
+func (_e *MockTeamRepository_Expecter) GetByName(ctx interface{}, teamName interface{}) *MockTeamRepository_GetByName_Call {
+	return &MockTeamRepository_GetByName_Call{Call: _e.mock.On("GetByName", ctx, teamName)}
+}
+
+func (_c *MockTeamRepository_GetByName_Call) Run(run func(ctx context.Context, teamName string)) *MockTeamRepository_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_GetByName_Call) Return(team *domain.Team, err error) *MockTeamRepository_GetByName_Call {
+	_c.Call.Return(team, err)
+	return _c
+}
+
+func (_c *MockTeamRepository_GetByName_Call) RunAndReturn(run func(ctx context.Context, teamName string) (*domain.Team, error)) *MockTeamRepository_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
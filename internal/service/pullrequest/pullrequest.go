@@ -6,18 +6,28 @@ import (
 	"log/slog"
 	"math/rand"
 	"slices"
+	"time"
 
 	"avitotech-pr-reviewer/internal/domain"
 	svcErr "avitotech-pr-reviewer/internal/service/errors"
 	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	"avitotech-pr-reviewer/internal/tracing"
 )
 
 type PrRepository interface {
 	Create(ctx context.Context, pr *domain.PullRequest) (*domain.PullRequest, error)
-	GetByID(ctx context.Context, prID string) (*domain.PullRequest, error)
-	GetReviewerIDs(ctx context.Context, prID string) ([]string, error)
-	SetMerged(ctx context.Context, prID string) (*domain.PullRequest, error)
-	UpdateReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) (*domain.PullRequest, error)
+	GetByID(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error)
+	GetReviewerIDs(ctx context.Context, repositoryID, prID string) ([]string, error)
+	SetMerged(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error)
+	ReassignReviewer(
+		ctx context.Context,
+		repositoryID, prID, oldReviewerID string,
+		choose func(pr *domain.PullRequest) (string, error),
+	) (*domain.PullRequest, string, error)
+	AddReview(ctx context.Context, review *domain.Review) (*domain.Review, error)
+	IsApproved(ctx context.Context, repositoryID, prID string) (bool, error)
+	ListOverdue(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error)
 }
 
 type UserRepository interface {
@@ -26,14 +36,46 @@ type UserRepository interface {
 
 type TeamRepository interface {
 	GetActiveMembersByTeamID(ctx context.Context, teamID string) ([]domain.Member, error)
+	GetByName(ctx context.Context, teamName string) (*domain.Team, error)
+}
+
+type CodeOwnersRepository interface {
+	ListByRepository(ctx context.Context, repositoryID string) ([]domain.CodeOwnersRule, error)
+}
+
+type RepoRepository interface {
+	GetByName(ctx context.Context, name string) (*domain.Repository, error)
+}
+
+// EventPublisher получает события о назначениях, одобрении и слиянии Pull Request'ов.
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event)
+}
+
+// TxManager выполняет функцию в транзакции, которую репозитории получают через контекст,
+// и откладывает побочные действия до её фиксации.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
+}
+
+// MetricsRecorder учитывает в метриках исходы переназначения ревьюверов.
+type MetricsRecorder interface {
+	ReviewerReassigned()
+	NoCandidates()
 }
 
 type Service struct {
 	lgr *slog.Logger
 
-	prRepo   PrRepository
-	userRepo UserRepository
-	teamRepo TeamRepository
+	prRepo         PrRepository
+	userRepo       UserRepository
+	teamRepo       TeamRepository
+	codeOwnersRepo CodeOwnersRepository
+	repoRepo       RepoRepository
+	publisher      EventPublisher
+	metrics        MetricsRecorder
+	txManager      TxManager
 
 	maxReviewers int // максимальное количество ревьюверов на PR
 }
@@ -43,31 +85,59 @@ func New(
 	prRepo PrRepository,
 	userRepo UserRepository,
 	teamRepo TeamRepository,
+	codeOwnersRepo CodeOwnersRepository,
+	repoRepo RepoRepository,
+	publisher EventPublisher,
+	metrics MetricsRecorder,
+	txManager TxManager,
 	maxReviewers int,
 ) *Service {
 	return &Service{
-		lgr:          lgr,
-		prRepo:       prRepo,
-		userRepo:     userRepo,
-		teamRepo:     teamRepo,
-		maxReviewers: maxReviewers,
+		lgr:            lgr,
+		prRepo:         prRepo,
+		userRepo:       userRepo,
+		teamRepo:       teamRepo,
+		codeOwnersRepo: codeOwnersRepo,
+		repoRepo:       repoRepo,
+		publisher:      publisher,
+		metrics:        metrics,
+		txManager:      txManager,
+		maxReviewers:   maxReviewers,
 	}
 }
 
-// CreatePullRequest создаёт новый Pull Request с указанным ID, именем и автором.
-// Выбирает ревьюверов из активных участников команды автора.
-// Если Pull Request с таким ID уже существует, возвращается ошибка svcErr.ErrPRExists.
+// CreatePullRequest создаёт новый Pull Request с указанным ID, именем и автором в репозитории.
+// Пустое имя репозитория означает репозиторий по умолчанию.
+// Если передан список изменённых файлов, сначала назначаются владельцы затронутых путей
+// по правилам CODEOWNERS репозитория, а оставшиеся места заполняются
+// активными участниками команды автора.
+// Количество ревьюверов ограничено настройкой репозитория, а если она не задана — общей настройкой.
+// Если Pull Request с таким ID уже существует в репозитории, возвращается ошибка svcErr.ErrPRExists.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
 // Если автор не найден, возвращается ошибка svcErr.ErrUserNotFound.
-func (s *Service) CreatePullRequest(ctx context.Context, id, name, authorID string) (*domain.PullRequest, error) {
+func (s *Service) CreatePullRequest(
+	ctx context.Context,
+	repository, id, name, authorID string,
+	changedFiles []string,
+) (*domain.PullRequest, error) {
 	const op = "pullrequest.CreatePullRequest"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	lgr := s.lgr.With(
 		slog.String("op", op),
 		slog.String("pull_request_id", id),
 		slog.String("name", name),
 		slog.String("author_id", authorID),
+		slog.String("repository", repository),
 	)
 
+	repo, err := s.repository(ctx, repository, lgr)
+	if err != nil {
+		return nil, err
+	}
+
 	author, err := s.userRepo.GetByID(ctx, authorID)
 	if errors.Is(err, repoErr.ErrUserNotFound) {
 		lgr.DebugContext(ctx, "author not found", slog.String("error", err.Error()))
@@ -80,6 +150,18 @@ func (s *Service) CreatePullRequest(ctx context.Context, id, name, authorID stri
 		return nil, err
 	}
 
+	maxReviewers := s.maxReviewers
+	if repo.Settings.MaxReviewers != nil {
+		maxReviewers = *repo.Settings.MaxReviewers
+	}
+
+	ownerReviewers, err := s.selectOwnerReviewers(ctx, repo.ID, changedFiles, authorID, maxReviewers)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to select code owners as reviewers", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
 	teamMembers, err := s.teamRepo.GetActiveMembersByTeamID(ctx, author.TeamID)
 	if err != nil {
 		lgr.ErrorContext(ctx, "failed to get team members by team ID", slog.String("error", err.Error()))
@@ -87,9 +169,16 @@ func (s *Service) CreatePullRequest(ctx context.Context, id, name, authorID stri
 		return nil, err
 	}
 
-	reviewers := s.selectReviewers(teamMembers, authorID, s.maxReviewers)
+	teamMembers = slices.DeleteFunc(teamMembers, func(m domain.Member) bool {
+		return slices.Contains(ownerReviewers, m.ID)
+	})
+
+	reviewers := append(ownerReviewers,
+		s.selectReviewers(teamMembers, authorID, maxReviewers-len(ownerReviewers))...)
 
 	pr := &domain.PullRequest{
+		RepositoryID:        repo.ID,
+		Repository:          repo.Name,
 		ID:                  id,
 		Name:                name,
 		AuthorID:            authorID,
@@ -112,22 +201,74 @@ func (s *Service) CreatePullRequest(ctx context.Context, id, name, authorID stri
 
 	lgr.InfoContext(ctx, "pull request created", slog.String("pull_request_id", pr.ID))
 
+	if len(pr.Reviewers) > 0 {
+		s.publish(ctx, lgr, domain.EventReviewersAssigned, domain.ReviewersAssignedEvent{
+			PullRequest: *pr,
+			ReviewerIDs: pr.Reviewers,
+		})
+	}
+
 	return pr, nil
 }
 
-// SetMerged помечает указанный Pull Request как merged.
+// GetPullRequest возвращает Pull Request репозитория.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
+// Если Pull Request не найден, возвращается ошибка svcErr.ErrPRNotFound.
+func (s *Service) GetPullRequest(ctx context.Context, repository, prID string) (*domain.PullRequest, error) {
+	const op = "pullrequest.GetPullRequest"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("repository", repository),
+		slog.String("pull_request_id", prID),
+	)
+
+	repo, err := s.repository(ctx, repository, lgr)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequest, err := s.prRepo.GetByID(ctx, repo.ID, prID)
+	if errors.Is(err, repoErr.ErrPRNotFound) {
+		lgr.DebugContext(ctx, "pull request not found", slog.String("error", err.Error()))
+
+		return nil, svcErr.ErrPRNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get pull request", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return pullRequest, nil
+}
+
+// SetMerged помечает указанный Pull Request репозитория как merged.
 // Количество ревьюверов не влияет на возможность слияния.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
 // Если Pull Request не найден, возвращается ошибка svcErr.ErrPRNotFound.
 // Если Pull Request уже помечен как merged, возвращается его текущее состояние - идемпотентная операция.
-func (s *Service) SetMerged(ctx context.Context, prID string) (*domain.PullRequest, error) {
+func (s *Service) SetMerged(ctx context.Context, repository, prID string) (*domain.PullRequest, error) {
 	const op = "pullrequest.SetMerged"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	lgr := s.lgr.With(
 		slog.String("op", op),
+		slog.String("repository", repository),
 		slog.String("pull_request_id", prID),
 	)
 
-	pullRequest, err := s.prRepo.GetByID(ctx, prID)
+	repo, err := s.repository(ctx, repository, lgr)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequest, err := s.prRepo.GetByID(ctx, repo.ID, prID)
 	if errors.Is(err, repoErr.ErrPRNotFound) {
 		lgr.DebugContext(ctx, "pull request not found", slog.String("error", err.Error()))
 
@@ -143,7 +284,7 @@ func (s *Service) SetMerged(ctx context.Context, prID string) (*domain.PullReque
 		return pullRequest, nil
 	}
 
-	mergedPR, err := s.prRepo.SetMerged(ctx, prID)
+	mergedPR, err := s.prRepo.SetMerged(ctx, repo.ID, prID)
 	if errors.Is(err, repoErr.ErrPRNotFound) {
 		lgr.DebugContext(ctx, "pull request not found", slog.String("error", err.Error()))
 
@@ -157,53 +298,274 @@ func (s *Service) SetMerged(ctx context.Context, prID string) (*domain.PullReque
 
 	lgr.InfoContext(ctx, "pull request marked as merged", slog.String("pull_request_id", prID))
 
+	s.publish(ctx, lgr, domain.EventPullRequestMerged, domain.PullRequestEvent{PullRequest: *mergedPR})
+
 	return mergedPR, nil
 }
 
+// ReassignReviewer заменяет ревьювера Pull Request'а случайным активным участником команды автора.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
+// Если Pull Request не найден, возвращается ошибка svcErr.ErrPRNotFound.
+// Если Pull Request уже помечен как merged, возвращается ошибка svcErr.ErrPRAlreadyMerged.
+// Если подходящих кандидатов нет, возвращается ошибка svcErr.ErrPRNoCandidates.
 func (s *Service) ReassignReviewer(
 	ctx context.Context,
-	prID, oldReviewerID string,
+	repository, prID, oldReviewerID string,
 ) (*domain.PullRequest, string, error) {
 	const op = "pullrequest.ReassignReviewer"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	lgr := s.lgr.With(
 		slog.String("op", op),
+		slog.String("repository", repository),
 		slog.String("pull_request_id", prID),
 		slog.String("old_reviewer_id", oldReviewerID),
 	)
 
-	pullRequest, err := s.prRepo.GetByID(ctx, prID)
+	repo, err := s.repository(ctx, repository, lgr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Проверка статуса и выбор кандидата выполняются в транзакции под блокировкой
+	// Pull Request'а, поэтому параллельные переназначения не выберут одного и того же
+	// ревьювера, а переназначение не состоится после слияния. Кандидаты читаются
+	// в той же транзакции: иначе каждое переназначение держало бы два подключения
+	// пула, и при исчерпании пула параллельные переназначения ждали бы друг друга.
+	var (
+		updatedPR     *domain.PullRequest
+		newReviewerID string
+		chooseErr     error
+	)
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		updatedPR, newReviewerID, err = s.prRepo.ReassignReviewer(ctx, repo.ID, prID, oldReviewerID,
+			func(pr *domain.PullRequest) (string, error) {
+				if pr.Status == domain.PRStatusMerged {
+					chooseErr = svcErr.ErrPRAlreadyMerged
+
+					return "", chooseErr
+				}
+
+				var newReviewerID string
+				newReviewerID, chooseErr = s.chooseNewReviewer(ctx, pr, oldReviewerID, lgr)
+
+				return newReviewerID, chooseErr
+			})
+		if err != nil {
+			return err
+		}
+
+		// Событие записывается в журнал в этой транзакции, а метрика и рассылка
+		// выполняются после фиксации: если переназначение выполняется во внешней
+		// транзакции, они не опередят её фиксацию и не повторятся при её повторе.
+		s.txManager.AfterCommit(ctx, func(context.Context) {
+			s.metrics.ReviewerReassigned()
+		})
+		s.publish(ctx, lgr, domain.EventReviewerReassigned, domain.ReviewerReassignedEvent{
+			PullRequest:   *updatedPR,
+			OldReviewerID: oldReviewerID,
+			NewReviewerID: newReviewerID,
+		})
+
+		return nil
+	})
+	if errors.Is(chooseErr, svcErr.ErrPRNoCandidates) {
+		s.txManager.AfterCommit(ctx, func(context.Context) {
+			s.metrics.NoCandidates()
+		})
+	}
+	if chooseErr != nil {
+		return nil, "", chooseErr
+	}
 	if errors.Is(err, repoErr.ErrPRNotFound) {
 		lgr.DebugContext(ctx, "pull request not found", slog.String("error", err.Error()))
 
 		return nil, "", svcErr.ErrPRNotFound
 	}
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "old reviewer is not assigned", slog.String("error", err.Error()))
+
+		return nil, "", svcErr.ErrUserNotFound
+	}
 	if err != nil {
+		lgr.ErrorContext(ctx, "failed to reassign reviewer", slog.String("error", err.Error()))
+
 		return nil, "", err
 	}
 
-	if pullRequest.Status == domain.PRStatusMerged {
-		return nil, "", svcErr.ErrPRAlreadyMerged
+	return updatedPR, newReviewerID, nil
+}
+
+// SubmitReview сохраняет ревью назначенного ревьювера. Время ревью используется
+// в аналитике времени прохождения ревью.
+// Если состояние ревью неизвестно, возвращается ошибка svcErr.ErrInvalidReviewState.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
+// Если Pull Request не найден, возвращается ошибка svcErr.ErrPRNotFound.
+// Если Pull Request уже помечен как merged, возвращается ошибка svcErr.ErrPRAlreadyMerged.
+// Если пользователь не назначен ревьювером, возвращается ошибка svcErr.ErrReviewerNotAssigned.
+func (s *Service) SubmitReview(
+	ctx context.Context,
+	repository, prID, reviewerID string,
+	state domain.ReviewState,
+) (*domain.Review, error) {
+	const op = "pullrequest.SubmitReview"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("repository", repository),
+		slog.String("pull_request_id", prID),
+		slog.String("reviewer_id", reviewerID),
+	)
+
+	if !state.IsValid() {
+		return nil, svcErr.ErrInvalidReviewState
 	}
 
-	newReviewerID, err := s.chooseNewReviewer(ctx, pullRequest, oldReviewerID, lgr)
+	repo, err := s.repository(ctx, repository, lgr)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	updatedPR, err := s.prRepo.UpdateReviewer(ctx, prID, oldReviewerID, newReviewerID)
+	pullRequest, err := s.prRepo.GetByID(ctx, repo.ID, prID)
 	if errors.Is(err, repoErr.ErrPRNotFound) {
 		lgr.DebugContext(ctx, "pull request not found", slog.String("error", err.Error()))
 
-		return nil, "", svcErr.ErrPRNotFound
+		return nil, svcErr.ErrPRNotFound
 	}
 	if err != nil {
-		lgr.ErrorContext(ctx, "failed to reassign reviewer", slog.String("error", err.Error()))
+		return nil, err
+	}
 
-		return nil, "", err
+	if pullRequest.Status == domain.PRStatusMerged {
+		return nil, svcErr.ErrPRAlreadyMerged
 	}
 
-	return updatedPR, newReviewerID, nil
+	review, err := s.prRepo.AddReview(ctx, &domain.Review{
+		RepositoryID:  repo.ID,
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+		State:         state,
+	})
+	if errors.Is(err, repoErr.ErrReviewerNotAssigned) {
+		lgr.DebugContext(ctx, "reviewer is not assigned", slog.String("error", err.Error()))
+
+		return nil, svcErr.ErrReviewerNotAssigned
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to add review", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	review.Repository = repo.Name
+
+	lgr.InfoContext(ctx, "review submitted", slog.String("state", string(state)))
+
+	if state == domain.ReviewStateApproved {
+		s.notifyIfApproved(ctx, lgr, pullRequest)
+	}
+
+	return review, nil
+}
+
+// notifyIfApproved публикует событие одобрения, если Pull Request одобрен всеми ревьюверами.
+// Ревью к этому моменту уже сохранено, поэтому ошибка проверки только логируется.
+func (s *Service) notifyIfApproved(ctx context.Context, lgr *slog.Logger, pr *domain.PullRequest) {
+	approved, err := s.prRepo.IsApproved(ctx, pr.RepositoryID, pr.ID)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to check pull request approval", slog.String("error", err.Error()))
+
+		return
+	}
+
+	if approved {
+		lgr.InfoContext(ctx, "pull request approved by all reviewers")
+
+		s.publish(ctx, lgr, domain.EventPullRequestApproved, domain.PullRequestEvent{PullRequest: *pr})
+	}
+}
+
+func (s *Service) publish(ctx context.Context, lgr *slog.Logger, eventType domain.EventType, data any) {
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to publish event", slog.String("event", string(eventType)),
+			slog.String("error", err.Error()))
+
+		return
+	}
+
+	s.publisher.Publish(ctx, domain.Event{
+		Type:       eventType,
+		OrgID:      orgID,
+		OccurredAt: time.Now(),
+		Data:       data,
+	})
+}
+
+// OverdueAssignments возвращает назначения, просроченные по SLA команды ревьювера,
+// по которым ревью так и не появилось. Если teamName не пуст, возвращаются только
+// назначения ревьюверов этой команды.
+// Если команда не найдена, возвращается ошибка svcErr.ErrTeamNotFound.
+func (s *Service) OverdueAssignments(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error) {
+	const op = "pullrequest.OverdueAssignments"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("team_name", teamName),
+	)
+
+	if teamName != "" {
+		_, err := s.teamRepo.GetByName(ctx, teamName)
+		if errors.Is(err, repoErr.ErrTeamNotFound) {
+			lgr.DebugContext(ctx, "team not found")
+
+			return nil, svcErr.ErrTeamNotFound
+		}
+		if err != nil {
+			lgr.ErrorContext(ctx, "failed to get team", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+	}
+
+	assignments, err := s.prRepo.ListOverdue(ctx, teamName)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to list overdue assignments", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// repository возвращает репозиторий по имени. Пустое имя означает репозиторий по умолчанию.
+func (s *Service) repository(ctx context.Context, name string, lgr *slog.Logger) (*domain.Repository, error) {
+	if name == "" {
+		name = domain.DefaultRepositoryName
+	}
+
+	repo, err := s.repoRepo.GetByName(ctx, name)
+	if errors.Is(err, repoErr.ErrRepositoryNotFound) {
+		lgr.DebugContext(ctx, "repository not found", slog.String("error", err.Error()))
+
+		return nil, svcErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get repository by name", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return repo, nil
 }
 
 func (s *Service) chooseNewReviewer(
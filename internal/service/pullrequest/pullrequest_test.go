@@ -2,6 +2,7 @@ package pullrequest
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"testing"
 	"time"
@@ -12,27 +13,48 @@ import (
 	svcErr "avitotech-pr-reviewer/internal/service/errors"
 	"avitotech-pr-reviewer/internal/service/pullrequest/mocks"
 	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+var defaultRepository = &domain.Repository{ID: "repo-1", Name: domain.DefaultRepositoryName}
+
 func TestService_CreatePullRequest(t *testing.T) {
 	tests := []struct {
-		name          string
-		prID          string
-		prName        string
-		authorID      string
-		setupMock     func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository)
-		expectedPR    *domain.PullRequest
-		expectedError error
+		name         string
+		prID         string
+		prName       string
+		authorID     string
+		repository   string
+		changedFiles []string
+		setupMock    func(
+			m *mocks.MockPrRepository,
+			um *mocks.MockUserRepository,
+			tm *mocks.MockTeamRepository,
+			cm *mocks.MockCodeOwnersRepository,
+			rm *mocks.MockRepoRepository,
+		)
+		expectedPR     *domain.PullRequest
+		expectedError  error
+		expectedEvents []domain.EventType
 	}{
 		{
 			name:     "success - pull request created with reviewers",
 			prID:     "pr-123",
 			prName:   "Add new feature",
 			authorID: "user-1",
-			setupMock: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
+			setupMock: func(
+				m *mocks.MockPrRepository,
+				um *mocks.MockUserRepository,
+				tm *mocks.MockTeamRepository,
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+			) {
+				rm.On("GetByName", mock.Anything, domain.DefaultRepositoryName).
+					Return(defaultRepository, nil)
+
 				um.On("GetByID", mock.Anything, "user-1").
 					Return(&domain.User{ID: "user-1", TeamID: "team-1"}, nil)
 
@@ -59,14 +81,264 @@ func TestService_CreatePullRequest(t *testing.T) {
 				Status:    domain.PRStatusOpen,
 				Reviewers: []string{"user-2", "user-3"},
 			},
-			expectedError: nil,
+			expectedError:  nil,
+			expectedEvents: []domain.EventType{domain.EventReviewersAssigned},
+		},
+		{
+			name:         "success - code owners of every touched path assigned",
+			prID:         "pr-124",
+			prName:       "Update docs and handlers",
+			authorID:     "user-1",
+			repository:   "backend",
+			changedFiles: []string{"docs/api.md", "internal/api/handler.go"},
+			setupMock: func(
+				m *mocks.MockPrRepository,
+				um *mocks.MockUserRepository,
+				tm *mocks.MockTeamRepository,
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "backend").
+					Return(&domain.Repository{ID: "repo-2", Name: "backend"}, nil)
+
+				um.On("GetByID", mock.Anything, "user-1").
+					Return(&domain.User{ID: "user-1", TeamID: "team-1", IsActive: true}, nil)
+
+				cm.On("ListByRepository", mock.Anything, "repo-2").
+					Return([]domain.CodeOwnersRule{
+						{Pattern: "/docs/", UserIDs: []string{"user-5"}},
+						{Pattern: "*.go", Teams: []domain.Team{{ID: "team-2", Name: "platform"}}},
+					}, nil)
+
+				um.On("GetByID", mock.Anything, "user-5").
+					Return(&domain.User{ID: "user-5", TeamID: "team-3", IsActive: true}, nil)
+
+				tm.On("GetActiveMembersByTeamID", mock.Anything, "team-2").
+					Return([]domain.Member{
+						{ID: "user-6", Username: "PlatformOwner", IsActive: true},
+					}, nil)
+
+				tm.On("GetActiveMembersByTeamID", mock.Anything, "team-1").
+					Return([]domain.Member{
+						{ID: "user-2", Username: "Reviewer1", IsActive: true},
+					}, nil)
+
+				m.On("Create", mock.Anything, mock.MatchedBy(func(pr *domain.PullRequest) bool {
+					return assert.ElementsMatch(t, []string{"user-5", "user-6"}, pr.Reviewers)
+				})).Return(&domain.PullRequest{
+					ID:        "pr-124",
+					Name:      "Update docs and handlers",
+					AuthorID:  "user-1",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"user-5", "user-6"},
+				}, nil)
+			},
+			expectedPR: &domain.PullRequest{
+				ID:        "pr-124",
+				Name:      "Update docs and handlers",
+				AuthorID:  "user-1",
+				Status:    domain.PRStatusOpen,
+				Reviewers: []string{"user-5", "user-6"},
+			},
+			expectedError:  nil,
+			expectedEvents: []domain.EventType{domain.EventReviewersAssigned},
+		},
+		{
+			name:         "success - single owner covers all paths, rest filled from team",
+			prID:         "pr-125",
+			prName:       "Refactor",
+			authorID:     "user-1",
+			repository:   "backend",
+			changedFiles: []string{"main.go", "README.md"},
+			setupMock: func(
+				m *mocks.MockPrRepository,
+				um *mocks.MockUserRepository,
+				tm *mocks.MockTeamRepository,
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "backend").
+					Return(&domain.Repository{ID: "repo-2", Name: "backend"}, nil)
+
+				um.On("GetByID", mock.Anything, "user-1").
+					Return(&domain.User{ID: "user-1", TeamID: "team-1", IsActive: true}, nil)
+
+				cm.On("ListByRepository", mock.Anything, "repo-2").
+					Return([]domain.CodeOwnersRule{
+						{Pattern: "*", UserIDs: []string{"user-2"}},
+					}, nil)
+
+				um.On("GetByID", mock.Anything, "user-2").
+					Return(&domain.User{ID: "user-2", TeamID: "team-1", IsActive: true}, nil)
+
+				tm.On("GetActiveMembersByTeamID", mock.Anything, "team-1").
+					Return([]domain.Member{
+						{ID: "user-1", Username: "Author", IsActive: true},
+						{ID: "user-2", Username: "Owner", IsActive: true},
+						{ID: "user-3", Username: "Reviewer", IsActive: true},
+					}, nil)
+
+				m.On("Create", mock.Anything, mock.MatchedBy(func(pr *domain.PullRequest) bool {
+					return assert.Equal(t, []string{"user-2", "user-3"}, pr.Reviewers)
+				})).Return(&domain.PullRequest{
+					ID:        "pr-125",
+					Name:      "Refactor",
+					AuthorID:  "user-1",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"user-2", "user-3"},
+				}, nil)
+			},
+			expectedPR: &domain.PullRequest{
+				ID:        "pr-125",
+				Name:      "Refactor",
+				AuthorID:  "user-1",
+				Status:    domain.PRStatusOpen,
+				Reviewers: []string{"user-2", "user-3"},
+			},
+			expectedError:  nil,
+			expectedEvents: []domain.EventType{domain.EventReviewersAssigned},
+		},
+		{
+			name:         "success - author and inactive owners skipped, team strategy used",
+			prID:         "pr-126",
+			prName:       "Fix typo",
+			authorID:     "user-1",
+			repository:   "backend",
+			changedFiles: []string{"docs/api.md"},
+			setupMock: func(
+				m *mocks.MockPrRepository,
+				um *mocks.MockUserRepository,
+				tm *mocks.MockTeamRepository,
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "backend").
+					Return(&domain.Repository{ID: "repo-2", Name: "backend"}, nil)
+
+				um.On("GetByID", mock.Anything, "user-1").
+					Return(&domain.User{ID: "user-1", TeamID: "team-1", IsActive: true}, nil)
+
+				cm.On("ListByRepository", mock.Anything, "repo-2").
+					Return([]domain.CodeOwnersRule{
+						{Pattern: "docs/", UserIDs: []string{"user-1", "user-7"}},
+					}, nil)
+
+				um.On("GetByID", mock.Anything, "user-7").
+					Return(&domain.User{ID: "user-7", TeamID: "team-1", IsActive: false}, nil)
+
+				tm.On("GetActiveMembersByTeamID", mock.Anything, "team-1").
+					Return([]domain.Member{
+						{ID: "user-2", Username: "Reviewer", IsActive: true},
+					}, nil)
+
+				m.On("Create", mock.Anything, mock.MatchedBy(func(pr *domain.PullRequest) bool {
+					return assert.Equal(t, []string{"user-2"}, pr.Reviewers)
+				})).Return(&domain.PullRequest{
+					ID:        "pr-126",
+					Name:      "Fix typo",
+					AuthorID:  "user-1",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"user-2"},
+				}, nil)
+			},
+			expectedPR: &domain.PullRequest{
+				ID:        "pr-126",
+				Name:      "Fix typo",
+				AuthorID:  "user-1",
+				Status:    domain.PRStatusOpen,
+				Reviewers: []string{"user-2"},
+			},
+			expectedError:  nil,
+			expectedEvents: []domain.EventType{domain.EventReviewersAssigned},
+		},
+		{
+			name:       "success - repository setting limits reviewers count",
+			prID:       "pr-127",
+			prName:     "Small fix",
+			authorID:   "user-1",
+			repository: "frontend",
+			setupMock: func(
+				m *mocks.MockPrRepository,
+				um *mocks.MockUserRepository,
+				tm *mocks.MockTeamRepository,
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+			) {
+				maxReviewers := 1
+				rm.On("GetByName", mock.Anything, "frontend").
+					Return(&domain.Repository{
+						ID:       "repo-3",
+						Name:     "frontend",
+						Settings: domain.RepositorySettings{MaxReviewers: &maxReviewers},
+					}, nil)
+
+				um.On("GetByID", mock.Anything, "user-1").
+					Return(&domain.User{ID: "user-1", TeamID: "team-1"}, nil)
+
+				tm.On("GetActiveMembersByTeamID", mock.Anything, "team-1").
+					Return([]domain.Member{
+						{ID: "user-2", Username: "Reviewer1", IsActive: true},
+						{ID: "user-3", Username: "Reviewer2", IsActive: true},
+					}, nil)
+
+				m.On("Create", mock.Anything, mock.MatchedBy(func(pr *domain.PullRequest) bool {
+					return pr.RepositoryID == "repo-3" && len(pr.Reviewers) == 1
+				})).Return(&domain.PullRequest{
+					RepositoryID: "repo-3",
+					Repository:   "frontend",
+					ID:           "pr-127",
+					Name:         "Small fix",
+					AuthorID:     "user-1",
+					Status:       domain.PRStatusOpen,
+					Reviewers:    []string{"user-2"},
+				}, nil)
+			},
+			expectedPR: &domain.PullRequest{
+				RepositoryID: "repo-3",
+				Repository:   "frontend",
+				ID:           "pr-127",
+				Name:         "Small fix",
+				AuthorID:     "user-1",
+				Status:       domain.PRStatusOpen,
+				Reviewers:    []string{"user-2"},
+			},
+			expectedError:  nil,
+			expectedEvents: []domain.EventType{domain.EventReviewersAssigned},
+		},
+		{
+			name:       "error - repository not found",
+			prID:       "pr-128",
+			prName:     "Orphan",
+			authorID:   "user-1",
+			repository: "unknown",
+			setupMock: func(
+				m *mocks.MockPrRepository,
+				um *mocks.MockUserRepository,
+				tm *mocks.MockTeamRepository,
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+			) {
+				rm.On("GetByName", mock.Anything, "unknown").
+					Return(nil, repoErr.ErrRepositoryNotFound)
+			},
+			expectedPR:    nil,
+			expectedError: svcErr.ErrRepositoryNotFound,
 		},
 		{
 			name:     "error - pull request already exists",
 			prID:     "pr-123",
 			prName:   "Add new feature",
 			authorID: "user-1",
-			setupMock: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
+			setupMock: func(
+				m *mocks.MockPrRepository,
+				um *mocks.MockUserRepository,
+				tm *mocks.MockTeamRepository,
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+			) {
+				rm.On("GetByName", mock.Anything, domain.DefaultRepositoryName).
+					Return(defaultRepository, nil)
+
 				um.On("GetByID", mock.Anything, "user-1").
 					Return(&domain.User{ID: "user-1", TeamID: "team-1"}, nil)
 
@@ -86,7 +358,16 @@ func TestService_CreatePullRequest(t *testing.T) {
 			prID:     "pr-123",
 			prName:   "Add new feature",
 			authorID: "user-unknown",
-			setupMock: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
+			setupMock: func(
+				m *mocks.MockPrRepository,
+				um *mocks.MockUserRepository,
+				tm *mocks.MockTeamRepository,
+				cm *mocks.MockCodeOwnersRepository,
+				rm *mocks.MockRepoRepository,
+			) {
+				rm.On("GetByName", mock.Anything, domain.DefaultRepositoryName).
+					Return(defaultRepository, nil)
+
 				um.On("GetByID", mock.Anything, "user-unknown").
 					Return(nil, repoErr.ErrUserNotFound)
 			},
@@ -100,21 +381,28 @@ func TestService_CreatePullRequest(t *testing.T) {
 			mockPrRepo := mocks.NewMockPrRepository(t)
 			mockUserRepo := mocks.NewMockUserRepository(t)
 			mockTeamRepo := mocks.NewMockTeamRepository(t)
+			mockCodeOwnersRepo := mocks.NewMockCodeOwnersRepository(t)
+			mockRepoRepo := mocks.NewMockRepoRepository(t)
 
-			tt.setupMock(mockPrRepo, mockUserRepo, mockTeamRepo)
+			tt.setupMock(mockPrRepo, mockUserRepo, mockTeamRepo, mockCodeOwnersRepo, mockRepoRepo)
 
 			lgr := slog.New(slog.DiscardHandler)
 
+			publisher, published := newPublisher(t)
+
 			svc := &Service{
-				lgr:          lgr,
-				prRepo:       mockPrRepo,
-				userRepo:     mockUserRepo,
-				teamRepo:     mockTeamRepo,
-				maxReviewers: 2,
+				lgr:            lgr,
+				prRepo:         mockPrRepo,
+				userRepo:       mockUserRepo,
+				teamRepo:       mockTeamRepo,
+				codeOwnersRepo: mockCodeOwnersRepo,
+				repoRepo:       mockRepoRepo,
+				publisher:      publisher,
+				maxReviewers:   2,
 			}
 
-			ctx := context.Background()
-			pr, err := svc.CreatePullRequest(ctx, tt.prID, tt.prName, tt.authorID)
+			ctx := orgContext()
+			pr, err := svc.CreatePullRequest(ctx, tt.repository, tt.prID, tt.prName, tt.authorID, tt.changedFiles)
 
 			if tt.expectedError != nil {
 				require.ErrorIs(t, err, tt.expectedError)
@@ -123,30 +411,93 @@ func TestService_CreatePullRequest(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, tt.expectedPR, pr)
 			}
+			assert.Equal(t, tt.expectedEvents, *published)
 
 			mockPrRepo.AssertExpectations(t)
 			mockUserRepo.AssertExpectations(t)
 			mockTeamRepo.AssertExpectations(t)
+			mockCodeOwnersRepo.AssertExpectations(t)
+			mockRepoRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestService_SetMerged(t *testing.T) {
-	createdTime := time.Now().Add(-1 * time.Hour)
-	mergedTime := time.Now()
-
+func TestService_GetPullRequest(t *testing.T) {
 	tests := []struct {
 		name          string
 		prID          string
 		setupMock     func(m *mocks.MockPrRepository)
 		expectedPR    *domain.PullRequest
 		expectedError error
+	}{
+		{
+			name: "success - pull request found",
+			prID: "pr-100",
+			setupMock: func(m *mocks.MockPrRepository) {
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
+					Return(&domain.PullRequest{ID: "pr-100", AuthorID: "u123", Status: domain.PRStatusOpen}, nil)
+			},
+			expectedPR: &domain.PullRequest{ID: "pr-100", AuthorID: "u123", Status: domain.PRStatusOpen},
+		},
+		{
+			name: "error - pr not found",
+			prID: "pr-000",
+			setupMock: func(m *mocks.MockPrRepository) {
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-000").
+					Return(nil, repoErr.ErrPRNotFound)
+			},
+			expectedError: svcErr.ErrPRNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPrRepo := mocks.NewMockPrRepository(t)
+
+			mockRepoRepo := mocks.NewMockRepoRepository(t)
+			mockRepoRepo.On("GetByName", mock.Anything, domain.DefaultRepositoryName).
+				Return(defaultRepository, nil)
+
+			tt.setupMock(mockPrRepo)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				prRepo:   mockPrRepo,
+				repoRepo: mockRepoRepo,
+			}
+
+			pr, err := svc.GetPullRequest(orgContext(), "", tt.prID)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, pr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPR, pr)
+			}
+
+			mockPrRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_SetMerged(t *testing.T) {
+	createdTime := time.Now().Add(-1 * time.Hour)
+	mergedTime := time.Now()
+
+	tests := []struct {
+		name           string
+		prID           string
+		setupMock      func(m *mocks.MockPrRepository)
+		expectedPR     *domain.PullRequest
+		expectedError  error
+		expectedEvents []domain.EventType
 	}{
 		{
 			name: "success - pull request set merged",
 			prID: "pr-100",
 			setupMock: func(m *mocks.MockPrRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
 					Return(&domain.PullRequest{
 						ID:        "pr-100",
 						Name:      "Add cart",
@@ -157,7 +508,7 @@ func TestService_SetMerged(t *testing.T) {
 						MergedAt:  nil,
 					}, nil)
 
-				m.On("SetMerged", mock.Anything, "pr-100", mock.Anything).
+				m.On("SetMerged", mock.Anything, defaultRepository.ID, "pr-100").
 					Return(&domain.PullRequest{
 						ID:        "pr-100",
 						Name:      "Add cart",
@@ -177,13 +528,14 @@ func TestService_SetMerged(t *testing.T) {
 				CreatedAt: createdTime,
 				MergedAt:  &mergedTime,
 			},
-			expectedError: nil,
+			expectedError:  nil,
+			expectedEvents: []domain.EventType{domain.EventPullRequestMerged},
 		},
 		{
 			name: "success - pr already merged, do nothing",
 			prID: "pr-100",
 			setupMock: func(m *mocks.MockPrRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
 					Return(&domain.PullRequest{
 						ID:        "pr-100",
 						Name:      "Add cart",
@@ -209,7 +561,7 @@ func TestService_SetMerged(t *testing.T) {
 			name: "success - but no reviewrs",
 			prID: "pr-100",
 			setupMock: func(m *mocks.MockPrRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
 					Return(&domain.PullRequest{
 						ID:        "pr-100",
 						Name:      "Add cart",
@@ -220,7 +572,7 @@ func TestService_SetMerged(t *testing.T) {
 						MergedAt:  nil,
 					}, nil)
 
-				m.On("SetMerged", mock.Anything, "pr-100", mock.Anything).
+				m.On("SetMerged", mock.Anything, defaultRepository.ID, "pr-100").
 					Return(&domain.PullRequest{
 						ID:        "pr-100",
 						Name:      "Add cart",
@@ -240,13 +592,14 @@ func TestService_SetMerged(t *testing.T) {
 				CreatedAt: createdTime,
 				MergedAt:  &mergedTime,
 			},
-			expectedError: nil,
+			expectedError:  nil,
+			expectedEvents: []domain.EventType{domain.EventPullRequestMerged},
 		},
 		{
 			name: "error - pr not founded",
 			prID: "pr-000",
 			setupMock: func(m *mocks.MockPrRepository) {
-				m.On("GetByID", mock.Anything, "pr-000").
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-000").
 					Return(nil, repoErr.ErrPRNotFound)
 			},
 			expectedPR:    nil,
@@ -258,17 +611,25 @@ func TestService_SetMerged(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockPrRepo := mocks.NewMockPrRepository(t)
 
+			mockRepoRepo := mocks.NewMockRepoRepository(t)
+			mockRepoRepo.On("GetByName", mock.Anything, domain.DefaultRepositoryName).
+				Return(defaultRepository, nil)
+
 			tt.setupMock(mockPrRepo)
 
 			lgr := slog.New(slog.DiscardHandler)
 
+			publisher, published := newPublisher(t)
+
 			svc := &Service{
-				lgr:    lgr,
-				prRepo: mockPrRepo,
+				lgr:       lgr,
+				prRepo:    mockPrRepo,
+				repoRepo:  mockRepoRepo,
+				publisher: publisher,
 			}
 
-			ctx := context.Background()
-			pr, err := svc.SetMerged(ctx, tt.prID)
+			ctx := orgContext()
+			pr, err := svc.SetMerged(ctx, "", tt.prID)
 
 			if tt.expectedError != nil {
 				require.ErrorIs(t, err, tt.expectedError)
@@ -277,12 +638,32 @@ func TestService_SetMerged(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tt.expectedPR, pr)
 			}
+			assert.Equal(t, tt.expectedEvents, *published)
 
 			mockPrRepo.AssertExpectations(t)
 		})
 	}
 }
 
+// expectReassign ожидает вызов ReassignReviewer и вызывает переданный сервисом choose
+// с Pull Request'ом locked, как это делает репозиторий под блокировкой. При успешном
+// выборе возвращается updated.
+func expectReassign(m *mocks.MockPrRepository, prID, oldReviewerID string, locked, updated *domain.PullRequest) {
+	m.On("ReassignReviewer", mock.Anything, defaultRepository.ID, prID, oldReviewerID, mock.Anything).
+		Return(func(
+			_ context.Context,
+			_, _, _ string,
+			choose func(pr *domain.PullRequest) (string, error),
+		) (*domain.PullRequest, string, error) {
+			newReviewerID, err := choose(locked)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return updated, newReviewerID, nil
+		}, "", nil)
+}
+
 func TestService_ReassignReviewer(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -292,20 +673,20 @@ func TestService_ReassignReviewer(t *testing.T) {
 		expectedPR         *domain.PullRequest
 		expectedReplacedBy []string
 		expectedError      error
+		expectedEvents     []domain.EventType
 	}{
 		{
 			name:        "success - команда из трех, только один был ревьювером",
 			prID:        "pr-100",
 			oldReviewID: "u100",
 			mockSetup: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u100"},
-					}, nil)
+				locked := &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u100"},
+				}
 
 				um.On("GetByID", mock.Anything, "u123").
 					Return(&domain.User{ID: "u123", TeamID: "team-1"}, nil)
@@ -317,14 +698,13 @@ func TestService_ReassignReviewer(t *testing.T) {
 						{ID: "u123", Username: "Author", IsActive: true},
 					}, nil)
 
-				m.On("UpdateReviewer", mock.Anything, "pr-100", "u100", "u101").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u101"},
-					}, nil)
+				expectReassign(m, "pr-100", "u100", locked, &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u101"},
+				})
 			},
 			expectedPR: &domain.PullRequest{
 				ID:        "pr-100",
@@ -335,20 +715,20 @@ func TestService_ReassignReviewer(t *testing.T) {
 			},
 			expectedReplacedBy: []string{"u101"},
 			expectedError:      nil,
+			expectedEvents:     []domain.EventType{domain.EventReviewerReassigned},
 		},
 		{
 			name:        "success - команда из четырех, 2 бывших ревьювера и автор, 1 кандидат",
 			prID:        "pr-100",
 			oldReviewID: "u100",
 			mockSetup: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u100", "u101"},
-					}, nil)
+				locked := &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u100", "u101"},
+				}
 
 				um.On("GetByID", mock.Anything, "u123").
 					Return(&domain.User{ID: "u123", TeamID: "team-1"}, nil)
@@ -361,14 +741,13 @@ func TestService_ReassignReviewer(t *testing.T) {
 						{ID: "u123", Username: "Author", IsActive: true},
 					}, nil)
 
-				m.On("UpdateReviewer", mock.Anything, "pr-100", "u100", "u102").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u101", "u102"},
-					}, nil)
+				expectReassign(m, "pr-100", "u100", locked, &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u101", "u102"},
+				})
 			},
 			expectedPR: &domain.PullRequest{
 				ID:        "pr-100",
@@ -379,20 +758,20 @@ func TestService_ReassignReviewer(t *testing.T) {
 			},
 			expectedReplacedBy: []string{"u102"},
 			expectedError:      nil,
+			expectedEvents:     []domain.EventType{domain.EventReviewerReassigned},
 		},
 		{
 			name:        "success - команда из пятерых, 2 бывших ревьювера и автор, 2 кандидата",
 			prID:        "pr-100",
 			oldReviewID: "u100",
 			mockSetup: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u100", "u101"},
-					}, nil)
+				locked := &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u100", "u101"},
+				}
 
 				um.On("GetByID", mock.Anything, "u123").
 					Return(&domain.User{ID: "u123", TeamID: "team-1"}, nil)
@@ -406,14 +785,13 @@ func TestService_ReassignReviewer(t *testing.T) {
 						{ID: "u123", Username: "Author", IsActive: true},
 					}, nil)
 
-				m.On("UpdateReviewer", mock.Anything, "pr-100", "u100", mock.Anything).
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u101", "u102"}, // или "u101", newID — не критично для теста
-					}, nil)
+				expectReassign(m, "pr-100", "u100", locked, &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u101", "u102"}, // или "u101", newID — не критично для теста
+				})
 			},
 			expectedPR: &domain.PullRequest{
 				ID:        "pr-100",
@@ -424,20 +802,20 @@ func TestService_ReassignReviewer(t *testing.T) {
 			},
 			expectedReplacedBy: []string{"u102", "u103"},
 			expectedError:      nil,
+			expectedEvents:     []domain.EventType{domain.EventReviewerReassigned},
 		},
 		{
 			name:        "error - нет кандидатов (в команде только два активных участника, один из которых - автор)",
 			prID:        "pr-100",
 			oldReviewID: "u100",
 			mockSetup: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u100"},
-					}, nil)
+				locked := &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u100"},
+				}
 
 				um.On("GetByID", mock.Anything, "u123").
 					Return(&domain.User{ID: "u123", TeamID: "team-1"}, nil)
@@ -447,6 +825,8 @@ func TestService_ReassignReviewer(t *testing.T) {
 						{ID: "u100", Username: "Reviewer1", IsActive: true},
 						{ID: "u123", Username: "Author", IsActive: true},
 					}, nil)
+
+				expectReassign(m, "pr-100", "u100", locked, nil)
 			},
 			expectedPR:    nil,
 			expectedError: svcErr.ErrPRNoCandidates,
@@ -456,14 +836,13 @@ func TestService_ReassignReviewer(t *testing.T) {
 			prID:        "pr-100",
 			oldReviewID: "u100",
 			mockSetup: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u100", "u101"},
-					}, nil)
+				locked := &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u100", "u101"},
+				}
 
 				um.On("GetByID", mock.Anything, "u123").
 					Return(&domain.User{ID: "u123", TeamID: "team-1"}, nil)
@@ -474,6 +853,8 @@ func TestService_ReassignReviewer(t *testing.T) {
 						{ID: "u101", Username: "Reviewer2", IsActive: true},
 						{ID: "u123", Username: "Author", IsActive: true},
 					}, nil)
+
+				expectReassign(m, "pr-100", "u100", locked, nil)
 			},
 			expectedPR:    nil,
 			expectedError: svcErr.ErrPRNoCandidates,
@@ -483,8 +864,8 @@ func TestService_ReassignReviewer(t *testing.T) {
 			prID:        "pr-999",
 			oldReviewID: "u100",
 			mockSetup: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
-				m.On("GetByID", mock.Anything, "pr-999").
-					Return(nil, repoErr.ErrPRNotFound)
+				m.On("ReassignReviewer", mock.Anything, defaultRepository.ID, "pr-999", "u100", mock.Anything).
+					Return(nil, "", repoErr.ErrPRNotFound)
 			},
 			expectedPR:    nil,
 			expectedError: svcErr.ErrPRNotFound,
@@ -494,14 +875,15 @@ func TestService_ReassignReviewer(t *testing.T) {
 			prID:        "pr-100",
 			oldReviewID: "u999",
 			mockSetup: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusOpen,
-						Reviewers: []string{"u100"},
-					}, nil)
+				locked := &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusOpen,
+					Reviewers: []string{"u100"},
+				}
+
+				expectReassign(m, "pr-100", "u999", locked, nil)
 			},
 			expectedPR:    nil,
 			expectedError: svcErr.ErrUserNotFound,
@@ -511,14 +893,15 @@ func TestService_ReassignReviewer(t *testing.T) {
 			prID:        "pr-100",
 			oldReviewID: "u100",
 			mockSetup: func(m *mocks.MockPrRepository, um *mocks.MockUserRepository, tm *mocks.MockTeamRepository) {
-				m.On("GetByID", mock.Anything, "pr-100").
-					Return(&domain.PullRequest{
-						ID:        "pr-100",
-						Name:      "Improve UI",
-						AuthorID:  "u123",
-						Status:    domain.PRStatusMerged,
-						Reviewers: []string{"u100"},
-					}, nil)
+				locked := &domain.PullRequest{
+					ID:        "pr-100",
+					Name:      "Improve UI",
+					AuthorID:  "u123",
+					Status:    domain.PRStatusMerged,
+					Reviewers: []string{"u100"},
+				}
+
+				expectReassign(m, "pr-100", "u100", locked, nil)
 			},
 			expectedPR:    nil,
 			expectedError: svcErr.ErrPRAlreadyMerged,
@@ -531,20 +914,37 @@ func TestService_ReassignReviewer(t *testing.T) {
 			mockUserRepo := mocks.NewMockUserRepository(t)
 			mockTeamRepo := mocks.NewMockTeamRepository(t)
 
+			mockRepoRepo := mocks.NewMockRepoRepository(t)
+			mockRepoRepo.On("GetByName", mock.Anything, domain.DefaultRepositoryName).
+				Return(defaultRepository, nil)
+
 			tt.mockSetup(mockPrRepo, mockUserRepo, mockTeamRepo)
 
 			lgr := slog.New(slog.DiscardHandler)
 
+			publisher, published := newPublisher(t)
+
+			recorder := mocks.NewMockMetricsRecorder(t)
+			if tt.expectedError == nil {
+				recorder.On("ReviewerReassigned").Return().Once()
+			}
+			if errors.Is(tt.expectedError, svcErr.ErrPRNoCandidates) {
+				recorder.On("NoCandidates").Return().Once()
+			}
+
 			svc := &Service{
-				lgr:      lgr,
-				prRepo:   mockPrRepo,
-				userRepo: mockUserRepo,
-				teamRepo: mockTeamRepo,
+				lgr:       lgr,
+				prRepo:    mockPrRepo,
+				userRepo:  mockUserRepo,
+				teamRepo:  mockTeamRepo,
+				repoRepo:  mockRepoRepo,
+				publisher: publisher,
+				metrics:   recorder,
+				txManager: newTxManager(t),
 			}
 
-			ctx := context.Background()
-			pr, newAddedReviewer, err := svc.ReassignReviewer(ctx, tt.prID, tt.oldReviewID)
-			_ = newAddedReviewer
+			ctx := orgContext()
+			pr, newAddedReviewer, err := svc.ReassignReviewer(ctx, "", tt.prID, tt.oldReviewID)
 
 			if tt.expectedError != nil {
 				require.ErrorIs(t, err, tt.expectedError)
@@ -552,11 +952,318 @@ func TestService_ReassignReviewer(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tt.expectedPR, pr)
+				assert.Contains(t, tt.expectedReplacedBy, newAddedReviewer)
 			}
+			assert.Equal(t, tt.expectedEvents, *published)
 
 			mockPrRepo.AssertExpectations(t)
 			mockUserRepo.AssertExpectations(t)
 			mockTeamRepo.AssertExpectations(t)
+
+			// Блокировка и выбор кандидата выполняются в одной транзакции.
+			for _, calls := range [][]mock.Call{mockPrRepo.Calls, mockUserRepo.Calls, mockTeamRepo.Calls} {
+				for _, call := range calls {
+					assert.True(t, inTx(call.Arguments.Get(0).(context.Context)), "%s must run in the transaction", call.Method)
+				}
+			}
 		})
 	}
 }
+
+func TestService_SubmitReview(t *testing.T) {
+	submittedAt := time.Now()
+
+	tests := []struct {
+		name           string
+		reviewerID     string
+		state          domain.ReviewState
+		setupMock      func(m *mocks.MockPrRepository)
+		expectedReview *domain.Review
+		expectedError  error
+		expectedEvents []domain.EventType
+	}{
+		{
+			name:       "success - review approved",
+			reviewerID: "u100",
+			state:      domain.ReviewStateApproved,
+			setupMock: func(m *mocks.MockPrRepository) {
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
+					Return(&domain.PullRequest{ID: "pr-100", Status: domain.PRStatusOpen}, nil)
+				m.On("AddReview", mock.Anything, &domain.Review{
+					RepositoryID:  defaultRepository.ID,
+					PullRequestID: "pr-100",
+					ReviewerID:    "u100",
+					State:         domain.ReviewStateApproved,
+				}).Return(&domain.Review{
+					RepositoryID:  defaultRepository.ID,
+					PullRequestID: "pr-100",
+					ReviewerID:    "u100",
+					State:         domain.ReviewStateApproved,
+					SubmittedAt:   submittedAt,
+				}, nil)
+				m.On("IsApproved", mock.Anything, mock.Anything, "pr-100").Return(true, nil)
+			},
+			expectedReview: &domain.Review{
+				RepositoryID:  defaultRepository.ID,
+				Repository:    defaultRepository.Name,
+				PullRequestID: "pr-100",
+				ReviewerID:    "u100",
+				State:         domain.ReviewStateApproved,
+				SubmittedAt:   submittedAt,
+			},
+			expectedEvents: []domain.EventType{domain.EventPullRequestApproved},
+		},
+		{
+			name:       "success - approved, other reviewers pending",
+			reviewerID: "u100",
+			state:      domain.ReviewStateApproved,
+			setupMock: func(m *mocks.MockPrRepository) {
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
+					Return(&domain.PullRequest{ID: "pr-100", Status: domain.PRStatusOpen}, nil)
+				m.On("AddReview", mock.Anything, mock.Anything).Return(&domain.Review{
+					RepositoryID:  defaultRepository.ID,
+					PullRequestID: "pr-100",
+					ReviewerID:    "u100",
+					State:         domain.ReviewStateApproved,
+					SubmittedAt:   submittedAt,
+				}, nil)
+				m.On("IsApproved", mock.Anything, mock.Anything, "pr-100").Return(false, nil)
+			},
+			expectedReview: &domain.Review{
+				RepositoryID:  defaultRepository.ID,
+				Repository:    defaultRepository.Name,
+				PullRequestID: "pr-100",
+				ReviewerID:    "u100",
+				State:         domain.ReviewStateApproved,
+				SubmittedAt:   submittedAt,
+			},
+		},
+		{
+			name:       "success - changes requested, approval not checked",
+			reviewerID: "u100",
+			state:      domain.ReviewStateChangesRequested,
+			setupMock: func(m *mocks.MockPrRepository) {
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
+					Return(&domain.PullRequest{ID: "pr-100", Status: domain.PRStatusOpen}, nil)
+				m.On("AddReview", mock.Anything, mock.Anything).Return(&domain.Review{
+					RepositoryID:  defaultRepository.ID,
+					PullRequestID: "pr-100",
+					ReviewerID:    "u100",
+					State:         domain.ReviewStateChangesRequested,
+					SubmittedAt:   submittedAt,
+				}, nil)
+			},
+			expectedReview: &domain.Review{
+				RepositoryID:  defaultRepository.ID,
+				Repository:    defaultRepository.Name,
+				PullRequestID: "pr-100",
+				ReviewerID:    "u100",
+				State:         domain.ReviewStateChangesRequested,
+				SubmittedAt:   submittedAt,
+			},
+		},
+		{
+			name:       "error - reviewer is not assigned",
+			reviewerID: "u999",
+			state:      domain.ReviewStateCommented,
+			setupMock: func(m *mocks.MockPrRepository) {
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
+					Return(&domain.PullRequest{ID: "pr-100", Status: domain.PRStatusOpen}, nil)
+				m.On("AddReview", mock.Anything, mock.Anything).Return(nil, repoErr.ErrReviewerNotAssigned)
+			},
+			expectedError: svcErr.ErrReviewerNotAssigned,
+		},
+		{
+			name:       "error - pr already merged",
+			reviewerID: "u100",
+			state:      domain.ReviewStateApproved,
+			setupMock: func(m *mocks.MockPrRepository) {
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
+					Return(&domain.PullRequest{ID: "pr-100", Status: domain.PRStatusMerged}, nil)
+			},
+			expectedError: svcErr.ErrPRAlreadyMerged,
+		},
+		{
+			name:       "error - pr not found",
+			reviewerID: "u100",
+			state:      domain.ReviewStateApproved,
+			setupMock: func(m *mocks.MockPrRepository) {
+				m.On("GetByID", mock.Anything, defaultRepository.ID, "pr-100").
+					Return(nil, repoErr.ErrPRNotFound)
+			},
+			expectedError: svcErr.ErrPRNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPrRepo := mocks.NewMockPrRepository(t)
+
+			mockRepoRepo := mocks.NewMockRepoRepository(t)
+			mockRepoRepo.On("GetByName", mock.Anything, domain.DefaultRepositoryName).
+				Return(defaultRepository, nil)
+
+			tt.setupMock(mockPrRepo)
+
+			publisher, published := newPublisher(t)
+
+			svc := &Service{
+				lgr:       slog.New(slog.DiscardHandler),
+				prRepo:    mockPrRepo,
+				repoRepo:  mockRepoRepo,
+				publisher: publisher,
+			}
+
+			review, err := svc.SubmitReview(orgContext(), "", "pr-100", tt.reviewerID, tt.state)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, review)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedReview, review)
+			}
+			assert.Equal(t, tt.expectedEvents, *published)
+		})
+	}
+
+	t.Run("error - invalid state", func(t *testing.T) {
+		svc := &Service{lgr: slog.New(slog.DiscardHandler)}
+
+		review, err := svc.SubmitReview(context.Background(), "", "pr-100", "u100", "LGTM")
+
+		require.ErrorIs(t, err, svcErr.ErrInvalidReviewState)
+		assert.Nil(t, review)
+	})
+}
+
+func TestService_OverdueAssignments(t *testing.T) {
+	overdueAt := time.Now()
+	assignments := []domain.ReviewAssignment{
+		{Repository: "backend", PullRequestID: "pr-1", ReviewerID: "u2", TeamName: "backend", OverdueAt: &overdueAt},
+	}
+
+	tests := []struct {
+		name          string
+		teamName      string
+		setupMocks    func(pm *mocks.MockPrRepository, tm *mocks.MockTeamRepository)
+		expected      []domain.ReviewAssignment
+		expectedError error
+	}{
+		{
+			name: "success - all teams",
+			setupMocks: func(pm *mocks.MockPrRepository, tm *mocks.MockTeamRepository) {
+				pm.On("ListOverdue", mock.Anything, "").Return(assignments, nil)
+			},
+			expected: assignments,
+		},
+		{
+			name:     "success - filtered by team",
+			teamName: "backend",
+			setupMocks: func(pm *mocks.MockPrRepository, tm *mocks.MockTeamRepository) {
+				tm.On("GetByName", mock.Anything, "backend").Return(&domain.Team{ID: "team-1", Name: "backend"}, nil)
+				pm.On("ListOverdue", mock.Anything, "backend").Return(assignments, nil)
+			},
+			expected: assignments,
+		},
+		{
+			name:     "error - team not found",
+			teamName: "ghosts",
+			setupMocks: func(pm *mocks.MockPrRepository, tm *mocks.MockTeamRepository) {
+				tm.On("GetByName", mock.Anything, "ghosts").Return(nil, repoErr.ErrTeamNotFound)
+			},
+			expectedError: svcErr.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPrRepo := mocks.NewMockPrRepository(t)
+			mockTeamRepo := mocks.NewMockTeamRepository(t)
+			tt.setupMocks(mockPrRepo, mockTeamRepo)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				prRepo:   mockPrRepo,
+				teamRepo: mockTeamRepo,
+			}
+
+			got, err := svc.OverdueAssignments(context.Background(), tt.teamName)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func orgContext() context.Context {
+	return tenant.WithPrincipal(context.Background(), domain.Principal{OrgID: "org-1", Role: domain.RoleAdmin})
+}
+
+type txKey struct{}
+
+// newTxManager возвращает мок менеджера транзакций, который отмечает контекст транзакции
+// и выполняет отложенные в ней действия только после её успешного завершения.
+func newTxManager(t *testing.T) *mocks.MockTxManager {
+	t.Helper()
+
+	var hooks []func(ctx context.Context)
+
+	tm := mocks.NewMockTxManager(t)
+	tm.On("WithinTx", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			hooks = nil
+
+			err := fn(context.WithValue(ctx, txKey{}, true))
+			if err != nil {
+				return err
+			}
+
+			for _, hook := range hooks {
+				hook(ctx)
+			}
+
+			return nil
+		})
+	tm.On("AfterCommit", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		ctx := args.Get(0).(context.Context)
+		hook := args.Get(1).(func(ctx context.Context))
+		if !inTx(ctx) {
+			hook(ctx)
+
+			return
+		}
+
+		hooks = append(hooks, hook)
+	}).Maybe()
+
+	return tm
+}
+
+func inTx(ctx context.Context) bool {
+	inTx, _ := ctx.Value(txKey{}).(bool)
+
+	return inTx
+}
+
+// newPublisher возвращает мок публикатора и типы опубликованных через него событий.
+func newPublisher(t *testing.T) (*mocks.MockEventPublisher, *[]domain.EventType) {
+	t.Helper()
+
+	var published []domain.EventType
+
+	publisher := mocks.NewMockEventPublisher(t)
+	publisher.On("Publish", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		event := args.Get(1).(domain.Event)
+		assert.Equal(t, "org-1", event.OrgID)
+
+		published = append(published, event.Type)
+	}).Maybe()
+
+	return publisher, &published
+}
@@ -0,0 +1,91 @@
+package pullrequest_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/events"
+	"avitotech-pr-reviewer/internal/metrics"
+	prService "avitotech-pr-reviewer/internal/service/pullrequest"
+	codeOwnersRepository "avitotech-pr-reviewer/internal/storage/postgres/codeowners"
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	prRepository "avitotech-pr-reviewer/internal/storage/postgres/pullrequest"
+	repoRepository "avitotech-pr-reviewer/internal/storage/postgres/repository"
+	teamRepository "avitotech-pr-reviewer/internal/storage/postgres/team"
+	userRepository "avitotech-pr-reviewer/internal/storage/postgres/user"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+// TestService_ReassignReviewer_SmallPool проверяет, что переназначение держит одно подключение:
+// если бы кандидаты читались вне транзакции с блокировкой, параллельные переназначения
+// заняли бы весь пул и ждали бы друг друга до истечения срока.
+func TestService_ReassignReviewer_SmallPool(t *testing.T) {
+	const (
+		maxConns     = 2
+		pullRequests = 8
+	)
+
+	pool := pgtest.Pool(t, pgPkg.WithMaxConns(maxConns))
+	txManager := pgPkg.NewTxManager(pool)
+
+	lgr := slog.New(slog.DiscardHandler)
+	svc := prService.New(lgr,
+		prRepository.New(txManager),
+		userRepository.New(txManager),
+		teamRepository.New(txManager),
+		codeOwnersRepository.New(txManager),
+		repoRepository.New(txManager),
+		events.NewBus(lgr),
+		metrics.New(),
+		txManager,
+		2,
+	)
+
+	org := pgtest.Organization(t, pool)
+
+	members := make([]domain.Member, 0, 6)
+	for i := range cap(members) {
+		members = append(members, domain.Member{ID: fmt.Sprintf("u%d", i+1), Username: "user", IsActive: true})
+	}
+	_, err := teamRepository.New(pool).CreateWithMembers(org, "backend", members)
+	require.NoError(t, err)
+
+	reviewers := make(map[string]string, pullRequests)
+	for i := range pullRequests {
+		prID := fmt.Sprintf("pr-%d", i+1)
+
+		pr, err := svc.CreatePullRequest(org, "", prID, "Add search", "u1", nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, pr.Reviewers)
+
+		reviewers[prID] = pr.Reviewers[0]
+	}
+
+	ctx, cancel := context.WithTimeout(org, 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for prID, oldReviewerID := range reviewers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			pr, newReviewerID, err := svc.ReassignReviewer(ctx, "", prID, oldReviewerID)
+			if !assert.NoError(t, err, prID) {
+				return
+			}
+			assert.NotEqual(t, oldReviewerID, newReviewerID)
+			assert.Contains(t, pr.Reviewers, newReviewerID)
+			assert.NotContains(t, pr.Reviewers, oldReviewerID)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,142 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	codeownersPkg "avitotech-pr-reviewer/pkg/codeowners"
+)
+
+// selectOwnerReviewers выбирает ревьюверов среди владельцев изменённых файлов по правилам CODEOWNERS.
+// Жадно набирает не более maxCount ревьюверов так, чтобы среди них оказался хотя бы один
+// владелец каждого затронутого пути, если это возможно. Автор и неактивные пользователи
+// не рассматриваются. Оставшиеся места заполняются обычной стратегией.
+func (s *Service) selectOwnerReviewers(
+	ctx context.Context,
+	repositoryID string,
+	changedFiles []string,
+	authorID string,
+	maxCount int,
+) ([]string, error) {
+	if len(changedFiles) == 0 || maxCount <= 0 {
+		return nil, nil
+	}
+
+	rules, err := s.codeOwnersRepo.ListByRepository(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	patterns := make([]string, len(rules))
+	for i, rule := range rules {
+		patterns[i] = rule.Pattern
+	}
+
+	ownersByRule := make(map[int][]string)
+	uncovered := make([][]string, 0, len(changedFiles))
+	for _, file := range changedFiles {
+		idx := codeownersPkg.MatchLast(patterns, file)
+		if idx < 0 {
+			continue
+		}
+
+		owners, ok := ownersByRule[idx]
+		if !ok {
+			owners, err = s.expandOwners(ctx, rules[idx], authorID)
+			if err != nil {
+				return nil, err
+			}
+			ownersByRule[idx] = owners
+		}
+
+		if len(owners) > 0 {
+			uncovered = append(uncovered, owners)
+		}
+	}
+
+	selected := make([]string, 0, maxCount)
+	for len(uncovered) > 0 && len(selected) < maxCount {
+		best := bestCoveringOwner(uncovered)
+		selected = append(selected, best)
+
+		uncovered = slices.DeleteFunc(uncovered, func(owners []string) bool {
+			return slices.Contains(owners, best)
+		})
+	}
+
+	return selected, nil
+}
+
+// expandOwners раскрывает владельцев правила в список активных пользователей, исключая автора.
+func (s *Service) expandOwners(ctx context.Context, rule domain.CodeOwnersRule, authorID string) ([]string, error) {
+	owners := make([]string, 0, len(rule.UserIDs))
+
+	for _, userID := range rule.UserIDs {
+		if userID == authorID || slices.Contains(owners, userID) {
+			continue
+		}
+
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if errors.Is(err, repoErr.ErrUserNotFound) || errors.Is(err, repoErr.ErrTeamNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if user.IsActive && !user.IsAway(time.Now()) {
+			owners = append(owners, userID)
+		}
+	}
+
+	for _, team := range rule.Teams {
+		members, err := s.teamRepo.GetActiveMembersByTeamID(ctx, team.ID)
+		if errors.Is(err, repoErr.ErrTeamNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			if member.ID != authorID && !slices.Contains(owners, member.ID) {
+				owners = append(owners, member.ID)
+			}
+		}
+	}
+
+	return owners, nil
+}
+
+// bestCoveringOwner возвращает владельца, покрывающего наибольшее число путей.
+// При равенстве выбор случаен, чтобы нагрузка распределялась между владельцами.
+func bestCoveringOwner(uncovered [][]string) string {
+	coverage := make(map[string]int)
+	for _, owners := range uncovered {
+		for _, owner := range owners {
+			coverage[owner]++
+		}
+	}
+
+	var best []string
+	bestCount := 0
+	for owner, count := range coverage {
+		switch {
+		case count > bestCount:
+			best = []string{owner}
+			bestCount = count
+		case count == bestCount:
+			best = append(best, owner)
+		}
+	}
+
+	return best[rand.Intn(len(best))]
+}
@@ -0,0 +1,152 @@
+// Package reminder собирает для ревьюверов дайджесты открытых Pull Request'ов,
+// ожидающих их ревью, и отправляет их через Notifier.
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/tenant"
+	"avitotech-pr-reviewer/internal/tracing"
+)
+
+type PrRepository interface {
+	ListPendingReviews(ctx context.Context, frequency domain.ReminderFrequency) ([]domain.PendingReview, error)
+}
+
+type OrgRepository interface {
+	ListIDs(ctx context.Context) ([]string, error)
+}
+
+// Notifier доставляет дайджест пользователю.
+type Notifier interface {
+	SendDigest(ctx context.Context, digest domain.Digest) error
+}
+
+type Service struct {
+	lgr *slog.Logger
+
+	prRepo   PrRepository
+	orgRepo  OrgRepository
+	notifier Notifier
+
+	now func() time.Time
+}
+
+func New(
+	lgr *slog.Logger,
+	prRepo PrRepository,
+	orgRepo OrgRepository,
+	notifier Notifier,
+) *Service {
+	return &Service{
+		lgr:      lgr,
+		prRepo:   prRepo,
+		orgRepo:  orgRepo,
+		notifier: notifier,
+		now:      time.Now,
+	}
+}
+
+// SendDigests отправляет дайджесты всем пользователям с заданной частотой напоминаний,
+// у которых есть ожидающие ревью. Ошибка в одной организации или у одного пользователя
+// не мешает отправке остальным.
+func (s *Service) SendDigests(ctx context.Context, frequency domain.ReminderFrequency) error {
+	const op = "reminder.SendDigests"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	orgIDs, err := s.orgRepo.ListIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var errs []error
+	for _, orgID := range orgIDs {
+		orgCtx := tenant.WithPrincipal(ctx, domain.Principal{OrgID: orgID, Role: domain.RoleAdmin})
+
+		err = s.sendOrganization(orgCtx, orgID, frequency)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("organization %s: %w", orgID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", op, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func (s *Service) sendOrganization(ctx context.Context, orgID string, frequency domain.ReminderFrequency) error {
+	const op = "reminder.sendOrganization"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("orgID", orgID),
+		slog.String("frequency", string(frequency)),
+	)
+
+	pending, err := s.prRepo.ListPendingReviews(ctx, frequency)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var errs []error
+	for _, digest := range buildDigests(orgID, pending, s.now()) {
+		err = s.notifier.SendDigest(ctx, digest)
+		if err != nil {
+			lgr.ErrorContext(ctx, "failed to send digest",
+				slog.String("userID", digest.UserID), slog.Any("error", err))
+
+			errs = append(errs, fmt.Errorf("user %s: %w", digest.UserID, err))
+			continue
+		}
+
+		lgr.DebugContext(ctx, "digest sent",
+			slog.String("userID", digest.UserID), slog.Int("pullRequests", len(digest.Items)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// buildDigests группирует ожидающие ревью по ревьюверу. Порядок пользователей
+// и Pull Request'ов сохраняется таким, каким его вернуло хранилище.
+func buildDigests(orgID string, pending []domain.PendingReview, now time.Time) []domain.Digest {
+	var digests []domain.Digest
+	index := make(map[string]int)
+
+	for _, p := range pending {
+		i, ok := index[p.ReviewerID]
+		if !ok {
+			i = len(digests)
+			index[p.ReviewerID] = i
+			digests = append(digests, domain.Digest{
+				OrgID:       orgID,
+				UserID:      p.ReviewerID,
+				Username:    p.ReviewerName,
+				Email:       p.ReviewerEmail,
+				GeneratedAt: now,
+			})
+		}
+
+		digests[i].Items = append(digests[i].Items, domain.DigestItem{
+			Repository:      p.Repository,
+			PullRequestID:   p.PullRequestID,
+			PullRequestName: p.PullRequestName,
+			AuthorID:        p.AuthorID,
+			AssignedAt:      p.AssignedAt,
+			Age:             now.Sub(p.CreatedAt),
+		})
+	}
+
+	return digests
+}
@@ -0,0 +1,144 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/service/reminder/mocks"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+func TestService_SendDigests(t *testing.T) {
+	now := time.Date(2025, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	pending := func(reviewerID, prID string, createdAt time.Time) domain.PendingReview {
+		return domain.PendingReview{
+			ReviewerID:      reviewerID,
+			ReviewerName:    "name-" + reviewerID,
+			Repository:      "backend",
+			PullRequestID:   prID,
+			PullRequestName: "Change " + prID,
+			AuthorID:        "u1",
+			CreatedAt:       createdAt,
+			AssignedAt:      createdAt,
+		}
+	}
+
+	digestItem := func(prID string, age time.Duration) domain.DigestItem {
+		return domain.DigestItem{
+			Repository:      "backend",
+			PullRequestID:   prID,
+			PullRequestName: "Change " + prID,
+			AuthorID:        "u1",
+			AssignedAt:      now.Add(-age),
+			Age:             age,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		pending         []domain.PendingReview
+		pendingErr      error
+		sendErr         map[string]error
+		expectedDigests []domain.Digest
+		expectedError   error
+	}{
+		{
+			name: "success - digests grouped by reviewer",
+			pending: []domain.PendingReview{
+				pending("u2", "pr-1", now.Add(-48*time.Hour)),
+				pending("u2", "pr-2", now.Add(-time.Hour)),
+				pending("u3", "pr-1", now.Add(-48*time.Hour)),
+			},
+			expectedDigests: []domain.Digest{
+				{
+					OrgID:       "org-1",
+					UserID:      "u2",
+					Username:    "name-u2",
+					GeneratedAt: now,
+					Items:       []domain.DigestItem{digestItem("pr-1", 48*time.Hour), digestItem("pr-2", time.Hour)},
+				},
+				{
+					OrgID:       "org-1",
+					UserID:      "u3",
+					Username:    "name-u3",
+					GeneratedAt: now,
+					Items:       []domain.DigestItem{digestItem("pr-1", 48*time.Hour)},
+				},
+			},
+		},
+		{
+			name:    "success - nothing pending",
+			pending: []domain.PendingReview{},
+		},
+		{
+			name: "error - failed delivery does not stop others",
+			pending: []domain.PendingReview{
+				pending("u2", "pr-1", now.Add(-time.Hour)),
+				pending("u3", "pr-1", now.Add(-time.Hour)),
+			},
+			sendErr: map[string]error{"u2": errUnexpected},
+			expectedDigests: []domain.Digest{
+				{
+					OrgID:       "org-1",
+					UserID:      "u3",
+					Username:    "name-u3",
+					GeneratedAt: now,
+					Items:       []domain.DigestItem{digestItem("pr-1", time.Hour)},
+				},
+			},
+			expectedError: errUnexpected,
+		},
+		{
+			name:          "error - list pending reviews",
+			pendingErr:    errUnexpected,
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pm := mocks.NewMockPrRepository(t)
+			om := mocks.NewMockOrgRepository(t)
+			nm := mocks.NewMockNotifier(t)
+
+			om.On("ListIDs", mock.Anything).Return([]string{"org-1"}, nil)
+			pm.On("ListPendingReviews", mock.MatchedBy(func(ctx context.Context) bool {
+				orgID, err := tenant.OrgID(ctx)
+				return err == nil && orgID == "org-1"
+			}), domain.ReminderDaily).Return(tt.pending, tt.pendingErr)
+
+			var sent []domain.Digest
+			nm.On("SendDigest", mock.Anything, mock.Anything).Return(func(_ context.Context, d domain.Digest) error {
+				if err := tt.sendErr[d.UserID]; err != nil {
+					return err
+				}
+				sent = append(sent, d)
+
+				return nil
+			}).Maybe()
+
+			svc := New(slog.New(slog.DiscardHandler), pm, om, nm)
+			svc.now = func() time.Time { return now }
+
+			err := svc.SendDigests(context.Background(), domain.ReminderDaily)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedDigests, sent)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tracing"
+)
+
+type RepoRepository interface {
+	Create(ctx context.Context, repository *domain.Repository) (*domain.Repository, error)
+	GetByName(ctx context.Context, name string) (*domain.Repository, error)
+	UpdateSettings(ctx context.Context, repositoryID string, settings domain.RepositorySettings) (*domain.Repository, error)
+}
+
+type TeamRepository interface {
+	GetByName(ctx context.Context, teamName string) (*domain.Team, error)
+}
+
+type Service struct {
+	lgr *slog.Logger
+
+	repoRepo RepoRepository
+	teamRepo TeamRepository
+}
+
+func New(
+	lgr *slog.Logger,
+	repoRepo RepoRepository,
+	teamRepo TeamRepository,
+) *Service {
+	return &Service{
+		lgr:      lgr,
+		repoRepo: repoRepo,
+		teamRepo: teamRepo,
+	}
+}
+
+// CreateRepository создаёт репозиторий с указанным именем, командой-владельцем и настройками.
+// Команда-владелец необязательна.
+// Если репозиторий с таким именем уже существует, возвращается ошибка svcErr.ErrRepositoryExists.
+// Если команда-владелец не найдена, возвращается ошибка svcErr.ErrTeamNotFound.
+func (s *Service) CreateRepository(
+	ctx context.Context,
+	name, teamName string,
+	settings domain.RepositorySettings,
+) (*domain.Repository, error) {
+	const op = "repository.CreateRepository"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("repository", name),
+		slog.String("teamName", teamName),
+	)
+
+	repository := &domain.Repository{
+		Name:     name,
+		Settings: settings,
+	}
+
+	if teamName != "" {
+		team, err := s.teamRepo.GetByName(ctx, teamName)
+		if errors.Is(err, repoErr.ErrTeamNotFound) {
+			lgr.DebugContext(ctx, "owning team not found")
+
+			return nil, svcErr.ErrTeamNotFound
+		}
+		if err != nil {
+			lgr.ErrorContext(ctx, "failed to get owning team", slog.Any("error", err))
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		repository.TeamID = team.ID
+	}
+
+	created, err := s.repoRepo.Create(ctx, repository)
+	if errors.Is(err, repoErr.ErrRepositoryExists) {
+		lgr.DebugContext(ctx, "repository already exists")
+
+		return nil, svcErr.ErrRepositoryExists
+	}
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "owning team not found")
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to create repository", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.InfoContext(ctx, "repository created", slog.String("repositoryID", created.ID))
+
+	return created, nil
+}
+
+// Repository возвращает репозиторий по имени.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
+func (s *Service) Repository(ctx context.Context, name string) (*domain.Repository, error) {
+	const op = "repository.Repository"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("repository", name),
+	)
+
+	repository, err := s.repoRepo.GetByName(ctx, name)
+	if errors.Is(err, repoErr.ErrRepositoryNotFound) {
+		lgr.DebugContext(ctx, "repository not found")
+
+		return nil, svcErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get repository", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return repository, nil
+}
+
+// UpdateSettings заменяет настройки назначения ревьюверов репозитория.
+// Если репозиторий не найден, возвращается ошибка svcErr.ErrRepositoryNotFound.
+func (s *Service) UpdateSettings(
+	ctx context.Context,
+	name string,
+	settings domain.RepositorySettings,
+) (*domain.Repository, error) {
+	const op = "repository.UpdateSettings"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("repository", name),
+	)
+
+	repository, err := s.repoRepo.GetByName(ctx, name)
+	if errors.Is(err, repoErr.ErrRepositoryNotFound) {
+		lgr.DebugContext(ctx, "repository not found")
+
+		return nil, svcErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get repository", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updated, err := s.repoRepo.UpdateSettings(ctx, repository.ID, settings)
+	if errors.Is(err, repoErr.ErrRepositoryNotFound) {
+		lgr.DebugContext(ctx, "repository not found")
+
+		return nil, svcErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to update repository settings", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.InfoContext(ctx, "repository settings updated")
+
+	return updated, nil
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/service/repository/mocks"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+func TestService_CreateRepository(t *testing.T) {
+	maxReviewers := 1
+
+	tests := []struct {
+		name          string
+		repoName      string
+		teamName      string
+		settings      domain.RepositorySettings
+		setupMocks    func(rm *mocks.MockRepoRepository, tm *mocks.MockTeamRepository)
+		expectedRepo  *domain.Repository
+		expectedError error
+	}{
+		{
+			name:     "success - with owning team and settings",
+			repoName: "backend",
+			teamName: "platform",
+			settings: domain.RepositorySettings{MaxReviewers: &maxReviewers},
+			setupMocks: func(rm *mocks.MockRepoRepository, tm *mocks.MockTeamRepository) {
+				tm.On("GetByName", mock.Anything, "platform").
+					Return(&domain.Team{ID: "team-1", Name: "platform"}, nil)
+				rm.On("Create", mock.Anything, &domain.Repository{
+					Name:     "backend",
+					TeamID:   "team-1",
+					Settings: domain.RepositorySettings{MaxReviewers: &maxReviewers},
+				}).Return(&domain.Repository{
+					ID:       "repo-1",
+					Name:     "backend",
+					TeamID:   "team-1",
+					TeamName: "platform",
+					Settings: domain.RepositorySettings{MaxReviewers: &maxReviewers},
+				}, nil)
+			},
+			expectedRepo: &domain.Repository{
+				ID:       "repo-1",
+				Name:     "backend",
+				TeamID:   "team-1",
+				TeamName: "platform",
+				Settings: domain.RepositorySettings{MaxReviewers: &maxReviewers},
+			},
+		},
+		{
+			name:     "success - without owning team",
+			repoName: "docs",
+			setupMocks: func(rm *mocks.MockRepoRepository, tm *mocks.MockTeamRepository) {
+				rm.On("Create", mock.Anything, &domain.Repository{Name: "docs"}).
+					Return(&domain.Repository{ID: "repo-2", Name: "docs"}, nil)
+			},
+			expectedRepo: &domain.Repository{ID: "repo-2", Name: "docs"},
+		},
+		{
+			name:     "error - owning team not found",
+			repoName: "backend",
+			teamName: "ghosts",
+			setupMocks: func(rm *mocks.MockRepoRepository, tm *mocks.MockTeamRepository) {
+				tm.On("GetByName", mock.Anything, "ghosts").Return(nil, repoErr.ErrTeamNotFound)
+			},
+			expectedError: svcErr.ErrTeamNotFound,
+		},
+		{
+			name:     "error - repository already exists",
+			repoName: "default",
+			setupMocks: func(rm *mocks.MockRepoRepository, tm *mocks.MockTeamRepository) {
+				rm.On("Create", mock.Anything, mock.Anything).Return(nil, repoErr.ErrRepositoryExists)
+			},
+			expectedError: svcErr.ErrRepositoryExists,
+		},
+		{
+			name:     "error - unexpected from repo",
+			repoName: "backend",
+			setupMocks: func(rm *mocks.MockRepoRepository, tm *mocks.MockTeamRepository) {
+				rm.On("Create", mock.Anything, mock.Anything).Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := mocks.NewMockRepoRepository(t)
+			tm := mocks.NewMockTeamRepository(t)
+			tt.setupMocks(rm, tm)
+
+			svc := New(slog.New(slog.DiscardHandler), rm, tm)
+
+			got, err := svc.CreateRepository(context.Background(), tt.repoName, tt.teamName, tt.settings)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedRepo, got)
+			}
+		})
+	}
+}
+
+func TestService_UpdateSettings(t *testing.T) {
+	maxReviewers := 3
+
+	tests := []struct {
+		name          string
+		repoName      string
+		settings      domain.RepositorySettings
+		setupMocks    func(rm *mocks.MockRepoRepository)
+		expectedRepo  *domain.Repository
+		expectedError error
+	}{
+		{
+			name:     "success - settings replaced",
+			repoName: "backend",
+			settings: domain.RepositorySettings{MaxReviewers: &maxReviewers},
+			setupMocks: func(rm *mocks.MockRepoRepository) {
+				rm.On("GetByName", mock.Anything, "backend").
+					Return(&domain.Repository{ID: "repo-1", Name: "backend"}, nil)
+				rm.On("UpdateSettings", mock.Anything, "repo-1", domain.RepositorySettings{MaxReviewers: &maxReviewers}).
+					Return(&domain.Repository{
+						ID:       "repo-1",
+						Name:     "backend",
+						Settings: domain.RepositorySettings{MaxReviewers: &maxReviewers},
+					}, nil)
+			},
+			expectedRepo: &domain.Repository{
+				ID:       "repo-1",
+				Name:     "backend",
+				Settings: domain.RepositorySettings{MaxReviewers: &maxReviewers},
+			},
+		},
+		{
+			name:     "error - repository not found",
+			repoName: "ghost",
+			setupMocks: func(rm *mocks.MockRepoRepository) {
+				rm.On("GetByName", mock.Anything, "ghost").Return(nil, repoErr.ErrRepositoryNotFound)
+			},
+			expectedError: svcErr.ErrRepositoryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := mocks.NewMockRepoRepository(t)
+			tt.setupMocks(rm)
+
+			svc := New(slog.New(slog.DiscardHandler), rm, mocks.NewMockTeamRepository(t))
+
+			got, err := svc.UpdateSettings(context.Background(), tt.repoName, tt.settings)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedRepo, got)
+			}
+		})
+	}
+}
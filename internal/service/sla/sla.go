@@ -0,0 +1,337 @@
+// Package sla отслеживает сроки ревью: находит назначения, по которым ревьювер
+// не оставил ревью за время SLA своей команды, помечает их просроченными,
+// публикует событие и, если так настроено, переназначает ревью или эскалирует его лиду.
+package sla
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	"avitotech-pr-reviewer/internal/tracing"
+)
+
+type PrRepository interface {
+	GetByID(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error)
+	ListPendingAssignments(ctx context.Context) ([]domain.ReviewAssignment, error)
+	MarkOverdue(ctx context.Context, repositoryID, prID, reviewerID string) error
+	AddReviewer(ctx context.Context, repositoryID, prID, reviewerID string) error
+}
+
+type UserRepository interface {
+	GetByID(ctx context.Context, userID string) (*domain.User, error)
+}
+
+type OrgRepository interface {
+	ListIDs(ctx context.Context) ([]string, error)
+}
+
+// Reassigner переназначает ревью. Реализуется сервисом Pull Request'ов, который откладывает
+// метрики и рассылку события до фиксации транзакции из контекста.
+type Reassigner interface {
+	ReassignReviewer(ctx context.Context, repository, prID, oldReviewerID string) (*domain.PullRequest, string, error)
+}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event)
+}
+
+// TxManager выполняет функцию в транзакции, общей для всех репозиториев.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Leader выбирает одну реплику, которая проверяет сроки, когда сервис запущен в нескольких репликах.
+type Leader interface {
+	IsLeader(ctx context.Context) (bool, error)
+}
+
+type Service struct {
+	lgr *slog.Logger
+
+	prRepo     PrRepository
+	userRepo   UserRepository
+	orgRepo    OrgRepository
+	reassigner Reassigner
+	publisher  EventPublisher
+	txManager  TxManager
+	leader     Leader
+
+	hours    WorkingHours
+	interval time.Duration
+	now      func() time.Time
+}
+
+type Option func(*Service)
+
+// WithLeader проверяет сроки, только пока реплика является лидером. Без него проверка
+// выполняется в каждой реплике, и каждая публиковала бы событие о том же просроченном
+// назначении. Лидерством владеет планировщик, поэтому сервис от него не отказывается.
+func WithLeader(leader Leader) Option {
+	return func(s *Service) {
+		s.leader = leader
+	}
+}
+
+func New(
+	lgr *slog.Logger,
+	prRepo PrRepository,
+	userRepo UserRepository,
+	orgRepo OrgRepository,
+	reassigner Reassigner,
+	publisher EventPublisher,
+	txManager TxManager,
+	hours WorkingHours,
+	interval time.Duration,
+	opts ...Option,
+) *Service {
+	s := &Service{
+		lgr:        lgr,
+		prRepo:     prRepo,
+		userRepo:   userRepo,
+		orgRepo:    orgRepo,
+		reassigner: reassigner,
+		publisher:  publisher,
+		txManager:  txManager,
+		hours:      hours,
+		interval:   interval,
+		now:        time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Run проверяет сроки ревью с заданным интервалом, пока не будет отменён контекст.
+func (s *Service) Run(ctx context.Context) {
+	const op = "sla.Run"
+
+	lgr := s.lgr.With(slog.String("op", op), slog.Duration("interval", s.interval))
+
+	lgr.InfoContext(ctx, "starting SLA checker")
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			lgr.InfoContext(ctx, "SLA checker stopped")
+			return
+		case <-ticker.C:
+			s.tick(ctx, lgr)
+		}
+	}
+}
+
+// tick выполняет одну проверку сроков, если реплика является лидером.
+func (s *Service) tick(ctx context.Context, lgr *slog.Logger) {
+	if s.leader != nil {
+		leader, err := s.leader.IsLeader(ctx)
+		if err != nil {
+			lgr.ErrorContext(ctx, "failed to check leadership, check skipped", slog.Any("error", err))
+			return
+		}
+		if !leader {
+			lgr.DebugContext(ctx, "not the leader, check skipped")
+			return
+		}
+	}
+
+	err := s.CheckOverdue(ctx)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to check overdue reviews", slog.Any("error", err))
+	}
+}
+
+// CheckOverdue обходит все организации и обрабатывает просроченные назначения.
+// Ошибка в одной организации не мешает проверке остальных.
+func (s *Service) CheckOverdue(ctx context.Context) error {
+	const op = "sla.CheckOverdue"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	orgIDs, err := s.orgRepo.ListIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var errs []error
+	for _, orgID := range orgIDs {
+		orgCtx := tenant.WithPrincipal(ctx, domain.Principal{OrgID: orgID, Role: domain.RoleAdmin})
+
+		err = s.checkOrganization(orgCtx, orgID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("organization %s: %w", orgID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", op, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func (s *Service) checkOrganization(ctx context.Context, orgID string) error {
+	const op = "sla.checkOrganization"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(slog.String("op", op), slog.String("orgID", orgID))
+
+	assignments, err := s.prRepo.ListPendingAssignments(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Ошибка по одному назначению не мешает обработать остальные: необработанное
+	// назначение не помечается и будет повторено при следующей проверке.
+	var errs []error
+
+	now := s.now()
+	for _, a := range assignments {
+		if now.Before(s.hours.Deadline(a.AssignedAt, a.SLA.ReviewHours)) {
+			continue
+		}
+
+		err = s.handleOverdue(ctx, lgr, orgID, a)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("pull request %s/%s: %w", a.Repository, a.PullRequestID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", op, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// handleOverdue помечает назначение просроченным, выполняет действие SLA команды
+// и публикует событие. Пометка и действие выполняются в одной транзакции: если
+// действие завершилось ошибкой, назначение не помечается и будет обработано при
+// следующей проверке. Отсутствие кандидатов для переназначения или неподходящий лид
+// ошибкой не считаются: назначение остаётся просроченным и попадает в список для лида.
+// Лид, который уже назначен ревьювером, тоже не эскалируется: событие сообщает действие none.
+// Побочные действия переназначения выполняются только после фиксации транзакции,
+// поэтому при откате или повторе транзакции они не опережают её и не дублируются.
+func (s *Service) handleOverdue(
+	ctx context.Context,
+	lgr *slog.Logger,
+	orgID string,
+	a domain.ReviewAssignment,
+) error {
+	lgr = lgr.With(
+		slog.String("repository", a.Repository),
+		slog.String("pull_request_id", a.PullRequestID),
+		slog.String("reviewer_id", a.ReviewerID),
+	)
+
+	now := s.now()
+	a.OverdueAt = &now
+
+	var event domain.ReviewOverdueEvent
+
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		event = domain.ReviewOverdueEvent{
+			Assignment: a,
+			Action:     domain.SLAActionNone,
+		}
+
+		err := s.prRepo.MarkOverdue(ctx, a.RepositoryID, a.PullRequestID, a.ReviewerID)
+		if err != nil {
+			return err
+		}
+
+		switch a.SLA.Action {
+		case domain.SLAActionReassign:
+			_, newReviewerID, err := s.reassigner.ReassignReviewer(ctx, a.Repository, a.PullRequestID, a.ReviewerID)
+			if errors.Is(err, svcErr.ErrPRNoCandidates) {
+				lgr.WarnContext(ctx, "no candidates to reassign overdue review")
+
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			event.Action = domain.SLAActionReassign
+			event.ReassignedTo = newReviewerID
+		case domain.SLAActionEscalate:
+			ok, err := s.canEscalate(ctx, a, now)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				lgr.WarnContext(ctx, "team lead cannot take over overdue review", slog.String("leadID", a.SLA.LeadID))
+
+				return nil
+			}
+
+			err = s.prRepo.AddReviewer(ctx, a.RepositoryID, a.PullRequestID, a.SLA.LeadID)
+			if err != nil {
+				return err
+			}
+
+			event.Action = domain.SLAActionEscalate
+			event.EscalatedTo = a.SLA.LeadID
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	lgr.InfoContext(ctx, "review is overdue", slog.String("action", string(event.Action)))
+
+	s.publisher.Publish(ctx, domain.Event{
+		Type:       domain.EventReviewOverdue,
+		OrgID:      orgID,
+		OccurredAt: now,
+		Data:       event,
+	})
+
+	return nil
+}
+
+// canEscalate сообщает, может ли лид команды взять просроченное ревью: лид задан,
+// не является автором, активен, не отсутствует и ещё не назначен ревьювером PR.
+// Уже назначенного лида добавлять незачем: ревью от него и так ожидается, а событие
+// об эскалации отправило бы ему письмо о назначении, которого не было.
+func (s *Service) canEscalate(ctx context.Context, a domain.ReviewAssignment, now time.Time) (bool, error) {
+	if a.SLA.LeadID == "" || a.SLA.LeadID == a.ReviewerID || a.SLA.LeadID == a.AuthorID {
+		return false, nil
+	}
+
+	lead, err := s.userRepo.GetByID(ctx, a.SLA.LeadID)
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !lead.IsActive || lead.IsAway(now) {
+		return false, nil
+	}
+
+	pr, err := s.prRepo.GetByID(ctx, a.RepositoryID, a.PullRequestID)
+	if err != nil {
+		return false, err
+	}
+
+	return !slices.Contains(pr.Reviewers, a.SLA.LeadID), nil
+}
@@ -0,0 +1,335 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/service/sla/mocks"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+func TestService_CheckOverdue(t *testing.T) {
+	// Среда, 12:00 UTC.
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	assignment := func(assignedAt time.Time, sla domain.TeamSLA) domain.ReviewAssignment {
+		return domain.ReviewAssignment{
+			RepositoryID:  "repo-1",
+			Repository:    "backend",
+			PullRequestID: "pr-1",
+			AuthorID:      "u1",
+			ReviewerID:    "u2",
+			TeamName:      "backend",
+			AssignedAt:    assignedAt,
+			SLA:           sla,
+		}
+	}
+
+	overdue := now.Add(-25 * time.Hour)
+	fresh := now.Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		assignments    []domain.ReviewAssignment
+		setupMocks     func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner)
+		expectedEvents []domain.ReviewOverdueEvent
+		expectedError  error
+	}{
+		{
+			name: "not overdue yet",
+			assignments: []domain.ReviewAssignment{
+				assignment(fresh, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionNone}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {},
+		},
+		{
+			name: "overdue - only marked",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionNone}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionNone},
+			},
+		},
+		{
+			name: "overdue - reassigned",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionReassign}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+				rm.On("ReassignReviewer", mock.Anything, "backend", "pr-1", "u2").
+					Return(&domain.PullRequest{ID: "pr-1"}, "u3", nil)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionReassign, ReassignedTo: "u3"},
+			},
+		},
+		{
+			name: "overdue - no candidates to reassign",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionReassign}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+				rm.On("ReassignReviewer", mock.Anything, "backend", "pr-1", "u2").
+					Return(nil, "", svcErr.ErrPRNoCandidates)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionNone},
+			},
+		},
+		{
+			name: "overdue - escalated to lead",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "lead"}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+				um.On("GetByID", mock.Anything, "lead").Return(&domain.User{ID: "lead", IsActive: true}, nil)
+				pm.On("GetByID", mock.Anything, "repo-1", "pr-1").
+					Return(&domain.PullRequest{ID: "pr-1", Reviewers: []string{"u2"}}, nil)
+				pm.On("AddReviewer", mock.Anything, "repo-1", "pr-1", "lead").Return(nil)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionEscalate, EscalatedTo: "lead"},
+			},
+		},
+		{
+			name: "overdue - lead already reviews, not escalated",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "lead"}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+				um.On("GetByID", mock.Anything, "lead").Return(&domain.User{ID: "lead", IsActive: true}, nil)
+				pm.On("GetByID", mock.Anything, "repo-1", "pr-1").
+					Return(&domain.PullRequest{ID: "pr-1", Reviewers: []string{"u2", "lead"}}, nil)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionNone},
+			},
+		},
+		{
+			name: "overdue - lead is inactive, not escalated",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "lead"}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+				um.On("GetByID", mock.Anything, "lead").Return(&domain.User{ID: "lead", IsActive: false}, nil)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionNone},
+			},
+		},
+		{
+			name: "overdue - lead is away, not escalated",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "lead"}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				awayUntil := now.Add(48 * time.Hour)
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+				um.On("GetByID", mock.Anything, "lead").
+					Return(&domain.User{ID: "lead", IsActive: true, AwayUntil: &awayUntil}, nil)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionNone},
+			},
+		},
+		{
+			name: "overdue - lead not found, not escalated",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "lead"}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+				um.On("GetByID", mock.Anything, "lead").Return(nil, repoErr.ErrUserNotFound)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionNone},
+			},
+		},
+		{
+			name: "overdue - lead is the reviewer, not escalated",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "u2"}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionNone},
+			},
+		},
+		{
+			name: "error - mark overdue failed",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionNone}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+		{
+			name: "error - escalation failed, nothing published",
+			assignments: []domain.ReviewAssignment{
+				assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "lead"}),
+			},
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil)
+				um.On("GetByID", mock.Anything, "lead").Return(&domain.User{ID: "lead", IsActive: true}, nil)
+				pm.On("GetByID", mock.Anything, "repo-1", "pr-1").
+					Return(&domain.PullRequest{ID: "pr-1", Reviewers: []string{"u2"}}, nil)
+				pm.On("AddReviewer", mock.Anything, "repo-1", "pr-1", "lead").Return(errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+		{
+			name: "error - failed assignment does not stop the rest",
+			assignments: func() []domain.ReviewAssignment {
+				failing := assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionNone})
+				next := assignment(overdue, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionNone})
+				next.PullRequestID = "pr-2"
+
+				return []domain.ReviewAssignment{failing, next}
+			}(),
+			setupMocks: func(pm *mocks.MockPrRepository, um *mocks.MockUserRepository, rm *mocks.MockReassigner) {
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(errUnexpected)
+				pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-2", "u2").Return(nil)
+			},
+			expectedEvents: []domain.ReviewOverdueEvent{
+				{Action: domain.SLAActionNone},
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pm := mocks.NewMockPrRepository(t)
+			um := mocks.NewMockUserRepository(t)
+			om := mocks.NewMockOrgRepository(t)
+			rm := mocks.NewMockReassigner(t)
+			em := mocks.NewMockEventPublisher(t)
+			tm := mocks.NewMockTxManager(t)
+
+			tm.On("WithinTx", mock.Anything, mock.Anything).
+				Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).
+				Maybe()
+
+			om.On("ListIDs", mock.Anything).Return([]string{"org-1"}, nil)
+			pm.On("ListPendingAssignments", mock.MatchedBy(func(ctx context.Context) bool {
+				orgID, err := tenant.OrgID(ctx)
+				return err == nil && orgID == "org-1"
+			})).Return(tt.assignments, nil)
+			tt.setupMocks(pm, um, rm)
+
+			var published []domain.ReviewOverdueEvent
+			em.On("Publish", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				event := args.Get(1).(domain.Event)
+				assert.Equal(t, domain.EventReviewOverdue, event.Type)
+				assert.Equal(t, "org-1", event.OrgID)
+
+				data := event.Data.(domain.ReviewOverdueEvent)
+				assert.Equal(t, "u2", data.Assignment.ReviewerID)
+				require.NotNil(t, data.Assignment.OverdueAt)
+				data.Assignment = domain.ReviewAssignment{}
+				published = append(published, data)
+			}).Maybe()
+
+			svc := New(slog.New(slog.DiscardHandler), pm, um, om, rm, em, tm,
+				WorkingHours{Start: 0, End: 24, Location: time.UTC}, time.Minute)
+			svc.now = func() time.Time { return now }
+
+			err := svc.CheckOverdue(context.Background())
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedEvents, published)
+		})
+	}
+}
+
+// sharedLock — блокировка, общая для реплик: лидером становится первая реплика, запросившая её.
+type sharedLock struct {
+	mu     sync.Mutex
+	holder string
+}
+
+type replicaLeader struct {
+	lock    *sharedLock
+	replica string
+}
+
+func (l replicaLeader) IsLeader(context.Context) (bool, error) {
+	l.lock.mu.Lock()
+	defer l.lock.mu.Unlock()
+
+	if l.lock.holder == "" {
+		l.lock.holder = l.replica
+	}
+
+	return l.lock.holder == l.replica, nil
+}
+
+func TestService_OnlyLeaderChecksOverdue(t *testing.T) {
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	overdue := domain.ReviewAssignment{
+		RepositoryID:  "repo-1",
+		Repository:    "backend",
+		PullRequestID: "pr-1",
+		AuthorID:      "u1",
+		ReviewerID:    "u2",
+		AssignedAt:    now.Add(-25 * time.Hour),
+		SLA:           domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionNone},
+	}
+
+	pm := mocks.NewMockPrRepository(t)
+	om := mocks.NewMockOrgRepository(t)
+	em := mocks.NewMockEventPublisher(t)
+	tm := mocks.NewMockTxManager(t)
+
+	tm.On("WithinTx", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+	om.On("ListIDs", mock.Anything).Return([]string{"org-1"}, nil).Once()
+	pm.On("ListPendingAssignments", mock.Anything).Return([]domain.ReviewAssignment{overdue}, nil).Once()
+	pm.On("MarkOverdue", mock.Anything, "repo-1", "pr-1", "u2").Return(nil).Once()
+	em.On("Publish", mock.Anything, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Type == domain.EventReviewOverdue
+	})).Once()
+
+	lock := &sharedLock{}
+	lgr := slog.New(slog.DiscardHandler)
+	for _, replica := range []string{"replica-1", "replica-2"} {
+		svc := New(lgr, pm, mocks.NewMockUserRepository(t), om, mocks.NewMockReassigner(t), em, tm,
+			WorkingHours{Start: 0, End: 24, Location: time.UTC}, time.Minute,
+			WithLeader(replicaLeader{lock: lock, replica: replica}))
+		svc.now = func() time.Time { return now }
+
+		svc.tick(context.Background(), lgr)
+	}
+
+	em.AssertNumberOfCalls(t, "Publish", 1)
+}
@@ -0,0 +1,49 @@
+package sla
+
+import "time"
+
+// WorkingHours — рабочее время, в котором отсчитывается SLA:
+// будние дни с Start до End часов в часовом поясе Location.
+type WorkingHours struct {
+	Start    int
+	End      int
+	Location *time.Location
+}
+
+// Deadline возвращает момент, когда от from пройдёт hours рабочих часов.
+// Время до начала рабочего дня, после его окончания и в выходные не учитывается.
+func (w WorkingHours) Deadline(from time.Time, hours int) time.Time {
+	remaining := time.Duration(hours) * time.Hour
+	t := from.In(w.Location)
+
+	for {
+		dayStart := w.at(t, w.Start)
+		dayEnd := w.at(t, w.End)
+
+		if isWeekend(t) || !t.Before(dayEnd) {
+			t = w.at(t.AddDate(0, 0, 1), w.Start)
+			continue
+		}
+		if t.Before(dayStart) {
+			t = dayStart
+		}
+
+		available := dayEnd.Sub(t)
+		if remaining <= available {
+			return t.Add(remaining)
+		}
+
+		remaining -= available
+		t = w.at(t.AddDate(0, 0, 1), w.Start)
+	}
+}
+
+// at возвращает указанный час дня, к которому относится t.
+// Час 24 означает полночь следующего дня.
+func (w WorkingHours) at(t time.Time, hour int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), hour, 0, 0, 0, w.Location)
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
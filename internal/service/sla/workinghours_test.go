@@ -0,0 +1,84 @@
+package sla
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkingHours_Deadline(t *testing.T) {
+	office := WorkingHours{Start: 9, End: 18, Location: time.UTC}
+	allDay := WorkingHours{Start: 0, End: 24, Location: time.UTC}
+
+	// 2025-01-06 — понедельник.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		hours    WorkingHours
+		from     time.Time
+		sla      int
+		expected time.Time
+	}{
+		{
+			name:     "within one working day",
+			hours:    office,
+			from:     at(6, 10, 0),
+			sla:      4,
+			expected: at(6, 14, 0),
+		},
+		{
+			name:     "carries over to next day",
+			hours:    office,
+			from:     at(6, 16, 30),
+			sla:      4,
+			expected: at(7, 11, 30),
+		},
+		{
+			name:     "assigned before working day starts",
+			hours:    office,
+			from:     at(6, 7, 0),
+			sla:      2,
+			expected: at(6, 11, 0),
+		},
+		{
+			name:     "assigned after working day ends",
+			hours:    office,
+			from:     at(6, 20, 0),
+			sla:      1,
+			expected: at(7, 10, 0),
+		},
+		{
+			name:     "skips weekend",
+			hours:    office,
+			from:     at(10, 17, 0),
+			sla:      2,
+			expected: at(13, 10, 0),
+		},
+		{
+			name:     "assigned on weekend",
+			hours:    office,
+			from:     at(11, 12, 0),
+			sla:      24,
+			expected: at(15, 15, 0),
+		},
+		{
+			name:     "round-the-clock weekdays",
+			hours:    allDay,
+			from:     at(10, 12, 0),
+			sla:      24,
+			expected: at(13, 12, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.hours.Deadline(tt.from, tt.sla)
+
+			assert.True(t, tt.expected.Equal(got), "expected %s, got %s", tt.expected, got)
+		})
+	}
+}
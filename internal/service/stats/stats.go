@@ -0,0 +1,209 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tracing"
+)
+
+type StatsRepository interface {
+	ReviewerAssignments(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStats, error)
+	TeamAssignments(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error)
+	PullRequestCounts(ctx context.Context) (domain.PullRequestCounts, error)
+	CycleTime(
+		ctx context.Context,
+		group domain.CycleTimeGroup,
+		filter domain.StatsFilter,
+	) ([]domain.CycleTimeStats, error)
+}
+
+type TeamRepository interface {
+	GetByName(ctx context.Context, teamName string) (*domain.Team, error)
+}
+
+type Service struct {
+	lgr *slog.Logger
+
+	statsRepo StatsRepository
+	teamRepo  TeamRepository
+}
+
+func New(
+	lgr *slog.Logger,
+	statsRepo StatsRepository,
+	teamRepo TeamRepository,
+) *Service {
+	return &Service{
+		lgr:       lgr,
+		statsRepo: statsRepo,
+		teamRepo:  teamRepo,
+	}
+}
+
+// ReviewerStats возвращает число назначений ревьювером для каждого пользователя.
+// Если фильтр некорректен, возвращается ошибка svcErr.ErrInvalidStatsFilter.
+// Если в фильтре указана несуществующая команда, возвращается ошибка svcErr.ErrTeamNotFound.
+func (s *Service) ReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStats, error) {
+	const op = "stats.ReviewerStats"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(slog.String("op", op), slog.String("teamName", filter.TeamName))
+
+	err := s.checkFilter(ctx, lgr, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.ReviewerAssignments(ctx, filter)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get reviewer assignments", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// TeamStats возвращает распределение назначений внутри каждой команды.
+// Если фильтр некорректен, возвращается ошибка svcErr.ErrInvalidStatsFilter.
+// Если в фильтре указана несуществующая команда, возвращается ошибка svcErr.ErrTeamNotFound.
+func (s *Service) TeamStats(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	const op = "stats.TeamStats"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(slog.String("op", op), slog.String("teamName", filter.TeamName))
+
+	err := s.checkFilter(ctx, lgr, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.TeamAssignments(ctx, filter)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get team assignments", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// Workload возвращает текущую нагрузку организации: число открытых Pull Request'ов,
+// из них — без ревьюверов, и назначения на открытые Pull Request'ы по командам.
+func (s *Service) Workload(ctx context.Context) (*domain.Workload, error) {
+	const op = "stats.Workload"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(slog.String("op", op))
+
+	counts, err := s.statsRepo.PullRequestCounts(ctx)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to count pull requests", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	open := domain.PRStatusOpen
+	teams, err := s.statsRepo.TeamAssignments(ctx, domain.StatsFilter{Status: &open})
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get team assignments", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &domain.Workload{
+		PullRequests: counts,
+		Teams:        teams,
+	}, nil
+}
+
+// CycleTime возвращает медиану и 90-й перцентиль времени до первого ревью, до одобрения
+// и до merge в разрезе команд, репозиториев или ревьюверов по неделям.
+// Если разрез неизвестен или фильтр некорректен, возвращается ошибка svcErr.ErrInvalidStatsFilter.
+// Если в фильтре указана несуществующая команда, возвращается ошибка svcErr.ErrTeamNotFound.
+func (s *Service) CycleTime(
+	ctx context.Context,
+	group domain.CycleTimeGroup,
+	filter domain.StatsFilter,
+) ([]domain.CycleTimeStats, error) {
+	const op = "stats.CycleTime"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("group", string(group)),
+		slog.String("teamName", filter.TeamName),
+	)
+
+	if !group.IsValid() {
+		lgr.DebugContext(ctx, "unknown group")
+
+		return nil, fmt.Errorf("%w: unknown group %q", svcErr.ErrInvalidStatsFilter, group)
+	}
+
+	err := s.checkFilter(ctx, lgr, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.CycleTime(ctx, group, filter)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get cycle time", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// checkFilter проверяет корректность фильтра и существование указанной в нём команды.
+func (s *Service) checkFilter(ctx context.Context, lgr *slog.Logger, filter domain.StatsFilter) error {
+	const op = "stats.checkFilter"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		lgr.DebugContext(ctx, "empty time range")
+
+		return fmt.Errorf("%w: from must be before to", svcErr.ErrInvalidStatsFilter)
+	}
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		lgr.DebugContext(ctx, "unknown status", slog.String("status", string(*filter.Status)))
+
+		return fmt.Errorf("%w: unknown status %q", svcErr.ErrInvalidStatsFilter, *filter.Status)
+	}
+
+	if filter.TeamName == "" {
+		return nil
+	}
+
+	_, err := s.teamRepo.GetByName(ctx, filter.TeamName)
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "team not found")
+
+		return svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get team", slog.Any("error", err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
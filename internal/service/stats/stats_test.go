@@ -0,0 +1,312 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/service/stats/mocks"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+func TestService_ReviewerStats(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	merged := domain.PRStatusMerged
+	unknown := domain.PRStatus("CLOSED")
+
+	tests := []struct {
+		name          string
+		filter        domain.StatsFilter
+		setupMocks    func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository)
+		expectedStats []domain.ReviewerStats
+		expectedError error
+	}{
+		{
+			name:   "success - with range, status and team",
+			filter: domain.StatsFilter{From: &from, To: &to, Status: &merged, TeamName: "backend"},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				tm.On("GetByName", mock.Anything, "backend").
+					Return(&domain.Team{ID: "team-1", Name: "backend"}, nil)
+				sm.On("ReviewerAssignments", mock.Anything, domain.StatsFilter{
+					From: &from, To: &to, Status: &merged, TeamName: "backend",
+				}).Return([]domain.ReviewerStats{
+					{UserID: "u2", Username: "bob", TeamName: "backend", IsActive: true, Assignments: 2},
+				}, nil)
+			},
+			expectedStats: []domain.ReviewerStats{
+				{UserID: "u2", Username: "bob", TeamName: "backend", IsActive: true, Assignments: 2},
+			},
+		},
+		{
+			name:   "success - without filter",
+			filter: domain.StatsFilter{},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				sm.On("ReviewerAssignments", mock.Anything, domain.StatsFilter{}).
+					Return([]domain.ReviewerStats{}, nil)
+			},
+			expectedStats: []domain.ReviewerStats{},
+		},
+		{
+			name:          "error - from is after to",
+			filter:        domain.StatsFilter{From: &to, To: &from},
+			setupMocks:    func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {},
+			expectedError: svcErr.ErrInvalidStatsFilter,
+		},
+		{
+			name:          "error - unknown status",
+			filter:        domain.StatsFilter{Status: &unknown},
+			setupMocks:    func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {},
+			expectedError: svcErr.ErrInvalidStatsFilter,
+		},
+		{
+			name:   "error - team not found",
+			filter: domain.StatsFilter{TeamName: "ghosts"},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				tm.On("GetByName", mock.Anything, "ghosts").Return(nil, repoErr.ErrTeamNotFound)
+			},
+			expectedError: svcErr.ErrTeamNotFound,
+		},
+		{
+			name:   "error - unexpected from repo",
+			filter: domain.StatsFilter{},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				sm.On("ReviewerAssignments", mock.Anything, mock.Anything).Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := mocks.NewMockStatsRepository(t)
+			tm := mocks.NewMockTeamRepository(t)
+			tt.setupMocks(sm, tm)
+
+			svc := New(slog.New(slog.DiscardHandler), sm, tm)
+
+			got, err := svc.ReviewerStats(context.Background(), tt.filter)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedStats, got)
+			}
+		})
+	}
+}
+
+func TestService_TeamStats(t *testing.T) {
+	tests := []struct {
+		name          string
+		filter        domain.StatsFilter
+		setupMocks    func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository)
+		expectedStats []domain.TeamStats
+		expectedError error
+	}{
+		{
+			name:   "success",
+			filter: domain.StatsFilter{},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				sm.On("TeamAssignments", mock.Anything, domain.StatsFilter{}).Return([]domain.TeamStats{
+					{TeamName: "backend", Members: 2, Assignments: 3, MaxAssignments: 2, MinAssignments: 1, AvgAssignments: 1.5},
+				}, nil)
+			},
+			expectedStats: []domain.TeamStats{
+				{TeamName: "backend", Members: 2, Assignments: 3, MaxAssignments: 2, MinAssignments: 1, AvgAssignments: 1.5},
+			},
+		},
+		{
+			name:   "error - team not found",
+			filter: domain.StatsFilter{TeamName: "ghosts"},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				tm.On("GetByName", mock.Anything, "ghosts").Return(nil, repoErr.ErrTeamNotFound)
+			},
+			expectedError: svcErr.ErrTeamNotFound,
+		},
+		{
+			name:   "error - unexpected from repo",
+			filter: domain.StatsFilter{},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				sm.On("TeamAssignments", mock.Anything, mock.Anything).Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := mocks.NewMockStatsRepository(t)
+			tm := mocks.NewMockTeamRepository(t)
+			tt.setupMocks(sm, tm)
+
+			svc := New(slog.New(slog.DiscardHandler), sm, tm)
+
+			got, err := svc.TeamStats(context.Background(), tt.filter)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedStats, got)
+			}
+		})
+	}
+}
+
+func TestService_CycleTime(t *testing.T) {
+	median := 2 * time.Hour
+	weekStart := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		group         domain.CycleTimeGroup
+		filter        domain.StatsFilter
+		setupMocks    func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository)
+		expectedStats []domain.CycleTimeStats
+		expectedError error
+	}{
+		{
+			name:   "success - by repository",
+			group:  domain.CycleTimeByRepository,
+			filter: domain.StatsFilter{},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				sm.On("CycleTime", mock.Anything, domain.CycleTimeByRepository, domain.StatsFilter{}).
+					Return([]domain.CycleTimeStats{
+						{
+							Group:             "backend",
+							WeekStart:         weekStart,
+							PullRequests:      1,
+							TimeToFirstReview: domain.DurationStats{Count: 1, Median: &median, P90: &median},
+						},
+					}, nil)
+			},
+			expectedStats: []domain.CycleTimeStats{
+				{
+					Group:             "backend",
+					WeekStart:         weekStart,
+					PullRequests:      1,
+					TimeToFirstReview: domain.DurationStats{Count: 1, Median: &median, P90: &median},
+				},
+			},
+		},
+		{
+			name:          "error - unknown group",
+			group:         "author",
+			setupMocks:    func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {},
+			expectedError: svcErr.ErrInvalidStatsFilter,
+		},
+		{
+			name:   "error - team not found",
+			group:  domain.CycleTimeByTeam,
+			filter: domain.StatsFilter{TeamName: "ghosts"},
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				tm.On("GetByName", mock.Anything, "ghosts").Return(nil, repoErr.ErrTeamNotFound)
+			},
+			expectedError: svcErr.ErrTeamNotFound,
+		},
+		{
+			name:  "error - unexpected from repo",
+			group: domain.CycleTimeByReviewer,
+			setupMocks: func(sm *mocks.MockStatsRepository, tm *mocks.MockTeamRepository) {
+				sm.On("CycleTime", mock.Anything, domain.CycleTimeByReviewer, mock.Anything).
+					Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := mocks.NewMockStatsRepository(t)
+			tm := mocks.NewMockTeamRepository(t)
+			tt.setupMocks(sm, tm)
+
+			svc := New(slog.New(slog.DiscardHandler), sm, tm)
+
+			got, err := svc.CycleTime(context.Background(), tt.group, tt.filter)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedStats, got)
+			}
+		})
+	}
+}
+
+func TestService_Workload(t *testing.T) {
+	open := domain.PRStatusOpen
+	openFilter := domain.StatsFilter{Status: &open}
+
+	tests := []struct {
+		name             string
+		setupMocks       func(sm *mocks.MockStatsRepository)
+		expectedWorkload *domain.Workload
+		expectedError    error
+	}{
+		{
+			name: "success - assignments counted on open pull requests",
+			setupMocks: func(sm *mocks.MockStatsRepository) {
+				sm.On("PullRequestCounts", mock.Anything).
+					Return(domain.PullRequestCounts{Open: 3, WithoutReviewers: 1}, nil)
+				sm.On("TeamAssignments", mock.Anything, openFilter).Return([]domain.TeamStats{
+					{TeamName: "backend", Members: 2, Assignments: 3},
+				}, nil)
+			},
+			expectedWorkload: &domain.Workload{
+				PullRequests: domain.PullRequestCounts{Open: 3, WithoutReviewers: 1},
+				Teams:        []domain.TeamStats{{TeamName: "backend", Members: 2, Assignments: 3}},
+			},
+		},
+		{
+			name: "error - counting pull requests fails",
+			setupMocks: func(sm *mocks.MockStatsRepository) {
+				sm.On("PullRequestCounts", mock.Anything).Return(domain.PullRequestCounts{}, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+		{
+			name: "error - team assignments fail",
+			setupMocks: func(sm *mocks.MockStatsRepository) {
+				sm.On("PullRequestCounts", mock.Anything).Return(domain.PullRequestCounts{}, nil)
+				sm.On("TeamAssignments", mock.Anything, openFilter).Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := mocks.NewMockStatsRepository(t)
+			tt.setupMocks(sm)
+
+			svc := New(slog.New(slog.DiscardHandler), sm, mocks.NewMockTeamRepository(t))
+
+			got, err := svc.Workload(context.Background())
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedWorkload, got)
+			}
+		})
+	}
+}
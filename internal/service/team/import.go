@@ -0,0 +1,218 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tracing"
+)
+
+// ImportTeams сравнивает желаемое состояние команд организации с текущим и возвращает
+// план изменений; при apply план применяется в одной транзакции. Команды, отсутствующие
+// в желаемом состоянии, не удаляются, но их участники деактивируются. SLA команд не меняется.
+// Если желаемое состояние некорректно, возвращается ошибка svcErr.ErrInvalidTeamImport.
+func (s *Service) ImportTeams(ctx context.Context, desired []domain.Team, apply bool) (*domain.TeamPlan, error) {
+	const op = "team.ImportTeams"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.Bool("apply", apply),
+	)
+
+	err := validateImport(desired)
+	if err != nil {
+		lgr.DebugContext(ctx, "invalid team import", slog.Any("error", err))
+
+		return nil, err
+	}
+
+	current, err := s.teamsRepo.ListWithMembers(ctx)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to list teams with members", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	plan := planImport(current, desired)
+	if !apply || plan.Empty() {
+		return &plan, nil
+	}
+
+	err = s.teamsRepo.ApplyPlan(ctx, plan)
+	if errors.Is(err, repoErr.ErrUsernameTaken) {
+		lgr.DebugContext(ctx, "username is already taken")
+
+		return nil, fmt.Errorf("%w: username is already taken by another user", svcErr.ErrInvalidTeamImport)
+	}
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "team removed while applying plan")
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "user removed while applying plan")
+
+		return nil, svcErr.ErrUserNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to apply team import plan", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.InfoContext(ctx, "team import applied", slog.Int("changesCount", len(plan.Changes)))
+
+	return &plan, nil
+}
+
+// ExportTeams возвращает все команды организации с участниками в формате,
+// который принимает ImportTeams.
+func (s *Service) ExportTeams(ctx context.Context) ([]domain.Team, error) {
+	const op = "team.ExportTeams"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	teams, err := s.teamsRepo.ListWithMembers(ctx)
+	if err != nil {
+		s.lgr.ErrorContext(ctx, "failed to list teams with members",
+			slog.String("op", op),
+			slog.Any("error", err),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return teams, nil
+}
+
+// validateImport проверяет, что имена команд, идентификаторы и имена пользователей
+// заполнены и уникальны, а email участников корректны.
+func validateImport(desired []domain.Team) error {
+	teams := make(map[string]struct{}, len(desired))
+	userIDs := make(map[string]struct{})
+	usernames := make(map[string]struct{})
+
+	for i, team := range desired {
+		if team.Name == "" {
+			return fmt.Errorf("%w: teams[%d]: team name is required", svcErr.ErrInvalidTeamImport, i)
+		}
+		if _, ok := teams[team.Name]; ok {
+			return fmt.Errorf("%w: team %q is declared twice", svcErr.ErrInvalidTeamImport, team.Name)
+		}
+		teams[team.Name] = struct{}{}
+
+		for j, m := range team.Members {
+			if m.ID == "" || m.Username == "" {
+				return fmt.Errorf("%w: team %q: members[%d]: user id and username are required",
+					svcErr.ErrInvalidTeamImport, team.Name, j)
+			}
+			if _, ok := userIDs[m.ID]; ok {
+				return fmt.Errorf("%w: user %q is declared twice", svcErr.ErrInvalidTeamImport, m.ID)
+			}
+			userIDs[m.ID] = struct{}{}
+
+			if _, ok := usernames[m.Username]; ok {
+				return fmt.Errorf("%w: username %q is declared twice", svcErr.ErrInvalidTeamImport, m.Username)
+			}
+			usernames[m.Username] = struct{}{}
+
+			if m.Email != "" && !domain.IsValidEmail(m.Email) {
+				return fmt.Errorf("%w: user %q: %w", svcErr.ErrInvalidTeamImport, m.ID, svcErr.ErrInvalidEmail)
+			}
+		}
+	}
+
+	return nil
+}
+
+// planImport строит план перехода от текущего состояния команд к желаемому.
+// Изменения идут в порядке желаемого состояния: сначала создание команд, затем
+// добавление, перевод и обновление пользователей, в конце деактивация
+// пользователей, отсутствующих в желаемом состоянии. Пустой email участника
+// означает «не менять».
+func planImport(current, desired []domain.Team) domain.TeamPlan {
+	type placement struct {
+		team   string
+		member domain.Member
+	}
+
+	existingTeams := make(map[string]struct{}, len(current))
+	existingUsers := make(map[string]placement)
+	for _, team := range current {
+		existingTeams[team.Name] = struct{}{}
+		for _, m := range team.Members {
+			existingUsers[m.ID] = placement{team: team.Name, member: m}
+		}
+	}
+
+	var plan domain.TeamPlan
+
+	for _, team := range desired {
+		if _, ok := existingTeams[team.Name]; !ok {
+			plan.Changes = append(plan.Changes, domain.TeamChange{
+				Action:   domain.TeamChangeCreateTeam,
+				TeamName: team.Name,
+			})
+		}
+	}
+
+	declared := make(map[string]struct{})
+	for _, team := range desired {
+		for _, m := range team.Members {
+			declared[m.ID] = struct{}{}
+
+			cur, ok := existingUsers[m.ID]
+			switch {
+			case !ok:
+				plan.Changes = append(plan.Changes, domain.TeamChange{
+					Action:   domain.TeamChangeAddUser,
+					TeamName: team.Name,
+					Member:   m,
+				})
+			case cur.team != team.Name:
+				plan.Changes = append(plan.Changes, domain.TeamChange{
+					Action:   domain.TeamChangeMoveUser,
+					TeamName: team.Name,
+					FromTeam: cur.team,
+					Member:   m,
+				})
+			case memberChanged(cur.member, m):
+				plan.Changes = append(plan.Changes, domain.TeamChange{
+					Action:   domain.TeamChangeUpdateUser,
+					TeamName: team.Name,
+					Member:   m,
+				})
+			}
+		}
+	}
+
+	for _, team := range current {
+		for _, m := range team.Members {
+			if _, ok := declared[m.ID]; ok || !m.IsActive {
+				continue
+			}
+			plan.Changes = append(plan.Changes, domain.TeamChange{
+				Action:   domain.TeamChangeDeactivateUser,
+				TeamName: team.Name,
+				Member:   m,
+			})
+		}
+	}
+
+	return plan
+}
+
+func memberChanged(cur, desired domain.Member) bool {
+	return cur.Username != desired.Username ||
+		cur.IsActive != desired.IsActive ||
+		(desired.Email != "" && desired.Email != cur.Email)
+}
@@ -0,0 +1,195 @@
+package team
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	svcErr "avitotech-pr-reviewer/internal/service/errors"
+	"avitotech-pr-reviewer/internal/service/team/mocks"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+func TestPlanImport(t *testing.T) {
+	current := []domain.Team{
+		{Name: "backend", Members: []domain.Member{
+			{ID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+			{ID: "u2", Username: "bob", IsActive: true},
+			{ID: "u3", Username: "carol", IsActive: false},
+		}},
+		{Name: "legacy", Members: []domain.Member{
+			{ID: "u4", Username: "dave", IsActive: true},
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		desired  []domain.Team
+		expected []domain.TeamChange
+	}{
+		{
+			name:    "success - same state yields empty plan",
+			desired: current,
+		},
+		{
+			name: "success - empty email keeps stored one",
+			desired: []domain.Team{
+				{Name: "backend", Members: []domain.Member{
+					{ID: "u1", Username: "alice", IsActive: true},
+					{ID: "u2", Username: "bob", IsActive: true},
+					{ID: "u3", Username: "carol", IsActive: false},
+				}},
+				{Name: "legacy", Members: []domain.Member{{ID: "u4", Username: "dave", IsActive: true}}},
+			},
+		},
+		{
+			name: "success - create, add, move, update and deactivate",
+			desired: []domain.Team{
+				{Name: "backend", Members: []domain.Member{
+					{ID: "u1", Username: "alice", IsActive: true, Email: "alice@corp.example.com"},
+					{ID: "u5", Username: "erin", IsActive: true},
+				}},
+				{Name: "mobile", Members: []domain.Member{
+					{ID: "u2", Username: "bob", IsActive: true},
+				}},
+			},
+			expected: []domain.TeamChange{
+				{Action: domain.TeamChangeCreateTeam, TeamName: "mobile"},
+				{Action: domain.TeamChangeUpdateUser, TeamName: "backend",
+					Member: domain.Member{ID: "u1", Username: "alice", IsActive: true, Email: "alice@corp.example.com"}},
+				{Action: domain.TeamChangeAddUser, TeamName: "backend",
+					Member: domain.Member{ID: "u5", Username: "erin", IsActive: true}},
+				{Action: domain.TeamChangeMoveUser, TeamName: "mobile", FromTeam: "backend",
+					Member: domain.Member{ID: "u2", Username: "bob", IsActive: true}},
+				// u3 уже неактивен, поэтому деактивируется только u4 из команды legacy.
+				{Action: domain.TeamChangeDeactivateUser, TeamName: "legacy",
+					Member: domain.Member{ID: "u4", Username: "dave", IsActive: true}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := planImport(current, tt.desired)
+
+			assert.Equal(t, tt.expected, plan.Changes)
+		})
+	}
+}
+
+func TestService_ImportTeams(t *testing.T) {
+	current := []domain.Team{
+		{Name: "backend", Members: []domain.Member{{ID: "u1", Username: "alice", IsActive: true}}},
+	}
+	desired := []domain.Team{
+		{Name: "mobile", Members: []domain.Member{{ID: "u1", Username: "alice", IsActive: true}}},
+	}
+	plan := domain.TeamPlan{Changes: []domain.TeamChange{
+		{Action: domain.TeamChangeCreateTeam, TeamName: "mobile"},
+		{Action: domain.TeamChangeMoveUser, TeamName: "mobile", FromTeam: "backend",
+			Member: domain.Member{ID: "u1", Username: "alice", IsActive: true}},
+	}}
+
+	tests := []struct {
+		name          string
+		desired       []domain.Team
+		apply         bool
+		setupMock     func(m *mocks.MockTeamRepository)
+		expectedPlan  *domain.TeamPlan
+		expectedError error
+	}{
+		{
+			name:    "success - plan only",
+			desired: desired,
+			setupMock: func(m *mocks.MockTeamRepository) {
+				m.On("ListWithMembers", mock.Anything).Return(current, nil)
+			},
+			expectedPlan: &plan,
+		},
+		{
+			name:    "success - apply",
+			desired: desired,
+			apply:   true,
+			setupMock: func(m *mocks.MockTeamRepository) {
+				m.On("ListWithMembers", mock.Anything).Return(current, nil)
+				m.On("ApplyPlan", mock.Anything, plan).Return(nil)
+			},
+			expectedPlan: &plan,
+		},
+		{
+			name:    "success - empty plan is not applied",
+			desired: current,
+			apply:   true,
+			setupMock: func(m *mocks.MockTeamRepository) {
+				m.On("ListWithMembers", mock.Anything).Return(current, nil)
+			},
+			expectedPlan: &domain.TeamPlan{},
+		},
+		{
+			name: "error - duplicate user",
+			desired: []domain.Team{
+				{Name: "backend", Members: []domain.Member{{ID: "u1", Username: "alice"}}},
+				{Name: "mobile", Members: []domain.Member{{ID: "u1", Username: "alice2"}}},
+			},
+			setupMock:     func(m *mocks.MockTeamRepository) {},
+			expectedError: svcErr.ErrInvalidTeamImport,
+		},
+		{
+			name:          "error - duplicate team",
+			desired:       []domain.Team{{Name: "backend"}, {Name: "backend"}},
+			setupMock:     func(m *mocks.MockTeamRepository) {},
+			expectedError: svcErr.ErrInvalidTeamImport,
+		},
+		{
+			name: "error - invalid email",
+			desired: []domain.Team{
+				{Name: "backend", Members: []domain.Member{{ID: "u1", Username: "alice", Email: "alice"}}},
+			},
+			setupMock:     func(m *mocks.MockTeamRepository) {},
+			expectedError: svcErr.ErrInvalidEmail,
+		},
+		{
+			name:    "error - username taken",
+			desired: desired,
+			apply:   true,
+			setupMock: func(m *mocks.MockTeamRepository) {
+				m.On("ListWithMembers", mock.Anything).Return(current, nil)
+				m.On("ApplyPlan", mock.Anything, plan).Return(repoErr.ErrUsernameTaken)
+			},
+			expectedError: svcErr.ErrInvalidTeamImport,
+		},
+		{
+			name:    "error - unexpected",
+			desired: desired,
+			setupMock: func(m *mocks.MockTeamRepository) {
+				m.On("ListWithMembers", mock.Anything).Return(nil, ErrUnexpected)
+			},
+			expectedError: ErrUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTeamRepo := mocks.NewMockTeamRepository(t)
+			mockUserRepo := mocks.NewMockUserRepository(t)
+			tt.setupMock(mockTeamRepo)
+
+			service := New(slog.New(slog.DiscardHandler), mockTeamRepo, mockUserRepo)
+
+			got, err := service.ImportTeams(t.Context(), tt.desired, tt.apply)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPlan, got)
+		})
+	}
+}
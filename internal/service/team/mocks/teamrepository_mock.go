@@ -179,3 +179,196 @@ func (_c *MockTeamRepository_GetByName_Call) RunAndReturn(run func(ctx context.C
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateSLA provides a mock function for the type MockTeamRepository
+func (_mock *MockTeamRepository) UpdateSLA(ctx context.Context, teamID string, sla domain.TeamSLA) (*domain.Team, error) {
+	ret := _mock.Called(ctx, teamID, sla)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSLA")
+	}
+
+	var r0 *domain.Team
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.TeamSLA) (*domain.Team, error)); ok {
+		return returnFunc(ctx, teamID, sla)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.TeamSLA) *domain.Team); ok {
+		r0 = returnFunc(ctx, teamID, sla)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Team)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.TeamSLA) error); ok {
+		r1 = returnFunc(ctx, teamID, sla)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTeamRepository_UpdateSLA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSLA'
+type MockTeamRepository_UpdateSLA_Call struct {
+	*mock.Call
+}
+
+// UpdateSLA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID string
+//   - sla domain.TeamSLA
+func (_e *MockTeamRepository_Expecter) UpdateSLA(ctx interface{}, teamID interface{}, sla interface{}) *MockTeamRepository_UpdateSLA_Call {
+	return &MockTeamRepository_UpdateSLA_Call{Call: _e.mock.On("UpdateSLA", ctx, teamID, sla)}
+}
+
+func (_c *MockTeamRepository_UpdateSLA_Call) Run(run func(ctx context.Context, teamID string, sla domain.TeamSLA)) *MockTeamRepository_UpdateSLA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.TeamSLA
+		if args[2] != nil {
+			arg2 = args[2].(domain.TeamSLA)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_UpdateSLA_Call) Return(team *domain.Team, err error) *MockTeamRepository_UpdateSLA_Call {
+	_c.Call.Return(team, err)
+	return _c
+}
+
+func (_c *MockTeamRepository_UpdateSLA_Call) RunAndReturn(run func(ctx context.Context, teamID string, sla domain.TeamSLA) (*domain.Team, error)) *MockTeamRepository_UpdateSLA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithMembers provides a mock function for the type MockTeamRepository
+func (_mock *MockTeamRepository) ListWithMembers(ctx context.Context) ([]domain.Team, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithMembers")
+	}
+
+	var r0 []domain.Team
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.Team, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.Team); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Team)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTeamRepository_ListWithMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithMembers'
+type MockTeamRepository_ListWithMembers_Call struct {
+	*mock.Call
+}
+
+// ListWithMembers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTeamRepository_Expecter) ListWithMembers(ctx interface{}) *MockTeamRepository_ListWithMembers_Call {
+	return &MockTeamRepository_ListWithMembers_Call{Call: _e.mock.On("ListWithMembers", ctx)}
+}
+
+func (_c *MockTeamRepository_ListWithMembers_Call) Run(run func(ctx context.Context)) *MockTeamRepository_ListWithMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_ListWithMembers_Call) Return(teams []domain.Team, err error) *MockTeamRepository_ListWithMembers_Call {
+	_c.Call.Return(teams, err)
+	return _c
+}
+
+func (_c *MockTeamRepository_ListWithMembers_Call) RunAndReturn(run func(ctx context.Context) ([]domain.Team, error)) *MockTeamRepository_ListWithMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyPlan provides a mock function for the type MockTeamRepository
+func (_mock *MockTeamRepository) ApplyPlan(ctx context.Context, plan domain.TeamPlan) error {
+	ret := _mock.Called(ctx, plan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPlan")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TeamPlan) error); ok {
+		r0 = returnFunc(ctx, plan)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTeamRepository_ApplyPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyPlan'
+type MockTeamRepository_ApplyPlan_Call struct {
+	*mock.Call
+}
+
+// ApplyPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - plan domain.TeamPlan
+func (_e *MockTeamRepository_Expecter) ApplyPlan(ctx interface{}, plan interface{}) *MockTeamRepository_ApplyPlan_Call {
+	return &MockTeamRepository_ApplyPlan_Call{Call: _e.mock.On("ApplyPlan", ctx, plan)}
+}
+
+func (_c *MockTeamRepository_ApplyPlan_Call) Run(run func(ctx context.Context, plan domain.TeamPlan)) *MockTeamRepository_ApplyPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.TeamPlan
+		if args[1] != nil {
+			arg1 = args[1].(domain.TeamPlan)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_ApplyPlan_Call) Return(err error) *MockTeamRepository_ApplyPlan_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTeamRepository_ApplyPlan_Call) RunAndReturn(run func(ctx context.Context, plan domain.TeamPlan) error) *MockTeamRepository_ApplyPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
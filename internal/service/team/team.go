@@ -5,15 +5,20 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"avitotech-pr-reviewer/internal/domain"
 	svcErr "avitotech-pr-reviewer/internal/service/errors"
 	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tracing"
 )
 
 type TeamRepository interface {
 	CreateWithMembers(ctx context.Context, teamName string, members []domain.Member) (*domain.Team, error)
 	GetByName(ctx context.Context, teamName string) (*domain.Team, error)
+	UpdateSLA(ctx context.Context, teamID string, sla domain.TeamSLA) (*domain.Team, error)
+	ListWithMembers(ctx context.Context) ([]domain.Team, error)
+	ApplyPlan(ctx context.Context, plan domain.TeamPlan) error
 }
 
 type UserRepository interface {
@@ -40,6 +45,7 @@ func New(
 }
 
 // CreateTeam создает команду с указанным именем и участниками.
+// Если у участника указан некорректный email, возвращается ошибка svcErr.ErrInvalidEmail.
 // Если команда с таким именем уже существует, возвращается ошибка svcErr.ErrTeamExists.
 func (s *Service) CreateTeam(
 	ctx context.Context,
@@ -48,11 +54,22 @@ func (s *Service) CreateTeam(
 ) (*domain.Team, error) {
 	const op = "team.CreateTeam"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	lgr := s.lgr.With(
 		slog.String("op", op),
 		slog.String("teamName", teamName),
 	)
 
+	for _, m := range members {
+		if m.Email != "" && !domain.IsValidEmail(m.Email) {
+			lgr.DebugContext(ctx, "invalid member email", slog.String("userID", m.ID))
+
+			return nil, svcErr.ErrInvalidEmail
+		}
+	}
+
 	createdTeam, err := s.teamsRepo.CreateWithMembers(ctx, teamName, members)
 	if errors.Is(err, repoErr.ErrTeamExists) {
 		lgr.DebugContext(ctx, "team already exists")
@@ -77,6 +94,9 @@ func (s *Service) CreateTeam(
 func (s *Service) TeamWithMembers(ctx context.Context, teamName string) (*domain.Team, error) {
 	const op = "team.TeamWithMembers"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	lgr := s.lgr.With(
 		slog.String("op", op),
 		slog.String("teamName", teamName),
@@ -105,3 +125,69 @@ func (s *Service) TeamWithMembers(ctx context.Context, teamName string) (*domain
 
 	return teamDB, nil
 }
+
+// SetSLA задаёт срок ревью для участников команды и действие при его нарушении.
+// Нулевой sla.ReviewHours отключает SLA. Лид должен быть участником команды;
+// для эскалации лид обязателен.
+// Если команда не найдена, возвращается ошибка svcErr.ErrTeamNotFound.
+// Если настройки некорректны, возвращается ошибка svcErr.ErrInvalidSLA.
+func (s *Service) SetSLA(ctx context.Context, teamName string, sla domain.TeamSLA) (*domain.Team, error) {
+	const op = "team.SetSLA"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("teamName", teamName),
+	)
+
+	if sla.Action == "" {
+		sla.Action = domain.SLAActionNone
+	}
+	if sla.ReviewHours < 0 || !sla.Action.IsValid() {
+		return nil, fmt.Errorf("%w: review hours must not be negative and action must be known", svcErr.ErrInvalidSLA)
+	}
+	if sla.Action == domain.SLAActionEscalate && sla.LeadID == "" {
+		return nil, fmt.Errorf("%w: escalation requires a team lead", svcErr.ErrInvalidSLA)
+	}
+
+	team, err := s.teamsRepo.GetByName(ctx, teamName)
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "team not found")
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get team by name", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members, err := s.usersRepo.ListByTeamID(ctx, team.ID)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to list team members", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if sla.LeadID != "" && !slices.ContainsFunc(members, func(m domain.Member) bool { return m.ID == sla.LeadID }) {
+		return nil, fmt.Errorf("%w: lead %q is not a member of the team", svcErr.ErrInvalidSLA, sla.LeadID)
+	}
+
+	updated, err := s.teamsRepo.UpdateSLA(ctx, team.ID, sla)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to update team SLA", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.InfoContext(ctx, "team SLA updated",
+		slog.Int("reviewHours", sla.ReviewHours),
+		slog.String("action", string(sla.Action)),
+	)
+
+	updated.Members = members
+
+	return updated, nil
+}
@@ -164,6 +164,16 @@ func TestService_CreateTeam(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:     "error - invalid member email",
+			teamName: "backend",
+			members: []domain.Member{
+				{ID: "u1", Username: "Alice", IsActive: true, Email: "alice@example.com"},
+				{ID: "u2", Username: "Bob", IsActive: true, Email: "Bob <bob@example.com>"},
+			},
+			setupMock:     func(m *mocks.MockTeamRepository) {},
+			expectedError: svcErr.ErrInvalidEmail,
+		},
 		{
 			name:     "error - team already exists",
 			teamName: "existing-team",
@@ -254,3 +264,110 @@ func TestService_CreateTeam(t *testing.T) {
 		})
 	}
 }
+
+func TestService_SetSLA(t *testing.T) {
+	members := []domain.Member{
+		{ID: "u1", Username: "Charlie", IsActive: true},
+		{ID: "u2", Username: "Dana", IsActive: true},
+	}
+
+	tests := []struct {
+		name          string
+		sla           domain.TeamSLA
+		setupMock     func(m1 *mocks.MockTeamRepository, m2 *mocks.MockUserRepository)
+		expectedTeam  *domain.Team
+		expectedError error
+	}{
+		{
+			name: "success - escalate to lead",
+			sla:  domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "u1"},
+			setupMock: func(m1 *mocks.MockTeamRepository, m2 *mocks.MockUserRepository) {
+				m1.On("GetByName", mock.Anything, "devops").Return(&domain.Team{ID: "team-001", Name: "devops"}, nil)
+				m2.On("ListByTeamID", mock.Anything, "team-001").Return(members, nil)
+				m1.On("UpdateSLA", mock.Anything, "team-001",
+					domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "u1"}).
+					Return(&domain.Team{
+						ID:   "team-001",
+						Name: "devops",
+						SLA:  domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "u1"},
+					}, nil)
+			},
+			expectedTeam: &domain.Team{
+				ID:      "team-001",
+				Name:    "devops",
+				Members: members,
+				SLA:     domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "u1"},
+			},
+		},
+		{
+			name: "success - action defaults to none",
+			sla:  domain.TeamSLA{ReviewHours: 8},
+			setupMock: func(m1 *mocks.MockTeamRepository, m2 *mocks.MockUserRepository) {
+				m1.On("GetByName", mock.Anything, "devops").Return(&domain.Team{ID: "team-001", Name: "devops"}, nil)
+				m2.On("ListByTeamID", mock.Anything, "team-001").Return(members, nil)
+				m1.On("UpdateSLA", mock.Anything, "team-001",
+					domain.TeamSLA{ReviewHours: 8, Action: domain.SLAActionNone}).
+					Return(&domain.Team{
+						ID:   "team-001",
+						Name: "devops",
+						SLA:  domain.TeamSLA{ReviewHours: 8, Action: domain.SLAActionNone},
+					}, nil)
+			},
+			expectedTeam: &domain.Team{
+				ID:      "team-001",
+				Name:    "devops",
+				Members: members,
+				SLA:     domain.TeamSLA{ReviewHours: 8, Action: domain.SLAActionNone},
+			},
+		},
+		{
+			name:          "error - escalation without lead",
+			sla:           domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate},
+			setupMock:     func(m1 *mocks.MockTeamRepository, m2 *mocks.MockUserRepository) {},
+			expectedError: svcErr.ErrInvalidSLA,
+		},
+		{
+			name:          "error - unknown action",
+			sla:           domain.TeamSLA{ReviewHours: 24, Action: "ping"},
+			setupMock:     func(m1 *mocks.MockTeamRepository, m2 *mocks.MockUserRepository) {},
+			expectedError: svcErr.ErrInvalidSLA,
+		},
+		{
+			name: "error - lead is not a team member",
+			sla:  domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "u9"},
+			setupMock: func(m1 *mocks.MockTeamRepository, m2 *mocks.MockUserRepository) {
+				m1.On("GetByName", mock.Anything, "devops").Return(&domain.Team{ID: "team-001", Name: "devops"}, nil)
+				m2.On("ListByTeamID", mock.Anything, "team-001").Return(members, nil)
+			},
+			expectedError: svcErr.ErrInvalidSLA,
+		},
+		{
+			name: "error - team not found",
+			sla:  domain.TeamSLA{ReviewHours: 24},
+			setupMock: func(m1 *mocks.MockTeamRepository, m2 *mocks.MockUserRepository) {
+				m1.On("GetByName", mock.Anything, "devops").Return(nil, repoErr.ErrTeamNotFound)
+			},
+			expectedError: svcErr.ErrTeamNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTeamRepo := mocks.NewMockTeamRepository(t)
+			mockUserRepo := mocks.NewMockUserRepository(t)
+			tt.setupMock(mockTeamRepo, mockUserRepo)
+
+			service := New(slog.New(slog.DiscardHandler), mockTeamRepo, mockUserRepo)
+
+			result, err := service.SetSLA(context.Background(), "devops", tt.sla)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTeam, result)
+			}
+		})
+	}
+}
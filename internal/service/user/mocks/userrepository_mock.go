@@ -7,6 +7,7 @@ package mocks
 import (
 	"avitotech-pr-reviewer/internal/domain"
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,23 +39,25 @@ func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
 	return &MockUserRepository_Expecter{mock: &_m.Mock}
 }
 
-// GetUsersTeamName provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) GetUsersTeamName(ctx context.Context, userID string) (string, error) {
+// GetByID provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByID(ctx context.Context, userID string) (*domain.User, error) {
 	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUsersTeamName")
+		panic("no return value specified for GetByID")
 	}
 
-	var r0 string
+	var r0 *domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
 		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
 		r0 = returnFunc(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = returnFunc(ctx, userID)
@@ -64,19 +67,19 @@ func (_mock *MockUserRepository) GetUsersTeamName(ctx context.Context, userID st
 	return r0, r1
 }
 
-// MockUserRepository_GetUsersTeamName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersTeamName'
-type MockUserRepository_GetUsersTeamName_Call struct {
+// MockUserRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserRepository_GetByID_Call struct {
 	*mock.Call
 }
 
-// GetUsersTeamName is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *MockUserRepository_Expecter) GetUsersTeamName(ctx interface{}, userID interface{}) *MockUserRepository_GetUsersTeamName_Call {
-	return &MockUserRepository_GetUsersTeamName_Call{Call: _e.mock.On("GetUsersTeamName", ctx, userID)}
+func (_e *MockUserRepository_Expecter) GetByID(ctx interface{}, userID interface{}) *MockUserRepository_GetByID_Call {
+	return &MockUserRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, userID)}
 }
 
-func (_c *MockUserRepository_GetUsersTeamName_Call) Run(run func(ctx context.Context, userID string)) *MockUserRepository_GetUsersTeamName_Call {
+func (_c *MockUserRepository_GetByID_Call) Run(run func(ctx context.Context, userID string)) *MockUserRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -94,12 +97,80 @@ func (_c *MockUserRepository_GetUsersTeamName_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockUserRepository_GetUsersTeamName_Call) Return(s string, err error) *MockUserRepository_GetUsersTeamName_Call {
-	_c.Call.Return(s, err)
+func (_c *MockUserRepository_GetByID_Call) Return(user *domain.User, err error) *MockUserRepository_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.User, error)) *MockUserRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	ret := _mock.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.User, error)); ok {
+		return returnFunc(ctx, userIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.User); ok {
+		r0 = returnFunc(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockUserRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []string
+func (_e *MockUserRepository_Expecter) GetByIDs(ctx interface{}, userIDs interface{}) *MockUserRepository_GetByIDs_Call {
+	return &MockUserRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, userIDs)}
+}
+
+func (_c *MockUserRepository_GetByIDs_Call) Run(run func(ctx context.Context, userIDs []string)) *MockUserRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByIDs_Call) Return(users []domain.User, err error) *MockUserRepository_GetByIDs_Call {
+	_c.Call.Return(users, err)
 	return _c
 }
 
-func (_c *MockUserRepository_GetUsersTeamName_Call) RunAndReturn(run func(ctx context.Context, userID string) (string, error)) *MockUserRepository_GetUsersTeamName_Call {
+func (_c *MockUserRepository_GetByIDs_Call) RunAndReturn(run func(ctx context.Context, userIDs []string) ([]domain.User, error)) *MockUserRepository_GetByIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -177,3 +248,367 @@ func (_c *MockUserRepository_SetIsActive_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// SetReminderFrequency provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) SetReminderFrequency(ctx context.Context, userID string, frequency domain.ReminderFrequency) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID, frequency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReminderFrequency")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.ReminderFrequency) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID, frequency)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.ReminderFrequency) *domain.User); ok {
+		r0 = returnFunc(ctx, userID, frequency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.ReminderFrequency) error); ok {
+		r1 = returnFunc(ctx, userID, frequency)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_SetReminderFrequency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReminderFrequency'
+type MockUserRepository_SetReminderFrequency_Call struct {
+	*mock.Call
+}
+
+// SetReminderFrequency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - frequency domain.ReminderFrequency
+func (_e *MockUserRepository_Expecter) SetReminderFrequency(ctx interface{}, userID interface{}, frequency interface{}) *MockUserRepository_SetReminderFrequency_Call {
+	return &MockUserRepository_SetReminderFrequency_Call{Call: _e.mock.On("SetReminderFrequency", ctx, userID, frequency)}
+}
+
+func (_c *MockUserRepository_SetReminderFrequency_Call) Run(run func(ctx context.Context, userID string, frequency domain.ReminderFrequency)) *MockUserRepository_SetReminderFrequency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.ReminderFrequency
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReminderFrequency)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetReminderFrequency_Call) Return(user *domain.User, err error) *MockUserRepository_SetReminderFrequency_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_SetReminderFrequency_Call) RunAndReturn(run func(ctx context.Context, userID string, frequency domain.ReminderFrequency) (*domain.User, error)) *MockUserRepository_SetReminderFrequency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEmail provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) SetEmail(ctx context.Context, userID string, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, userID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_SetEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEmail'
+type MockUserRepository_SetEmail_Call struct {
+	*mock.Call
+}
+
+// SetEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - email string
+func (_e *MockUserRepository_Expecter) SetEmail(ctx interface{}, userID interface{}, email interface{}) *MockUserRepository_SetEmail_Call {
+	return &MockUserRepository_SetEmail_Call{Call: _e.mock.On("SetEmail", ctx, userID, email)}
+}
+
+func (_c *MockUserRepository_SetEmail_Call) Run(run func(ctx context.Context, userID string, email string)) *MockUserRepository_SetEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetEmail_Call) Return(user *domain.User, err error) *MockUserRepository_SetEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_SetEmail_Call) RunAndReturn(run func(ctx context.Context, userID string, email string) (*domain.User, error)) *MockUserRepository_SetEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByChatID provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) GetByChatID(ctx context.Context, chatUserID string) (*domain.User, error) {
+	ret := _mock.Called(ctx, chatUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByChatID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, chatUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, chatUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, chatUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_GetByChatID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByChatID'
+type MockUserRepository_GetByChatID_Call struct {
+	*mock.Call
+}
+
+// GetByChatID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatUserID string
+func (_e *MockUserRepository_Expecter) GetByChatID(ctx interface{}, chatUserID interface{}) *MockUserRepository_GetByChatID_Call {
+	return &MockUserRepository_GetByChatID_Call{Call: _e.mock.On("GetByChatID", ctx, chatUserID)}
+}
+
+func (_c *MockUserRepository_GetByChatID_Call) Run(run func(ctx context.Context, chatUserID string)) *MockUserRepository_GetByChatID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_GetByChatID_Call) Return(user *domain.User, err error) *MockUserRepository_GetByChatID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_GetByChatID_Call) RunAndReturn(run func(ctx context.Context, chatUserID string) (*domain.User, error)) *MockUserRepository_GetByChatID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetChatUserID provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) SetChatUserID(ctx context.Context, userID string, chatUserID string) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID, chatUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetChatUserID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID, chatUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, userID, chatUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, chatUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_SetChatUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChatUserID'
+type MockUserRepository_SetChatUserID_Call struct {
+	*mock.Call
+}
+
+// SetChatUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - chatUserID string
+func (_e *MockUserRepository_Expecter) SetChatUserID(ctx interface{}, userID interface{}, chatUserID interface{}) *MockUserRepository_SetChatUserID_Call {
+	return &MockUserRepository_SetChatUserID_Call{Call: _e.mock.On("SetChatUserID", ctx, userID, chatUserID)}
+}
+
+func (_c *MockUserRepository_SetChatUserID_Call) Run(run func(ctx context.Context, userID string, chatUserID string)) *MockUserRepository_SetChatUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetChatUserID_Call) Return(user *domain.User, err error) *MockUserRepository_SetChatUserID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_SetChatUserID_Call) RunAndReturn(run func(ctx context.Context, userID string, chatUserID string) (*domain.User, error)) *MockUserRepository_SetChatUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAwayUntil provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) SetAwayUntil(ctx context.Context, userID string, until *time.Time) (*domain.User, error) {
+	ret := _mock.Called(ctx, userID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAwayUntil")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *time.Time) (*domain.User, error)); ok {
+		return returnFunc(ctx, userID, until)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *time.Time) *domain.User); ok {
+		r0 = returnFunc(ctx, userID, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_SetAwayUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAwayUntil'
+type MockUserRepository_SetAwayUntil_Call struct {
+	*mock.Call
+}
+
+// SetAwayUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - until *time.Time
+func (_e *MockUserRepository_Expecter) SetAwayUntil(ctx interface{}, userID interface{}, until interface{}) *MockUserRepository_SetAwayUntil_Call {
+	return &MockUserRepository_SetAwayUntil_Call{Call: _e.mock.On("SetAwayUntil", ctx, userID, until)}
+}
+
+func (_c *MockUserRepository_SetAwayUntil_Call) Run(run func(ctx context.Context, userID string, until *time.Time)) *MockUserRepository_SetAwayUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *time.Time
+		if args[2] != nil {
+			arg2 = args[2].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_SetAwayUntil_Call) Return(user *domain.User, err error) *MockUserRepository_SetAwayUntil_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_SetAwayUntil_Call) RunAndReturn(run func(ctx context.Context, userID string, until *time.Time) (*domain.User, error)) *MockUserRepository_SetAwayUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,40 +5,53 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"avitotech-pr-reviewer/internal/domain"
 	svcErr "avitotech-pr-reviewer/internal/service/errors"
 	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tracing"
 )
 
 type UserRepository interface {
+	GetByID(ctx context.Context, userID string) (*domain.User, error)
+	GetByIDs(ctx context.Context, userIDs []string) ([]domain.User, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
+	SetReminderFrequency(ctx context.Context, userID string, frequency domain.ReminderFrequency) (*domain.User, error)
+	SetEmail(ctx context.Context, userID, email string) (*domain.User, error)
+	GetByChatID(ctx context.Context, chatUserID string) (*domain.User, error)
+	SetChatUserID(ctx context.Context, userID, chatUserID string) (*domain.User, error)
+	SetAwayUntil(ctx context.Context, userID string, until *time.Time) (*domain.User, error)
 }
 
 type TeamRepository interface {
 	GetByID(ctx context.Context, teamID string) (*domain.Team, error)
 }
 
+type PrRepository interface {
+	ListByReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequest, error)
+	ListByReviewerIDs(ctx context.Context, reviewerIDs []string) (map[string][]domain.PullRequest, error)
+}
+
 type Service struct {
 	lgr *slog.Logger
 
 	userRepo UserRepository
 	teamRepo TeamRepository
-
-	adminToken string // Допущение: см. README.md
+	prRepo   PrRepository
 }
 
 func New(
 	lgr *slog.Logger,
 	userRepo UserRepository,
 	teamRepo TeamRepository,
-	adminToken string,
+	prRepo PrRepository,
 ) *Service {
 	return &Service{
-		lgr:        lgr,
-		userRepo:   userRepo,
-		teamRepo:   teamRepo,
-		adminToken: adminToken,
+		lgr:      lgr,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		prRepo:   prRepo,
 	}
 }
 
@@ -48,6 +61,9 @@ func New(
 func (s *Service) SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
 	const op = "user.SetIsActive"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
 	lgr := s.lgr.With(
 		slog.String("op", op),
 		slog.String("userID", userID),
@@ -76,21 +92,295 @@ func (s *Service) SetIsActive(ctx context.Context, userID string, isActive bool)
 	return userItem, nil
 }
 
-// VerifyAdminAccess проверяет наличие прав администратора по переданному токену.
-func (s *Service) VerifyAdminAccess(ctx context.Context, adminToken string) (bool, error) {
-	const op = "user.VerifyAdminAccess"
+// SetReminderFrequency обновляет частоту напоминаний пользователя. Частота off отключает напоминания.
+// Если частота неизвестна, возвращается svcErr.ErrInvalidReminderFrequency.
+// Если пользователь не найден, возвращается svcErr.ErrUserNotFound.
+// Если команда пользователя не найдена, возвращается svcErr.ErrTeamNotFound.
+func (s *Service) SetReminderFrequency(
+	ctx context.Context,
+	userID string,
+	frequency domain.ReminderFrequency,
+) (*domain.User, error) {
+	const op = "user.SetReminderFrequency"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("userID", userID),
+		slog.String("frequency", string(frequency)),
+	)
+
+	if !frequency.IsValid() {
+		lgr.DebugContext(ctx, "invalid reminder frequency")
+
+		return nil, svcErr.ErrInvalidReminderFrequency
+	}
+
+	userItem, err := s.userRepo.SetReminderFrequency(ctx, userID, frequency)
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "user not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrUserNotFound
+	}
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "user's team not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to set reminder frequency", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.Info("user reminder frequency updated successfully")
+
+	return userItem, nil
+}
+
+// SetEmail обновляет адрес, на который пользователю приходят уведомления. Пустой адрес удаляет его.
+// Если адрес некорректен, возвращается svcErr.ErrInvalidEmail.
+// Если пользователь не найден, возвращается svcErr.ErrUserNotFound.
+// Если команда пользователя не найдена, возвращается svcErr.ErrTeamNotFound.
+func (s *Service) SetEmail(ctx context.Context, userID, email string) (*domain.User, error) {
+	const op = "user.SetEmail"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("userID", userID),
+	)
+
+	if email != "" && !domain.IsValidEmail(email) {
+		lgr.DebugContext(ctx, "invalid email")
+
+		return nil, svcErr.ErrInvalidEmail
+	}
+
+	userItem, err := s.userRepo.SetEmail(ctx, userID, email)
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "user not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrUserNotFound
+	}
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "user's team not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to set email", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.Info("user email updated successfully")
+
+	return userItem, nil
+}
+
+// LinkChat привязывает пользователя к идентификатору в чате. Пустой идентификатор удаляет привязку.
+// Если пользователь не найден, возвращается svcErr.ErrUserNotFound.
+// Если команда пользователя не найдена, возвращается svcErr.ErrTeamNotFound.
+// Если идентификатор уже привязан к другому пользователю, возвращается svcErr.ErrChatUserLinked.
+func (s *Service) LinkChat(ctx context.Context, userID, chatUserID string) (*domain.User, error) {
+	const op = "user.LinkChat"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("userID", userID),
+		slog.String("chatUserID", chatUserID),
+	)
+
+	userItem, err := s.userRepo.SetChatUserID(ctx, userID, chatUserID)
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "user not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrUserNotFound
+	}
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "user's team not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if errors.Is(err, repoErr.ErrChatUserLinked) {
+		lgr.DebugContext(ctx, "chat user already linked", slog.Any("error", err))
+
+		return nil, svcErr.ErrChatUserLinked
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to link chat user", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.Info("user chat link updated successfully")
+
+	return userItem, nil
+}
+
+// GetByChatID возвращает пользователя, привязанного к идентификатору в чате.
+// Если привязки нет, возвращается svcErr.ErrChatUserNotLinked.
+// Если команда пользователя не найдена, возвращается svcErr.ErrTeamNotFound.
+func (s *Service) GetByChatID(ctx context.Context, chatUserID string) (*domain.User, error) {
+	const op = "user.GetByChatID"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("chatUserID", chatUserID),
+	)
+
+	userItem, err := s.userRepo.GetByChatID(ctx, chatUserID)
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "chat user not linked", slog.Any("error", err))
+
+		return nil, svcErr.ErrChatUserNotLinked
+	}
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "user's team not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to get user by chat id", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userItem, nil
+}
+
+// SetAwayUntil отмечает пользователя отсутствующим до указанного времени. Nil снимает отметку.
+// Пока пользователь отсутствует, он не назначается ревьювером и не получает напоминаний.
+// Если пользователь не найден, возвращается svcErr.ErrUserNotFound.
+// Если команда пользователя не найдена, возвращается svcErr.ErrTeamNotFound.
+func (s *Service) SetAwayUntil(ctx context.Context, userID string, until *time.Time) (*domain.User, error) {
+	const op = "user.SetAwayUntil"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
 	lgr := s.lgr.With(
 		slog.String("op", op),
+		slog.String("userID", userID),
 	)
 
-	if adminToken != s.adminToken {
-		lgr.DebugContext(ctx, "admin token is invalid")
+	userItem, err := s.userRepo.SetAwayUntil(ctx, userID, until)
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "user not found", slog.Any("error", err))
 
-		return false, nil
+		return nil, svcErr.ErrUserNotFound
 	}
+	if errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.DebugContext(ctx, "user's team not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrTeamNotFound
+	}
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to set away until", slog.Any("error", err))
 
-	lgr.DebugContext(ctx, "admin access granted")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgr.Info("user away status updated successfully")
+
+	return userItem, nil
+}
+
+// GetReview возвращает Pull Request'ы всех репозиториев, на которые пользователь назначен ревьювером.
+// Если пользователь не найден, возвращается svcErr.ErrUserNotFound.
+func (s *Service) GetReview(ctx context.Context, userID string) ([]domain.PullRequest, error) {
+	const op = "user.GetReview"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	lgr := s.lgr.With(
+		slog.String("op", op),
+		slog.String("userID", userID),
+	)
+
+	_, err := s.userRepo.GetByID(ctx, userID)
+	if errors.Is(err, repoErr.ErrUserNotFound) {
+		lgr.DebugContext(ctx, "user not found", slog.Any("error", err))
+
+		return nil, svcErr.ErrUserNotFound
+	}
+	if err != nil && !errors.Is(err, repoErr.ErrTeamNotFound) {
+		lgr.ErrorContext(ctx, "failed to get user", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pullRequests, err := s.prRepo.ListByReviewer(ctx, userID)
+	if err != nil {
+		lgr.ErrorContext(ctx, "failed to list pull requests by reviewer", slog.Any("error", err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// GetByIDs возвращает пользователей с указанными идентификаторами одним обращением к хранилищу.
+// Ненайденные пользователи пропускаются, порядок результата не определён.
+func (s *Service) GetByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	const op = "user.GetByIDs"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.userRepo.GetByIDs(ctx, userIDs)
+	if err != nil {
+		s.lgr.ErrorContext(ctx, "failed to get users",
+			slog.String("op", op),
+			slog.Int("count", len(userIDs)),
+			slog.Any("error", err),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, nil
+}
+
+// GetReviews возвращает Pull Request'ы, на которые назначены пользователи userIDs, одним обращением
+// к хранилищу. В отличие от GetReview, существование пользователей не проверяется: пользователи
+// без назначений и ненайденные пользователи в результат не попадают.
+func (s *Service) GetReviews(ctx context.Context, userIDs []string) (map[string][]domain.PullRequest, error) {
+	const op = "user.GetReviews"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if len(userIDs) == 0 {
+		return map[string][]domain.PullRequest{}, nil
+	}
+
+	pullRequests, err := s.prRepo.ListByReviewerIDs(ctx, userIDs)
+	if err != nil {
+		s.lgr.ErrorContext(ctx, "failed to list pull requests by reviewers",
+			slog.String("op", op),
+			slog.Int("count", len(userIDs)),
+			slog.Any("error", err),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-	return true, nil
+	return pullRequests, nil
 }
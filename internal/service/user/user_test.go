@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -81,10 +82,9 @@ func TestService_SetIsActive(t *testing.T) {
 			tt.setupMocks(ur, tr)
 
 			svc := &Service{
-				lgr:        slog.New(slog.DiscardHandler),
-				userRepo:   ur,
-				teamRepo:   tr,
-				adminToken: "secret",
+				lgr:      slog.New(slog.DiscardHandler),
+				userRepo: ur,
+				teamRepo: tr,
 			}
 
 			got, err := svc.SetIsActive(context.Background(), tt.userID, tt.isActive)
@@ -106,17 +106,465 @@ func TestService_SetIsActive(t *testing.T) {
 	}
 }
 
-func TestService_VerifyAdminAccess(t *testing.T) {
-	svc := &Service{
-		lgr:        slog.New(slog.DiscardHandler),
-		adminToken: "tok123",
+func TestService_SetReminderFrequency(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        string
+		frequency     domain.ReminderFrequency
+		setupMocks    func(u *usermocks.MockUserRepository)
+		expectedUser  *domain.User
+		expectedError error
+	}{
+		{
+			name:      "success - frequency updated",
+			userID:    "u1",
+			frequency: domain.ReminderWeekly,
+			setupMocks: func(u *usermocks.MockUserRepository) {
+				u.On("SetReminderFrequency", mock.Anything, "u1", domain.ReminderWeekly).Return(&domain.User{
+					ID:                "u1",
+					Username:          "Alice",
+					IsActive:          true,
+					TeamID:            "t1",
+					TeamName:          "AI",
+					ReminderFrequency: domain.ReminderWeekly,
+				}, nil)
+			},
+			expectedUser: &domain.User{
+				ID:                "u1",
+				Username:          "Alice",
+				IsActive:          true,
+				TeamID:            "t1",
+				TeamName:          "AI",
+				ReminderFrequency: domain.ReminderWeekly,
+			},
+		},
+		{
+			name:          "error - invalid frequency",
+			userID:        "u1",
+			frequency:     "hourly",
+			setupMocks:    func(u *usermocks.MockUserRepository) {},
+			expectedError: svcErr.ErrInvalidReminderFrequency,
+		},
+		{
+			name:      "error - user not found",
+			userID:    "nope",
+			frequency: domain.ReminderOff,
+			setupMocks: func(u *usermocks.MockUserRepository) {
+				u.On("SetReminderFrequency", mock.Anything, "nope", domain.ReminderOff).
+					Return((*domain.User)(nil), repoErr.ErrUserNotFound)
+			},
+			expectedError: svcErr.ErrUserNotFound,
+		},
+		{
+			name:      "error - unexpected from repo",
+			userID:    "u2",
+			frequency: domain.ReminderDaily,
+			setupMocks: func(u *usermocks.MockUserRepository) {
+				u.On("SetReminderFrequency", mock.Anything, "u2", domain.ReminderDaily).
+					Return((*domain.User)(nil), errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := usermocks.NewMockUserRepository(t)
+			tt.setupMocks(ur)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				userRepo: ur,
+			}
+
+			got, err := svc.SetReminderFrequency(context.Background(), tt.userID, tt.frequency)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				if errors.Is(tt.expectedError, errUnexpected) {
+					require.Contains(t, err.Error(), tt.expectedError.Error())
+				} else {
+					require.ErrorIs(t, err, tt.expectedError)
+				}
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, got)
+			}
+		})
+	}
+}
+
+func TestService_SetEmail(t *testing.T) {
+	tests := []struct {
+		name          string
+		email         string
+		setupMocks    func(u *usermocks.MockUserRepository)
+		expectedUser  *domain.User
+		expectedError error
+	}{
+		{
+			name:  "success - email updated",
+			email: "alice@example.com",
+			setupMocks: func(u *usermocks.MockUserRepository) {
+				u.On("SetEmail", mock.Anything, "u1", "alice@example.com").
+					Return(&domain.User{ID: "u1", Username: "Alice", Email: "alice@example.com"}, nil)
+			},
+			expectedUser: &domain.User{ID: "u1", Username: "Alice", Email: "alice@example.com"},
+		},
+		{
+			name:  "success - email removed",
+			email: "",
+			setupMocks: func(u *usermocks.MockUserRepository) {
+				u.On("SetEmail", mock.Anything, "u1", "").
+					Return(&domain.User{ID: "u1", Username: "Alice"}, nil)
+			},
+			expectedUser: &domain.User{ID: "u1", Username: "Alice"},
+		},
+		{
+			name:          "error - invalid email",
+			email:         "alice",
+			setupMocks:    func(u *usermocks.MockUserRepository) {},
+			expectedError: svcErr.ErrInvalidEmail,
+		},
+		{
+			name:  "error - user not found",
+			email: "alice@example.com",
+			setupMocks: func(u *usermocks.MockUserRepository) {
+				u.On("SetEmail", mock.Anything, "u1", "alice@example.com").
+					Return((*domain.User)(nil), repoErr.ErrUserNotFound)
+			},
+			expectedError: svcErr.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := usermocks.NewMockUserRepository(t)
+			tt.setupMocks(ur)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				userRepo: ur,
+			}
+
+			got, err := svc.SetEmail(context.Background(), "u1", tt.email)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, got)
+			}
+		})
+	}
+}
+
+func TestService_LinkChat(t *testing.T) {
+	tests := []struct {
+		name          string
+		repoErr       error
+		expectedError error
+	}{
+		{name: "success - chat user linked"},
+		{name: "error - user not found", repoErr: repoErr.ErrUserNotFound, expectedError: svcErr.ErrUserNotFound},
+		{name: "error - chat user taken", repoErr: repoErr.ErrChatUserLinked, expectedError: svcErr.ErrChatUserLinked},
+		{name: "error - unexpected from repo", repoErr: errUnexpected, expectedError: errUnexpected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := usermocks.NewMockUserRepository(t)
+			var repoUser *domain.User
+			if tt.repoErr == nil {
+				repoUser = &domain.User{ID: "u1", Username: "Alice", ChatUserID: "U123"}
+			}
+			ur.On("SetChatUserID", mock.Anything, "u1", "U123").Return(repoUser, tt.repoErr)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				userRepo: ur,
+			}
+
+			got, err := svc.LinkChat(context.Background(), "u1", "U123")
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, repoUser, got)
+			}
+		})
+	}
+}
+
+func TestService_GetByChatID(t *testing.T) {
+	tests := []struct {
+		name          string
+		repoErr       error
+		expectedError error
+	}{
+		{name: "success - linked user found"},
+		{name: "error - not linked", repoErr: repoErr.ErrUserNotFound, expectedError: svcErr.ErrChatUserNotLinked},
+		{name: "error - users team not found", repoErr: repoErr.ErrTeamNotFound, expectedError: svcErr.ErrTeamNotFound},
+		{name: "error - unexpected from repo", repoErr: errUnexpected, expectedError: errUnexpected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := usermocks.NewMockUserRepository(t)
+			var repoUser *domain.User
+			if tt.repoErr == nil {
+				repoUser = &domain.User{ID: "u1", Username: "Alice", ChatUserID: "U123"}
+			}
+			ur.On("GetByChatID", mock.Anything, "U123").Return(repoUser, tt.repoErr)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				userRepo: ur,
+			}
+
+			got, err := svc.GetByChatID(context.Background(), "U123")
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, repoUser, got)
+			}
+		})
+	}
+}
+
+func TestService_SetAwayUntil(t *testing.T) {
+	until := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		until         *time.Time
+		repoErr       error
+		expectedError error
+	}{
+		{name: "success - away set", until: &until},
+		{name: "success - away cleared", until: nil},
+		{name: "error - user not found", until: &until, repoErr: repoErr.ErrUserNotFound, expectedError: svcErr.ErrUserNotFound},
+		{name: "error - unexpected from repo", until: &until, repoErr: errUnexpected, expectedError: errUnexpected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := usermocks.NewMockUserRepository(t)
+			var repoUser *domain.User
+			if tt.repoErr == nil {
+				repoUser = &domain.User{ID: "u1", Username: "Alice", AwayUntil: tt.until}
+			}
+			ur.On("SetAwayUntil", mock.Anything, "u1", tt.until).Return(repoUser, tt.repoErr)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				userRepo: ur,
+			}
+
+			got, err := svc.SetAwayUntil(context.Background(), "u1", tt.until)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, repoUser, got)
+			}
+		})
+	}
+}
+
+func TestService_GetReview(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        string
+		setupMocks    func(u *usermocks.MockUserRepository, pr *usermocks.MockPrRepository)
+		expectedPRs   []domain.PullRequest
+		expectedError error
+	}{
+		{
+			name:   "success - pull requests across repositories",
+			userID: "u2",
+			setupMocks: func(u *usermocks.MockUserRepository, pr *usermocks.MockPrRepository) {
+				u.On("GetByID", mock.Anything, "u2").Return(&domain.User{ID: "u2"}, nil)
+				pr.On("ListByReviewer", mock.Anything, "u2").Return([]domain.PullRequest{
+					{Repository: "default", ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: domain.PRStatusOpen},
+					{Repository: "backend", ID: "pr-1", Name: "Fix cache", AuthorID: "u3", Status: domain.PRStatusMerged},
+				}, nil)
+			},
+			expectedPRs: []domain.PullRequest{
+				{Repository: "default", ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: domain.PRStatusOpen},
+				{Repository: "backend", ID: "pr-1", Name: "Fix cache", AuthorID: "u3", Status: domain.PRStatusMerged},
+			},
+		},
+		{
+			name:   "error - user not found",
+			userID: "nope",
+			setupMocks: func(u *usermocks.MockUserRepository, pr *usermocks.MockPrRepository) {
+				u.On("GetByID", mock.Anything, "nope").Return((*domain.User)(nil), repoErr.ErrUserNotFound)
+			},
+			expectedError: svcErr.ErrUserNotFound,
+		},
+		{
+			name:   "error - unexpected from repo",
+			userID: "u2",
+			setupMocks: func(u *usermocks.MockUserRepository, pr *usermocks.MockPrRepository) {
+				u.On("GetByID", mock.Anything, "u2").Return(&domain.User{ID: "u2"}, nil)
+				pr.On("ListByReviewer", mock.Anything, "u2").Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := usermocks.NewMockUserRepository(t)
+			pr := usermocks.NewMockPrRepository(t)
+			tt.setupMocks(ur, pr)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				userRepo: ur,
+				prRepo:   pr,
+			}
+
+			got, err := svc.GetReview(context.Background(), tt.userID)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPRs, got)
+			}
+		})
+	}
+}
+
+func TestService_GetByIDs(t *testing.T) {
+	tests := []struct {
+		name          string
+		userIDs       []string
+		setupMocks    func(u *usermocks.MockUserRepository)
+		expectedUsers []domain.User
+		expectedError error
+	}{
+		{
+			name:    "success - users found",
+			userIDs: []string{"u1", "u2", "missing"},
+			setupMocks: func(u *usermocks.MockUserRepository) {
+				u.On("GetByIDs", mock.Anything, []string{"u1", "u2", "missing"}).Return([]domain.User{
+					{ID: "u1", TeamName: "backend"},
+					{ID: "u2", TeamName: "frontend"},
+				}, nil)
+			},
+			expectedUsers: []domain.User{
+				{ID: "u1", TeamName: "backend"},
+				{ID: "u2", TeamName: "frontend"},
+			},
+		},
+		{
+			name:       "success - no ids skips repository",
+			userIDs:    nil,
+			setupMocks: func(u *usermocks.MockUserRepository) {},
+		},
+		{
+			name:    "error - unexpected from repo",
+			userIDs: []string{"u1"},
+			setupMocks: func(u *usermocks.MockUserRepository) {
+				u.On("GetByIDs", mock.Anything, []string{"u1"}).Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ur := usermocks.NewMockUserRepository(t)
+			tt.setupMocks(ur)
+
+			svc := &Service{
+				lgr:      slog.New(slog.DiscardHandler),
+				userRepo: ur,
+			}
+
+			got, err := svc.GetByIDs(context.Background(), tt.userIDs)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedUsers, got)
+			}
+		})
+	}
+}
+
+func TestService_GetReviews(t *testing.T) {
+	tests := []struct {
+		name          string
+		userIDs       []string
+		setupMocks    func(pr *usermocks.MockPrRepository)
+		expectedPRs   map[string][]domain.PullRequest
+		expectedError error
+	}{
+		{
+			name:    "success - grouped by reviewer",
+			userIDs: []string{"u2", "u3"},
+			setupMocks: func(pr *usermocks.MockPrRepository) {
+				pr.On("ListByReviewerIDs", mock.Anything, []string{"u2", "u3"}).Return(map[string][]domain.PullRequest{
+					"u2": {{ID: "pr-1", Reviewers: []string{"u2", "u3"}}},
+					"u3": {{ID: "pr-1", Reviewers: []string{"u2", "u3"}}},
+				}, nil)
+			},
+			expectedPRs: map[string][]domain.PullRequest{
+				"u2": {{ID: "pr-1", Reviewers: []string{"u2", "u3"}}},
+				"u3": {{ID: "pr-1", Reviewers: []string{"u2", "u3"}}},
+			},
+		},
+		{
+			name:        "success - no ids skips repository",
+			userIDs:     []string{},
+			setupMocks:  func(pr *usermocks.MockPrRepository) {},
+			expectedPRs: map[string][]domain.PullRequest{},
+		},
+		{
+			name:    "error - unexpected from repo",
+			userIDs: []string{"u2"},
+			setupMocks: func(pr *usermocks.MockPrRepository) {
+				pr.On("ListByReviewerIDs", mock.Anything, []string{"u2"}).Return(nil, errUnexpected)
+			},
+			expectedError: errUnexpected,
+		},
 	}
 
-	ok, err := svc.VerifyAdminAccess(context.Background(), "tok123")
-	require.NoError(t, err)
-	assert.True(t, ok)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := usermocks.NewMockPrRepository(t)
+			tt.setupMocks(pr)
+
+			svc := &Service{
+				lgr:    slog.New(slog.DiscardHandler),
+				prRepo: pr,
+			}
+
+			got, err := svc.GetReviews(context.Background(), tt.userIDs)
 
-	ok, err = svc.VerifyAdminAccess(context.Background(), "wrong")
-	require.NoError(t, err)
-	assert.False(t, ok)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPRs, got)
+			}
+		})
+	}
 }
@@ -0,0 +1,112 @@
+// Package cycletime считает показатели времени прохождения ревью для хранилищ,
+// в которых нет percentile_cont: хранилище собирает факты о Pull Request'ах,
+// а медиана и перцентили считаются здесь так же, как в PostgreSQL.
+package cycletime
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+// Fact — факты об одном Pull Request'е или об одном назначении ревьювера.
+// Время до ревью и одобрения отсчитывается от StartedAt, время до merge — от CreatedAt.
+type Fact struct {
+	Group           string
+	CreatedAt       time.Time
+	StartedAt       time.Time
+	FirstReviewAt   *time.Time
+	FirstApprovalAt *time.Time
+	MergedAt        *time.Time
+}
+
+// Aggregate группирует факты по группе и неделе создания Pull Request'а и считает
+// медиану и 90-й перцентиль длительностей. Результат упорядочен по группе и неделе.
+func Aggregate(facts []Fact) []domain.CycleTimeStats {
+	type groupKey struct {
+		group     string
+		weekStart time.Time
+	}
+	type samples struct {
+		pullRequests int
+		firstReview  []float64
+		approval     []float64
+		merge        []float64
+	}
+	groups := make(map[groupKey]*samples)
+
+	for _, f := range facts {
+		k := groupKey{group: f.Group, weekStart: WeekStart(f.CreatedAt)}
+		g, ok := groups[k]
+		if !ok {
+			g = &samples{}
+			groups[k] = g
+		}
+
+		g.pullRequests++
+		if f.FirstReviewAt != nil {
+			g.firstReview = append(g.firstReview, f.FirstReviewAt.Sub(f.StartedAt).Seconds())
+		}
+		if f.FirstApprovalAt != nil {
+			g.approval = append(g.approval, f.FirstApprovalAt.Sub(f.StartedAt).Seconds())
+		}
+		if f.MergedAt != nil {
+			g.merge = append(g.merge, f.MergedAt.Sub(f.CreatedAt).Seconds())
+		}
+	}
+
+	stats := make([]domain.CycleTimeStats, 0, len(groups))
+	for k, g := range groups {
+		stats = append(stats, domain.CycleTimeStats{
+			Group:             k.group,
+			WeekStart:         k.weekStart,
+			PullRequests:      g.pullRequests,
+			TimeToFirstReview: durationStats(g.firstReview),
+			TimeToApproval:    durationStats(g.approval),
+			TimeToMerge:       durationStats(g.merge),
+		})
+	}
+	slices.SortFunc(stats, func(a, b domain.CycleTimeStats) int {
+		return cmp.Or(cmp.Compare(a.Group, b.Group), a.WeekStart.Compare(b.WeekStart))
+	})
+
+	return stats
+}
+
+// WeekStart возвращает понедельник недели t, 00:00, как date_trunc('week') в PostgreSQL.
+func WeekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) + 6) % 7
+
+	return day.AddDate(0, 0, -offset)
+}
+
+// durationStats считает медиану и 90-й перцентиль длительностей в секундах.
+func durationStats(seconds []float64) domain.DurationStats {
+	stats := domain.DurationStats{Count: len(seconds)}
+	if len(seconds) == 0 {
+		return stats
+	}
+
+	slices.Sort(seconds)
+	median := percentile(seconds, 0.5)
+	p90 := percentile(seconds, 0.9)
+	stats.Median = &median
+	stats.P90 = &p90
+
+	return stats
+}
+
+// percentile возвращает перцентиль p упорядоченных значений с линейной интерполяцией,
+// как percentile_cont в PostgreSQL.
+func percentile(sorted []float64, p float64) time.Duration {
+	pos := p * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	value := sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+
+	return time.Duration(value * float64(time.Second))
+}
@@ -7,9 +7,21 @@ var (
 	ErrTeamNotFound = errors.New("team not found")
 
 	ErrUserNotFound = errors.New("user not found")
+	ErrUsernameTaken = errors.New("username is already taken")
+
+	ErrChatUserLinked = errors.New("chat user is already linked to another user")
 
 	ErrPRNotFound = errors.New("pull request not found")
 	ErrPRExists = errors.New("pull request already exists")
 
 	ErrInvalidStatus = errors.New("invalid pull request status")
+
+	ErrReviewerNotAssigned = errors.New("reviewer is not assigned to pull request")
+
+	ErrRepositoryExists = errors.New("repository already exists")
+	ErrRepositoryNotFound = errors.New("repository not found")
+
+	ErrOrganizationExists = errors.New("organization already exists")
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrTokenNotFound = errors.New("token not found")
 )
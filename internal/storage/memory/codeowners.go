@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+type CodeOwnersRepository struct {
+	s *Store
+}
+
+func NewCodeOwnersRepository(s *Store) *CodeOwnersRepository {
+	return &CodeOwnersRepository{
+		s: s,
+	}
+}
+
+// Replace заменяет все правила CODEOWNERS репозитория с указанным идентификатором переданными.
+// Порядок правил сохраняется.
+// Если репозиторий не принадлежит организации субъекта запроса, возвращается ошибка repoErr.ErrRepositoryNotFound.
+// Если владелец-пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Если владелец-команда не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *CodeOwnersRepository) Replace(ctx context.Context, repositoryID string, rules []domain.CodeOwnersRule) error {
+	const op = "memory.codeowners.Replace"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, ok := org.repositories[repositoryID]; !ok {
+		return repoErr.ErrRepositoryNotFound
+	}
+
+	stored := make([]codeOwnersRule, 0, len(rules))
+	for _, rule := range rules {
+		for _, userID := range rule.UserIDs {
+			if _, ok := org.users[userID]; !ok {
+				return repoErr.ErrUserNotFound
+			}
+		}
+
+		teamIDs := make([]string, 0, len(rule.Teams))
+		for _, team := range rule.Teams {
+			if _, ok := org.teams[team.ID]; !ok {
+				return repoErr.ErrTeamNotFound
+			}
+			teamIDs = append(teamIDs, team.ID)
+		}
+
+		stored = append(stored, codeOwnersRule{
+			pattern: rule.Pattern,
+			userIDs: slices.Clone(rule.UserIDs),
+			teamIDs: teamIDs,
+		})
+	}
+	org.codeOwners[repositoryID] = stored
+
+	return nil
+}
+
+// ListByRepository возвращает правила CODEOWNERS репозитория в исходном порядке.
+// Если для репозитория правила не загружены, возвращается пустой слайс.
+func (r *CodeOwnersRepository) ListByRepository(
+	ctx context.Context,
+	repositoryID string,
+) ([]domain.CodeOwnersRule, error) {
+	const op = "memory.codeowners.ListByRepository"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var rules []domain.CodeOwnersRule
+	for _, rule := range org.codeOwners[repositoryID] {
+		teams := make([]domain.Team, 0, len(rule.teamIDs))
+		for _, teamID := range rule.teamIDs {
+			teams = append(teams, domain.Team{ID: teamID, Name: org.teams[teamID].name})
+		}
+
+		userIDs := slices.Clone(rule.userIDs)
+		if userIDs == nil {
+			userIDs = []string{}
+		}
+
+		rules = append(rules, domain.CodeOwnersRule{
+			Pattern: rule.pattern,
+			UserIDs: userIDs,
+			Teams:   teams,
+		})
+	}
+
+	return rules, nil
+}
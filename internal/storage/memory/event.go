@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+// EventRepository хранит журнал событий организации.
+type EventRepository struct {
+	s *Store
+}
+
+func NewEventRepository(s *Store) *EventRepository {
+	return &EventRepository{
+		s: s,
+	}
+}
+
+// Append сохраняет событие в журнале организации субъекта запроса и возвращает его с присвоенным ID.
+// ID событий возрастают в порядке записи во всех организациях. Время события хранится в UTC.
+func (r *EventRepository) Append(ctx context.Context, record domain.EventRecord) (*domain.EventRecord, error) {
+	const op = "memory.event.Append"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.write(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	r.s.nextEventID++
+	record.ID = r.s.nextEventID
+	record.OrgID = org.id
+	record.OccurredAt = record.OccurredAt.UTC()
+	record.UserIDs = slices.Clone(record.UserIDs)
+	if record.UserIDs == nil {
+		record.UserIDs = []string{}
+	}
+	record.Payload = slices.Clone(record.Payload)
+	org.events = append(org.events, record)
+
+	return &record, nil
+}
+
+// ListAfter возвращает не более limit событий организации субъекта запроса с ID больше afterID
+// в порядке записи.
+func (r *EventRepository) ListAfter(ctx context.Context, afterID int64, limit int) ([]domain.EventRecord, error) {
+	const op = "memory.event.ListAfter"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	records := make([]domain.EventRecord, 0)
+	for _, record := range org.events {
+		if len(records) >= limit {
+			break
+		}
+		if record.ID > afterID {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// LastID возвращает ID последнего события организации субъекта запроса или 0, если журнал пуст.
+func (r *EventRepository) LastID(ctx context.Context) (int64, error) {
+	const op = "memory.event.LastID"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(org.events) == 0 {
+		return 0, nil
+	}
+
+	return org.events[len(org.events)-1].ID, nil
+}
+
+// DeleteBefore удаляет события организации субъекта запроса, произошедшие раньше before.
+// Возвращает количество удалённых событий.
+func (r *EventRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	const op = "memory.event.DeleteBefore"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	n := len(org.events)
+	org.events = slices.DeleteFunc(org.events, func(record domain.EventRecord) bool {
+		return record.OccurredAt.Before(before)
+	})
+
+	return int64(n - len(org.events)), nil
+}
@@ -0,0 +1,293 @@
+// Package memory содержит хранилища, держащие данные в памяти процесса.
+// Они реализуют те же методы и возвращают те же ошибки repoErr, что и хранилища PostgreSQL,
+// и предназначены для локальной разработки и быстрых тестов: данные теряются при остановке сервиса.
+//
+// Все хранилища пакета работают поверх одного Store. Каждый метод выполняется атомарно
+// под блокировкой Store, поэтому данные остаются согласованными при параллельных запросах.
+package memory
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+// Store — общее состояние хранилищ в памяти.
+type Store struct {
+	mu sync.RWMutex
+
+	orgs map[string]*organization
+	// tokens — субъекты по хешу токена.
+	tokens map[string]domain.Principal
+
+	nextEventID  int64
+	nextReviewID int64
+
+	// prLocks сериализует изменения одного Pull Request'а, которым нужно выполнить
+	// внешний код без удержания mu, как блокировка строки в PostgreSQL.
+	prLocks sync.Map
+
+	now func() time.Time
+}
+
+type organization struct {
+	id   string
+	name string
+
+	teams        map[string]*team
+	users        map[string]*user
+	repositories map[string]*repository
+	pullRequests map[prKey]*pullRequest
+	reviews      []storedReview
+	// codeOwners — правила CODEOWNERS по ID репозитория.
+	codeOwners map[string][]codeOwnersRule
+	events     []domain.EventRecord
+}
+
+type team struct {
+	id   string
+	name string
+	sla  domain.TeamSLA
+}
+
+type user struct {
+	id                string
+	username          string
+	isActive          bool
+	teamID            string
+	email             string
+	reminderFrequency domain.ReminderFrequency
+	chatUserID        string
+	awayUntil         *time.Time
+}
+
+type repository struct {
+	id           string
+	name         string
+	teamID       string
+	maxReviewers *int
+}
+
+type prKey struct {
+	repositoryID string
+	prID         string
+}
+
+type pullRequest struct {
+	repositoryID        string
+	id                  string
+	name                string
+	authorID            string
+	status              domain.PRStatus
+	inNeedMoreReviewers bool
+	createdAt           time.Time
+	mergedAt            *time.Time
+	reviewers           []*assignment
+}
+
+type assignment struct {
+	reviewerID string
+	assignedAt time.Time
+	overdueAt  *time.Time
+}
+
+type storedReview struct {
+	id int64
+	domain.Review
+}
+
+type codeOwnersRule struct {
+	pattern string
+	userIDs []string
+	teamIDs []string
+}
+
+// NewStore создаёт пустое хранилище с организацией по умолчанию и её репозиторием по умолчанию,
+// как после применения миграций PostgreSQL.
+func NewStore() *Store {
+	s := &Store{
+		orgs:   make(map[string]*organization),
+		tokens: make(map[string]domain.Principal),
+		now:    func() time.Time { return time.Now().UTC() },
+	}
+
+	s.addOrganization(domain.DefaultOrganizationID, "default")
+
+	return s
+}
+
+// addOrganization создаёт организацию с репозиторием по умолчанию. Вызывается под s.mu.
+func (s *Store) addOrganization(id, name string) *organization {
+	org := &organization{
+		id:           id,
+		name:         name,
+		teams:        make(map[string]*team),
+		users:        make(map[string]*user),
+		repositories: make(map[string]*repository),
+		pullRequests: make(map[prKey]*pullRequest),
+		codeOwners:   make(map[string][]codeOwnersRule),
+	}
+
+	repositoryID := newID()
+	org.repositories[repositoryID] = &repository{id: repositoryID, name: domain.DefaultRepositoryName}
+	s.orgs[id] = org
+
+	return org
+}
+
+// read возвращает организацию субъекта запроса для чтения. Неизвестная организация
+// читается как пустая: как и в PostgreSQL, в ней просто ничего не находится.
+// Вызывается под s.mu.
+func (s *Store) read(ctx context.Context) (*organization, error) {
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, ok := s.orgs[orgID]
+	if !ok {
+		return &organization{id: orgID}, nil
+	}
+
+	return org, nil
+}
+
+// write возвращает организацию субъекта запроса для изменения.
+// Если организация не найдена, возвращается ошибка repoErr.ErrOrganizationNotFound.
+// Вызывается под s.mu.
+func (s *Store) write(ctx context.Context) (*organization, error) {
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, ok := s.orgs[orgID]
+	if !ok {
+		return nil, repoErr.ErrOrganizationNotFound
+	}
+
+	return org, nil
+}
+
+// lockPullRequest блокирует Pull Request организации orgID до вызова возвращённой функции.
+func (s *Store) lockPullRequest(orgID string, key prKey) func() {
+	value, _ := s.prLocks.LoadOrStore(orgID+"/"+key.repositoryID+"/"+key.prID, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+
+	return mu.Unlock
+}
+
+func (o *organization) teamByName(name string) (*team, bool) {
+	for _, t := range o.teams {
+		if t.name == name {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+func (o *organization) repositoryByName(name string) (*repository, bool) {
+	for _, r := range o.repositories {
+		if r.name == name {
+			return r, true
+		}
+	}
+
+	return nil, false
+}
+
+func (o *organization) userByName(username string) (*user, bool) {
+	for _, u := range o.users {
+		if u.username == username {
+			return u, true
+		}
+	}
+
+	return nil, false
+}
+
+// cloneTeams копирует команды организации, чтобы восстановить их при ошибке.
+func (o *organization) cloneTeams() map[string]*team {
+	teams := make(map[string]*team, len(o.teams))
+	for id, t := range o.teams {
+		clone := *t
+		teams[id] = &clone
+	}
+
+	return teams
+}
+
+// cloneUsers копирует пользователей организации, чтобы восстановить их при ошибке.
+func (o *organization) cloneUsers() map[string]*user {
+	users := make(map[string]*user, len(o.users))
+	for id, u := range o.users {
+		clone := *u
+		users[id] = &clone
+	}
+
+	return users
+}
+
+// TxManager выполняет функцию без транзакции: каждый метод хранилищ в памяти и так атомарен,
+// а отката изменений нескольких методов хранилище не поддерживает. Действия, отложенные
+// через AfterCommit, выполняются, только если функция завершилась без ошибки.
+type TxManager struct{}
+
+type afterCommitKey struct{}
+
+// afterCommit — действия, отложенные до завершения WithinTx верхнего уровня.
+type afterCommit struct {
+	fns []func(ctx context.Context)
+}
+
+func (TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	hooks := &afterCommit{}
+
+	err := fn(context.WithValue(ctx, afterCommitKey{}, hooks))
+	if err != nil {
+		return err
+	}
+
+	if parent, ok := ctx.Value(afterCommitKey{}).(*afterCommit); ok {
+		parent.fns = append(parent.fns, hooks.fns...)
+
+		return nil
+	}
+
+	for _, hook := range hooks.fns {
+		hook(ctx)
+	}
+
+	return nil
+}
+
+// AfterCommit откладывает fn до успешного завершения WithinTx из контекста.
+// Вне WithinTx fn выполняется сразу.
+func (TxManager) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommit)
+	if !ok {
+		fn(ctx)
+
+		return
+	}
+
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// newID возвращает случайный UUID версии 4, как gen_random_uuid() в PostgreSQL.
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,35 @@
+package memory_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/storage/memory"
+	"avitotech-pr-reviewer/internal/storage/storagetest"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+func TestContract(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Backend {
+		store := memory.NewStore()
+		orgs := memory.NewOrgRepository(store)
+
+		return storagetest.Backend{
+			Teams:        memory.NewTeamRepository(store),
+			Users:        memory.NewUserRepository(store),
+			PullRequests: memory.NewPullRequestRepository(store),
+			Repositories: memory.NewRepoRepository(store),
+			Stats:        memory.NewStatsRepository(store),
+			NewOrganization: func(t *testing.T) context.Context {
+				org, err := orgs.Create(context.Background(), t.Name()+"-"+rand.Text(), rand.Text())
+				require.NoError(t, err)
+
+				return tenant.WithPrincipal(context.Background(), domain.Principal{OrgID: org.ID, Role: domain.RoleAdmin})
+			},
+		}
+	})
+}
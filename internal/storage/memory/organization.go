@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+// OrgRepository хранит организации и их токены.
+// В отличие от остальных хранилищ, методы не ограничиваются организацией субъекта:
+// именно здесь субъект запроса и определяется.
+type OrgRepository struct {
+	s *Store
+}
+
+func NewOrgRepository(s *Store) *OrgRepository {
+	return &OrgRepository{
+		s: s,
+	}
+}
+
+// Create создаёт организацию вместе с её репозиторием по умолчанию и токеном администратора.
+// Если организация с таким именем уже существует, возвращается ошибка repoErr.ErrOrganizationExists.
+func (r *OrgRepository) Create(_ context.Context, name, adminTokenHash string) (*domain.Organization, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	for _, org := range r.s.orgs {
+		if org.name == name {
+			return nil, repoErr.ErrOrganizationExists
+		}
+	}
+
+	org := r.s.addOrganization(newID(), name)
+	r.s.tokens[adminTokenHash] = domain.Principal{OrgID: org.id, Role: domain.RoleAdmin}
+
+	return &domain.Organization{ID: org.id, Name: org.name}, nil
+}
+
+// AddToken сохраняет хеш нового токена организации с указанной ролью.
+// Если userID не пуст, токен выдаётся этому пользователю организации.
+// Если организация не найдена, возвращается ошибка repoErr.ErrOrganizationNotFound,
+// если не найден пользователь — repoErr.ErrUserNotFound.
+func (r *OrgRepository) AddToken(_ context.Context, orgID, tokenHash string, role domain.Role, userID string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, ok := r.s.orgs[orgID]
+	if !ok {
+		return repoErr.ErrOrganizationNotFound
+	}
+	if _, ok := org.users[userID]; userID != "" && !ok {
+		return repoErr.ErrUserNotFound
+	}
+	r.s.tokens[tokenHash] = domain.Principal{OrgID: orgID, Role: role, UserID: userID}
+
+	return nil
+}
+
+// GetPrincipalByTokenHash возвращает субъекта, которому выдан токен с указанным хешем.
+// Если токен не найден, возвращается ошибка repoErr.ErrTokenNotFound.
+func (r *OrgRepository) GetPrincipalByTokenHash(_ context.Context, tokenHash string) (*domain.Principal, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	principal, ok := r.s.tokens[tokenHash]
+	if !ok {
+		return nil, repoErr.ErrTokenNotFound
+	}
+
+	return &principal, nil
+}
+
+// ListIDs возвращает идентификаторы всех организаций.
+// Используется фоновыми задачами, которые обходят организации по очереди.
+func (r *OrgRepository) ListIDs(_ context.Context) ([]string, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.s.orgs))
+	for id := range r.s.orgs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	return ids, nil
+}
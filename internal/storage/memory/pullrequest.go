@@ -0,0 +1,698 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+type PullRequestRepository struct {
+	s *Store
+}
+
+func NewPullRequestRepository(s *Store) *PullRequestRepository {
+	return &PullRequestRepository{
+		s: s,
+	}
+}
+
+// Create создаёт новый Pull Request в репозитории pr.RepositoryID вместе с его ревьюверами.
+// Если Pull Request с таким ID уже существует в репозитории, возвращается ошибка repoErr.ErrPRExists.
+// Если автор или ревьювер не найден, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *PullRequestRepository) Create(ctx context.Context, pr *domain.PullRequest) (*domain.PullRequest, error) {
+	const op = "memory.pullrequest.Create"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.write(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	repo, ok := org.repositories[pr.RepositoryID]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrRepositoryNotFound)
+	}
+	key := prKey{repositoryID: pr.RepositoryID, prID: pr.ID}
+	if _, ok := org.pullRequests[key]; ok {
+		return nil, repoErr.ErrPRExists
+	}
+	if _, ok := org.users[pr.AuthorID]; !ok {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+
+	now := r.s.now()
+	created := &pullRequest{
+		repositoryID:        pr.RepositoryID,
+		id:                  pr.ID,
+		name:                pr.Name,
+		authorID:            pr.AuthorID,
+		status:              domain.PRStatusOpen,
+		inNeedMoreReviewers: true,
+		createdAt:           now,
+	}
+	for _, reviewerID := range pr.Reviewers {
+		if _, ok := org.users[reviewerID]; !ok {
+			return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+		}
+		if created.assignment(reviewerID) != nil {
+			return nil, fmt.Errorf("%s: reviewer %s is assigned twice", op, reviewerID)
+		}
+		created.reviewers = append(created.reviewers, &assignment{reviewerID: reviewerID, assignedAt: now})
+	}
+	org.pullRequests[key] = created
+
+	result := created.toDomain(repo.name)
+	// Как и в PostgreSQL, ревьюверы возвращаются в переданном порядке.
+	result.Reviewers = pr.Reviewers
+
+	return result, nil
+}
+
+// GetByID возвращает обогащенный ревьюверами и статусом Pull Request по его ID в репозитории.
+// Если Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
+func (r *PullRequestRepository) GetByID(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error) {
+	const op = "memory.pullrequest.GetByID"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, err := org.pullRequest(repositoryID, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pr, nil
+}
+
+// GetByIDs возвращает обогащенные ревьюверами и статусом Pull Request'ы репозитория по их ID,
+// упорядоченные по времени создания. Ненайденные Pull Request'ы в результат не попадают.
+func (r *PullRequestRepository) GetByIDs(
+	ctx context.Context,
+	repositoryID string,
+	prIDs []string,
+) ([]domain.PullRequest, error) {
+	const op = "memory.pullrequest.GetByIDs"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org.listPullRequests(func(pr *pullRequest) bool {
+		return pr.repositoryID == repositoryID && slices.Contains(prIDs, pr.id)
+	}), nil
+}
+
+// ListByRepository возвращает обогащенные ревьюверами и статусом Pull Request'ы репозитория,
+// упорядоченные по времени создания. Пустой status не ограничивает выборку.
+// Если в репозитории нет Pull Request'ов, возвращается пустой слайс.
+func (r *PullRequestRepository) ListByRepository(
+	ctx context.Context,
+	repositoryID string,
+	status domain.PRStatus,
+) ([]domain.PullRequest, error) {
+	const op = "memory.pullrequest.ListByRepository"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org.listPullRequests(func(pr *pullRequest) bool {
+		return pr.repositoryID == repositoryID && (status == "" || pr.status == status)
+	}), nil
+}
+
+// GetReviewerIDs возвращает список ID ревьюеров, назначенных на указанный Pull Request.
+// Метод не возвращает ошибку, если Pull Request не найден или у него нет назначенных ревьюеров.
+func (r *PullRequestRepository) GetReviewerIDs(ctx context.Context, repositoryID, prID string) ([]string, error) {
+	const op = "memory.pullrequest.GetReviewerIDs"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, ok := org.pullRequests[prKey{repositoryID: repositoryID, prID: prID}]
+	if !ok {
+		return nil, nil
+	}
+
+	return pr.reviewerIDs(), nil
+}
+
+// ReassignReviewer заменяет старого ревьюера новым, которого выбирает choose.
+// Pull Request блокируется до конца замены, а choose получает Pull Request, прочитанный
+// уже под блокировкой: параллельные переназначения и слияние того же Pull Request'а ждут
+// её снятия. Хранилище при этом не блокируется, поэтому choose может обращаться к другим хранилищам.
+// Ошибка choose возвращается без изменений.
+// Если указанный Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
+// Если указанный старый ревьюер не назначен на этот Pull Request, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *PullRequestRepository) ReassignReviewer(
+	ctx context.Context,
+	repositoryID, prID, oldReviewerID string,
+	choose func(pr *domain.PullRequest) (string, error),
+) (*domain.PullRequest, string, error) {
+	const op = "memory.pullrequest.ReassignReviewer"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	unlock := r.s.lockPullRequest(orgID, prKey{repositoryID: repositoryID, prID: prID})
+	defer unlock()
+
+	current, err := r.GetByID(ctx, repositoryID, prID)
+	if errors.Is(err, repoErr.ErrPRNotFound) {
+		return nil, "", repoErr.ErrPRNotFound
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	newReviewerID, err := choose(current)
+	if err != nil {
+		return nil, "", err
+	}
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.write(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, ok := org.pullRequests[prKey{repositoryID: repositoryID, prID: prID}]
+	if !ok {
+		return nil, "", repoErr.ErrPRNotFound
+	}
+	old := pr.assignment(oldReviewerID)
+	if old == nil {
+		return nil, "", repoErr.ErrUserNotFound
+	}
+	if _, ok := org.users[newReviewerID]; !ok {
+		return nil, "", fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+	if newReviewerID != oldReviewerID && pr.assignment(newReviewerID) != nil {
+		return nil, "", fmt.Errorf("%s: reviewer %s is already assigned", op, newReviewerID)
+	}
+
+	pr.reviewers = slices.DeleteFunc(pr.reviewers, func(a *assignment) bool { return a == old })
+	pr.reviewers = append(pr.reviewers, &assignment{reviewerID: newReviewerID, assignedAt: r.s.now()})
+
+	updated, err := org.pullRequest(repositoryID, prID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, newReviewerID, nil
+}
+
+// SetMerged помечает указанный Pull Request как merged.
+// Возвращается обновлённый Pull Request, обогащенный списком назначенных ревьюеров и статусом.
+// Если Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
+// Операция не является идемпотентной. Нужно вызывать только если Pull Request ещё не был помечен как merged.
+func (r *PullRequestRepository) SetMerged(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error) {
+	const op = "memory.pullrequest.SetMerged"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Слияние ждёт завершения переназначений того же Pull Request'а.
+	unlock := r.s.lockPullRequest(orgID, prKey{repositoryID: repositoryID, prID: prID})
+	defer unlock()
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, ok := org.pullRequests[prKey{repositoryID: repositoryID, prID: prID}]
+	if !ok {
+		return nil, repoErr.ErrPRNotFound
+	}
+
+	now := r.s.now()
+	pr.status = domain.PRStatusMerged
+	pr.mergedAt = &now
+
+	return pr.toDomain(org.repositories[repositoryID].name), nil
+}
+
+// ListByReviewer возвращает Pull Request'ы всех репозиториев, на которые назначен ревьювер.
+// Список ревьюверов в возвращаемых Pull Request'ах не заполняется.
+// Если ревьювер не найден или ни на что не назначен, возвращается пустой слайс.
+func (r *PullRequestRepository) ListByReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequest, error) {
+	const op = "memory.pullrequest.ListByReviewer"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var pullRequests []domain.PullRequest
+	for _, pr := range org.sortedPullRequests() {
+		if pr.assignment(reviewerID) != nil {
+			pullRequests = append(pullRequests, domain.PullRequest{
+				RepositoryID: pr.repositoryID,
+				Repository:   org.repositories[pr.repositoryID].name,
+				ID:           pr.id,
+				Name:         pr.name,
+				AuthorID:     pr.authorID,
+				Status:       pr.status,
+			})
+		}
+	}
+
+	return pullRequests, nil
+}
+
+// ListByReviewerIDs возвращает Pull Request'ы всех репозиториев, на которые назначены ревьюверы
+// reviewerIDs, сгруппированные по ревьюверу. В отличие от ListByReviewer,
+// список ревьюверов в Pull Request'ах заполняется. Ревьюверы без назначений в результат не попадают.
+func (r *PullRequestRepository) ListByReviewerIDs(
+	ctx context.Context,
+	reviewerIDs []string,
+) (map[string][]domain.PullRequest, error) {
+	const op = "memory.pullrequest.ListByReviewerIDs"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	byReviewer := make(map[string][]domain.PullRequest, len(reviewerIDs))
+	for _, pr := range org.sortedPullRequests() {
+		for _, a := range pr.reviewers {
+			if !slices.Contains(reviewerIDs, a.reviewerID) {
+				continue
+			}
+
+			assigned := pr.toDomain(org.repositories[pr.repositoryID].name)
+			// Как и в PostgreSQL, признак нехватки ревьюверов в этой выборке не заполняется.
+			assigned.InNeedMoreReviewers = false
+			byReviewer[a.reviewerID] = append(byReviewer[a.reviewerID], *assigned)
+		}
+	}
+
+	return byReviewer, nil
+}
+
+// AddReview сохраняет ревью Pull Request'а с текущим временем.
+// Если ревьювер не назначен на Pull Request, возвращается ошибка repoErr.ErrReviewerNotAssigned.
+func (r *PullRequestRepository) AddReview(ctx context.Context, review *domain.Review) (*domain.Review, error) {
+	const op = "memory.pullrequest.AddReview"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, ok := org.pullRequests[prKey{repositoryID: review.RepositoryID, prID: review.PullRequestID}]
+	if !ok || pr.assignment(review.ReviewerID) == nil {
+		return nil, repoErr.ErrReviewerNotAssigned
+	}
+
+	r.s.nextReviewID++
+	created := storedReview{id: r.s.nextReviewID, Review: *review}
+	created.SubmittedAt = r.s.now()
+	org.reviews = append(org.reviews, created)
+
+	result := created.Review
+
+	return &result, nil
+}
+
+// IsApproved сообщает, одобрен ли Pull Request всеми назначенными ревьюверами:
+// последнее ревью каждого из них после назначения — APPROVED.
+// Pull Request без ревьюверов одобренным не считается.
+func (r *PullRequestRepository) IsApproved(ctx context.Context, repositoryID, prID string) (bool, error) {
+	const op = "memory.pullrequest.IsApproved"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, ok := org.pullRequests[prKey{repositoryID: repositoryID, prID: prID}]
+	if !ok || len(pr.reviewers) == 0 {
+		return false, nil
+	}
+
+	for _, a := range pr.reviewers {
+		latest := org.latestReview(pr, a)
+		if latest == nil || latest.State != domain.ReviewStateApproved {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ListPendingAssignments возвращает ещё не помеченные просроченными назначения без ревью
+// у ревьюверов из команд с заданным SLA.
+func (r *PullRequestRepository) ListPendingAssignments(ctx context.Context) ([]domain.ReviewAssignment, error) {
+	const op = "memory.pullrequest.ListPendingAssignments"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	assignments := org.pendingAssignments(func(a domain.ReviewAssignment) bool {
+		return a.OverdueAt == nil && a.SLA.Enabled()
+	})
+	slices.SortStableFunc(assignments, func(a, b domain.ReviewAssignment) int {
+		return a.AssignedAt.Compare(b.AssignedAt)
+	})
+
+	return assignments, nil
+}
+
+// ListOverdue возвращает просроченные назначения, по которым всё ещё нет ревью,
+// начиная с самых старых. Если teamName не пуст, возвращаются только назначения
+// ревьюверов этой команды.
+func (r *PullRequestRepository) ListOverdue(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error) {
+	const op = "memory.pullrequest.ListOverdue"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	assignments := org.pendingAssignments(func(a domain.ReviewAssignment) bool {
+		return a.OverdueAt != nil && (teamName == "" || a.TeamName == teamName)
+	})
+	slices.SortStableFunc(assignments, func(a, b domain.ReviewAssignment) int {
+		return cmp.Or(a.OverdueAt.Compare(*b.OverdueAt), a.AssignedAt.Compare(b.AssignedAt))
+	})
+
+	return assignments, nil
+}
+
+// ListPendingReviews возвращает открытые Pull Request'ы, ожидающие ревью активных пользователей
+// с заданной частотой напоминаний, упорядоченные по ревьюверу и времени создания Pull Request'а.
+func (r *PullRequestRepository) ListPendingReviews(
+	ctx context.Context,
+	frequency domain.ReminderFrequency,
+) ([]domain.PendingReview, error) {
+	const op = "memory.pullrequest.ListPendingReviews"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := r.s.now()
+
+	pending := make([]domain.PendingReview, 0)
+	for _, pr := range org.pullRequests {
+		if pr.status != domain.PRStatusOpen {
+			continue
+		}
+
+		for _, a := range pr.reviewers {
+			u := org.users[a.reviewerID]
+			if !u.isActive || (u.awayUntil != nil && u.awayUntil.After(now)) ||
+				u.reminderFrequency != frequency || org.latestReview(pr, a) != nil {
+				continue
+			}
+
+			pending = append(pending, domain.PendingReview{
+				ReviewerID:      u.id,
+				ReviewerName:    u.username,
+				ReviewerEmail:   u.email,
+				Repository:      org.repositories[pr.repositoryID].name,
+				PullRequestID:   pr.id,
+				PullRequestName: pr.name,
+				AuthorID:        pr.authorID,
+				CreatedAt:       pr.createdAt,
+				AssignedAt:      a.assignedAt,
+			})
+		}
+	}
+
+	slices.SortFunc(pending, func(a, b domain.PendingReview) int {
+		return cmp.Or(
+			cmp.Compare(a.ReviewerID, b.ReviewerID),
+			a.CreatedAt.Compare(b.CreatedAt),
+			cmp.Compare(a.Repository, b.Repository),
+			cmp.Compare(a.PullRequestID, b.PullRequestID),
+		)
+	})
+
+	return pending, nil
+}
+
+// MarkOverdue помечает назначение просроченным. Повторная пометка не меняет время.
+// Если ревьювер не назначен на Pull Request, возвращается ошибка repoErr.ErrReviewerNotAssigned.
+func (r *PullRequestRepository) MarkOverdue(ctx context.Context, repositoryID, prID, reviewerID string) error {
+	const op = "memory.pullrequest.MarkOverdue"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, ok := org.pullRequests[prKey{repositoryID: repositoryID, prID: prID}]
+	if !ok {
+		return repoErr.ErrReviewerNotAssigned
+	}
+	a := pr.assignment(reviewerID)
+	if a == nil {
+		return repoErr.ErrReviewerNotAssigned
+	}
+
+	if a.overdueAt == nil {
+		now := r.s.now()
+		a.overdueAt = &now
+	}
+
+	return nil
+}
+
+// AddReviewer назначает дополнительного ревьювера на Pull Request.
+// Если ревьювер уже назначен, ничего не происходит.
+// Если пользователь или Pull Request не найден, возвращается ошибка repoErr.ErrUserNotFound,
+// как и при нарушении внешнего ключа в PostgreSQL.
+func (r *PullRequestRepository) AddReviewer(ctx context.Context, repositoryID, prID, reviewerID string) error {
+	const op = "memory.pullrequest.AddReviewer"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.write(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, ok := org.pullRequests[prKey{repositoryID: repositoryID, prID: prID}]
+	if _, userFound := org.users[reviewerID]; !ok || !userFound {
+		return repoErr.ErrUserNotFound
+	}
+
+	if pr.assignment(reviewerID) == nil {
+		pr.reviewers = append(pr.reviewers, &assignment{reviewerID: reviewerID, assignedAt: r.s.now()})
+	}
+
+	return nil
+}
+
+// pullRequest возвращает Pull Request в доменной модели.
+// Если Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
+func (o *organization) pullRequest(repositoryID, prID string) (*domain.PullRequest, error) {
+	pr, ok := o.pullRequests[prKey{repositoryID: repositoryID, prID: prID}]
+	if !ok {
+		return nil, repoErr.ErrPRNotFound
+	}
+
+	return pr.toDomain(o.repositories[repositoryID].name), nil
+}
+
+// listPullRequests возвращает Pull Request'ы, для которых match возвращает true,
+// упорядоченные по времени создания.
+func (o *organization) listPullRequests(match func(pr *pullRequest) bool) []domain.PullRequest {
+	pullRequests := make([]domain.PullRequest, 0)
+	for _, pr := range o.sortedPullRequests() {
+		if match(pr) {
+			pullRequests = append(pullRequests, *pr.toDomain(o.repositories[pr.repositoryID].name))
+		}
+	}
+
+	return pullRequests
+}
+
+// sortedPullRequests возвращает Pull Request'ы организации, упорядоченные по времени создания,
+// имени репозитория и ID.
+func (o *organization) sortedPullRequests() []*pullRequest {
+	pullRequests := make([]*pullRequest, 0, len(o.pullRequests))
+	for _, pr := range o.pullRequests {
+		pullRequests = append(pullRequests, pr)
+	}
+	slices.SortFunc(pullRequests, func(a, b *pullRequest) int {
+		return cmp.Or(
+			a.createdAt.Compare(b.createdAt),
+			cmp.Compare(o.repositories[a.repositoryID].name, o.repositories[b.repositoryID].name),
+			cmp.Compare(a.id, b.id),
+		)
+	})
+
+	return pullRequests
+}
+
+// pendingAssignments возвращает назначения на открытые Pull Request'ы, по которым ревьювер
+// ещё не оставил ревью после назначения, вместе с командой ревьювера и её SLA.
+func (o *organization) pendingAssignments(match func(a domain.ReviewAssignment) bool) []domain.ReviewAssignment {
+	assignments := make([]domain.ReviewAssignment, 0)
+	for _, pr := range o.sortedPullRequests() {
+		if pr.status != domain.PRStatusOpen {
+			continue
+		}
+
+		for _, a := range pr.reviewers {
+			t, ok := o.teams[o.users[a.reviewerID].teamID]
+			if !ok || o.latestReview(pr, a) != nil {
+				continue
+			}
+
+			var overdueAt *time.Time
+			if a.overdueAt != nil {
+				at := *a.overdueAt
+				overdueAt = &at
+			}
+
+			assignment := domain.ReviewAssignment{
+				RepositoryID:    pr.repositoryID,
+				Repository:      o.repositories[pr.repositoryID].name,
+				PullRequestID:   pr.id,
+				PullRequestName: pr.name,
+				AuthorID:        pr.authorID,
+				ReviewerID:      a.reviewerID,
+				TeamName:        t.name,
+				AssignedAt:      a.assignedAt,
+				OverdueAt:       overdueAt,
+				SLA:             t.sla,
+			}
+			if match(assignment) {
+				assignments = append(assignments, assignment)
+			}
+		}
+	}
+
+	return assignments
+}
+
+// latestReview возвращает последнее ревью ревьювера a, оставленное после назначения, или nil.
+func (o *organization) latestReview(pr *pullRequest, a *assignment) *storedReview {
+	var latest *storedReview
+	for i := range o.reviews {
+		rv := &o.reviews[i]
+		if rv.RepositoryID != pr.repositoryID || rv.PullRequestID != pr.id || rv.ReviewerID != a.reviewerID ||
+			rv.SubmittedAt.Before(a.assignedAt) {
+			continue
+		}
+		if latest == nil || !rv.SubmittedAt.Before(latest.SubmittedAt) {
+			latest = rv
+		}
+	}
+
+	return latest
+}
+
+func (pr *pullRequest) assignment(reviewerID string) *assignment {
+	for _, a := range pr.reviewers {
+		if a.reviewerID == reviewerID {
+			return a
+		}
+	}
+
+	return nil
+}
+
+func (pr *pullRequest) reviewerIDs() []string {
+	var ids []string
+	for _, a := range pr.reviewers {
+		ids = append(ids, a.reviewerID)
+	}
+
+	return ids
+}
+
+func (pr *pullRequest) toDomain(repositoryName string) *domain.PullRequest {
+	// Как и в PostgreSQL, ревьюверы упорядочены по ID, а без ревьюверов список равен nil.
+	reviewers := pr.reviewerIDs()
+	slices.Sort(reviewers)
+
+	var mergedAt *time.Time
+	if pr.mergedAt != nil {
+		at := *pr.mergedAt
+		mergedAt = &at
+	}
+
+	return &domain.PullRequest{
+		RepositoryID:        pr.repositoryID,
+		Repository:          repositoryName,
+		ID:                  pr.id,
+		Name:                pr.name,
+		AuthorID:            pr.authorID,
+		InNeedMoreReviewers: pr.inNeedMoreReviewers,
+		Status:              pr.status,
+		Reviewers:           reviewers,
+		CreatedAt:           pr.createdAt,
+		MergedAt:            mergedAt,
+	}
+}
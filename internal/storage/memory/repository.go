@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+type RepoRepository struct {
+	s *Store
+}
+
+func NewRepoRepository(s *Store) *RepoRepository {
+	return &RepoRepository{
+		s: s,
+	}
+}
+
+// Create создаёт репозиторий в организации субъекта запроса.
+// Если репозиторий с таким именем уже существует, возвращается ошибка repoErr.ErrRepositoryExists.
+// Если команда-владелец не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *RepoRepository) Create(ctx context.Context, repo *domain.Repository) (*domain.Repository, error) {
+	const op = "memory.repository.Create"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.write(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, ok := org.repositoryByName(repo.Name); ok {
+		return nil, repoErr.ErrRepositoryExists
+	}
+	if _, ok := org.teams[repo.TeamID]; repo.TeamID != "" && !ok {
+		return nil, repoErr.ErrTeamNotFound
+	}
+
+	created := &repository{
+		id:           newID(),
+		name:         repo.Name,
+		teamID:       repo.TeamID,
+		maxReviewers: copyInt(repo.Settings.MaxReviewers),
+	}
+	org.repositories[created.id] = created
+
+	return org.repositoryToDomain(created), nil
+}
+
+// GetByName возвращает репозиторий по его имени.
+// Если репозиторий не найден, возвращается ошибка repoErr.ErrRepositoryNotFound.
+func (r *RepoRepository) GetByName(ctx context.Context, name string) (*domain.Repository, error) {
+	const op = "memory.repository.GetByName"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	found, ok := org.repositoryByName(name)
+	if !ok {
+		return nil, repoErr.ErrRepositoryNotFound
+	}
+
+	return org.repositoryToDomain(found), nil
+}
+
+// UpdateSettings сохраняет настройки репозитория.
+// Если репозиторий не найден, возвращается ошибка repoErr.ErrRepositoryNotFound.
+func (r *RepoRepository) UpdateSettings(
+	ctx context.Context,
+	repositoryID string,
+	settings domain.RepositorySettings,
+) (*domain.Repository, error) {
+	const op = "memory.repository.UpdateSettings"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	found, ok := org.repositories[repositoryID]
+	if !ok {
+		return nil, repoErr.ErrRepositoryNotFound
+	}
+	found.maxReviewers = copyInt(settings.MaxReviewers)
+
+	return org.repositoryToDomain(found), nil
+}
+
+func (o *organization) repositoryToDomain(r *repository) *domain.Repository {
+	var teamName string
+	if t, ok := o.teams[r.teamID]; ok {
+		teamName = t.name
+	}
+
+	return &domain.Repository{
+		ID:       r.id,
+		Name:     r.name,
+		TeamID:   r.teamID,
+		TeamName: teamName,
+		Settings: domain.RepositorySettings{
+			MaxReviewers: copyInt(r.maxReviewers),
+		},
+	}
+}
+
+func copyInt(v *int) *int {
+	if v == nil {
+		return nil
+	}
+
+	c := *v
+
+	return &c
+}
@@ -0,0 +1,282 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/storage/cycletime"
+)
+
+type StatsRepository struct {
+	s *Store
+}
+
+func NewStatsRepository(s *Store) *StatsRepository {
+	return &StatsRepository{
+		s: s,
+	}
+}
+
+// ReviewerAssignments возвращает число назначений ревьювером для каждого пользователя организации,
+// начиная с самых загруженных. Если в фильтре задана команда, учитываются только её участники.
+func (r *StatsRepository) ReviewerAssignments(
+	ctx context.Context,
+	filter domain.StatsFilter,
+) ([]domain.ReviewerStats, error) {
+	const op = "memory.stats.ReviewerAssignments"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	counts := org.assignmentCounts(filter)
+
+	stats := make([]domain.ReviewerStats, 0)
+	for _, u := range sortedUsers(org) {
+		var teamName string
+		if t, ok := org.teams[u.teamID]; ok {
+			teamName = t.name
+		}
+		if filter.TeamName != "" && teamName != filter.TeamName {
+			continue
+		}
+
+		stats = append(stats, domain.ReviewerStats{
+			UserID:      u.id,
+			Username:    u.username,
+			TeamName:    teamName,
+			IsActive:    u.isActive,
+			Assignments: counts[u.id],
+		})
+	}
+
+	slices.SortStableFunc(stats, func(a, b domain.ReviewerStats) int {
+		return cmp.Compare(b.Assignments, a.Assignments)
+	})
+
+	return stats, nil
+}
+
+// TeamAssignments возвращает распределение назначений по командам организации.
+// Учитываются только активные участники: неактивные не могут получать назначения
+// и исказили бы показатели равномерности. Команды упорядочены по имени.
+func (r *StatsRepository) TeamAssignments(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	const op = "memory.stats.TeamAssignments"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	counts := org.assignmentCounts(filter)
+
+	stats := make([]domain.TeamStats, 0)
+	for _, t := range sortedTeams(org) {
+		if filter.TeamName != "" && t.name != filter.TeamName {
+			continue
+		}
+
+		s := domain.TeamStats{TeamName: t.name}
+		for _, u := range org.users {
+			if u.teamID != t.id || !u.isActive {
+				continue
+			}
+
+			n := counts[u.id]
+			if s.Members == 0 || n > s.MaxAssignments {
+				s.MaxAssignments = n
+			}
+			if s.Members == 0 || n < s.MinAssignments {
+				s.MinAssignments = n
+			}
+			if n == 0 {
+				s.IdleMembers++
+			}
+			s.Members++
+			s.Assignments += n
+		}
+		if s.Members > 0 {
+			s.AvgAssignments = float64(s.Assignments) / float64(s.Members)
+		}
+
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
+// PullRequestCounts возвращает число открытых Pull Request'ов организации
+// и тех из них, на которые не назначено ни одного ревьювера.
+func (r *StatsRepository) PullRequestCounts(ctx context.Context) (domain.PullRequestCounts, error) {
+	const op = "memory.stats.PullRequestCounts"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return domain.PullRequestCounts{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var counts domain.PullRequestCounts
+	for _, pr := range org.pullRequests {
+		if pr.status != domain.PRStatusOpen {
+			continue
+		}
+
+		counts.Open++
+		if len(pr.reviewers) == 0 {
+			counts.WithoutReviewers++
+		}
+	}
+
+	return counts, nil
+}
+
+// CycleTime возвращает медиану и 90-й перцентиль времени до первого ревью, до одобрения
+// и до merge по группам и неделям создания Pull Request'а.
+// Фильтр по команде ограничивает Pull Request'ы командой автора.
+func (r *StatsRepository) CycleTime(
+	ctx context.Context,
+	group domain.CycleTimeGroup,
+	filter domain.StatsFilter,
+) ([]domain.CycleTimeStats, error) {
+	const op = "memory.stats.CycleTime"
+
+	if !group.IsValid() {
+		return nil, fmt.Errorf("%s: unknown group %q", op, group)
+	}
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var facts []cycletime.Fact
+	for _, pr := range org.pullRequests {
+		authorTeam := org.authorTeam(pr)
+		if !matchesFilter(pr, authorTeam, filter) {
+			continue
+		}
+
+		switch group {
+		case domain.CycleTimeByTeam, domain.CycleTimeByRepository:
+			key := authorTeam
+			if group == domain.CycleTimeByRepository {
+				key = org.repositories[pr.repositoryID].name
+			}
+			facts = append(facts, fact(key, pr, pr.createdAt, org.reviewsOf(pr, "", time.Time{})))
+		case domain.CycleTimeByReviewer:
+			for _, a := range pr.reviewers {
+				facts = append(facts, fact(a.reviewerID, pr, a.assignedAt, org.reviewsOf(pr, a.reviewerID, a.assignedAt)))
+			}
+		}
+	}
+
+	return cycletime.Aggregate(facts), nil
+}
+
+// fact собирает факты о Pull Request'е для группы key по его ревью.
+func fact(key string, pr *pullRequest, startedAt time.Time, reviews []storedReview) cycletime.Fact {
+	return cycletime.Fact{
+		Group:           key,
+		CreatedAt:       pr.createdAt,
+		StartedAt:       startedAt,
+		FirstReviewAt:   firstSubmitted(reviews, false),
+		FirstApprovalAt: firstSubmitted(reviews, true),
+		MergedAt:        pr.mergedAt,
+	}
+}
+
+// assignmentCounts возвращает число назначений каждого ревьювера по Pull Request'ам, прошедшим фильтр.
+// Фильтр по команде здесь не применяется: он ограничивает ревьюверов, а не Pull Request'ы.
+func (o *organization) assignmentCounts(filter domain.StatsFilter) map[string]int {
+	counts := make(map[string]int)
+	for _, pr := range o.pullRequests {
+		if !matchesFilter(pr, "", domain.StatsFilter{From: filter.From, To: filter.To, Status: filter.Status}) {
+			continue
+		}
+		for _, a := range pr.reviewers {
+			counts[a.reviewerID]++
+		}
+	}
+
+	return counts
+}
+
+// authorTeam возвращает имя команды автора Pull Request'а или пустую строку.
+func (o *organization) authorTeam(pr *pullRequest) string {
+	u, ok := o.users[pr.authorID]
+	if !ok {
+		return ""
+	}
+
+	t, ok := o.teams[u.teamID]
+	if !ok {
+		return ""
+	}
+
+	return t.name
+}
+
+// reviewsOf возвращает ревью Pull Request'а. Непустой reviewerID ограничивает их ревью
+// этого ревьювера, оставленными не раньше since.
+func (o *organization) reviewsOf(pr *pullRequest, reviewerID string, since time.Time) []storedReview {
+	var reviews []storedReview
+	for _, rv := range o.reviews {
+		if rv.RepositoryID != pr.repositoryID || rv.PullRequestID != pr.id {
+			continue
+		}
+		if reviewerID != "" && (rv.ReviewerID != reviewerID || rv.SubmittedAt.Before(since)) {
+			continue
+		}
+		reviews = append(reviews, rv)
+	}
+
+	return reviews
+}
+
+func matchesFilter(pr *pullRequest, authorTeam string, filter domain.StatsFilter) bool {
+	if filter.From != nil && pr.createdAt.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && !pr.createdAt.Before(*filter.To) {
+		return false
+	}
+	if filter.Status != nil && pr.status != *filter.Status {
+		return false
+	}
+
+	return filter.TeamName == "" || authorTeam == filter.TeamName
+}
+
+// firstSubmitted возвращает время первого ревью, а при approvedOnly — первого одобрения,
+// или nil, если подходящих ревью нет.
+func firstSubmitted(reviews []storedReview, approvedOnly bool) *time.Time {
+	var first *time.Time
+	for _, rv := range reviews {
+		if approvedOnly && rv.State != domain.ReviewStateApproved {
+			continue
+		}
+		if first == nil || rv.SubmittedAt.Before(*first) {
+			at := rv.SubmittedAt
+			first = &at
+		}
+	}
+
+	return first
+}
@@ -0,0 +1,299 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+type TeamRepository struct {
+	s *Store
+}
+
+func NewTeamRepository(s *Store) *TeamRepository {
+	return &TeamRepository{
+		s: s,
+	}
+}
+
+// CreateWithMembers создает команду и пользователей, принадлежащих к этой команде.
+// Существующие пользователи обновляются и переводятся в команду, пустой email не затирает сохранённый.
+// Если команда с таким именем уже существует, возвращается ошибка repoErr.ErrTeamExists.
+// Если имя пользователя занято другим пользователем, возвращается ошибка repoErr.ErrUsernameTaken.
+func (r *TeamRepository) CreateWithMembers(
+	ctx context.Context,
+	teamName string,
+	members []domain.Member,
+) (*domain.Team, error) {
+	const op = "memory.team.CreateWithMembers"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.write(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, ok := org.teamByName(teamName); ok {
+		return nil, repoErr.ErrTeamExists
+	}
+
+	users := org.cloneUsers()
+	t := &team{id: newID(), name: teamName, sla: domain.TeamSLA{Action: domain.SLAActionNone}}
+	for _, m := range members {
+		err = upsertMember(org, t.id, m)
+		if err != nil {
+			org.users = users
+
+			return nil, err
+		}
+	}
+	org.teams[t.id] = t
+
+	return &domain.Team{ID: t.id, Name: t.name, Members: members}, nil
+}
+
+// GetByName возвращает команду по ее имени.
+// Если команда с таким именем не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *TeamRepository) GetByName(ctx context.Context, teamName string) (*domain.Team, error) {
+	const op = "memory.team.GetByName"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	t, ok := org.teamByName(teamName)
+	if !ok {
+		return nil, repoErr.ErrTeamNotFound
+	}
+
+	return t.toDomain(), nil
+}
+
+// GetByID возвращает команду по ее идентификатору.
+// Если команда с таким идентификатором не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *TeamRepository) GetByID(ctx context.Context, teamID string) (*domain.Team, error) {
+	const op = "memory.team.GetByID"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	t, ok := org.teams[teamID]
+	if !ok {
+		return nil, repoErr.ErrTeamNotFound
+	}
+
+	return t.toDomain(), nil
+}
+
+// GetActiveMembersByTeamID возвращает список активных участников команды по идентификатору команды.
+// Участники, отметившие отсутствие, в список не попадают.
+// Если команда с таким идентификатором не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *TeamRepository) GetActiveMembersByTeamID(ctx context.Context, teamID string) ([]domain.Member, error) {
+	const op = "memory.team.GetActiveMembersByTeamID"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, ok := org.teams[teamID]; !ok {
+		return nil, repoErr.ErrTeamNotFound
+	}
+
+	now := r.s.now()
+
+	var members []domain.Member
+	for _, u := range sortedUsers(org) {
+		if u.teamID == teamID && u.isActive && (u.awayUntil == nil || !u.awayUntil.After(now)) {
+			members = append(members, domain.Member{ID: u.id, Username: u.username, IsActive: u.isActive})
+		}
+	}
+
+	return members, nil
+}
+
+// UpdateSLA заменяет SLA команды. Нулевой sla.ReviewHours отключает SLA.
+// Если команда не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+// Если лид не найден в организации, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *TeamRepository) UpdateSLA(ctx context.Context, teamID string, sla domain.TeamSLA) (*domain.Team, error) {
+	const op = "memory.team.UpdateSLA"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	t, ok := org.teams[teamID]
+	if !ok {
+		return nil, repoErr.ErrTeamNotFound
+	}
+	if _, ok := org.users[sla.LeadID]; sla.LeadID != "" && !ok {
+		return nil, repoErr.ErrUserNotFound
+	}
+
+	if !sla.Enabled() {
+		sla.ReviewHours = 0
+	}
+	t.sla = sla
+
+	return t.toDomain(), nil
+}
+
+// ListWithMembers возвращает все команды организации, упорядоченные по имени,
+// вместе со всеми участниками, включая неактивных.
+func (r *TeamRepository) ListWithMembers(ctx context.Context) ([]domain.Team, error) {
+	const op = "memory.team.ListWithMembers"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var teams []domain.Team
+	for _, t := range sortedTeams(org) {
+		members := []domain.Member{}
+		for _, u := range sortedUsers(org) {
+			if u.teamID == t.id {
+				members = append(members, u.toMember())
+			}
+		}
+		teams = append(teams, domain.Team{ID: t.id, Name: t.name, Members: members})
+	}
+
+	return teams, nil
+}
+
+// ApplyPlan применяет план импорта команд атомарно: создаёт команды,
+// создаёт, переводит и обновляет пользователей, деактивирует отсутствующих.
+// Пустой email участника не затирает сохранённый.
+// Если имя пользователя занято другим пользователем, возвращается ошибка repoErr.ErrUsernameTaken.
+// Если команда пользователя или деактивируемый пользователь не найдены, возвращаются
+// ошибки repoErr.ErrTeamNotFound и repoErr.ErrUserNotFound соответственно.
+func (r *TeamRepository) ApplyPlan(ctx context.Context, plan domain.TeamPlan) error {
+	const op = "memory.team.ApplyPlan"
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.write(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Изменения применяются по порядку, как в транзакции PostgreSQL, и откатываются при первой ошибке.
+	teams, users := org.cloneTeams(), org.cloneUsers()
+	for _, change := range plan.Changes {
+		err = applyChange(org, change)
+		if err != nil {
+			org.teams, org.users = teams, users
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return nil
+}
+
+func applyChange(org *organization, change domain.TeamChange) error {
+	switch change.Action {
+	case domain.TeamChangeCreateTeam:
+		if _, ok := org.teamByName(change.TeamName); !ok {
+			t := &team{id: newID(), name: change.TeamName, sla: domain.TeamSLA{Action: domain.SLAActionNone}}
+			org.teams[t.id] = t
+		}
+
+		return nil
+	case domain.TeamChangeAddUser, domain.TeamChangeMoveUser, domain.TeamChangeUpdateUser:
+		t, ok := org.teamByName(change.TeamName)
+		if !ok {
+			return repoErr.ErrTeamNotFound
+		}
+
+		return upsertMember(org, t.id, change.Member)
+	case domain.TeamChangeDeactivateUser:
+		u, ok := org.users[change.Member.ID]
+		if !ok {
+			return repoErr.ErrUserNotFound
+		}
+		u.isActive = false
+
+		return nil
+	default:
+		return fmt.Errorf("unknown change action %q", change.Action)
+	}
+}
+
+// upsertMember создаёт участника команды teamID или обновляет существующего пользователя.
+// Если имя занято другим пользователем, возвращается ошибка repoErr.ErrUsernameTaken.
+func upsertMember(org *organization, teamID string, m domain.Member) error {
+	if other, ok := org.userByName(m.Username); ok && other.id != m.ID {
+		return repoErr.ErrUsernameTaken
+	}
+
+	u, ok := org.users[m.ID]
+	if !ok {
+		u = &user{id: m.ID, reminderFrequency: domain.ReminderDaily}
+		org.users[m.ID] = u
+	}
+
+	u.username = m.Username
+	u.isActive = m.IsActive
+	u.teamID = teamID
+	if m.Email != "" {
+		u.email = m.Email
+	}
+
+	return nil
+}
+
+func sortedTeams(org *organization) []*team {
+	teams := make([]*team, 0, len(org.teams))
+	for _, t := range org.teams {
+		teams = append(teams, t)
+	}
+	slices.SortFunc(teams, func(a, b *team) int { return cmp.Compare(a.name, b.name) })
+
+	return teams
+}
+
+func sortedUsers(org *organization) []*user {
+	users := make([]*user, 0, len(org.users))
+	for _, u := range org.users {
+		users = append(users, u)
+	}
+	slices.SortFunc(users, func(a, b *user) int { return cmp.Compare(a.id, b.id) })
+
+	return users
+}
+
+func (t *team) toDomain() *domain.Team {
+	return &domain.Team{
+		ID:   t.id,
+		Name: t.name,
+		SLA:  t.sla,
+	}
+}
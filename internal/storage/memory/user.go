@@ -0,0 +1,261 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+)
+
+type UserRepository struct {
+	s *Store
+}
+
+func NewUserRepository(s *Store) *UserRepository {
+	return &UserRepository{
+		s: s,
+	}
+}
+
+// ListByTeamID возвращает список участников по идентификатору команды.
+// Если команда не найдена или у команды нет участников, возвращается пустой слайс.
+func (r *UserRepository) ListByTeamID(ctx context.Context, teamID string) ([]domain.Member, error) {
+	const op = "memory.user.ListByTeamID"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var members []domain.Member
+	for _, u := range sortedUsers(org) {
+		if u.teamID == teamID {
+			members = append(members, domain.Member{ID: u.id, Username: u.username, IsActive: u.isActive})
+		}
+	}
+
+	return members, nil
+}
+
+// SetIsActive обновляет статус активности пользователя.
+// Возвращает обновленного пользователя с именем команды.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrTeamNotFound, как и в PostgreSQL:
+// команда пользователя ищется раньше него самого.
+func (r *UserRepository) SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
+	return r.update(ctx, "memory.user.SetIsActive", userID, func(_ *organization, u *user) error {
+		u.isActive = isActive
+
+		return nil
+	})
+}
+
+// GetByID возвращает пользователя по его идентификатору.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Может вернуть repoErr.ErrTeamNotFound, если команда пользователя не найдена.
+func (r *UserRepository) GetByID(ctx context.Context, userID string) (*domain.User, error) {
+	const op = "memory.user.GetByID"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	u, ok := org.users[userID]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+
+	t, ok := org.teams[u.teamID]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrTeamNotFound)
+	}
+
+	return u.toDomain(t.name), nil
+}
+
+// GetByIDs возвращает пользователей с указанными идентификаторами вместе с именами их команд.
+// Ненайденные идентификаторы пропускаются, порядок результата не определён.
+func (r *UserRepository) GetByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	const op = "memory.user.GetByIDs"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	users := make([]domain.User, 0, len(userIDs))
+	seen := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		u, ok := org.users[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		var teamName string
+		if t, ok := org.teams[u.teamID]; ok {
+			teamName = t.name
+		}
+		users = append(users, *u.toDomain(teamName))
+	}
+
+	return users, nil
+}
+
+// SetReminderFrequency обновляет частоту напоминаний пользователя.
+// Возвращает обновленного пользователя с именем команды.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *UserRepository) SetReminderFrequency(
+	ctx context.Context,
+	userID string,
+	frequency domain.ReminderFrequency,
+) (*domain.User, error) {
+	return r.update(ctx, "memory.user.SetReminderFrequency", userID, func(_ *organization, u *user) error {
+		u.reminderFrequency = frequency
+
+		return nil
+	})
+}
+
+// SetEmail обновляет адрес для уведомлений пользователя. Пустой адрес удаляет его.
+// Возвращает обновленного пользователя с именем команды.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *UserRepository) SetEmail(ctx context.Context, userID, email string) (*domain.User, error) {
+	return r.update(ctx, "memory.user.SetEmail", userID, func(_ *organization, u *user) error {
+		u.email = email
+
+		return nil
+	})
+}
+
+// GetByChatID возвращает пользователя по его идентификатору в чате.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *UserRepository) GetByChatID(ctx context.Context, chatUserID string) (*domain.User, error) {
+	const op = "memory.user.GetByChatID"
+
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, u := range org.users {
+		if chatUserID == "" || u.chatUserID != chatUserID {
+			continue
+		}
+
+		t, ok := org.teams[u.teamID]
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", op, repoErr.ErrTeamNotFound)
+		}
+
+		return u.toDomain(t.name), nil
+	}
+
+	return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+}
+
+// SetChatUserID привязывает пользователя к идентификатору в чате. Пустой идентификатор удаляет привязку.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrTeamNotFound.
+// Если идентификатор уже привязан к другому пользователю, возвращается ошибка repoErr.ErrChatUserLinked.
+func (r *UserRepository) SetChatUserID(ctx context.Context, userID, chatUserID string) (*domain.User, error) {
+	return r.update(ctx, "memory.user.SetChatUserID", userID, func(org *organization, u *user) error {
+		for _, other := range org.users {
+			if chatUserID != "" && other.id != u.id && other.chatUserID == chatUserID {
+				return repoErr.ErrChatUserLinked
+			}
+		}
+		u.chatUserID = chatUserID
+
+		return nil
+	})
+}
+
+// SetAwayUntil отмечает пользователя отсутствующим до указанного времени. Nil снимает отметку.
+// Отсутствующие пользователи не назначаются ревьюверами и не получают напоминаний.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *UserRepository) SetAwayUntil(ctx context.Context, userID string, until *time.Time) (*domain.User, error) {
+	return r.update(ctx, "memory.user.SetAwayUntil", userID, func(_ *organization, u *user) error {
+		u.awayUntil = nil
+		if until != nil {
+			untilUTC := until.UTC()
+			u.awayUntil = &untilUTC
+		}
+
+		return nil
+	})
+}
+
+// update изменяет пользователя функцией fn и возвращает его с именем команды.
+func (r *UserRepository) update(
+	ctx context.Context,
+	op string,
+	userID string,
+	fn func(org *organization, u *user) error,
+) (*domain.User, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	org, err := r.s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	u, ok := org.users[userID]
+	if !ok {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, repoErr.ErrTeamNotFound)
+	}
+	t, ok := org.teams[u.teamID]
+	if !ok {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, repoErr.ErrTeamNotFound)
+	}
+
+	err = fn(org, u)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return u.toDomain(t.name), nil
+}
+
+func (u *user) toDomain(teamName string) *domain.User {
+	var awayUntil *time.Time
+	if u.awayUntil != nil {
+		until := *u.awayUntil
+		awayUntil = &until
+	}
+
+	return &domain.User{
+		ID:                u.id,
+		Username:          u.username,
+		IsActive:          u.isActive,
+		TeamID:            u.teamID,
+		TeamName:          teamName,
+		Email:             u.email,
+		ReminderFrequency: u.reminderFrequency,
+		ChatUserID:        u.chatUserID,
+		AwayUntil:         awayUntil,
+	}
+}
+
+func (u *user) toMember() domain.Member {
+	return domain.Member{
+		ID:       u.id,
+		Username: u.username,
+		IsActive: u.isActive,
+		Email:    u.email,
+	}
+}
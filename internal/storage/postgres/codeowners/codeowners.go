@@ -0,0 +1,176 @@
+package codeowners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/storage/postgres/codeowners/model"
+	"avitotech-pr-reviewer/internal/tenant"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+const (
+	userForeignKey = "fk_codeowners_owners_user"
+	teamForeignKey = "fk_codeowners_owners_team"
+)
+
+type Repository struct {
+	db pgPkg.DB
+}
+
+func New(db pgPkg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Replace заменяет все правила CODEOWNERS репозитория с указанным идентификатором переданными.
+// Порядок правил сохраняется.
+// Если репозиторий не принадлежит организации субъекта запроса, возвращается ошибка repoErr.ErrRepositoryNotFound.
+// Если владелец-пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Если владелец-команда не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *Repository) Replace(ctx context.Context, repositoryID string, rules []domain.CodeOwnersRule) error {
+	const op = "repository.codeowners.Replace"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	const existsQuery = `
+		SELECT 1 FROM repositories
+		WHERE org_id = $1 AND repository_id = $2
+		FOR UPDATE
+	`
+	var exists int
+	err = tx.QueryRow(ctx, existsQuery, orgID, repositoryID).Scan(&exists)
+	if pgPkg.IsNoRowsError(err) {
+		return repoErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	const deleteQuery = `
+		DELETE FROM codeowners_rules
+		WHERE repository_id = $1
+	`
+	_, err = tx.Exec(ctx, deleteQuery, repositoryID)
+	if err != nil {
+		return fmt.Errorf("%s: delete rules: %w", op, err)
+	}
+
+	const insertRuleQuery = `
+		INSERT INTO codeowners_rules (repository_id, position, pattern)
+		VALUES ($1, $2, $3)
+	`
+	const insertOwnerQuery = `
+		INSERT INTO codeowners_owners (org_id, repository_id, position, user_id, team_id)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	batch := &pgPkg.Batch{}
+	for position, rule := range rules {
+		batch.Queue(insertRuleQuery, repositoryID, position, rule.Pattern)
+
+		for _, userID := range rule.UserIDs {
+			batch.Queue(insertOwnerQuery, orgID, repositoryID, position, userID, nil)
+		}
+		for _, team := range rule.Teams {
+			batch.Queue(insertOwnerQuery, orgID, repositoryID, position, nil, team.ID)
+		}
+	}
+
+	batchResults := tx.SendBatch(ctx, batch)
+	defer func() {
+		_ = batchResults.Close()
+	}()
+
+	for range batch.Len() {
+		_, execErr := batchResults.Exec()
+		if execErr != nil {
+			err = errors.Join(batchResults.Close(), execErr)
+
+			switch pgPkg.ConstraintName(execErr) {
+			case userForeignKey:
+				return repoErr.ErrUserNotFound
+			case teamForeignKey:
+				return repoErr.ErrTeamNotFound
+			}
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	err = batchResults.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListByRepository возвращает правила CODEOWNERS репозитория в исходном порядке.
+// Если для репозитория правила не загружены, возвращается пустой слайс.
+func (r *Repository) ListByRepository(ctx context.Context, repositoryID string) ([]domain.CodeOwnersRule, error) {
+	const op = "repository.codeowners.ListByRepository"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const listQuery = `
+		SELECT r.pattern,
+			   COALESCE(array_agg(o.user_id) FILTER (WHERE o.user_id IS NOT NULL), '{}') AS user_ids,
+			   COALESCE(array_agg(t.team_id::TEXT) FILTER (WHERE t.team_id IS NOT NULL), '{}') AS team_ids,
+			   COALESCE(array_agg(t.team_name) FILTER (WHERE t.team_id IS NOT NULL), '{}') AS team_names
+		FROM codeowners_rules r
+		JOIN repositories repo ON repo.repository_id = r.repository_id
+		LEFT JOIN codeowners_owners o ON o.repository_id = r.repository_id AND o.position = r.position
+		LEFT JOIN teams t ON t.team_id = o.team_id
+		WHERE repo.org_id = $1 AND r.repository_id = $2
+		GROUP BY r.repository_id, r.position, r.pattern
+		ORDER BY r.position
+	`
+
+	rows, err := r.db.Query(ctx, listQuery, orgID, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var rules []domain.CodeOwnersRule
+	for rows.Next() {
+		rule, err := pgPkg.RowToStructByName[model.Rule](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		rules = append(rules, rule.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rules, nil
+}
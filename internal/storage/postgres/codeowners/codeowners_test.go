@@ -0,0 +1,54 @@
+package codeowners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	repoRepository "avitotech-pr-reviewer/internal/storage/postgres/repository"
+	teamRepository "avitotech-pr-reviewer/internal/storage/postgres/team"
+)
+
+func TestRepository_TenantIsolation(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	repoRepo := repoRepository.New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	orgA := pgtest.Organization(t, pool)
+	orgB := pgtest.Organization(t, pool)
+
+	_, err := teamRepo.CreateWithMembers(orgA, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repositoryA, err := repoRepo.GetByName(orgA, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	rules := []domain.CodeOwnersRule{{Pattern: "*", UserIDs: []string{"u1"}}}
+	err = repo.Replace(orgA, repositoryA.ID, rules)
+	require.NoError(t, err)
+
+	rulesB, err := repo.ListByRepository(orgB, repositoryA.ID)
+	require.NoError(t, err)
+	assert.Empty(t, rulesB)
+
+	err = repo.Replace(orgB, repositoryA.ID, nil)
+	require.ErrorIs(t, err, repoErr.ErrRepositoryNotFound)
+
+	// Пользователь u1 существует только в организации A.
+	repositoryB, err := repoRepo.GetByName(orgB, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	err = repo.Replace(orgB, repositoryB.ID, rules)
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	rulesA, err := repo.ListByRepository(orgA, repositoryA.ID)
+	require.NoError(t, err)
+	assert.Len(t, rulesA, 1)
+}
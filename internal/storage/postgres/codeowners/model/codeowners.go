@@ -0,0 +1,23 @@
+package model
+
+import "avitotech-pr-reviewer/internal/domain"
+
+type Rule struct {
+	Pattern   string   `db:"pattern"`
+	UserIDs   []string `db:"user_ids"`
+	TeamIDs   []string `db:"team_ids"`
+	TeamNames []string `db:"team_names"`
+}
+
+func (r Rule) ToDomain() domain.CodeOwnersRule {
+	teams := make([]domain.Team, len(r.TeamIDs))
+	for i, teamID := range r.TeamIDs {
+		teams[i] = domain.Team{ID: teamID, Name: r.TeamNames[i]}
+	}
+
+	return domain.CodeOwnersRule{
+		Pattern: r.Pattern,
+		UserIDs: r.UserIDs,
+		Teams:   teams,
+	}
+}
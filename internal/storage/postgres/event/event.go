@@ -0,0 +1,182 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/storage/postgres/event/model"
+	"avitotech-pr-reviewer/internal/tenant"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+const selectColumns = `event_id, org_id, event_type, occurred_at, repository_name, pull_request_id, user_ids, payload`
+
+// Repository хранит журнал событий организации.
+type Repository struct {
+	db pgPkg.DB
+}
+
+func New(db pgPkg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Append сохраняет событие в журнале организации субъекта запроса и возвращает его с присвоенным ID.
+// Время события хранится в UTC.
+//
+// Запись берёт транзакционную advisory-блокировку журнала организации до получения ID,
+// поэтому события организации фиксируются в порядке ID, даже если Append вызван
+// в транзакции издателя: читатель, запомнивший последний ID, не пропустит событие,
+// зафиксированное позже с меньшим ID.
+func (r *Repository) Append(ctx context.Context, record domain.EventRecord) (*domain.EventRecord, error) {
+	const op = "repository.event.Append"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	userIDs := record.UserIDs
+	if userIDs == nil {
+		userIDs = []string{}
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	const lockQuery = `SELECT pg_advisory_xact_lock(hashtext('events/' || $1::text))`
+
+	_, err = tx.Exec(ctx, lockQuery, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: lock event log: %w", op, err)
+	}
+
+	const insertQuery = `
+		INSERT INTO events (org_id, event_type, occurred_at, repository_name, pull_request_id, user_ids, payload)
+		VALUES (@org_id, @event_type, @occurred_at, @repository_name, @pull_request_id, @user_ids, @payload)
+		RETURNING ` + selectColumns
+
+	rows, err := tx.Query(ctx, insertQuery, pgx.NamedArgs{
+		"org_id":          orgID,
+		"event_type":      string(record.Type),
+		"occurred_at":     record.OccurredAt.UTC(),
+		"repository_name": record.Repository,
+		"pull_request_id": record.PullRequestID,
+		"user_ids":        userIDs,
+		"payload":         string(record.Payload),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	eventDB, err := pgPkg.CollectExactlyOneRow(rows, pgPkg.RowToStructByName[model.Event])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stored := eventDB.ToDomain()
+
+	return &stored, nil
+}
+
+// ListAfter возвращает не более limit событий организации субъекта запроса с ID больше afterID
+// в порядке записи.
+func (r *Repository) ListAfter(ctx context.Context, afterID int64, limit int) ([]domain.EventRecord, error) {
+	const op = "repository.event.ListAfter"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	listQuery := `
+		SELECT ` + selectColumns + `
+		FROM events
+		WHERE org_id = $1 AND event_id > $2
+		ORDER BY event_id
+		LIMIT $3
+	`
+
+	rows, err := r.db.Query(ctx, listQuery, orgID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	records := make([]domain.EventRecord, 0)
+	for rows.Next() {
+		eventDB, err := pgPkg.RowToStructByName[model.Event](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		records = append(records, eventDB.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return records, nil
+}
+
+// LastID возвращает ID последнего события организации субъекта запроса или 0, если журнал пуст.
+func (r *Repository) LastID(ctx context.Context) (int64, error) {
+	const op = "repository.event.LastID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const lastIDQuery = `SELECT COALESCE(MAX(event_id), 0) FROM events WHERE org_id = $1`
+
+	var lastID int64
+	err = r.db.QueryRow(ctx, lastIDQuery, orgID).Scan(&lastID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return lastID, nil
+}
+
+// DeleteBefore удаляет события организации субъекта запроса, произошедшие раньше before.
+// Возвращает количество удалённых событий.
+func (r *Repository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	const op = "repository.event.DeleteBefore"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const deleteQuery = `
+		DELETE FROM events
+		WHERE org_id = $1 AND occurred_at < $2
+	`
+
+	tag, err := r.db.Exec(ctx, deleteQuery, orgID, before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
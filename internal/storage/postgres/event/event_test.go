@@ -0,0 +1,135 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+func TestRepository_AppendListDelete(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+
+	orgA := pgtest.Organization(t, pool)
+	orgB := pgtest.Organization(t, pool)
+
+	old := time.Now().Add(-48 * time.Hour).UTC().Truncate(time.Second)
+	recent := time.Now().UTC().Truncate(time.Second)
+
+	lastID, err := repo.LastID(orgA)
+	require.NoError(t, err)
+	assert.Zero(t, lastID)
+
+	first, err := repo.Append(orgA, domain.EventRecord{
+		Type:          domain.EventReviewersAssigned,
+		OccurredAt:    old,
+		Repository:    domain.DefaultRepositoryName,
+		PullRequestID: "pr-1",
+		UserIDs:       []string{"u1", "u2"},
+		Payload:       json.RawMessage(`{"reviewer_ids":["u2"]}`),
+	})
+	require.NoError(t, err)
+	assert.Positive(t, first.ID)
+	assert.Equal(t, []string{"u1", "u2"}, first.UserIDs)
+
+	second, err := repo.Append(orgA, domain.EventRecord{
+		Type:       domain.EventPullRequestMerged,
+		OccurredAt: recent,
+		Payload:    json.RawMessage(`{}`),
+	})
+	require.NoError(t, err)
+	assert.Greater(t, second.ID, first.ID)
+	assert.Empty(t, second.UserIDs)
+
+	lastID, err = repo.LastID(orgA)
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, lastID)
+
+	lastID, err = repo.LastID(orgB)
+	require.NoError(t, err)
+	assert.Zero(t, lastID)
+
+	all, err := repo.ListAfter(orgA, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, first.ID, all[0].ID)
+	assert.JSONEq(t, `{"reviewer_ids":["u2"]}`, string(all[0].Payload))
+
+	after, err := repo.ListAfter(orgA, first.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, after, 1)
+	assert.Equal(t, second.ID, after[0].ID)
+
+	other, err := repo.ListAfter(orgB, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+
+	deleted, err := repo.DeleteBefore(orgA, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	left, err := repo.ListAfter(orgA, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, left, 1)
+	assert.Equal(t, second.ID, left[0].ID)
+}
+
+func TestRepository_AppendWaitsForPublisherTx(t *testing.T) {
+	pool := pgtest.Pool(t)
+	txManager := pgPkg.NewTxManager(pool)
+	repo := New(txManager)
+
+	org := pgtest.Organization(t, pool)
+	event := domain.EventRecord{Type: domain.EventPullRequestMerged, OccurredAt: time.Now(), Payload: json.RawMessage(`{}`)}
+
+	appended := make(chan struct{})
+	commit := make(chan struct{})
+	txDone := make(chan error, 1)
+	go func() {
+		txDone <- txManager.WithinTx(org, func(ctx context.Context) error {
+			_, err := repo.Append(ctx, event)
+			close(appended)
+			<-commit
+
+			return err
+		})
+	}()
+	<-appended
+
+	later := make(chan *domain.EventRecord, 1)
+	go func() {
+		record, err := repo.Append(org, event)
+		assert.NoError(t, err)
+		later <- record
+	}()
+
+	// Пока транзакция издателя открыта, событие с большим ID не может быть зафиксировано раньше.
+	select {
+	case <-later:
+		t.Fatal("append did not wait for the open publisher transaction")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(commit)
+	require.NoError(t, <-txDone)
+
+	var second *domain.EventRecord
+	select {
+	case second = <-later:
+	case <-time.After(5 * time.Second):
+		t.Fatal("append did not finish after the publisher transaction committed")
+	}
+
+	records, err := repo.ListAfter(org, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, second.ID, records[1].ID)
+}
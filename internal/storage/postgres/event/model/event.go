@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type Event struct {
+	EventID       int64     `db:"event_id"`
+	OrgID         string    `db:"org_id"`
+	EventType     string    `db:"event_type"`
+	OccurredAt    time.Time `db:"occurred_at"`
+	Repository    string    `db:"repository_name"`
+	PullRequestID string    `db:"pull_request_id"`
+	UserIDs       []string  `db:"user_ids"`
+	Payload       []byte    `db:"payload"`
+}
+
+func (e Event) ToDomain() domain.EventRecord {
+	return domain.EventRecord{
+		ID:            e.EventID,
+		OrgID:         e.OrgID,
+		Type:          domain.EventType(e.EventType),
+		OccurredAt:    e.OccurredAt,
+		Repository:    e.Repository,
+		PullRequestID: e.PullRequestID,
+		UserIDs:       e.UserIDs,
+		Payload:       e.Payload,
+	}
+}
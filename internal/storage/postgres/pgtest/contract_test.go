@@ -0,0 +1,31 @@
+package pgtest_test
+
+import (
+	"context"
+	"testing"
+
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	prRepository "avitotech-pr-reviewer/internal/storage/postgres/pullrequest"
+	repoRepository "avitotech-pr-reviewer/internal/storage/postgres/repository"
+	statsRepository "avitotech-pr-reviewer/internal/storage/postgres/stats"
+	teamRepository "avitotech-pr-reviewer/internal/storage/postgres/team"
+	userRepository "avitotech-pr-reviewer/internal/storage/postgres/user"
+	"avitotech-pr-reviewer/internal/storage/storagetest"
+)
+
+func TestContract(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Backend {
+		pool := pgtest.Pool(t)
+
+		return storagetest.Backend{
+			Teams:        teamRepository.New(pool),
+			Users:        userRepository.New(pool),
+			PullRequests: prRepository.New(pool),
+			Repositories: repoRepository.New(pool),
+			Stats:        statsRepository.New(pool),
+			NewOrganization: func(t *testing.T) context.Context {
+				return pgtest.Organization(t, pool)
+			},
+		}
+	})
+}
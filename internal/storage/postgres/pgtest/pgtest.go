@@ -0,0 +1,83 @@
+// Package pgtest содержит помощники для интеграционных тестов хранилищ PostgreSQL.
+// Тесты выполняются, только если в переменной окружения TEST_POSTGRES_DSN
+// указана база данных с применёнными миграциями, иначе они пропускаются.
+package pgtest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/tenant"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+const dsnEnv = "TEST_POSTGRES_DSN"
+
+// Pool возвращает пул подключений к тестовой базе данных с параметрами opts
+// или пропускает тест, если база данных не задана.
+func Pool(t testing.TB, opts ...pgPkg.Option) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	pool, err := pgPkg.NewPool(context.Background(), dsn, opts...)
+	if err != nil {
+		t.Fatalf("connect to test database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+// Organization создаёт организацию со случайным именем и репозиторием по умолчанию
+// и возвращает контекст администратора этой организации.
+// После теста организация удаляется вместе со всеми её данными.
+func Organization(t testing.TB, db pgPkg.DB) context.Context {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var orgID string
+	err := db.QueryRow(ctx,
+		`INSERT INTO organizations (org_name) VALUES ($1) RETURNING org_id`,
+		t.Name()+"-"+randomSuffix(t),
+	).Scan(&orgID)
+	if err != nil {
+		t.Fatalf("create organization: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = db.Exec(context.Background(), `DELETE FROM organizations WHERE org_id = $1`, orgID)
+	})
+
+	_, err = db.Exec(ctx,
+		`INSERT INTO repositories (org_id, repository_name) VALUES ($1, $2)`,
+		orgID, domain.DefaultRepositoryName,
+	)
+	if err != nil {
+		t.Fatalf("create default repository: %v", err)
+	}
+
+	return tenant.WithPrincipal(ctx, domain.Principal{OrgID: orgID, Role: domain.RoleAdmin})
+}
+
+func randomSuffix(t testing.TB) string {
+	t.Helper()
+
+	b := make([]byte, 8)
+	_, err := rand.Read(b)
+	if err != nil {
+		t.Fatalf("generate random suffix: %v", err)
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -8,29 +8,171 @@ import (
 )
 
 type PullRequest struct {
+	RepositoryID        string       `db:"repository_id"`
+	RepositoryName      string       `db:"repository_name"`
 	ID                  string       `db:"pull_request_id"`
 	Name                string       `db:"pull_request_name"`
 	AuthorID            string       `db:"author_id"`
-	StatusID            string       `db:"status_id"`
+	Status              string       `db:"status"`
+	ReviewerIDs         []string     `db:"reviewer_ids"`
 	InNeedMoreReviewers bool         `db:"is_need_more_reviewers"`
 	CreatedAt           time.Time    `db:"created_at"`
 	MergedAt            sql.NullTime `db:"merged_at"`
 }
 
-func (pr *PullRequest) ToDomain(reviewerIDs []string, status domain.PRStatus) *domain.PullRequest {
+func (pr *PullRequest) ToDomain() *domain.PullRequest {
 	var mergedAt *time.Time
 	if pr.MergedAt.Valid {
 		mergedAt = &pr.MergedAt.Time
 	}
 
 	return &domain.PullRequest{
+		RepositoryID:        pr.RepositoryID,
+		Repository:          pr.RepositoryName,
 		ID:                  pr.ID,
 		Name:                pr.Name,
 		AuthorID:            pr.AuthorID,
-		Status:              status,
-		Reviewers:           reviewerIDs,
+		Status:              domain.PRStatus(pr.Status),
+		Reviewers:           pr.ReviewerIDs,
 		InNeedMoreReviewers: pr.InNeedMoreReviewers,
 		CreatedAt:           pr.CreatedAt,
 		MergedAt:            mergedAt,
 	}
 }
+
+type PullRequestShort struct {
+	RepositoryID   string `db:"repository_id"`
+	RepositoryName string `db:"repository_name"`
+	ID             string `db:"pull_request_id"`
+	Name           string `db:"pull_request_name"`
+	AuthorID       string `db:"author_id"`
+	Status         string `db:"status"`
+}
+
+func (pr *PullRequestShort) ToDomain() domain.PullRequest {
+	return domain.PullRequest{
+		RepositoryID: pr.RepositoryID,
+		Repository:   pr.RepositoryName,
+		ID:           pr.ID,
+		Name:         pr.Name,
+		AuthorID:     pr.AuthorID,
+		Status:       domain.PRStatus(pr.Status),
+	}
+}
+
+// AssignedPullRequest — Pull Request со списком ревьюверов, найденный по назначению
+// ревьювера AssignedReviewerID.
+type AssignedPullRequest struct {
+	AssignedReviewerID string       `db:"assigned_reviewer_id"`
+	RepositoryID       string       `db:"repository_id"`
+	RepositoryName     string       `db:"repository_name"`
+	ID                 string       `db:"pull_request_id"`
+	Name               string       `db:"pull_request_name"`
+	AuthorID           string       `db:"author_id"`
+	Status             string       `db:"status"`
+	ReviewerIDs        []string     `db:"reviewer_ids"`
+	CreatedAt          time.Time    `db:"created_at"`
+	MergedAt           sql.NullTime `db:"merged_at"`
+}
+
+func (pr *AssignedPullRequest) ToDomain() domain.PullRequest {
+	var mergedAt *time.Time
+	if pr.MergedAt.Valid {
+		mergedAt = &pr.MergedAt.Time
+	}
+
+	return domain.PullRequest{
+		RepositoryID: pr.RepositoryID,
+		Repository:   pr.RepositoryName,
+		ID:           pr.ID,
+		Name:         pr.Name,
+		AuthorID:     pr.AuthorID,
+		Status:       domain.PRStatus(pr.Status),
+		Reviewers:    pr.ReviewerIDs,
+		CreatedAt:    pr.CreatedAt,
+		MergedAt:     mergedAt,
+	}
+}
+
+type Review struct {
+	RepositoryID  string    `db:"repository_id"`
+	PullRequestID string    `db:"pull_request_id"`
+	ReviewerID    string    `db:"reviewer_id"`
+	State         string    `db:"state"`
+	SubmittedAt   time.Time `db:"submitted_at"`
+}
+
+func (r *Review) ToDomain() *domain.Review {
+	return &domain.Review{
+		RepositoryID:  r.RepositoryID,
+		PullRequestID: r.PullRequestID,
+		ReviewerID:    r.ReviewerID,
+		State:         domain.ReviewState(r.State),
+		SubmittedAt:   r.SubmittedAt,
+	}
+}
+
+type ReviewAssignment struct {
+	RepositoryID    string         `db:"repository_id"`
+	RepositoryName  string         `db:"repository_name"`
+	PullRequestID   string         `db:"pull_request_id"`
+	PullRequestName string         `db:"pull_request_name"`
+	AuthorID        string         `db:"author_id"`
+	ReviewerID      string         `db:"reviewer_id"`
+	TeamName        string         `db:"team_name"`
+	AssignedAt      time.Time      `db:"assigned_at"`
+	OverdueAt       sql.NullTime   `db:"overdue_at"`
+	ReviewSLAHours  sql.NullInt32  `db:"review_sla_hours"`
+	SLAAction       string         `db:"sla_action"`
+	LeadID          sql.NullString `db:"lead_id"`
+}
+
+func (a *ReviewAssignment) ToDomain() domain.ReviewAssignment {
+	var overdueAt *time.Time
+	if a.OverdueAt.Valid {
+		overdueAt = &a.OverdueAt.Time
+	}
+
+	return domain.ReviewAssignment{
+		RepositoryID:    a.RepositoryID,
+		Repository:      a.RepositoryName,
+		PullRequestID:   a.PullRequestID,
+		PullRequestName: a.PullRequestName,
+		AuthorID:        a.AuthorID,
+		ReviewerID:      a.ReviewerID,
+		TeamName:        a.TeamName,
+		AssignedAt:      a.AssignedAt,
+		OverdueAt:       overdueAt,
+		SLA: domain.TeamSLA{
+			ReviewHours: int(a.ReviewSLAHours.Int32),
+			Action:      domain.SLAAction(a.SLAAction),
+			LeadID:      a.LeadID.String,
+		},
+	}
+}
+
+type PendingReview struct {
+	ReviewerID      string    `db:"reviewer_id"`
+	ReviewerName    string    `db:"reviewer_name"`
+	ReviewerEmail   string    `db:"reviewer_email"`
+	RepositoryName  string    `db:"repository_name"`
+	PullRequestID   string    `db:"pull_request_id"`
+	PullRequestName string    `db:"pull_request_name"`
+	AuthorID        string    `db:"author_id"`
+	CreatedAt       time.Time `db:"created_at"`
+	AssignedAt      time.Time `db:"assigned_at"`
+}
+
+func (p *PendingReview) ToDomain() domain.PendingReview {
+	return domain.PendingReview{
+		ReviewerID:      p.ReviewerID,
+		ReviewerName:    p.ReviewerName,
+		ReviewerEmail:   p.ReviewerEmail,
+		Repository:      p.RepositoryName,
+		PullRequestID:   p.PullRequestID,
+		PullRequestName: p.PullRequestName,
+		AuthorID:        p.AuthorID,
+		CreatedAt:       p.CreatedAt,
+		AssignedAt:      p.AssignedAt,
+	}
+}
@@ -9,9 +9,33 @@ import (
 	"avitotech-pr-reviewer/internal/domain"
 	repoErr "avitotech-pr-reviewer/internal/storage/errors"
 	"avitotech-pr-reviewer/internal/storage/postgres/pullrequest/model"
+	"avitotech-pr-reviewer/internal/tenant"
 	pgPkg "avitotech-pr-reviewer/pkg/postgres"
 )
 
+// selectColumns — список колонок Pull Request'а вместе с именем репозитория, статусом
+// и ревьюверами. Запрос должен содержать псевдоним pr для pull_requests и соединения selectJoins.
+const selectColumns = `
+	pr.repository_id, r.repository_name, pr.pull_request_id, pr.pull_request_name,
+	pr.author_id, pr.created_at, s.status, pr.merged_at, pr.is_need_more_reviewers,
+	rv.reviewer_ids
+`
+
+// selectJoins присоединяет к pr репозиторий, статус и ревьюверов, собранные array_agg,
+// чтобы Pull Request целиком читался одним запросом. Подзапрос с агрегатом без GROUP BY
+// всегда возвращает строку, поэтому Pull Request без ревьюверов не теряется.
+const selectJoins = `
+	JOIN repositories r ON r.repository_id = pr.repository_id
+	JOIN pull_request_statuses s ON s.id = pr.status_id
+	CROSS JOIN LATERAL (
+		SELECT array_agg(prr.reviewer_id ORDER BY prr.reviewer_id) AS reviewer_ids
+		FROM pull_request_reviewers prr
+		WHERE prr.org_id = pr.org_id
+		  AND prr.repository_id = pr.repository_id
+		  AND prr.pull_request_id = pr.pull_request_id
+	) rv
+`
+
 type Repository struct {
 	db pgPkg.DB
 }
@@ -22,11 +46,16 @@ func New(db pgPkg.DB) *Repository {
 	}
 }
 
-// Create создаёт новый Pull Request.
-// Если Pull Request с таким ID уже существует, возвращается ошибка repoErr.ErrPRExists.
+// Create создаёт новый Pull Request в репозитории pr.RepositoryID.
+// Если Pull Request с таким ID уже существует в репозитории, возвращается ошибка repoErr.ErrPRExists.
 func (r *Repository) Create(ctx context.Context, pr *domain.PullRequest) (*domain.PullRequest, error) {
 	const op = "pullrequest.Repository.Create"
 
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -37,24 +66,30 @@ func (r *Repository) Create(ctx context.Context, pr *domain.PullRequest) (*domai
 		}
 	}()
 
-	const query = `
-        INSERT INTO pull_requests (
-            pull_request_id,
-            pull_request_name,
-            author_id
-        )
-        VALUES (@id, @name, @author_id)
-        RETURNING pull_request_id, pull_request_name, author_id,
-				  created_at, status_id, merged_at, is_need_more_reviewers
-    `
+	query := `
+		WITH pr AS (
+			INSERT INTO pull_requests (
+				org_id,
+				repository_id,
+				pull_request_id,
+				pull_request_name,
+				author_id
+			)
+			VALUES (@org_id, @repository_id, @id, @name, @author_id)
+			RETURNING *
+		)
+		SELECT ` + selectColumns + `
+		FROM pr` + selectJoins
 
 	rows, err := tx.Query(
 		ctx,
 		query,
 		pgx.NamedArgs{
-			"id":        pr.ID,
-			"name":      pr.Name,
-			"author_id": pr.AuthorID,
+			"org_id":        orgID,
+			"repository_id": pr.RepositoryID,
+			"id":            pr.ID,
+			"name":          pr.Name,
+			"author_id":     pr.AuthorID,
 		},
 	)
 	if err != nil {
@@ -70,13 +105,16 @@ func (r *Repository) Create(ctx context.Context, pr *domain.PullRequest) (*domai
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	status, err := r.getStatusByID(ctx, tx, created.StatusID)
+	// Ревьюверы добавляются после вставки Pull Request'а, поэтому запрос их ещё не видит.
+	created.ReviewerIDs = pr.Reviewers
+
+	createdPR, err := toDomain(created)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	if len(pr.Reviewers) > 0 {
-		err = r.addReviewers(ctx, tx, pr.ID, pr.Reviewers)
+		err = r.addReviewers(ctx, tx, orgID, pr.RepositoryID, pr.ID, pr.Reviewers)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
@@ -87,15 +125,20 @@ func (r *Repository) Create(ctx context.Context, pr *domain.PullRequest) (*domai
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return created.ToDomain(pr.Reviewers, status), nil
+	return createdPR, nil
 }
 
-// GetByID возвращает обогащенный ревьюверами и статусом Pull Request по его ID.
+// GetByID возвращает обогащенный ревьюверами и статусом Pull Request по его ID в репозитории.
 // Если Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
-func (r *Repository) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
+func (r *Repository) GetByID(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error) {
 	const op = "pullrequest.Repository.GetByID"
 
-	pullRequest, err := r.getByID(ctx, r.db, prID)
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pullRequest, err := r.getByID(ctx, r.db, orgID, repositoryID, prID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -103,12 +146,72 @@ func (r *Repository) GetByID(ctx context.Context, prID string) (*domain.PullRequ
 	return pullRequest, nil
 }
 
+// GetByIDs возвращает обогащенные ревьюверами и статусом Pull Request'ы репозитория по их ID
+// одним запросом, упорядоченные по времени создания. Ненайденные Pull Request'ы в результат не попадают.
+func (r *Repository) GetByIDs(ctx context.Context, repositoryID string, prIDs []string) ([]domain.PullRequest, error) {
+	const op = "pullrequest.Repository.GetByIDs"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + selectColumns + `
+		FROM pull_requests pr` + selectJoins + `
+		WHERE pr.org_id = $1 AND pr.repository_id = $2 AND pr.pull_request_id = ANY($3)
+		ORDER BY pr.created_at, pr.pull_request_id
+	`
+
+	pullRequests, err := r.list(ctx, query, orgID, repositoryID, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// ListByRepository возвращает обогащенные ревьюверами и статусом Pull Request'ы репозитория
+// одним запросом, упорядоченные по времени создания. Пустой status не ограничивает выборку.
+// Если в репозитории нет Pull Request'ов, возвращается пустой слайс.
+func (r *Repository) ListByRepository(
+	ctx context.Context,
+	repositoryID string,
+	status domain.PRStatus,
+) ([]domain.PullRequest, error) {
+	const op = "pullrequest.Repository.ListByRepository"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + selectColumns + `
+		FROM pull_requests pr` + selectJoins + `
+		WHERE pr.org_id = $1 AND pr.repository_id = $2 AND ($3::text = '' OR s.status = $3)
+		ORDER BY pr.created_at, pr.pull_request_id
+	`
+
+	pullRequests, err := r.list(ctx, query, orgID, repositoryID, string(status))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
 // GetReviewerIDs возвращает список ID ревьюеров, назначенных на указанный Pull Request.
 // Метод не возвращает ошибку, если Pull Request не найден или у него нет назначенных ревьюеров.
-func (r *Repository) GetReviewerIDs(ctx context.Context, prID string) ([]string, error) {
+func (r *Repository) GetReviewerIDs(ctx context.Context, repositoryID, prID string) ([]string, error) {
 	const op = "pullrequest.Repository.GetReviewerIDs"
 
-	reviewerIDs, err := r.getReviewerIDs(ctx, r.db, prID)
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	reviewerIDs, err := r.getReviewerIDs(ctx, r.db, orgID, repositoryID, prID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -116,18 +219,29 @@ func (r *Repository) GetReviewerIDs(ctx context.Context, prID string) ([]string,
 	return reviewerIDs, nil
 }
 
-// UpdateReviewer заменяет старого ревьюера новым для указанного Pull Request.
+// ReassignReviewer заменяет старого ревьюера новым, которого выбирает choose.
+// Строка Pull Request'а блокируется (SELECT ... FOR UPDATE) до конца транзакции,
+// а choose получает Pull Request, прочитанный уже под блокировкой: параллельные
+// переназначения и слияние того же Pull Request'а ждут завершения транзакции и не
+// могут выбрать того же ревьюера или изменить Pull Request после слияния.
+// Ошибка choose возвращается без изменений.
 // Если указанный Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
 // Если указанный старый ревьюер не назначен на этот Pull Request, возвращается ошибка repoErr.ErrUserNotFound.
-func (r *Repository) UpdateReviewer(
+func (r *Repository) ReassignReviewer(
 	ctx context.Context,
-	prID, oldReviewerID, newReviewerID string,
-) (*domain.PullRequest, error) {
-	const op = "pullrequest.Repository.SetNewReviewer"
+	repositoryID, prID, oldReviewerID string,
+	choose func(pr *domain.PullRequest) (string, error),
+) (*domain.PullRequest, string, error) {
+	const op = "pullrequest.Repository.ReassignReviewer"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 	defer func() {
 		if err != nil {
@@ -135,108 +249,534 @@ func (r *Repository) UpdateReviewer(
 		}
 	}()
 
+	const lockQuery = `
+		SELECT 1 FROM pull_requests
+		WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3
+		FOR UPDATE
+	`
+	var locked int
+	err = tx.QueryRow(ctx, lockQuery, orgID, repositoryID, prID).Scan(&locked)
+	if pgPkg.IsNoRowsError(err) {
+		err = repoErr.ErrPRNotFound
+
+		return nil, "", err
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: lock pull request: %w", op, err)
+	}
+
+	current, err := r.getByID(ctx, tx, orgID, repositoryID, prID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	newReviewerID, err := choose(current)
+	if err != nil {
+		return nil, "", err
+	}
+
 	const deleteQuery = `
 		DELETE FROM pull_request_reviewers
-		WHERE pull_request_id = $1 AND reviewer_id = $2
+		WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3 AND reviewer_id = $4
 	`
-	cmdTag, err := tx.Exec(ctx, deleteQuery, prID, oldReviewerID)
+	cmdTag, err := tx.Exec(ctx, deleteQuery, orgID, repositoryID, prID, oldReviewerID)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 	if cmdTag.RowsAffected() == 0 {
-		_ = tx.Rollback(ctx)
-		return nil, repoErr.ErrUserNotFound
+		err = repoErr.ErrUserNotFound
+
+		return nil, "", err
 	}
 
 	const insertQuery = `
-		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-		VALUES ($1, $2)
+		INSERT INTO pull_request_reviewers (org_id, repository_id, pull_request_id, reviewer_id)
+		VALUES ($1, $2, $3, $4)
 	`
-	_, err = tx.Exec(ctx, insertQuery, prID, newReviewerID)
+	_, err = tx.Exec(ctx, insertQuery, orgID, repositoryID, prID, newReviewerID)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	err = tx.Commit(ctx)
+	updatedPR, err := r.getByID(ctx, tx, orgID, repositoryID, prID)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	updatedPR, err := r.GetByID(ctx, prID)
+	err = tx.Commit(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	return updatedPR, nil
+	return updatedPR, newReviewerID, nil
 }
 
 // SetMerged помечает указанный Pull Request как merged.
 // Возвращается обновлённый Pull Request, обогащенный списком назначенных ревьюеров и статусом.
 // Если Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
 // Операция не является идемпотентной. Нужно вызывать только если Pull Request ещё не был помечен как merged.
-func (r *Repository) SetMerged(ctx context.Context, prID string) (*domain.PullRequest, error) {
+func (r *Repository) SetMerged(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error) {
 	const op = "pullrequest.Repository.SetMerged"
 
-	tx, err := r.db.Begin(ctx)
+	orgID, err := tenant.OrgID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	defer func() {
+
+	query := `
+		WITH pr AS (
+			UPDATE pull_requests
+			SET status_id = (SELECT id FROM pull_request_statuses WHERE UPPER(status) = 'MERGED'),
+				merged_at = NOW()
+			WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3
+			RETURNING *
+		)
+		SELECT ` + selectColumns + `
+		FROM pr` + selectJoins
+
+	rows, err := r.db.Query(ctx, query, orgID, repositoryID, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	updated, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.PullRequest])
+	if pgPkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrPRNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updatedPR, err := toDomain(updated)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updatedPR, nil
+}
+
+// ListByReviewer возвращает Pull Request'ы всех репозиториев, на которые назначен ревьювер.
+// Список ревьюверов в возвращаемых Pull Request'ах не заполняется.
+// Если ревьювер не найден или ни на что не назначен, возвращается пустой слайс.
+func (r *Repository) ListByReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequest, error) {
+	const op = "pullrequest.Repository.ListByReviewer"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT pr.repository_id, r.repository_name, pr.pull_request_id,
+			   pr.pull_request_name, pr.author_id, s.status
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr
+			ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		JOIN pull_request_statuses s ON s.id = pr.status_id
+		WHERE prr.org_id = $1 AND prr.reviewer_id = $2
+		ORDER BY pr.created_at, r.repository_name, pr.pull_request_id
+	`
+
+	rows, err := r.db.Query(ctx, query, orgID, reviewerID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var pullRequests []domain.PullRequest
+	for rows.Next() {
+		pr, err := pgPkg.RowToStructByName[model.PullRequestShort](rows)
 		if err != nil {
-			_ = tx.Rollback(ctx)
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
 		}
-	}()
+		pullRequests = append(pullRequests, pr.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// ListByReviewerIDs возвращает Pull Request'ы всех репозиториев, на которые назначены ревьюверы
+// reviewerIDs, одним запросом, сгруппированные по ревьюверу. В отличие от ListByReviewer,
+// список ревьюверов в Pull Request'ах заполняется. Ревьюверы без назначений в результат не попадают.
+func (r *Repository) ListByReviewerIDs(
+	ctx context.Context,
+	reviewerIDs []string,
+) (map[string][]domain.PullRequest, error) {
+	const op = "pullrequest.Repository.ListByReviewerIDs"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
 	const query = `
-		UPDATE pull_requests
-		SET status_id = (SELECT id FROM pull_request_statuses WHERE UPPER(status) = 'MERGED'),
-			merged_at = NOW()
-		WHERE pull_request_id = $1
-		RETURNING *
+		SELECT prr.reviewer_id AS assigned_reviewer_id,
+			   pr.repository_id, r.repository_name, pr.pull_request_id, pr.pull_request_name,
+			   pr.author_id, pr.created_at, pr.merged_at, s.status,
+			   ARRAY(
+				   SELECT x.reviewer_id
+				   FROM pull_request_reviewers x
+				   WHERE x.org_id = prr.org_id
+					 AND x.repository_id = pr.repository_id
+					 AND x.pull_request_id = pr.pull_request_id
+				   ORDER BY x.reviewer_id
+			   ) AS reviewer_ids
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr
+			ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		JOIN pull_request_statuses s ON s.id = pr.status_id
+		WHERE prr.org_id = $1 AND prr.reviewer_id = ANY($2)
+		ORDER BY pr.created_at, r.repository_name, pr.pull_request_id
 	`
-	rows, err := tx.Query(ctx, query, prID)
+
+	rows, err := r.db.Query(ctx, query, orgID, reviewerIDs)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer rows.Close()
 
-	updated, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.PullRequest])
+	byReviewer := make(map[string][]domain.PullRequest, len(reviewerIDs))
+	for rows.Next() {
+		pr, err := pgPkg.RowToStructByName[model.AssignedPullRequest](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		byReviewer[pr.AssignedReviewerID] = append(byReviewer[pr.AssignedReviewerID], pr.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return byReviewer, nil
+}
+
+// AddReview сохраняет ревью Pull Request'а с текущим временем.
+// Если ревьювер не назначен на Pull Request, возвращается ошибка repoErr.ErrReviewerNotAssigned.
+func (r *Repository) AddReview(ctx context.Context, review *domain.Review) (*domain.Review, error) {
+	const op = "pullrequest.Repository.AddReview"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		INSERT INTO pull_request_reviews (org_id, repository_id, pull_request_id, reviewer_id, state)
+		SELECT org_id, repository_id, pull_request_id, reviewer_id, @state
+		FROM pull_request_reviewers
+		WHERE org_id = @org_id
+			AND repository_id = @repository_id
+			AND pull_request_id = @pull_request_id
+			AND reviewer_id = @reviewer_id
+		RETURNING repository_id, pull_request_id, reviewer_id, state, submitted_at
+	`
+
+	rows, err := r.db.Query(ctx, query, pgx.NamedArgs{
+		"org_id":          orgID,
+		"repository_id":   review.RepositoryID,
+		"pull_request_id": review.PullRequestID,
+		"reviewer_id":     review.ReviewerID,
+		"state":           string(review.State),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	created, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Review])
 	if pgPkg.IsNoRowsError(err) {
-		return nil, repoErr.ErrPRNotFound
+		return nil, repoErr.ErrReviewerNotAssigned
 	}
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	status, err := r.getStatusByID(ctx, tx, updated.StatusID)
+	return created.ToDomain(), nil
+}
+
+// pendingAssignments — назначения на открытые Pull Request'ы, по которым ревьювер
+// ещё не оставил ревью после назначения, вместе с командой ревьювера и её SLA.
+const pendingAssignments = `
+	SELECT prr.repository_id, r.repository_name, prr.pull_request_id, pr.pull_request_name,
+		   pr.author_id, prr.reviewer_id, t.team_name, prr.assigned_at, prr.overdue_at,
+		   t.review_sla_hours, t.sla_action, t.lead_id
+	FROM pull_request_reviewers prr
+	JOIN pull_requests pr
+		ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+	JOIN pull_request_statuses s ON s.id = pr.status_id AND s.status = 'OPEN'
+	JOIN repositories r ON r.repository_id = prr.repository_id
+	JOIN users u ON u.org_id = prr.org_id AND u.user_id = prr.reviewer_id
+	JOIN teams t ON t.org_id = u.org_id AND t.team_id = u.team_id
+	WHERE prr.org_id = @org_id
+		AND NOT EXISTS (
+			SELECT 1 FROM pull_request_reviews rv
+			WHERE rv.repository_id = prr.repository_id
+				AND rv.pull_request_id = prr.pull_request_id
+				AND rv.reviewer_id = prr.reviewer_id
+				AND rv.submitted_at >= prr.assigned_at
+		)
+`
+
+// IsApproved сообщает, одобрен ли Pull Request всеми назначенными ревьюверами:
+// последнее ревью каждого из них после назначения — APPROVED.
+// Pull Request без ревьюверов одобренным не считается.
+func (r *Repository) IsApproved(ctx context.Context, repositoryID, prID string) (bool, error) {
+	const op = "pullrequest.Repository.IsApproved"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT COALESCE(COUNT(*) > 0 AND bool_and(COALESCE(latest.state = 'APPROVED', FALSE)), FALSE)
+		FROM pull_request_reviewers prr
+		LEFT JOIN LATERAL (
+			SELECT rv.state
+			FROM pull_request_reviews rv
+			WHERE rv.repository_id = prr.repository_id
+				AND rv.pull_request_id = prr.pull_request_id
+				AND rv.reviewer_id = prr.reviewer_id
+				AND rv.submitted_at >= prr.assigned_at
+			ORDER BY rv.submitted_at DESC, rv.review_id DESC
+			LIMIT 1
+		) latest ON TRUE
+		WHERE prr.org_id = $1 AND prr.repository_id = $2 AND prr.pull_request_id = $3
+	`
+
+	var approved bool
+	err = r.db.QueryRow(ctx, query, orgID, repositoryID, prID).Scan(&approved)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return approved, nil
+}
+
+// ListPendingAssignments возвращает ещё не помеченные просроченными назначения без ревью
+// у ревьюверов из команд с заданным SLA.
+func (r *Repository) ListPendingAssignments(ctx context.Context) ([]domain.ReviewAssignment, error) {
+	const op = "pullrequest.Repository.ListPendingAssignments"
+
+	orgID, err := tenant.OrgID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	reviewers, err := r.getReviewerIDs(ctx, tx, prID)
+	query := pendingAssignments + `
+		AND prr.overdue_at IS NULL
+		AND t.review_sla_hours IS NOT NULL
+		ORDER BY prr.assigned_at
+	`
+
+	assignments, err := r.listAssignments(ctx, query, pgx.NamedArgs{"org_id": orgID})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	err = tx.Commit(ctx)
+	return assignments, nil
+}
+
+// ListOverdue возвращает просроченные назначения, по которым всё ещё нет ревью,
+// начиная с самых старых. Если teamName не пуст, возвращаются только назначения
+// ревьюверов этой команды.
+func (r *Repository) ListOverdue(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error) {
+	const op = "pullrequest.Repository.ListOverdue"
+
+	orgID, err := tenant.OrgID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return updated.ToDomain(reviewers, status), nil
+	var team *string
+	if teamName != "" {
+		team = &teamName
+	}
+
+	query := pendingAssignments + `
+		AND prr.overdue_at IS NOT NULL
+		AND (@team_name::TEXT IS NULL OR t.team_name = @team_name)
+		ORDER BY prr.overdue_at, prr.assigned_at
+	`
+
+	assignments, err := r.listAssignments(ctx, query, pgx.NamedArgs{"org_id": orgID, "team_name": team})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return assignments, nil
+}
+
+// ListPendingReviews возвращает открытые Pull Request'ы, ожидающие ревью активных пользователей
+// с заданной частотой напоминаний, упорядоченные по ревьюверу и времени создания Pull Request'а.
+func (r *Repository) ListPendingReviews(
+	ctx context.Context,
+	frequency domain.ReminderFrequency,
+) ([]domain.PendingReview, error) {
+	const op = "pullrequest.Repository.ListPendingReviews"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT prr.reviewer_id, u.username AS reviewer_name, COALESCE(u.email, '') AS reviewer_email,
+			   r.repository_name,
+			   prr.pull_request_id, pr.pull_request_name, pr.author_id,
+			   pr.created_at, prr.assigned_at
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr
+			ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+		JOIN pull_request_statuses s ON s.id = pr.status_id AND s.status = 'OPEN'
+		JOIN repositories r ON r.repository_id = prr.repository_id
+		JOIN users u ON u.org_id = prr.org_id AND u.user_id = prr.reviewer_id
+		WHERE prr.org_id = @org_id
+			AND u.is_active = TRUE
+			AND (u.away_until IS NULL OR u.away_until <= NOW())
+			AND u.reminder_frequency = @frequency
+			AND NOT EXISTS (
+				SELECT 1 FROM pull_request_reviews rv
+				WHERE rv.repository_id = prr.repository_id
+					AND rv.pull_request_id = prr.pull_request_id
+					AND rv.reviewer_id = prr.reviewer_id
+					AND rv.submitted_at >= prr.assigned_at
+			)
+		ORDER BY prr.reviewer_id, pr.created_at, r.repository_name, prr.pull_request_id
+	`
+
+	rows, err := r.db.Query(ctx, query, pgx.NamedArgs{"org_id": orgID, "frequency": string(frequency)})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	pending := make([]domain.PendingReview, 0)
+	for rows.Next() {
+		p, err := pgPkg.RowToStructByName[model.PendingReview](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		pending = append(pending, p.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pending, nil
 }
 
-func (r *Repository) addReviewers(ctx context.Context, q pgPkg.Tx, prID string, reviewerIDs []string) error {
+// MarkOverdue помечает назначение просроченным. Повторная пометка не меняет время.
+// Если ревьювер не назначен на Pull Request, возвращается ошибка repoErr.ErrReviewerNotAssigned.
+func (r *Repository) MarkOverdue(ctx context.Context, repositoryID, prID, reviewerID string) error {
+	const op = "pullrequest.Repository.MarkOverdue"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		UPDATE pull_request_reviewers
+		SET overdue_at = COALESCE(overdue_at, NOW())
+		WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3 AND reviewer_id = $4
+	`
+
+	cmdTag, err := r.db.Exec(ctx, query, orgID, repositoryID, prID, reviewerID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return repoErr.ErrReviewerNotAssigned
+	}
+
+	return nil
+}
+
+// AddReviewer назначает дополнительного ревьювера на Pull Request.
+// Если ревьювер уже назначен, ничего не происходит.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *Repository) AddReviewer(ctx context.Context, repositoryID, prID, reviewerID string) error {
+	const op = "pullrequest.Repository.AddReviewer"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		INSERT INTO pull_request_reviewers (org_id, repository_id, pull_request_id, reviewer_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+	`
+
+	_, err = r.db.Exec(ctx, query, orgID, repositoryID, prID, reviewerID)
+	if pgPkg.IsForeignKeyErr(err) {
+		return repoErr.ErrUserNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) listAssignments(
+	ctx context.Context,
+	query string,
+	args pgx.NamedArgs,
+) ([]domain.ReviewAssignment, error) {
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := make([]domain.ReviewAssignment, 0)
+	for rows.Next() {
+		a, err := pgPkg.RowToStructByName[model.ReviewAssignment](rows)
+		if err != nil {
+			return nil, fmt.Errorf("map row: %w", err)
+		}
+		assignments = append(assignments, a.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (r *Repository) addReviewers(
+	ctx context.Context,
+	q pgPkg.Tx,
+	orgID, repositoryID, prID string,
+	reviewerIDs []string,
+) error {
 	const op = "pullrequest.Repository.addReviewers"
 
 	const query = `
-		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-		VALUES ($1, $2)
+		INSERT INTO pull_request_reviewers (org_id, repository_id, pull_request_id, reviewer_id)
+		VALUES ($1, $2, $3, $4)
 	`
 
 	batch := &pgx.Batch{}
 	for _, reviewerID := range reviewerIDs {
-		batch.Queue(query, prID, reviewerID)
+		batch.Queue(query, orgID, repositoryID, prID, reviewerID)
 	}
 	batchResults := q.SendBatch(ctx, batch)
 	defer func() {
@@ -259,17 +799,20 @@ func (r *Repository) addReviewers(ctx context.Context, q pgPkg.Tx, prID string,
 	return nil
 }
 
-func (r *Repository) getByID(ctx context.Context, q pgPkg.Querier, prID string) (*domain.PullRequest, error) {
+func (r *Repository) getByID(
+	ctx context.Context,
+	q pgPkg.Querier,
+	orgID, repositoryID, prID string,
+) (*domain.PullRequest, error) {
 	const op = "pullrequest.Repository.getByID"
 
-	const query = `
-		SELECT pull_request_id, pull_request_name, author_id,
-			   created_at, status_id, merged_at, is_need_more_reviewers
-		FROM pull_requests
-		WHERE pull_request_id = $1
+	query := `
+		SELECT ` + selectColumns + `
+		FROM pull_requests pr` + selectJoins + `
+		WHERE pr.org_id = $1 AND pr.repository_id = $2 AND pr.pull_request_id = $3
 	`
 
-	rows, err := q.Query(ctx, query, prID)
+	rows, err := q.Query(ctx, query, orgID, repositoryID, prID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -283,29 +826,52 @@ func (r *Repository) getByID(ctx context.Context, q pgPkg.Querier, prID string)
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	status, err := r.getStatusByID(ctx, q, found.StatusID)
+	return toDomain(found)
+}
+
+func (r *Repository) list(ctx context.Context, query string, args ...any) ([]domain.PullRequest, error) {
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	pullRequests := make([]domain.PullRequest, 0)
+	for rows.Next() {
+		row, err := pgPkg.RowToStructByName[model.PullRequest](rows)
+		if err != nil {
+			return nil, fmt.Errorf("map row: %w", err)
+		}
+
+		pr, err := toDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		pullRequests = append(pullRequests, *pr)
 	}
 
-	reviewers, err := r.getReviewerIDs(ctx, q, prID)
+	err = rows.Err()
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
 
-	return found.ToDomain(reviewers, status), nil
+	return pullRequests, nil
 }
 
-func (r *Repository) getReviewerIDs(ctx context.Context, q pgPkg.Querier, prID string) ([]string, error) {
+func (r *Repository) getReviewerIDs(
+	ctx context.Context,
+	q pgPkg.Querier,
+	orgID, repositoryID, prID string,
+) ([]string, error) {
 	const op = "pullrequest.Repository.getReviewerIDs"
 
 	const query = `
 		SELECT reviewer_id
 		FROM pull_request_reviewers
-		WHERE pull_request_id = $1
+		WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3
 	`
 
-	rows, err := q.Query(ctx, query, prID)
+	rows, err := q.Query(ctx, query, orgID, repositoryID, prID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -330,24 +896,11 @@ func (r *Repository) getReviewerIDs(ctx context.Context, q pgPkg.Querier, prID s
 	return reviewers, nil
 }
 
-func (r *Repository) getStatusByID(ctx context.Context, q pgPkg.Querier, statusID string) (domain.PRStatus, error) {
-	const op = "pullrequest.Repository.getStatusByID"
-
-	const query = `
-        SELECT status
-        FROM pull_request_statuses
-        WHERE id = $1
-    `
-	var status string
-	err := q.QueryRow(ctx, query, statusID).Scan(&status)
-	if err != nil {
-		return "", fmt.Errorf("%s: %w", op, err)
-	}
-
-	domainStatus := domain.PRStatus(status)
-	if !domainStatus.IsValid() {
-		return "", repoErr.ErrInvalidStatus
+// toDomain переводит строку Pull Request'а в доменную модель, проверяя его статус.
+func toDomain(pr model.PullRequest) (*domain.PullRequest, error) {
+	if !domain.PRStatus(pr.Status).IsValid() {
+		return nil, repoErr.ErrInvalidStatus
 	}
 
-	return domainStatus, nil
+	return pr.ToDomain(), nil
 }
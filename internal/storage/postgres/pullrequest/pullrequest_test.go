@@ -0,0 +1,581 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	repoRepository "avitotech-pr-reviewer/internal/storage/postgres/repository"
+	teamRepository "avitotech-pr-reviewer/internal/storage/postgres/team"
+	userRepository "avitotech-pr-reviewer/internal/storage/postgres/user"
+)
+
+func TestRepository_TenantIsolation(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	repoRepo := repoRepository.New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	orgA := pgtest.Organization(t, pool)
+	orgB := pgtest.Organization(t, pool)
+
+	_, err := teamRepo.CreateWithMembers(orgA, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repositoryA, err := repoRepo.GetByName(orgA, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	_, err = repo.Create(orgA, &domain.PullRequest{
+		RepositoryID: repositoryA.ID,
+		ID:           "pr-1",
+		Name:         "Add search",
+		AuthorID:     "u1",
+		Reviewers:    []string{"u2"},
+	})
+	require.NoError(t, err)
+
+	_, err = repo.GetByID(orgB, repositoryA.ID, "pr-1")
+	require.ErrorIs(t, err, repoErr.ErrPRNotFound)
+
+	reviewers, err := repo.GetReviewerIDs(orgB, repositoryA.ID, "pr-1")
+	require.NoError(t, err)
+	assert.Empty(t, reviewers)
+
+	_, err = repo.SetMerged(orgB, repositoryA.ID, "pr-1")
+	require.ErrorIs(t, err, repoErr.ErrPRNotFound)
+
+	_, _, err = repo.ReassignReviewer(orgB, repositoryA.ID, "pr-1", "u2", func(*domain.PullRequest) (string, error) {
+		return "u1", nil
+	})
+	require.ErrorIs(t, err, repoErr.ErrPRNotFound)
+
+	listB, err := repo.ListByReviewer(orgB, "u2")
+	require.NoError(t, err)
+	assert.Empty(t, listB)
+
+	// Организация B не может создать PR в репозитории организации A.
+	_, err = repo.Create(orgB, &domain.PullRequest{
+		RepositoryID: repositoryA.ID,
+		ID:           "pr-2",
+		Name:         "Sneaky",
+		AuthorID:     "u1",
+	})
+	require.Error(t, err)
+
+	prA, err := repo.GetByID(orgA, repositoryA.ID, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.PRStatusOpen, prA.Status)
+	assert.Equal(t, []string{"u2"}, prA.Reviewers)
+
+	listA, err := repo.ListByReviewer(orgA, "u2")
+	require.NoError(t, err)
+	assert.Len(t, listA, 1)
+}
+
+func TestRepository_OverdueAssignments(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	repoRepo := repoRepository.New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	ctx := pgtest.Organization(t, pool)
+
+	team, err := teamRepo.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repository, err := repoRepo.GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	_, err = repo.Create(ctx, &domain.PullRequest{
+		RepositoryID: repository.ID,
+		ID:           "pr-1",
+		Name:         "Add search",
+		AuthorID:     "u1",
+		Reviewers:    []string{"u2", "u3"},
+	})
+	require.NoError(t, err)
+
+	// Без SLA команды назначения не отслеживаются.
+	pending, err := repo.ListPendingAssignments(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	_, err = teamRepo.UpdateSLA(ctx, team.ID, domain.TeamSLA{
+		ReviewHours: 24,
+		Action:      domain.SLAActionEscalate,
+		LeadID:      "u1",
+	})
+	require.NoError(t, err)
+
+	_, err = repo.AddReview(ctx, &domain.Review{
+		RepositoryID:  repository.ID,
+		PullRequestID: "pr-1",
+		ReviewerID:    "u3",
+		State:         domain.ReviewStateCommented,
+	})
+	require.NoError(t, err)
+
+	pending, err = repo.ListPendingAssignments(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "u2", pending[0].ReviewerID)
+	assert.Equal(t, "backend", pending[0].TeamName)
+	assert.Equal(t, domain.TeamSLA{ReviewHours: 24, Action: domain.SLAActionEscalate, LeadID: "u1"}, pending[0].SLA)
+
+	err = repo.MarkOverdue(ctx, repository.ID, "pr-1", "u2")
+	require.NoError(t, err)
+
+	pending, err = repo.ListPendingAssignments(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	overdue, err := repo.ListOverdue(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.Equal(t, "u2", overdue[0].ReviewerID)
+	assert.NotNil(t, overdue[0].OverdueAt)
+
+	overdue, err = repo.ListOverdue(ctx, "frontend")
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+
+	err = repo.AddReviewer(ctx, repository.ID, "pr-1", "u1")
+	require.NoError(t, err)
+	err = repo.AddReviewer(ctx, repository.ID, "pr-1", "u1")
+	require.NoError(t, err)
+
+	reviewers, err := repo.GetReviewerIDs(ctx, repository.ID, "pr-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"u1", "u2", "u3"}, reviewers)
+
+	err = repo.MarkOverdue(ctx, repository.ID, "pr-1", "u9")
+	require.ErrorIs(t, err, repoErr.ErrReviewerNotAssigned)
+}
+
+func TestRepository_ListPendingReviews(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	repoRepo := repoRepository.New(pool)
+	teamRepo := teamRepository.New(pool)
+	userRepo := userRepository.New(pool)
+
+	ctx := pgtest.Organization(t, pool)
+
+	_, err := teamRepo.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repository, err := repoRepo.GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	for _, id := range []string{"pr-1", "pr-2"} {
+		_, err = repo.Create(ctx, &domain.PullRequest{
+			RepositoryID: repository.ID,
+			ID:           id,
+			Name:         "Change " + id,
+			AuthorID:     "u1",
+			Reviewers:    []string{"u2", "u3"},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = repo.SetMerged(ctx, repository.ID, "pr-2")
+	require.NoError(t, err)
+
+	_, err = repo.AddReview(ctx, &domain.Review{
+		RepositoryID:  repository.ID,
+		PullRequestID: "pr-1",
+		ReviewerID:    "u3",
+		State:         domain.ReviewStateApproved,
+	})
+	require.NoError(t, err)
+
+	pending, err := repo.ListPendingReviews(ctx, domain.ReminderDaily)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "u2", pending[0].ReviewerID)
+	assert.Equal(t, "bob", pending[0].ReviewerName)
+	assert.Equal(t, "pr-1", pending[0].PullRequestID)
+	assert.Equal(t, domain.DefaultRepositoryName, pending[0].Repository)
+
+	_, err = userRepo.SetReminderFrequency(ctx, "u2", domain.ReminderWeekly)
+	require.NoError(t, err)
+
+	pending, err = repo.ListPendingReviews(ctx, domain.ReminderDaily)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	pending, err = repo.ListPendingReviews(ctx, domain.ReminderWeekly)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+}
+
+func TestRepository_IsApproved(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	repoRepo := repoRepository.New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	ctx := pgtest.Organization(t, pool)
+
+	_, err := teamRepo.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repository, err := repoRepo.GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	_, err = repo.Create(ctx, &domain.PullRequest{
+		RepositoryID: repository.ID,
+		ID:           "pr-1",
+		Name:         "Add search",
+		AuthorID:     "u1",
+		Reviewers:    []string{"u2", "u3"},
+	})
+	require.NoError(t, err)
+
+	review := func(reviewerID string, state domain.ReviewState) {
+		_, err := repo.AddReview(ctx, &domain.Review{
+			RepositoryID:  repository.ID,
+			PullRequestID: "pr-1",
+			ReviewerID:    reviewerID,
+			State:         state,
+		})
+		require.NoError(t, err)
+	}
+
+	approved, err := repo.IsApproved(ctx, repository.ID, "pr-1")
+	require.NoError(t, err)
+	assert.False(t, approved)
+
+	review("u2", domain.ReviewStateApproved)
+	review("u3", domain.ReviewStateChangesRequested)
+
+	approved, err = repo.IsApproved(ctx, repository.ID, "pr-1")
+	require.NoError(t, err)
+	assert.False(t, approved)
+
+	review("u3", domain.ReviewStateApproved)
+
+	approved, err = repo.IsApproved(ctx, repository.ID, "pr-1")
+	require.NoError(t, err)
+	assert.True(t, approved)
+
+	approved, err = repo.IsApproved(ctx, repository.ID, "pr-404")
+	require.NoError(t, err)
+	assert.False(t, approved)
+}
+
+func TestRepository_ListByReviewerIDs(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	repoRepo := repoRepository.New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	ctx := pgtest.Organization(t, pool)
+	otherOrg := pgtest.Organization(t, pool)
+
+	_, err := teamRepo.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: true},
+		{ID: "u4", Username: "dave", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repository, err := repoRepo.GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	for _, pr := range []domain.PullRequest{
+		{RepositoryID: repository.ID, ID: "pr-1", Name: "Add search", AuthorID: "u1", Reviewers: []string{"u2", "u3"}},
+		{RepositoryID: repository.ID, ID: "pr-2", Name: "Fix login", AuthorID: "u1", Reviewers: []string{"u2"}},
+	} {
+		_, err = repo.Create(ctx, &pr)
+		require.NoError(t, err)
+	}
+
+	byReviewer, err := repo.ListByReviewerIDs(ctx, []string{"u2", "u3", "u4"})
+	require.NoError(t, err)
+
+	require.Len(t, byReviewer["u2"], 2)
+	assert.Equal(t, "pr-1", byReviewer["u2"][0].ID)
+	assert.Equal(t, []string{"u2", "u3"}, byReviewer["u2"][0].Reviewers)
+	assert.Equal(t, domain.PRStatusOpen, byReviewer["u2"][0].Status)
+	assert.Equal(t, domain.DefaultRepositoryName, byReviewer["u2"][0].Repository)
+	assert.Equal(t, []string{"u2"}, byReviewer["u2"][1].Reviewers)
+
+	require.Len(t, byReviewer["u3"], 1)
+	assert.Equal(t, "pr-1", byReviewer["u3"][0].ID)
+
+	assert.NotContains(t, byReviewer, "u4")
+
+	other, err := repo.ListByReviewerIDs(otherOrg, []string{"u2", "u3"})
+	require.NoError(t, err)
+	assert.Empty(t, other)
+}
+
+// reassignFixture создаёт команду из автора u1 и участников u2–u4 и Pull Request
+// с ревьюверами u2 и u3: свободным кандидатом для переназначения остаётся только u4.
+func reassignFixture(t *testing.T) (*Repository, context.Context, string) {
+	t.Helper()
+
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	ctx := pgtest.Organization(t, pool)
+
+	_, err := teamRepository.New(pool).CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: true},
+		{ID: "u4", Username: "dave", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repository, err := repoRepository.New(pool).GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	_, err = repo.Create(ctx, &domain.PullRequest{
+		RepositoryID: repository.ID,
+		ID:           "pr-1",
+		Name:         "Add search",
+		AuthorID:     "u1",
+		Reviewers:    []string{"u2", "u3"},
+	})
+	require.NoError(t, err)
+
+	return repo, ctx, repository.ID
+}
+
+var errNoCandidates = errors.New("no candidates")
+
+// chooseFree выбирает, как сервис, участника команды, который не является
+// автором, заменяемым или уже назначенным ревьювером.
+func chooseFree(oldReviewerID string) func(pr *domain.PullRequest) (string, error) {
+	return func(pr *domain.PullRequest) (string, error) {
+		for _, id := range []string{"u2", "u3", "u4"} {
+			if id != pr.AuthorID && id != oldReviewerID && !slices.Contains(pr.Reviewers, id) {
+				return id, nil
+			}
+		}
+
+		return "", errNoCandidates
+	}
+}
+
+func TestRepository_ReassignReviewer_Concurrent(t *testing.T) {
+	repo, ctx, repositoryID := reassignFixture(t)
+
+	type result struct {
+		newReviewerID string
+		err           error
+	}
+
+	var (
+		inside  = make(chan struct{})
+		results = make(chan result, 2)
+	)
+
+	go func() {
+		_, newReviewerID, err := repo.ReassignReviewer(ctx, repositoryID, "pr-1", "u2",
+			func(pr *domain.PullRequest) (string, error) {
+				close(inside)
+				// Даём второму переназначению дойти до блокировки строки.
+				time.Sleep(200 * time.Millisecond)
+
+				return chooseFree("u2")(pr)
+			})
+		results <- result{newReviewerID, err}
+	}()
+
+	<-inside
+	go func() {
+		_, newReviewerID, err := repo.ReassignReviewer(ctx, repositoryID, "pr-1", "u3", chooseFree("u3"))
+		results <- result{newReviewerID, err}
+	}()
+
+	first, second := <-results, <-results
+	require.NoError(t, first.err)
+	assert.Equal(t, "u4", first.newReviewerID)
+	// Второе переназначение выбирало кандидата уже после коммита первого и увидело u4 среди ревьюверов.
+	require.ErrorIs(t, second.err, errNoCandidates)
+
+	reviewers, err := repo.GetReviewerIDs(ctx, repositoryID, "pr-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"u3", "u4"}, reviewers)
+}
+
+func TestRepository_ReassignReviewer_MergeWaitsForLock(t *testing.T) {
+	repo, ctx, repositoryID := reassignFixture(t)
+
+	var (
+		inside = make(chan struct{})
+		events = make(chan string, 2)
+	)
+
+	go func() {
+		_, _, err := repo.ReassignReviewer(ctx, repositoryID, "pr-1", "u2",
+			func(pr *domain.PullRequest) (string, error) {
+				close(inside)
+				time.Sleep(200 * time.Millisecond)
+
+				return chooseFree("u2")(pr)
+			})
+		assert.NoError(t, err)
+		events <- "reassigned"
+	}()
+
+	<-inside
+	go func() {
+		_, err := repo.SetMerged(ctx, repositoryID, "pr-1")
+		assert.NoError(t, err)
+		events <- "merged"
+	}()
+
+	// Слияние ждёт, пока транзакция переназначения не завершится.
+	assert.Equal(t, "reassigned", <-events)
+	assert.Equal(t, "merged", <-events)
+
+	// После слияния choose видит актуальный статус под блокировкой.
+	_, _, err := repo.ReassignReviewer(ctx, repositoryID, "pr-1", "u3",
+		func(pr *domain.PullRequest) (string, error) {
+			assert.Equal(t, domain.PRStatusMerged, pr.Status)
+
+			return "", errNoCandidates
+		})
+	require.ErrorIs(t, err, errNoCandidates)
+}
+
+func TestRepository_GetByIDs(t *testing.T) {
+	repo, ctx, repositoryID := reassignFixture(t)
+
+	_, err := repo.Create(ctx, &domain.PullRequest{
+		RepositoryID: repositoryID,
+		ID:           "pr-2",
+		Name:         "Fix login",
+		AuthorID:     "u1",
+	})
+	require.NoError(t, err)
+
+	_, err = repo.SetMerged(ctx, repositoryID, "pr-2")
+	require.NoError(t, err)
+
+	prs, err := repo.GetByIDs(ctx, repositoryID, []string{"pr-2", "pr-1", "pr-404"})
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+
+	assert.Equal(t, "pr-1", prs[0].ID)
+	assert.Equal(t, domain.PRStatusOpen, prs[0].Status)
+	assert.Equal(t, []string{"u2", "u3"}, prs[0].Reviewers)
+	assert.Equal(t, domain.DefaultRepositoryName, prs[0].Repository)
+
+	assert.Equal(t, "pr-2", prs[1].ID)
+	assert.Equal(t, domain.PRStatusMerged, prs[1].Status)
+	assert.Empty(t, prs[1].Reviewers)
+	assert.NotNil(t, prs[1].MergedAt)
+
+	merged, err := repo.ListByRepository(ctx, repositoryID, domain.PRStatusMerged)
+	require.NoError(t, err)
+	require.Len(t, merged, 1)
+	assert.Equal(t, "pr-2", merged[0].ID)
+
+	all, err := repo.ListByRepository(ctx, repositoryID, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	otherOrg := pgtest.Organization(t, pgtest.Pool(t))
+	prs, err = repo.GetByIDs(otherOrg, repositoryID, []string{"pr-1", "pr-2"})
+	require.NoError(t, err)
+	assert.Empty(t, prs)
+}
+
+// seedPullRequests создаёт в новой организации n Pull Request'ов с двумя ревьюверами каждый
+// и возвращает репозиторий, контекст организации, ID репозитория и ID Pull Request'ов.
+func seedPullRequests(b *testing.B, n int) (*Repository, context.Context, string, []string) {
+	b.Helper()
+
+	pool := pgtest.Pool(b)
+	repo := New(pool)
+	ctx := pgtest.Organization(b, pool)
+
+	_, err := teamRepository.New(pool).CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: true},
+	})
+	require.NoError(b, err)
+
+	repository, err := repoRepository.New(pool).GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(b, err)
+
+	prIDs := make([]string, n)
+	for i := range prIDs {
+		prIDs[i] = fmt.Sprintf("pr-%d", i)
+		_, err = repo.Create(ctx, &domain.PullRequest{
+			RepositoryID: repository.ID,
+			ID:           prIDs[i],
+			Name:         "Seeded",
+			AuthorID:     "u1",
+			Reviewers:    []string{"u2", "u3"},
+		})
+		require.NoError(b, err)
+	}
+
+	return repo, ctx, repository.ID, prIDs
+}
+
+// BenchmarkRepository_ReadPullRequests сравнивает чтение 100 Pull Request'ов по одному
+// через GetByID с одним запросом GetByIDs.
+func BenchmarkRepository_ReadPullRequests(b *testing.B) {
+	repo, ctx, repositoryID, prIDs := seedPullRequests(b, 100)
+
+	b.Run("GetByID", func(b *testing.B) {
+		for b.Loop() {
+			for _, prID := range prIDs {
+				_, err := repo.GetByID(ctx, repositoryID, prID)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("GetByIDs", func(b *testing.B) {
+		for b.Loop() {
+			_, err := repo.GetByIDs(ctx, repositoryID, prIDs)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("ListByRepository", func(b *testing.B) {
+		for b.Loop() {
+			_, err := repo.ListByRepository(ctx, repositoryID, "")
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
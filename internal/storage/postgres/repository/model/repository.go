@@ -0,0 +1,33 @@
+package model
+
+import (
+	"database/sql"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type Repository struct {
+	ID           string         `db:"repository_id"`
+	Name         string         `db:"repository_name"`
+	TeamID       sql.NullString `db:"team_id"`
+	TeamName     sql.NullString `db:"team_name"`
+	MaxReviewers sql.NullInt32  `db:"max_reviewers"`
+}
+
+func (r Repository) ToDomain() *domain.Repository {
+	var maxReviewers *int
+	if r.MaxReviewers.Valid {
+		v := int(r.MaxReviewers.Int32)
+		maxReviewers = &v
+	}
+
+	return &domain.Repository{
+		ID:       r.ID,
+		Name:     r.Name,
+		TeamID:   r.TeamID.String,
+		TeamName: r.TeamName.String,
+		Settings: domain.RepositorySettings{
+			MaxReviewers: maxReviewers,
+		},
+	}
+}
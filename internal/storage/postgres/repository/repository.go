@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/storage/postgres/repository/model"
+	"avitotech-pr-reviewer/internal/tenant"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+// selectColumns — список колонок для чтения репозитория вместе с именем команды-владельца.
+// Запрос должен содержать псевдоним r для repositories и LEFT JOIN teams t.
+const selectColumns = `
+	r.repository_id, r.repository_name,
+	r.team_id::TEXT AS team_id, t.team_name, r.max_reviewers
+`
+
+type Repository struct {
+	db pgPkg.DB
+}
+
+func New(db pgPkg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Create создаёт репозиторий в организации субъекта запроса.
+// Если репозиторий с таким именем уже существует, возвращается ошибка repoErr.ErrRepositoryExists.
+// Если команда-владелец не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *Repository) Create(ctx context.Context, repository *domain.Repository) (*domain.Repository, error) {
+	const op = "repository.repository.Create"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		WITH r AS (
+			INSERT INTO repositories (org_id, repository_name, team_id, max_reviewers)
+			VALUES (@org_id, @name, @team_id, @max_reviewers)
+			RETURNING *
+		)
+		SELECT ` + selectColumns + `
+		FROM r
+		LEFT JOIN teams t ON t.team_id = r.team_id
+	`
+
+	var teamID *string
+	if repository.TeamID != "" {
+		teamID = &repository.TeamID
+	}
+
+	rows, err := r.db.Query(ctx, query, pgx.NamedArgs{
+		"org_id":        orgID,
+		"name":          repository.Name,
+		"team_id":       teamID,
+		"max_reviewers": repository.Settings.MaxReviewers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	created, err := pgPkg.CollectExactlyOneRow(rows, pgPkg.RowToStructByName[model.Repository])
+	if pgPkg.IsUniqueViolationError(err) {
+		return nil, repoErr.ErrRepositoryExists
+	}
+	if pgPkg.IsForeignKeyErr(err) {
+		return nil, repoErr.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created.ToDomain(), nil
+}
+
+// GetByName возвращает репозиторий по его имени.
+// Если репозиторий не найден, возвращается ошибка repoErr.ErrRepositoryNotFound.
+func (r *Repository) GetByName(ctx context.Context, name string) (*domain.Repository, error) {
+	const op = "repository.repository.GetByName"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + selectColumns + `
+		FROM repositories r
+		LEFT JOIN teams t ON t.team_id = r.team_id
+		WHERE r.org_id = $1 AND r.repository_name = $2
+	`
+
+	rows, err := r.db.Query(ctx, query, orgID, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	found, err := pgPkg.CollectExactlyOneRow(rows, pgPkg.RowToStructByName[model.Repository])
+	if pgPkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return found.ToDomain(), nil
+}
+
+// UpdateSettings сохраняет настройки репозитория.
+// Если репозиторий не найден, возвращается ошибка repoErr.ErrRepositoryNotFound.
+func (r *Repository) UpdateSettings(
+	ctx context.Context,
+	repositoryID string,
+	settings domain.RepositorySettings,
+) (*domain.Repository, error) {
+	const op = "repository.repository.UpdateSettings"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		WITH r AS (
+			UPDATE repositories
+			SET max_reviewers = $3
+			WHERE org_id = $1 AND repository_id = $2
+			RETURNING *
+		)
+		SELECT ` + selectColumns + `
+		FROM r
+		LEFT JOIN teams t ON t.team_id = r.team_id
+	`
+
+	rows, err := r.db.Query(ctx, query, orgID, repositoryID, settings.MaxReviewers)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	updated, err := pgPkg.CollectExactlyOneRow(rows, pgPkg.RowToStructByName[model.Repository])
+	if pgPkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated.ToDomain(), nil
+}
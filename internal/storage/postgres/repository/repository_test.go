@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+)
+
+func TestRepository_TenantIsolation(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+
+	orgA := pgtest.Organization(t, pool)
+	orgB := pgtest.Organization(t, pool)
+
+	created, err := repo.Create(orgA, &domain.Repository{Name: "payments"})
+	require.NoError(t, err)
+
+	_, err = repo.GetByName(orgB, "payments")
+	require.ErrorIs(t, err, repoErr.ErrRepositoryNotFound)
+
+	maxReviewers := 1
+	_, err = repo.UpdateSettings(orgB, created.ID, domain.RepositorySettings{MaxReviewers: &maxReviewers})
+	require.ErrorIs(t, err, repoErr.ErrRepositoryNotFound)
+
+	found, err := repo.GetByName(orgA, "payments")
+	require.NoError(t, err)
+	assert.Nil(t, found.Settings.MaxReviewers)
+
+	_, err = repo.Create(orgB, &domain.Repository{Name: "payments"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+type ReviewerStats struct {
+	UserID      string         `db:"user_id"`
+	Username    string         `db:"username"`
+	TeamName    sql.NullString `db:"team_name"`
+	IsActive    bool           `db:"is_active"`
+	Assignments int            `db:"assignments"`
+}
+
+func (r ReviewerStats) ToDomain() domain.ReviewerStats {
+	return domain.ReviewerStats{
+		UserID:      r.UserID,
+		Username:    r.Username,
+		TeamName:    r.TeamName.String,
+		IsActive:    r.IsActive,
+		Assignments: r.Assignments,
+	}
+}
+
+type TeamStats struct {
+	TeamName       string  `db:"team_name"`
+	Members        int     `db:"members"`
+	Assignments    int     `db:"assignments"`
+	MaxAssignments int     `db:"max_assignments"`
+	MinAssignments int     `db:"min_assignments"`
+	AvgAssignments float64 `db:"avg_assignments"`
+	IdleMembers    int     `db:"idle_members"`
+}
+
+func (t TeamStats) ToDomain() domain.TeamStats {
+	return domain.TeamStats{
+		TeamName:       t.TeamName,
+		Members:        t.Members,
+		Assignments:    t.Assignments,
+		MaxAssignments: t.MaxAssignments,
+		MinAssignments: t.MinAssignments,
+		AvgAssignments: t.AvgAssignments,
+		IdleMembers:    t.IdleMembers,
+	}
+}
+
+type CycleTimeStats struct {
+	GroupKey         string          `db:"group_key"`
+	WeekStart        time.Time       `db:"week_start"`
+	PullRequests     int             `db:"pull_requests"`
+	FirstReviewCount int             `db:"first_review_count"`
+	FirstReviewP50   sql.NullFloat64 `db:"first_review_p50"`
+	FirstReviewP90   sql.NullFloat64 `db:"first_review_p90"`
+	ApprovalCount    int             `db:"approval_count"`
+	ApprovalP50      sql.NullFloat64 `db:"approval_p50"`
+	ApprovalP90      sql.NullFloat64 `db:"approval_p90"`
+	MergeCount       int             `db:"merge_count"`
+	MergeP50         sql.NullFloat64 `db:"merge_p50"`
+	MergeP90         sql.NullFloat64 `db:"merge_p90"`
+}
+
+func (c CycleTimeStats) ToDomain() domain.CycleTimeStats {
+	return domain.CycleTimeStats{
+		Group:             c.GroupKey,
+		WeekStart:         c.WeekStart,
+		PullRequests:      c.PullRequests,
+		TimeToFirstReview: durationStats(c.FirstReviewCount, c.FirstReviewP50, c.FirstReviewP90),
+		TimeToApproval:    durationStats(c.ApprovalCount, c.ApprovalP50, c.ApprovalP90),
+		TimeToMerge:       durationStats(c.MergeCount, c.MergeP50, c.MergeP90),
+	}
+}
+
+// durationStats переводит перцентили в секундах в длительности.
+func durationStats(count int, median, p90 sql.NullFloat64) domain.DurationStats {
+	return domain.DurationStats{
+		Count:  count,
+		Median: seconds(median),
+		P90:    seconds(p90),
+	}
+}
+
+func seconds(s sql.NullFloat64) *time.Duration {
+	if !s.Valid {
+		return nil
+	}
+
+	d := time.Duration(s.Float64 * float64(time.Second))
+
+	return &d
+}
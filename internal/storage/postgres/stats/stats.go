@@ -0,0 +1,325 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/storage/postgres/stats/model"
+	"avitotech-pr-reviewer/internal/tenant"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+// assignmentCounts — CTE с числом назначений каждого пользователя организации
+// по Pull Request'ам, прошедшим фильтр. Пользователи без назначений попадают в выборку с нулём.
+const assignmentCounts = `
+	WITH assignments AS (
+		SELECT prr.reviewer_id
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr
+			ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+		JOIN pull_request_statuses s ON s.id = pr.status_id
+		WHERE prr.org_id = @org_id
+			AND (@from::TIMESTAMP IS NULL OR pr.created_at >= @from)
+			AND (@to::TIMESTAMP IS NULL OR pr.created_at < @to)
+			AND (@status::TEXT IS NULL OR s.status = @status)
+	), counts AS (
+		SELECT u.user_id, u.username, u.is_active, u.team_id, COUNT(a.reviewer_id) AS assignments
+		FROM users u
+		LEFT JOIN assignments a ON a.reviewer_id = u.user_id
+		WHERE u.org_id = @org_id
+		GROUP BY u.user_id, u.username, u.is_active, u.team_id
+	)
+`
+
+type Repository struct {
+	db pgPkg.DB
+}
+
+func New(db pgPkg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// ReviewerAssignments возвращает число назначений ревьювером для каждого пользователя организации,
+// начиная с самых загруженных. Если в фильтре задана команда, учитываются только её участники.
+func (r *Repository) ReviewerAssignments(
+	ctx context.Context,
+	filter domain.StatsFilter,
+) ([]domain.ReviewerStats, error) {
+	const op = "repository.stats.ReviewerAssignments"
+
+	args, err := filterArgs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := assignmentCounts + `
+		SELECT c.user_id, c.username, t.team_name, c.is_active, c.assignments
+		FROM counts c
+		LEFT JOIN teams t ON t.org_id = @org_id AND t.team_id = c.team_id
+		WHERE (@team_name::TEXT IS NULL OR t.team_name = @team_name)
+		ORDER BY c.assignments DESC, c.user_id
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	stats := make([]domain.ReviewerStats, 0)
+	for rows.Next() {
+		s, err := pgPkg.RowToStructByName[model.ReviewerStats](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		stats = append(stats, s.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// TeamAssignments возвращает распределение назначений по командам организации.
+// Учитываются только активные участники: неактивные не могут получать назначения
+// и исказили бы показатели равномерности. Команды упорядочены по имени.
+func (r *Repository) TeamAssignments(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	const op = "repository.stats.TeamAssignments"
+
+	args, err := filterArgs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := assignmentCounts + `
+		SELECT t.team_name,
+			   COUNT(c.user_id)::INT AS members,
+			   COALESCE(SUM(c.assignments), 0)::INT AS assignments,
+			   COALESCE(MAX(c.assignments), 0)::INT AS max_assignments,
+			   COALESCE(MIN(c.assignments), 0)::INT AS min_assignments,
+			   COALESCE(AVG(c.assignments), 0)::FLOAT8 AS avg_assignments,
+			   COUNT(c.user_id) FILTER (WHERE c.assignments = 0)::INT AS idle_members
+		FROM teams t
+		LEFT JOIN counts c ON c.team_id = t.team_id AND c.is_active
+		WHERE t.org_id = @org_id
+			AND (@team_name::TEXT IS NULL OR t.team_name = @team_name)
+		GROUP BY t.team_name
+		ORDER BY t.team_name
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	stats := make([]domain.TeamStats, 0)
+	for rows.Next() {
+		s, err := pgPkg.RowToStructByName[model.TeamStats](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		stats = append(stats, s.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// PullRequestCounts возвращает число открытых Pull Request'ов организации
+// и тех из них, на которые не назначено ни одного ревьювера.
+func (r *Repository) PullRequestCounts(ctx context.Context) (domain.PullRequestCounts, error) {
+	const op = "repository.stats.PullRequestCounts"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return domain.PullRequestCounts{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT COUNT(*)::INT AS open,
+			   COUNT(*) FILTER (WHERE NOT EXISTS (
+				   SELECT 1 FROM pull_request_reviewers prr
+				   WHERE prr.repository_id = pr.repository_id AND prr.pull_request_id = pr.pull_request_id
+			   ))::INT AS without_reviewers
+		FROM pull_requests pr
+		JOIN pull_request_statuses s ON s.id = pr.status_id
+		WHERE pr.org_id = $1 AND s.status = 'OPEN'
+	`
+
+	var counts domain.PullRequestCounts
+	err = r.db.QueryRow(ctx, query, orgID).Scan(&counts.Open, &counts.WithoutReviewers)
+	if err != nil {
+		return domain.PullRequestCounts{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return counts, nil
+}
+
+// filteredPullRequests — CTE с Pull Request'ами организации, прошедшими фильтр,
+// вместе с именем репозитория и командой автора.
+const filteredPullRequests = `
+	WITH prs AS (
+		SELECT pr.repository_id, r.repository_name, pr.pull_request_id,
+			   COALESCE(t.team_name, '') AS author_team, pr.created_at, pr.merged_at
+		FROM pull_requests pr
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		JOIN pull_request_statuses s ON s.id = pr.status_id
+		LEFT JOIN users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id
+		LEFT JOIN teams t ON t.org_id = pr.org_id AND t.team_id = u.team_id
+		WHERE pr.org_id = @org_id
+			AND (@from::TIMESTAMP IS NULL OR pr.created_at >= @from)
+			AND (@to::TIMESTAMP IS NULL OR pr.created_at < @to)
+			AND (@status::TEXT IS NULL OR s.status = @status)
+			AND (@team_name::TEXT IS NULL OR t.team_name = @team_name)
+	)
+`
+
+// pullRequestFacts — моменты первого ревью и первого одобрения каждого Pull Request'а.
+// Время отсчитывается от создания Pull Request'а. Ключ группы подставляется через fmt.
+const pullRequestFacts = `
+	, facts AS (
+		SELECT %s AS group_key, p.created_at, p.created_at AS started_at, p.merged_at,
+			   rv.first_review_at, rv.first_approval_at
+		FROM prs p
+		LEFT JOIN LATERAL (
+			SELECT MIN(submitted_at) AS first_review_at,
+				   MIN(submitted_at) FILTER (WHERE state = 'APPROVED') AS first_approval_at
+			FROM pull_request_reviews
+			WHERE repository_id = p.repository_id AND pull_request_id = p.pull_request_id
+		) rv ON TRUE
+	)
+`
+
+// reviewerFacts — моменты первого ревью и первого одобрения каждого назначенного ревьювера.
+// Время отсчитывается от назначения ревьювера.
+const reviewerFacts = `
+	, facts AS (
+		SELECT prr.reviewer_id AS group_key, p.created_at, prr.assigned_at AS started_at, p.merged_at,
+			   rv.first_review_at, rv.first_approval_at
+		FROM prs p
+		JOIN pull_request_reviewers prr
+			ON prr.repository_id = p.repository_id AND prr.pull_request_id = p.pull_request_id
+		LEFT JOIN LATERAL (
+			SELECT MIN(submitted_at) AS first_review_at,
+				   MIN(submitted_at) FILTER (WHERE state = 'APPROVED') AS first_approval_at
+			FROM pull_request_reviews
+			WHERE repository_id = prr.repository_id
+				AND pull_request_id = prr.pull_request_id
+				AND reviewer_id = prr.reviewer_id
+				AND submitted_at >= prr.assigned_at
+		) rv ON TRUE
+	)
+`
+
+// cycleTimeAggregates считает медиану и 90-й перцентиль в секундах по группам и неделям.
+// percentile_cont пропускает NULL, поэтому PR без ревью или без merge не искажают показатели.
+const cycleTimeAggregates = `
+	SELECT group_key,
+		   date_trunc('week', created_at) AS week_start,
+		   COUNT(*)::INT AS pull_requests,
+		   COUNT(first_review_at)::INT AS first_review_count,
+		   percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM first_review_at - started_at)::FLOAT8)
+			   AS first_review_p50,
+		   percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM first_review_at - started_at)::FLOAT8)
+			   AS first_review_p90,
+		   COUNT(first_approval_at)::INT AS approval_count,
+		   percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM first_approval_at - started_at)::FLOAT8)
+			   AS approval_p50,
+		   percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM first_approval_at - started_at)::FLOAT8)
+			   AS approval_p90,
+		   COUNT(merged_at)::INT AS merge_count,
+		   percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM merged_at - created_at)::FLOAT8)
+			   AS merge_p50,
+		   percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM merged_at - created_at)::FLOAT8)
+			   AS merge_p90
+	FROM facts
+	GROUP BY group_key, week_start
+	ORDER BY group_key, week_start
+`
+
+// CycleTime возвращает медиану и 90-й перцентиль времени до первого ревью, до одобрения
+// и до merge по группам и неделям создания Pull Request'а.
+// Фильтр по команде ограничивает Pull Request'ы командой автора.
+func (r *Repository) CycleTime(
+	ctx context.Context,
+	group domain.CycleTimeGroup,
+	filter domain.StatsFilter,
+) ([]domain.CycleTimeStats, error) {
+	const op = "repository.stats.CycleTime"
+
+	args, err := filterArgs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var facts string
+	switch group {
+	case domain.CycleTimeByTeam:
+		facts = fmt.Sprintf(pullRequestFacts, "p.author_team")
+	case domain.CycleTimeByRepository:
+		facts = fmt.Sprintf(pullRequestFacts, "p.repository_name")
+	case domain.CycleTimeByReviewer:
+		facts = reviewerFacts
+	default:
+		return nil, fmt.Errorf("%s: unknown group %q", op, group)
+	}
+
+	rows, err := r.db.Query(ctx, filteredPullRequests+facts+cycleTimeAggregates, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	stats := make([]domain.CycleTimeStats, 0)
+	for rows.Next() {
+		s, err := pgPkg.RowToStructByName[model.CycleTimeStats](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		stats = append(stats, s.ToDomain())
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+func filterArgs(ctx context.Context, filter domain.StatsFilter) (pgx.NamedArgs, error) {
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status, teamName *string
+	if filter.Status != nil {
+		s := string(*filter.Status)
+		status = &s
+	}
+	if filter.TeamName != "" {
+		teamName = &filter.TeamName
+	}
+
+	return pgx.NamedArgs{
+		"org_id":    orgID,
+		"from":      filter.From,
+		"to":        filter.To,
+		"status":    status,
+		"team_name": teamName,
+	}, nil
+}
@@ -0,0 +1,177 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	prRepository "avitotech-pr-reviewer/internal/storage/postgres/pullrequest"
+	repoRepository "avitotech-pr-reviewer/internal/storage/postgres/repository"
+	teamRepository "avitotech-pr-reviewer/internal/storage/postgres/team"
+)
+
+func TestRepository_Assignments(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	prRepo := prRepository.New(pool)
+	repoRepo := repoRepository.New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	orgA := pgtest.Organization(t, pool)
+	orgB := pgtest.Organization(t, pool)
+
+	_, err := teamRepo.CreateWithMembers(orgA, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repository, err := repoRepo.GetByName(orgA, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	prs := []domain.PullRequest{
+		{ID: "pr-1", AuthorID: "u1", Reviewers: []string{"u2", "u3"}},
+		{ID: "pr-2", AuthorID: "u1", Reviewers: []string{"u2"}},
+		{ID: "pr-3", AuthorID: "u3", Reviewers: []string{"u2"}},
+	}
+	for _, pr := range prs {
+		pr.RepositoryID = repository.ID
+		pr.Name = pr.ID
+		_, err = prRepo.Create(orgA, &pr)
+		require.NoError(t, err)
+	}
+
+	_, err = prRepo.SetMerged(orgA, repository.ID, "pr-3")
+	require.NoError(t, err)
+
+	reviewers, err := repo.ReviewerAssignments(orgA, domain.StatsFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ReviewerStats{
+		{UserID: "u2", Username: "bob", TeamName: "backend", IsActive: true, Assignments: 3},
+		{UserID: "u3", Username: "carol", TeamName: "backend", IsActive: true, Assignments: 1},
+		{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true, Assignments: 0},
+	}, reviewers)
+
+	merged := domain.PRStatusMerged
+	reviewers, err = repo.ReviewerAssignments(orgA, domain.StatsFilter{Status: &merged})
+	require.NoError(t, err)
+	require.Len(t, reviewers, 3)
+	assert.Equal(t, 1, reviewers[0].Assignments)
+	assert.Equal(t, "u2", reviewers[0].UserID)
+
+	future := time.Now().UTC().Add(time.Hour)
+	reviewers, err = repo.ReviewerAssignments(orgA, domain.StatsFilter{From: &future})
+	require.NoError(t, err)
+	for _, r := range reviewers {
+		assert.Zero(t, r.Assignments)
+	}
+
+	teams, err := repo.TeamAssignments(orgA, domain.StatsFilter{})
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	assert.Equal(t, "backend", teams[0].TeamName)
+	assert.Equal(t, 3, teams[0].Members)
+	assert.Equal(t, 4, teams[0].Assignments)
+	assert.Equal(t, 3, teams[0].MaxAssignments)
+	assert.Equal(t, 0, teams[0].MinAssignments)
+	assert.InDelta(t, 4.0/3.0, teams[0].AvgAssignments, 1e-9)
+	assert.Equal(t, 1, teams[0].IdleMembers)
+
+	teamsB, err := repo.TeamAssignments(orgB, domain.StatsFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, teamsB)
+
+	reviewersB, err := repo.ReviewerAssignments(orgB, domain.StatsFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, reviewersB)
+}
+
+func TestRepository_CycleTime(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	prRepo := prRepository.New(pool)
+	repoRepo := repoRepository.New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	ctx := pgtest.Organization(t, pool)
+
+	_, err := teamRepo.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repository, err := repoRepo.GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	// Понедельник, чтобы оба PR попали в одну неделю.
+	createdAt := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+
+	for i, prID := range []string{"pr-1", "pr-2"} {
+		_, err = prRepo.Create(ctx, &domain.PullRequest{
+			RepositoryID: repository.ID,
+			ID:           prID,
+			Name:         prID,
+			AuthorID:     "u1",
+			Reviewers:    []string{"u2"},
+		})
+		require.NoError(t, err)
+
+		_, err = prRepo.AddReview(ctx, &domain.Review{
+			RepositoryID:  repository.ID,
+			PullRequestID: prID,
+			ReviewerID:    "u2",
+			State:         domain.ReviewStateApproved,
+		})
+		require.NoError(t, err)
+
+		// Первый PR ревьюят через час, второй — через три.
+		reviewedAt := createdAt.Add(time.Duration(2*i+1) * time.Hour)
+		_, err = pool.Exec(ctx, `
+			UPDATE pull_requests SET created_at = $3, merged_at = $4
+			WHERE repository_id = $1 AND pull_request_id = $2`,
+			repository.ID, prID, createdAt, reviewedAt.Add(time.Hour))
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `
+			UPDATE pull_request_reviewers SET assigned_at = $3
+			WHERE repository_id = $1 AND pull_request_id = $2`,
+			repository.ID, prID, createdAt)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `
+			UPDATE pull_request_reviews SET submitted_at = $3
+			WHERE repository_id = $1 AND pull_request_id = $2`,
+			repository.ID, prID, reviewedAt)
+		require.NoError(t, err)
+	}
+
+	for _, group := range []domain.CycleTimeGroup{
+		domain.CycleTimeByTeam,
+		domain.CycleTimeByRepository,
+		domain.CycleTimeByReviewer,
+	} {
+		t.Run(string(group), func(t *testing.T) {
+			stats, err := repo.CycleTime(ctx, group, domain.StatsFilter{})
+			require.NoError(t, err)
+			require.Len(t, stats, 1)
+
+			s := stats[0]
+			assert.True(t, s.WeekStart.Equal(createdAt.Truncate(24*time.Hour)))
+			assert.Equal(t, 2, s.PullRequests)
+
+			require.Equal(t, 2, s.TimeToFirstReview.Count)
+			assert.Equal(t, 2*time.Hour, *s.TimeToFirstReview.Median)
+			assert.InDelta(t, float64(2*time.Hour+48*time.Minute), float64(*s.TimeToFirstReview.P90), float64(time.Second))
+			assert.Equal(t, 2*time.Hour, *s.TimeToApproval.Median)
+			assert.Equal(t, 3*time.Hour, *s.TimeToMerge.Median)
+		})
+	}
+
+	stats, err := repo.CycleTime(ctx, domain.CycleTimeByReviewer, domain.StatsFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, "u2", stats[0].Group)
+}
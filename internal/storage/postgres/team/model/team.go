@@ -1,15 +1,47 @@
 package model
 
-import "avitotech-pr-reviewer/internal/domain"
+import (
+	"database/sql"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
 
 type Team struct {
-	ID   string `db:"team_id"`
-	Name string `db:"team_name"`
+	ID             string         `db:"team_id"`
+	Name           string         `db:"team_name"`
+	ReviewSLAHours sql.NullInt32  `db:"review_sla_hours"`
+	SLAAction      string         `db:"sla_action"`
+	LeadID         sql.NullString `db:"lead_id"`
 }
 
 func (t Team) ToDomain() *domain.Team {
 	return &domain.Team{
 		ID:   t.ID,
 		Name: t.Name,
+		SLA: domain.TeamSLA{
+			ReviewHours: int(t.ReviewSLAHours.Int32),
+			Action:      domain.SLAAction(t.SLAAction),
+			LeadID:      t.LeadID.String,
+		},
+	}
+}
+
+// MemberRow — строка выборки команды вместе с участником. Для команды
+// без участников поля пользователя пусты.
+type MemberRow struct {
+	TeamID   string         `db:"team_id"`
+	TeamName string         `db:"team_name"`
+	UserID   sql.NullString `db:"user_id"`
+	Username sql.NullString `db:"username"`
+	IsActive sql.NullBool   `db:"is_active"`
+	Email    sql.NullString `db:"email"`
+}
+
+func (r MemberRow) ToMemberDomain() domain.Member {
+	return domain.Member{
+		ID:       r.UserID.String,
+		Username: r.Username.String,
+		IsActive: r.IsActive.Bool,
+		Email:    r.Email.String,
 	}
 }
@@ -5,13 +5,19 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+
 	"avitotech-pr-reviewer/internal/domain"
 	repoErr "avitotech-pr-reviewer/internal/storage/errors"
 	"avitotech-pr-reviewer/internal/storage/postgres/team/model"
 	userModel "avitotech-pr-reviewer/internal/storage/postgres/user/model"
+	"avitotech-pr-reviewer/internal/tenant"
 	pgPkg "avitotech-pr-reviewer/pkg/postgres"
 )
 
+// selectColumns — список колонок команды вместе с настройками SLA.
+const selectColumns = `team_id, team_name, review_sla_hours, sla_action, lead_id`
+
 type Repository struct {
 	db pgPkg.DB
 }
@@ -26,6 +32,7 @@ func New(db pgPkg.DB) *Repository {
 // принадлежащих к этой команде, назначает их в эту команду.
 // Если переданный список пользователей содержит пользователей,
 // которые уже существуют в базе, то их данные обновляются.
+// Команда и пользователи создаются в организации субъекта запроса.
 // Если команда с таким именем уже существует, возвращается ошибка repoErr.ErrTeamExists.
 func (r *Repository) CreateWithMembers(
 	ctx context.Context,
@@ -34,6 +41,11 @@ func (r *Repository) CreateWithMembers(
 ) (*domain.Team, error) {
 	const op = "repository.team.CreateWithMembers"
 
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -46,10 +58,10 @@ func (r *Repository) CreateWithMembers(
 
 	var team domain.Team
 	const createTeamQuery = `
-		INSERT INTO teams (team_name)
-		VALUES ($1) RETURNING team_id, team_name
+		INSERT INTO teams (org_id, team_name)
+		VALUES ($1, $2) RETURNING team_id, team_name
 	`
-	err = tx.QueryRow(ctx, createTeamQuery, teamName).Scan(&team.ID, &team.Name)
+	err = tx.QueryRow(ctx, createTeamQuery, orgID, teamName).Scan(&team.ID, &team.Name)
 	if pgPkg.IsUniqueViolationError(err) {
 		return nil, repoErr.ErrTeamExists
 	}
@@ -58,21 +70,24 @@ func (r *Repository) CreateWithMembers(
 	}
 
 	const createTeamMemberQuery = `
-		INSERT INTO users (user_id, username, is_active, team_id)
-			VALUES ($1, $2, $3, $4)
-            ON CONFLICT (user_id)
+		INSERT INTO users (org_id, user_id, username, is_active, team_id, email)
+			VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''))
+            ON CONFLICT (org_id, user_id)
             DO UPDATE SET
                 username = EXCLUDED.username,
                 is_active = EXCLUDED.is_active,
-                team_id = EXCLUDED.team_id
+                team_id = EXCLUDED.team_id,
+                email = COALESCE(EXCLUDED.email, users.email)
 	`
 	batch := &pgPkg.Batch{}
 	for _, member := range members {
 		batch.Queue(createTeamMemberQuery,
+			orgID,
 			member.ID,
 			member.Username,
 			member.IsActive,
-			team.ID)
+			team.ID,
+			member.Email)
 	}
 	batchResults := tx.SendBatch(ctx, batch)
 	defer func() {
@@ -109,11 +124,16 @@ func (r *Repository) CreateWithMembers(
 func (r *Repository) GetByName(ctx context.Context, teamName string) (*domain.Team, error) {
 	const op = "repository.team.GetByName"
 
-	const getQuery = `
-		SELECT * FROM teams
-		WHERE team_name = $1
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	getQuery := `
+		SELECT ` + selectColumns + ` FROM teams
+		WHERE org_id = $1 AND team_name = $2
 	`
-	rows, err := r.db.Query(ctx, getQuery, teamName)
+	rows, err := r.db.Query(ctx, getQuery, orgID, teamName)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -131,16 +151,22 @@ func (r *Repository) GetByName(ctx context.Context, teamName string) (*domain.Te
 }
 
 // GetActiveMembersByTeamID возвращает список активных участников команды по идентификатору команды.
+// Участники, отметившие отсутствие (away_until в будущем), в список не попадают.
 // Если команда с таким идентификатором не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
 func (r *Repository) GetActiveMembersByTeamID(ctx context.Context, teamID string) ([]domain.Member, error) {
 	const op = "repository.team.GetActiveMembersByTeamID"
 
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	const existsQuery = `
 		SELECT 1 FROM teams
-		WHERE team_id = $1
+		WHERE org_id = $1 AND team_id = $2
 	`
 	var exists int
-	err := r.db.QueryRow(ctx, existsQuery, teamID).Scan(&exists)
+	err = r.db.QueryRow(ctx, existsQuery, orgID, teamID).Scan(&exists)
 	if pgPkg.IsNoRowsError(err) {
 		return nil, repoErr.ErrTeamNotFound
 	}
@@ -151,10 +177,11 @@ func (r *Repository) GetActiveMembersByTeamID(ctx context.Context, teamID string
 	const listQuery = `
 		SELECT user_id, username, is_active
 		FROM users
-		WHERE team_id = $1 AND is_active = TRUE
+		WHERE org_id = $1 AND team_id = $2 AND is_active = TRUE
+			AND (away_until IS NULL OR away_until <= NOW())
 	`
 
-	rows, err := r.db.Query(ctx, listQuery, teamID)
+	rows, err := r.db.Query(ctx, listQuery, orgID, teamID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -182,11 +209,16 @@ func (r *Repository) GetActiveMembersByTeamID(ctx context.Context, teamID string
 func (r *Repository) GetByID(ctx context.Context, teamID string) (*domain.Team, error) {
 	const op = "repository.team.GetByID"
 
-	const getQuery = `
-		SELECT * FROM teams
-		WHERE team_id = $1
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	getQuery := `
+		SELECT ` + selectColumns + ` FROM teams
+		WHERE org_id = $1 AND team_id = $2
 	`
-	rows, err := r.db.Query(ctx, getQuery, teamID)
+	rows, err := r.db.Query(ctx, getQuery, orgID, teamID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -202,3 +234,208 @@ func (r *Repository) GetByID(ctx context.Context, teamID string) (*domain.Team,
 
 	return teamDB.ToDomain(), nil
 }
+
+// UpdateSLA заменяет SLA команды. Нулевой sla.ReviewHours отключает SLA.
+// Если команда не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+// Если лид не найден в организации, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *Repository) UpdateSLA(ctx context.Context, teamID string, sla domain.TeamSLA) (*domain.Team, error) {
+	const op = "repository.team.UpdateSLA"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var hours *int
+	if sla.Enabled() {
+		hours = &sla.ReviewHours
+	}
+	var leadID *string
+	if sla.LeadID != "" {
+		leadID = &sla.LeadID
+	}
+
+	updateQuery := `
+		UPDATE teams
+		SET review_sla_hours = @hours, sla_action = @action, lead_id = @lead_id
+		WHERE org_id = @org_id AND team_id = @team_id
+		RETURNING ` + selectColumns
+
+	rows, err := r.db.Query(ctx, updateQuery, pgx.NamedArgs{
+		"org_id":  orgID,
+		"team_id": teamID,
+		"hours":   hours,
+		"action":  string(sla.Action),
+		"lead_id": leadID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	teamDB, err := pgPkg.CollectExactlyOneRow(rows, pgPkg.RowToStructByName[model.Team])
+	if pgPkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrTeamNotFound
+	}
+	if pgPkg.IsForeignKeyErr(err) {
+		return nil, repoErr.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return teamDB.ToDomain(), nil
+}
+
+// ListWithMembers возвращает все команды организации, упорядоченные по имени,
+// вместе со всеми участниками, включая неактивных.
+func (r *Repository) ListWithMembers(ctx context.Context) ([]domain.Team, error) {
+	const op = "repository.team.ListWithMembers"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const listQuery = `
+		SELECT t.team_id, t.team_name, u.user_id, u.username, u.is_active, u.email
+		FROM teams t
+		LEFT JOIN users u ON u.org_id = t.org_id AND u.team_id = t.team_id
+		WHERE t.org_id = $1
+		ORDER BY t.team_name, u.user_id
+	`
+	rows, err := r.db.Query(ctx, listQuery, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var teams []domain.Team
+	for rows.Next() {
+		row, err := pgPkg.RowToStructByName[model.MemberRow](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+
+		if len(teams) == 0 || teams[len(teams)-1].ID != row.TeamID {
+			teams = append(teams, domain.Team{ID: row.TeamID, Name: row.TeamName, Members: []domain.Member{}})
+		}
+		if row.UserID.Valid {
+			last := &teams[len(teams)-1]
+			last.Members = append(last.Members, row.ToMemberDomain())
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return teams, nil
+}
+
+// ApplyPlan применяет план импорта команд в одной транзакции: создаёт команды,
+// создаёт, переводит и обновляет пользователей, деактивирует отсутствующих.
+// Пустой email участника не затирает сохранённый.
+// Если имя пользователя занято другим пользователем, возвращается ошибка repoErr.ErrUsernameTaken.
+// Если команда пользователя или деактивируемый пользователь не найдены, возвращаются
+// ошибки repoErr.ErrTeamNotFound и repoErr.ErrUserNotFound соответственно.
+func (r *Repository) ApplyPlan(ctx context.Context, plan domain.TeamPlan) error {
+	const op = "repository.team.ApplyPlan"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	const createTeamQuery = `
+		INSERT INTO teams (org_id, team_name)
+		VALUES ($1, $2)
+		ON CONFLICT (org_id, team_name) DO NOTHING
+	`
+	const upsertMemberQuery = `
+		INSERT INTO users (org_id, user_id, username, is_active, team_id, email)
+			SELECT $1, $2, $3, $4, team_id, NULLIF($6, '')
+			FROM teams WHERE org_id = $1 AND team_name = $5
+			ON CONFLICT (org_id, user_id)
+			DO UPDATE SET
+				username = EXCLUDED.username,
+				is_active = EXCLUDED.is_active,
+				team_id = EXCLUDED.team_id,
+				email = COALESCE(EXCLUDED.email, users.email)
+	`
+	const deactivateQuery = `
+		UPDATE users SET is_active = FALSE
+		WHERE org_id = $1 AND user_id = $2
+	`
+
+	batch := &pgPkg.Batch{}
+	for _, change := range plan.Changes {
+		m := change.Member
+		switch change.Action {
+		case domain.TeamChangeCreateTeam:
+			batch.Queue(createTeamQuery, orgID, change.TeamName)
+		case domain.TeamChangeAddUser, domain.TeamChangeMoveUser, domain.TeamChangeUpdateUser:
+			batch.Queue(upsertMemberQuery, orgID, m.ID, m.Username, m.IsActive, change.TeamName, m.Email)
+		case domain.TeamChangeDeactivateUser:
+			batch.Queue(deactivateQuery, orgID, m.ID)
+		default:
+			err = fmt.Errorf("%s: unknown change action %q", op, change.Action)
+
+			return err
+		}
+	}
+
+	batchResults := tx.SendBatch(ctx, batch)
+	defer func() {
+		_ = batchResults.Close()
+	}()
+
+	for _, change := range plan.Changes {
+		tag, execErr := batchResults.Exec()
+		if pgPkg.IsUniqueViolationError(execErr) {
+			_ = batchResults.Close()
+			err = repoErr.ErrUsernameTaken
+
+			return err
+		}
+		if execErr != nil {
+			e := batchResults.Close()
+			err = errors.Join(e, fmt.Errorf("%s: %w", op, execErr))
+
+			return err
+		}
+		// Команда или пользователь могли быть удалены после построения плана.
+		if change.Action != domain.TeamChangeCreateTeam && tag.RowsAffected() == 0 {
+			_ = batchResults.Close()
+			err = repoErr.ErrTeamNotFound
+			if change.Action == domain.TeamChangeDeactivateUser {
+				err = repoErr.ErrUserNotFound
+			}
+
+			return err
+		}
+	}
+
+	err = batchResults.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
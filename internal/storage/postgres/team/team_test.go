@@ -0,0 +1,109 @@
+package team
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+func TestRepository_TenantIsolation(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+
+	orgA := pgtest.Organization(t, pool)
+	orgB := pgtest.Organization(t, pool)
+
+	members := []domain.Member{{ID: "u1", Username: "alice", IsActive: true}}
+
+	teamA, err := repo.CreateWithMembers(orgA, "backend", members)
+	require.NoError(t, err)
+
+	_, err = repo.GetByName(orgB, "backend")
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	_, err = repo.GetByID(orgB, teamA.ID)
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	_, err = repo.GetActiveMembersByTeamID(orgB, teamA.ID)
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	// Имя команды и идентификаторы пользователей уникальны только в пределах организации.
+	teamB, err := repo.CreateWithMembers(orgB, "backend", []domain.Member{{ID: "u1", Username: "bob", IsActive: false}})
+	require.NoError(t, err)
+	assert.NotEqual(t, teamA.ID, teamB.ID)
+
+	activeA, err := repo.GetActiveMembersByTeamID(orgA, teamA.ID)
+	require.NoError(t, err)
+	assert.Equal(t, members, activeA)
+
+	_, err = repo.CreateWithMembers(orgA, "backend", nil)
+	require.ErrorIs(t, err, repoErr.ErrTeamExists)
+}
+
+func TestRepository_RequiresPrincipal(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+
+	_, err := repo.GetByName(context.Background(), "backend")
+	require.ErrorIs(t, err, tenant.ErrNoPrincipal)
+
+	_, err = repo.CreateWithMembers(context.Background(), "backend", nil)
+	require.ErrorIs(t, err, tenant.ErrNoPrincipal)
+}
+
+func TestRepository_ApplyPlan(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	ctx := pgtest.Organization(t, pool)
+
+	_, err := repo.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+		{ID: "u2", Username: "bob", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	err = repo.ApplyPlan(ctx, domain.TeamPlan{Changes: []domain.TeamChange{
+		{Action: domain.TeamChangeCreateTeam, TeamName: "mobile"},
+		{Action: domain.TeamChangeCreateTeam, TeamName: "infra"},
+		{Action: domain.TeamChangeMoveUser, TeamName: "mobile", FromTeam: "backend",
+			Member: domain.Member{ID: "u1", Username: "alice", IsActive: true}},
+		{Action: domain.TeamChangeAddUser, TeamName: "mobile",
+			Member: domain.Member{ID: "u3", Username: "carol", IsActive: true}},
+		{Action: domain.TeamChangeDeactivateUser, TeamName: "backend",
+			Member: domain.Member{ID: "u2", Username: "bob", IsActive: true}},
+	}})
+	require.NoError(t, err)
+
+	teams, err := repo.ListWithMembers(ctx)
+	require.NoError(t, err)
+	require.Len(t, teams, 3)
+
+	assert.Equal(t, "backend", teams[0].Name)
+	assert.Equal(t, []domain.Member{{ID: "u2", Username: "bob", IsActive: false}}, teams[0].Members)
+	assert.Equal(t, "infra", teams[1].Name)
+	assert.Empty(t, teams[1].Members)
+	assert.Equal(t, "mobile", teams[2].Name)
+	// Пустой email в плане не затирает сохранённый.
+	assert.Equal(t, []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+		{ID: "u3", Username: "carol", IsActive: true},
+	}, teams[2].Members)
+
+	// Ошибка откатывает весь план.
+	err = repo.ApplyPlan(ctx, domain.TeamPlan{Changes: []domain.TeamChange{
+		{Action: domain.TeamChangeCreateTeam, TeamName: "web"},
+		{Action: domain.TeamChangeAddUser, TeamName: "web",
+			Member: domain.Member{ID: "u4", Username: "alice", IsActive: true}},
+	}})
+	require.ErrorIs(t, err, repoErr.ErrUsernameTaken)
+
+	_, err = repo.GetByName(ctx, "web")
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+}
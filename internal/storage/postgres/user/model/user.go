@@ -1,22 +1,39 @@
 package model
 
-import "avitotech-pr-reviewer/internal/domain"
+import (
+	"database/sql"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
 
 type User struct {
-	UserID   string `db:"user_id"`
-	Username string `db:"username"`
-	IsActive bool   `db:"is_active"`
-	TeamID   string `db:"team_id"`
+	UserID            string         `db:"user_id"`
+	Username          string         `db:"username"`
+	IsActive          bool           `db:"is_active"`
+	TeamID            string         `db:"team_id"`
+	Email             sql.NullString `db:"email"`
+	ReminderFrequency string         `db:"reminder_frequency"`
+	ChatUserID        sql.NullString `db:"chat_user_id"`
+	AwayUntil         sql.NullTime   `db:"away_until"`
 }
 
-
 func (u User) ToUserDomain(teamName string) *domain.User {
+	var awayUntil *time.Time
+	if u.AwayUntil.Valid {
+		awayUntil = &u.AwayUntil.Time
+	}
+
 	return &domain.User{
-		ID:       u.UserID,
-		Username: u.Username,
-		IsActive: u.IsActive,
-		TeamID:   u.TeamID,
-		TeamName: teamName,
+		ID:                u.UserID,
+		Username:          u.Username,
+		IsActive:          u.IsActive,
+		TeamID:            u.TeamID,
+		TeamName:          teamName,
+		Email:             u.Email.String,
+		ReminderFrequency: domain.ReminderFrequency(u.ReminderFrequency),
+		ChatUserID:        u.ChatUserID.String,
+		AwayUntil:         awayUntil,
 	}
 }
 
@@ -28,6 +45,12 @@ func (u User) ToMemberDomain() *domain.Member {
 	}
 }
 
+// UserWithTeam — пользователь вместе с именем его команды.
+type UserWithTeam struct {
+	User
+	TeamName string `db:"team_name"`
+}
+
 type Member struct {
 	UserID   string `db:"user_id"`
 	Username string `db:"username"`
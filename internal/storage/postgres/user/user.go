@@ -3,10 +3,14 @@ package user
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
 
 	"avitotech-pr-reviewer/internal/domain"
 	repoErr "avitotech-pr-reviewer/internal/storage/errors"
 	"avitotech-pr-reviewer/internal/storage/postgres/user/model"
+	"avitotech-pr-reviewer/internal/tenant"
 	pgPkg "avitotech-pr-reviewer/pkg/postgres"
 )
 
@@ -25,13 +29,18 @@ func New(db pgPkg.DB) *Repository {
 func (r *Repository) ListByTeamID(ctx context.Context, teamID string) ([]domain.Member, error) {
 	const op = "repository.team.ListByTeamID"
 
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	const listQuery = `
-		SELECT user_id, username, is_active, team_id
+		SELECT user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until
 		FROM users
-		WHERE team_id = $1
+		WHERE org_id = $1 AND team_id = $2
 	`
 
-	rows, err := r.db.Query(ctx, listQuery, teamID)
+	rows, err := r.db.Query(ctx, listQuery, orgID, teamID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -61,7 +70,12 @@ func (r *Repository) ListByTeamID(ctx context.Context, teamID string) ([]domain.
 func (r *Repository) SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
 	const op = "repository.user.SetIsActive"
 
-	teamName, err := r.getUsersTeamName(ctx, r.db, userID)
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	teamName, err := r.getUsersTeamName(ctx, r.db, orgID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
 	}
@@ -69,13 +83,12 @@ func (r *Repository) SetIsActive(ctx context.Context, userID string, isActive bo
 	const updateQuery = `
 		UPDATE users
 		SET is_active = $1
-		WHERE user_id = $2
-		RETURNING user_id, username, is_active, team_id
+		WHERE org_id = $2 AND user_id = $3
+		RETURNING user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until
 	`
 
-	row := r.db.QueryRow(ctx, updateQuery, isActive, userID)
-	var userDB model.User
-	err = row.Scan(&userDB.UserID, &userDB.Username, &userDB.IsActive, &userDB.TeamID)
+	row := r.db.QueryRow(ctx, updateQuery, isActive, orgID, userID)
+	userDB, err := scanUser(row)
 	if pgPkg.IsNoRowsError(err) {
 		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
 	}
@@ -92,23 +105,212 @@ func (r *Repository) SetIsActive(ctx context.Context, userID string, isActive bo
 func (r *Repository) GetByID(ctx context.Context, userID string) (*domain.User, error) {
 	const op = "repository.user.GetByID"
 
-	teamName, err := r.getUsersTeamName(ctx, r.db, userID)
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const getQuery = `
+		SELECT user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until
+		FROM users
+		WHERE org_id = $1 AND user_id = $2
+	`
+
+	row := r.db.QueryRow(ctx, getQuery, orgID, userID)
+	userDB, err := scanUser(row)
+	if pgPkg.IsNoRowsError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: scan row: %w", op, err)
+	}
+
+	teamName, err := r.getUsersTeamName(ctx, r.db, orgID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
 	}
 
+	return userDB.ToUserDomain(teamName), nil
+}
+
+// GetByIDs возвращает пользователей с указанными идентификаторами вместе с именами их команд
+// одним запросом. Ненайденные идентификаторы пропускаются, порядок результата не определён.
+func (r *Repository) GetByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	const op = "repository.user.GetByIDs"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	const getQuery = `
-		SELECT user_id, username, is_active, team_id
+		SELECT u.user_id, u.username, u.is_active, u.team_id, u.email, u.reminder_frequency,
+			   u.chat_user_id, u.away_until, COALESCE(t.team_name, '') AS team_name
+		FROM users u
+		LEFT JOIN teams t ON t.org_id = u.org_id AND t.team_id = u.team_id
+		WHERE u.org_id = $1 AND u.user_id = ANY($2)
+	`
+
+	rows, err := r.db.Query(ctx, getQuery, orgID, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0, len(userIDs))
+	for rows.Next() {
+		u, err := pgPkg.RowToStructByName[model.UserWithTeam](rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		users = append(users, *u.ToUserDomain(u.TeamName))
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, nil
+}
+
+// SetReminderFrequency обновляет частоту напоминаний пользователя.
+// Возвращает обновленного пользователя с именем команды.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Может вернуть repoErr.ErrTeamNotFound, если команда пользователя не найдена.
+func (r *Repository) SetReminderFrequency(
+	ctx context.Context,
+	userID string,
+	frequency domain.ReminderFrequency,
+) (*domain.User, error) {
+	const op = "repository.user.SetReminderFrequency"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	teamName, err := r.getUsersTeamName(ctx, r.db, orgID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
+	}
+
+	const updateQuery = `
+		UPDATE users
+		SET reminder_frequency = $1
+		WHERE org_id = $2 AND user_id = $3
+		RETURNING user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until
+	`
+
+	row := r.db.QueryRow(ctx, updateQuery, string(frequency), orgID, userID)
+	userDB, err := scanUser(row)
+	if pgPkg.IsNoRowsError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: scan row: %w", op, err)
+	}
+
+	return userDB.ToUserDomain(teamName), nil
+}
+
+// SetEmail обновляет адрес для уведомлений пользователя. Пустой адрес удаляет его.
+// Возвращает обновленного пользователя с именем команды.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Может вернуть repoErr.ErrTeamNotFound, если команда пользователя не найдена.
+func (r *Repository) SetEmail(ctx context.Context, userID, email string) (*domain.User, error) {
+	const op = "repository.user.SetEmail"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	teamName, err := r.getUsersTeamName(ctx, r.db, orgID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
+	}
+
+	const updateQuery = `
+		UPDATE users
+		SET email = NULLIF($1, '')
+		WHERE org_id = $2 AND user_id = $3
+		RETURNING user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until
+	`
+
+	userDB, err := scanUser(r.db.QueryRow(ctx, updateQuery, email, orgID, userID))
+	if pgPkg.IsNoRowsError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: scan row: %w", op, err)
+	}
+
+	return userDB.ToUserDomain(teamName), nil
+}
+
+// GetByChatID возвращает пользователя по его идентификатору в чате.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *Repository) GetByChatID(ctx context.Context, chatUserID string) (*domain.User, error) {
+	const op = "repository.user.GetByChatID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const getQuery = `
+		SELECT user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until
 		FROM users
-		WHERE user_id = $1
+		WHERE org_id = $1 AND chat_user_id = $2
 	`
 
-	row := r.db.QueryRow(ctx, getQuery, userID)
-	var userDB model.User
-	err = row.Scan(&userDB.UserID, &userDB.Username, &userDB.IsActive, &userDB.TeamID)
+	userDB, err := scanUser(r.db.QueryRow(ctx, getQuery, orgID, chatUserID))
+	if pgPkg.IsNoRowsError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: scan row: %w", op, err)
+	}
+
+	teamName, err := r.getUsersTeamName(ctx, r.db, orgID, userDB.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
+	}
+
+	return userDB.ToUserDomain(teamName), nil
+}
+
+// SetChatUserID привязывает пользователя к идентификатору в чате. Пустой идентификатор удаляет привязку.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Если идентификатор уже привязан к другому пользователю, возвращается ошибка repoErr.ErrChatUserLinked.
+func (r *Repository) SetChatUserID(ctx context.Context, userID, chatUserID string) (*domain.User, error) {
+	const op = "repository.user.SetChatUserID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	teamName, err := r.getUsersTeamName(ctx, r.db, orgID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
+	}
+
+	const updateQuery = `
+		UPDATE users
+		SET chat_user_id = NULLIF($1, '')
+		WHERE org_id = $2 AND user_id = $3
+		RETURNING user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until
+	`
+
+	userDB, err := scanUser(r.db.QueryRow(ctx, updateQuery, chatUserID, orgID, userID))
 	if pgPkg.IsNoRowsError(err) {
 		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
 	}
+	if pgPkg.IsUniqueViolationError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrChatUserLinked)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%s: scan row: %w", op, err)
 	}
@@ -116,17 +318,74 @@ func (r *Repository) GetByID(ctx context.Context, userID string) (*domain.User,
 	return userDB.ToUserDomain(teamName), nil
 }
 
-func (r *Repository) getUsersTeamName(ctx context.Context, q pgPkg.Querier, userID string) (string, error) {
+// SetAwayUntil отмечает пользователя отсутствующим до указанного времени. Nil снимает отметку.
+// Отсутствующие пользователи не назначаются ревьюверами и не получают напоминаний.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *Repository) SetAwayUntil(ctx context.Context, userID string, until *time.Time) (*domain.User, error) {
+	const op = "repository.user.SetAwayUntil"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	teamName, err := r.getUsersTeamName(ctx, r.db, orgID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
+	}
+
+	const updateQuery = `
+		UPDATE users
+		SET away_until = $1
+		WHERE org_id = $2 AND user_id = $3
+		RETURNING user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until
+	`
+
+	// Столбец хранит время без часового пояса, поэтому, как и остальные метки времени, оно хранится в UTC.
+	var untilUTC *time.Time
+	if until != nil {
+		u := until.UTC()
+		untilUTC = &u
+	}
+
+	userDB, err := scanUser(r.db.QueryRow(ctx, updateQuery, untilUTC, orgID, userID))
+	if pgPkg.IsNoRowsError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: scan row: %w", op, err)
+	}
+
+	return userDB.ToUserDomain(teamName), nil
+}
+
+func scanUser(row pgx.Row) (model.User, error) {
+	var userDB model.User
+	err := row.Scan(
+		&userDB.UserID,
+		&userDB.Username,
+		&userDB.IsActive,
+		&userDB.TeamID,
+		&userDB.Email,
+		&userDB.ReminderFrequency,
+		&userDB.ChatUserID,
+		&userDB.AwayUntil,
+	)
+
+	return userDB, err
+}
+
+func (r *Repository) getUsersTeamName(ctx context.Context, q pgPkg.Querier, orgID, userID string) (string, error) {
 	const op = "repository.user.getUsersTeamName"
 
 	const query = `
-		SELECT t.name
+		SELECT t.team_name
 		FROM users u
-		JOIN teams t ON u.team_id = t.team_id
-		WHERE u.user_id = $1
+		JOIN teams t ON t.org_id = u.org_id AND t.team_id = u.team_id
+		WHERE u.org_id = $1 AND u.user_id = $2
 	`
 
-	row := q.QueryRow(ctx, query, userID)
+	row := q.QueryRow(ctx, query, orgID, userID)
 	var teamName string
 	err := row.Scan(&teamName)
 	if pgPkg.IsNoRowsError(err) {
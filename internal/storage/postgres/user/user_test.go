@@ -0,0 +1,130 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	teamRepository "avitotech-pr-reviewer/internal/storage/postgres/team"
+)
+
+func TestRepository_TenantIsolation(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	orgA := pgtest.Organization(t, pool)
+	orgB := pgtest.Organization(t, pool)
+
+	teamA, err := teamRepo.CreateWithMembers(orgA, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	_, err = repo.GetByID(orgB, "u1")
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	membersB, err := repo.ListByTeamID(orgB, teamA.ID)
+	require.NoError(t, err)
+	assert.Empty(t, membersB)
+
+	_, err = repo.SetIsActive(orgB, "u1", false)
+	require.Error(t, err)
+
+	_, err = repo.SetReminderFrequency(orgB, "u1", domain.ReminderOff)
+	require.Error(t, err)
+
+	userA, err := repo.GetByID(orgA, "u1")
+	require.NoError(t, err)
+	assert.True(t, userA.IsActive)
+	assert.Equal(t, "backend", userA.TeamName)
+	assert.Equal(t, domain.ReminderDaily, userA.ReminderFrequency)
+}
+
+func TestRepository_ChatLinkAndAway(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	ctx := pgtest.Organization(t, pool)
+
+	team, err := teamRepo.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	_, err = repo.GetByChatID(ctx, "UALICE")
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	linked, err := repo.SetChatUserID(ctx, "u1", "UALICE")
+	require.NoError(t, err)
+	assert.Equal(t, "UALICE", linked.ChatUserID)
+
+	_, err = repo.SetChatUserID(ctx, "u2", "UALICE")
+	require.ErrorIs(t, err, repoErr.ErrChatUserLinked)
+
+	byChat, err := repo.GetByChatID(ctx, "UALICE")
+	require.NoError(t, err)
+	assert.Equal(t, "u1", byChat.ID)
+	assert.Equal(t, "backend", byChat.TeamName)
+
+	until := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	away, err := repo.SetAwayUntil(ctx, "u1", &until)
+	require.NoError(t, err)
+	require.NotNil(t, away.AwayUntil)
+	assert.True(t, away.IsAway(time.Now()))
+
+	active, err := teamRepo.GetActiveMembersByTeamID(ctx, team.ID)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, "u2", active[0].ID)
+
+	back, err := repo.SetAwayUntil(ctx, "u1", nil)
+	require.NoError(t, err)
+	assert.Nil(t, back.AwayUntil)
+
+	active, err = teamRepo.GetActiveMembersByTeamID(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Len(t, active, 2)
+}
+
+func TestRepository_GetByIDs(t *testing.T) {
+	pool := pgtest.Pool(t)
+	repo := New(pool)
+	teamRepo := teamRepository.New(pool)
+
+	ctx := pgtest.Organization(t, pool)
+	otherOrg := pgtest.Organization(t, pool)
+
+	_, err := teamRepo.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+		{ID: "u2", Username: "bob", IsActive: false},
+	})
+	require.NoError(t, err)
+	_, err = teamRepo.CreateWithMembers(ctx, "frontend", []domain.Member{
+		{ID: "u3", Username: "carol", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	users, err := repo.GetByIDs(ctx, []string{"u1", "u3", "missing"})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+
+	byID := make(map[string]domain.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+	assert.Equal(t, "backend", byID["u1"].TeamName)
+	assert.Equal(t, "alice@example.com", byID["u1"].Email)
+	assert.Equal(t, "frontend", byID["u3"].TeamName)
+
+	other, err := repo.GetByIDs(otherOrg, []string{"u1", "u2", "u3"})
+	require.NoError(t, err)
+	assert.Empty(t, other)
+}
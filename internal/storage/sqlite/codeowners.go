@@ -0,0 +1,199 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+type CodeOwnersRepository struct {
+	db sqlitePkg.DB
+}
+
+func NewCodeOwnersRepository(db sqlitePkg.DB) *CodeOwnersRepository {
+	return &CodeOwnersRepository{
+		db: db,
+	}
+}
+
+// Replace заменяет все правила CODEOWNERS репозитория с указанным идентификатором переданными.
+// Порядок правил сохраняется.
+// Если репозиторий не принадлежит организации субъекта запроса, возвращается ошибка repoErr.ErrRepositoryNotFound.
+// Если владелец-пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Если владелец-команда не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *CodeOwnersRepository) Replace(ctx context.Context, repositoryID string, rules []domain.CodeOwnersRule) error {
+	const op = "sqlite.codeowners.Replace"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const existsQuery = `
+		SELECT 1 FROM repositories
+		WHERE org_id = $1 AND repository_id = $2
+	`
+	var exists int
+	err = tx.QueryRowContext(ctx, existsQuery, orgID, repositoryID).Scan(&exists)
+	if sqlitePkg.IsNoRowsError(err) {
+		return repoErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM codeowners_rules WHERE repository_id = $1`, repositoryID)
+	if err != nil {
+		return fmt.Errorf("%s: delete rules: %w", op, err)
+	}
+
+	const insertRuleQuery = `
+		INSERT INTO codeowners_rules (repository_id, position, pattern)
+		VALUES ($1, $2, $3)
+	`
+	// SQLite не сообщает имя нарушенного внешнего ключа, поэтому владельцы вставляются
+	// выборкой из users и teams: ненайденный владелец не вставляет ни одной строки.
+	const insertUserQuery = `
+		INSERT INTO codeowners_owners (org_id, repository_id, position, user_id)
+		SELECT org_id, $2, $3, user_id FROM users
+		WHERE org_id = $1 AND user_id = $4
+	`
+	const insertTeamQuery = `
+		INSERT INTO codeowners_owners (org_id, repository_id, position, team_id)
+		SELECT $1, $2, $3, team_id FROM teams
+		WHERE team_id = $4
+	`
+
+	for position, rule := range rules {
+		_, err = tx.ExecContext(ctx, insertRuleQuery, repositoryID, position, rule.Pattern)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, userID := range rule.UserIDs {
+			err = insertOwner(ctx, tx, insertUserQuery, repoErr.ErrUserNotFound, orgID, repositoryID, position, userID)
+			if errors.Is(err, repoErr.ErrUserNotFound) {
+				return err
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		for _, team := range rule.Teams {
+			err = insertOwner(ctx, tx, insertTeamQuery, repoErr.ErrTeamNotFound, orgID, repositoryID, position, team.ID)
+			if errors.Is(err, repoErr.ErrTeamNotFound) {
+				return err
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// insertOwner вставляет владельца правила запросом query и возвращает notFound,
+// если владелец не найден.
+func insertOwner(ctx context.Context, tx sqlitePkg.Tx, query string, notFound error, args ...any) error {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+
+	return nil
+}
+
+// ListByRepository возвращает правила CODEOWNERS репозитория в исходном порядке.
+// Если для репозитория правила не загружены, возвращается пустой слайс.
+func (r *CodeOwnersRepository) ListByRepository(
+	ctx context.Context,
+	repositoryID string,
+) ([]domain.CodeOwnersRule, error) {
+	const op = "sqlite.codeowners.ListByRepository"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const listQuery = `
+		SELECT r.position, r.pattern, o.user_id, t.team_id, t.team_name
+		FROM codeowners_rules r
+		JOIN repositories repo ON repo.repository_id = r.repository_id
+		LEFT JOIN codeowners_owners o ON o.repository_id = r.repository_id AND o.position = r.position
+		LEFT JOIN teams t ON t.team_id = o.team_id
+		WHERE repo.org_id = $1 AND r.repository_id = $2
+		ORDER BY r.position, o.rowid
+	`
+	rows, err := r.db.QueryContext(ctx, listQuery, orgID, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var (
+		rules        []domain.CodeOwnersRule
+		lastPosition = -1
+	)
+	for rows.Next() {
+		var (
+			position                 int
+			pattern                  string
+			userID, teamID, teamName sql.NullString
+		)
+		err := rows.Scan(&position, &pattern, &userID, &teamID, &teamName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+
+		if position != lastPosition {
+			rules = append(rules, domain.CodeOwnersRule{Pattern: pattern, UserIDs: []string{}, Teams: []domain.Team{}})
+			lastPosition = position
+		}
+
+		last := &rules[len(rules)-1]
+		if userID.Valid {
+			last.UserIDs = append(last.UserIDs, userID.String)
+		}
+		if teamID.Valid {
+			last.Teams = append(last.Teams, domain.Team{ID: teamID.String, Name: teamName.String})
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rules, nil
+}
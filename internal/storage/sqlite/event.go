@@ -0,0 +1,167 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/tenant"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+const eventColumns = `event_id, org_id, event_type, occurred_at, repository_name, pull_request_id, user_ids, payload`
+
+// EventRepository хранит журнал событий организации.
+type EventRepository struct {
+	db sqlitePkg.DB
+}
+
+func NewEventRepository(db sqlitePkg.DB) *EventRepository {
+	return &EventRepository{
+		db: db,
+	}
+}
+
+// Append сохраняет событие в журнале организации субъекта запроса и возвращает его с присвоенным ID.
+// Время события хранится в UTC.
+func (r *EventRepository) Append(ctx context.Context, record domain.EventRecord) (*domain.EventRecord, error) {
+	const op = "sqlite.event.Append"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	insertQuery := `
+		INSERT INTO events (org_id, event_type, occurred_at, repository_name, pull_request_id, user_ids, payload)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + eventColumns
+
+	stored, err := scanEvent(r.db.QueryRowContext(ctx, insertQuery,
+		orgID,
+		string(record.Type),
+		sqlitePkg.FormatTime(record.OccurredAt),
+		record.Repository,
+		record.PullRequestID,
+		jsonList(record.UserIDs),
+		string(record.Payload),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stored, nil
+}
+
+// ListAfter возвращает не более limit событий организации субъекта запроса с ID больше afterID
+// в порядке записи.
+func (r *EventRepository) ListAfter(ctx context.Context, afterID int64, limit int) ([]domain.EventRecord, error) {
+	const op = "sqlite.event.ListAfter"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	listQuery := `
+		SELECT ` + eventColumns + `
+		FROM events
+		WHERE org_id = $1 AND event_id > $2
+		ORDER BY event_id
+		LIMIT $3
+	`
+	rows, err := r.db.QueryContext(ctx, listQuery, orgID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	records := make([]domain.EventRecord, 0)
+	for rows.Next() {
+		record, err := scanEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		records = append(records, *record)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return records, nil
+}
+
+// LastID возвращает ID последнего события организации субъекта запроса или 0, если журнал пуст.
+func (r *EventRepository) LastID(ctx context.Context) (int64, error) {
+	const op = "sqlite.event.LastID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const lastIDQuery = `SELECT COALESCE(MAX(event_id), 0) FROM events WHERE org_id = $1`
+
+	var lastID int64
+	err = r.db.QueryRowContext(ctx, lastIDQuery, orgID).Scan(&lastID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return lastID, nil
+}
+
+// DeleteBefore удаляет события организации субъекта запроса, произошедшие раньше before.
+// Возвращает количество удалённых событий.
+func (r *EventRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	const op = "sqlite.event.DeleteBefore"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const deleteQuery = `
+		DELETE FROM events
+		WHERE org_id = $1 AND occurred_at < $2
+	`
+	res, err := r.db.ExecContext(ctx, deleteQuery, orgID, sqlitePkg.FormatTime(before))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return deleted, nil
+}
+
+func scanEvent(row scanner) (*domain.EventRecord, error) {
+	var (
+		record     domain.EventRecord
+		eventType  string
+		occurredAt sqlitePkg.NullTime
+		userIDs    stringList
+		payload    []byte
+	)
+	err := row.Scan(&record.ID, &record.OrgID, &eventType, &occurredAt,
+		&record.Repository, &record.PullRequestID, &userIDs, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Type = domain.EventType(eventType)
+	record.OccurredAt = occurredAt.Time
+	record.UserIDs = userIDs
+	if record.UserIDs == nil {
+		record.UserIDs = []string{}
+	}
+	record.Payload = payload
+
+	return &record, nil
+}
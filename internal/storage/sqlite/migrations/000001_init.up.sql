@@ -0,0 +1,167 @@
+-- Схема SQLite повторяет итоговую схему миграций PostgreSQL из каталога migrations.
+-- UUID генерируются выражением по умолчанию, как gen_random_uuid(); метки времени
+-- хранятся текстом в UTC и задаются приложением.
+
+CREATE TABLE organizations (
+    org_id TEXT PRIMARY KEY DEFAULT (
+        lower(hex(randomblob(4))) || '-' || lower(hex(randomblob(2))) || '-4' ||
+        substr(lower(hex(randomblob(2))), 2) || '-' || substr('89ab', 1 + (abs(random()) % 4), 1) ||
+        substr(lower(hex(randomblob(2))), 2) || '-' || lower(hex(randomblob(6)))
+    ),
+    org_name TEXT NOT NULL UNIQUE
+);
+
+-- Организация по умолчанию, как в PostgreSQL.
+INSERT INTO organizations (org_id, org_name)
+VALUES ('00000000-0000-0000-0000-000000000001', 'default');
+
+-- Токены хранятся только в виде SHA-256 хеша.
+CREATE TABLE organization_tokens (
+    token_hash TEXT PRIMARY KEY,
+    org_id TEXT NOT NULL REFERENCES organizations(org_id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('admin', 'member')),
+    created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE teams (
+    team_id TEXT PRIMARY KEY DEFAULT (
+        lower(hex(randomblob(4))) || '-' || lower(hex(randomblob(2))) || '-4' ||
+        substr(lower(hex(randomblob(2))), 2) || '-' || substr('89ab', 1 + (abs(random()) % 4), 1) ||
+        substr(lower(hex(randomblob(2))), 2) || '-' || lower(hex(randomblob(6)))
+    ),
+    org_id TEXT NOT NULL REFERENCES organizations(org_id) ON DELETE CASCADE,
+    team_name TEXT NOT NULL,
+    review_sla_hours INTEGER CHECK (review_sla_hours > 0),
+    sla_action TEXT NOT NULL DEFAULT 'none' CHECK (sla_action IN ('none', 'reassign', 'escalate')),
+    lead_id TEXT,
+    CONSTRAINT uq_teams_org_name UNIQUE (org_id, team_name),
+    CONSTRAINT uq_teams_org_id UNIQUE (org_id, team_id),
+    CONSTRAINT fk_teams_lead FOREIGN KEY (org_id, lead_id) REFERENCES users(org_id, user_id)
+);
+
+CREATE TABLE users (
+    org_id TEXT NOT NULL REFERENCES organizations(org_id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    username TEXT NOT NULL,
+    is_active INTEGER NOT NULL DEFAULT 1,
+    team_id TEXT,
+    reminder_frequency TEXT NOT NULL DEFAULT 'daily' CHECK (reminder_frequency IN ('off', 'daily', 'weekly')),
+    email TEXT,
+    chat_user_id TEXT,
+    away_until TEXT,
+    CONSTRAINT pk_users PRIMARY KEY (org_id, user_id),
+    CONSTRAINT uq_users_org_username UNIQUE (org_id, username),
+    CONSTRAINT fk_users_team FOREIGN KEY (org_id, team_id) REFERENCES teams(org_id, team_id)
+);
+
+CREATE UNIQUE INDEX idx_users_chat_user_id ON users(org_id, chat_user_id)
+    WHERE chat_user_id IS NOT NULL;
+
+CREATE TABLE repositories (
+    repository_id TEXT PRIMARY KEY DEFAULT (
+        lower(hex(randomblob(4))) || '-' || lower(hex(randomblob(2))) || '-4' ||
+        substr(lower(hex(randomblob(2))), 2) || '-' || substr('89ab', 1 + (abs(random()) % 4), 1) ||
+        substr(lower(hex(randomblob(2))), 2) || '-' || lower(hex(randomblob(6)))
+    ),
+    org_id TEXT NOT NULL REFERENCES organizations(org_id) ON DELETE CASCADE,
+    repository_name TEXT NOT NULL,
+    team_id TEXT REFERENCES teams(team_id) ON DELETE SET NULL,
+    max_reviewers INTEGER CHECK (max_reviewers >= 0),
+    CONSTRAINT uq_repositories_org_name UNIQUE (org_id, repository_name),
+    CONSTRAINT uq_repositories_org_id UNIQUE (org_id, repository_id)
+);
+
+-- Репозиторий по умолчанию: в него попадают PR, созданные без указания репозитория.
+INSERT INTO repositories (org_id, repository_name)
+VALUES ('00000000-0000-0000-0000-000000000001', 'default');
+
+CREATE TABLE pull_requests (
+    org_id TEXT NOT NULL,
+    repository_id TEXT NOT NULL,
+    pull_request_id TEXT NOT NULL,
+    pull_request_name TEXT NOT NULL,
+    author_id TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'MERGED')),
+    is_need_more_reviewers INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT NOT NULL,
+    merged_at TEXT,
+    CONSTRAINT pk_pull_requests PRIMARY KEY (repository_id, pull_request_id),
+    CONSTRAINT fk_pull_requests_org_repository FOREIGN KEY (org_id, repository_id)
+        REFERENCES repositories(org_id, repository_id) ON DELETE CASCADE,
+    CONSTRAINT fk_pull_requests_author FOREIGN KEY (org_id, author_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_pr_author ON pull_requests(org_id, author_id);
+
+CREATE TABLE pull_request_reviewers (
+    org_id TEXT NOT NULL,
+    repository_id TEXT NOT NULL,
+    pull_request_id TEXT NOT NULL,
+    reviewer_id TEXT NOT NULL,
+    assigned_at TEXT NOT NULL,
+    overdue_at TEXT,
+    CONSTRAINT pk_pull_request_reviews PRIMARY KEY (repository_id, pull_request_id, reviewer_id),
+    CONSTRAINT fk_pull_request_reviewers_pr FOREIGN KEY (repository_id, pull_request_id)
+        REFERENCES pull_requests(repository_id, pull_request_id) ON DELETE CASCADE,
+    CONSTRAINT fk_pull_request_reviewers_reviewer FOREIGN KEY (org_id, reviewer_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_pr_reviewers_reviewer ON pull_request_reviewers(org_id, reviewer_id);
+CREATE INDEX idx_pr_reviewers_overdue ON pull_request_reviewers(org_id, overdue_at)
+    WHERE overdue_at IS NOT NULL;
+
+CREATE TABLE pull_request_reviews (
+    review_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    org_id TEXT NOT NULL,
+    repository_id TEXT NOT NULL,
+    pull_request_id TEXT NOT NULL,
+    reviewer_id TEXT NOT NULL,
+    state TEXT NOT NULL CHECK (state IN ('COMMENTED', 'CHANGES_REQUESTED', 'APPROVED')),
+    submitted_at TEXT NOT NULL,
+    CONSTRAINT fk_pull_request_reviews_pr FOREIGN KEY (repository_id, pull_request_id)
+        REFERENCES pull_requests(repository_id, pull_request_id) ON DELETE CASCADE,
+    CONSTRAINT fk_pull_request_reviews_reviewer FOREIGN KEY (org_id, reviewer_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_pr_reviews_pr ON pull_request_reviews(repository_id, pull_request_id, submitted_at);
+
+CREATE TABLE codeowners_rules (
+    repository_id TEXT NOT NULL REFERENCES repositories(repository_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    pattern TEXT NOT NULL,
+    CONSTRAINT pk_codeowners_rules PRIMARY KEY (repository_id, position)
+);
+
+CREATE TABLE codeowners_owners (
+    org_id TEXT NOT NULL,
+    repository_id TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    user_id TEXT,
+    team_id TEXT,
+    CONSTRAINT fk_codeowners_owners_rule FOREIGN KEY (repository_id, position)
+        REFERENCES codeowners_rules(repository_id, position) ON DELETE CASCADE,
+    CONSTRAINT fk_codeowners_owners_user FOREIGN KEY (org_id, user_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE,
+    CONSTRAINT fk_codeowners_owners_team FOREIGN KEY (team_id)
+        REFERENCES teams(team_id) ON DELETE CASCADE,
+    CONSTRAINT chk_codeowners_owner CHECK ((user_id IS NULL) <> (team_id IS NULL))
+);
+
+CREATE INDEX idx_codeowners_owners_rule ON codeowners_owners(repository_id, position);
+
+CREATE TABLE events (
+    event_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    org_id TEXT NOT NULL REFERENCES organizations(org_id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL,
+    occurred_at TEXT NOT NULL,
+    repository_name TEXT NOT NULL DEFAULT '',
+    pull_request_id TEXT NOT NULL DEFAULT '',
+    user_ids TEXT NOT NULL DEFAULT '[]',
+    payload TEXT NOT NULL
+);
+
+CREATE INDEX idx_events_org ON events(org_id, event_id);
+CREATE INDEX idx_events_occurred_at ON events(org_id, occurred_at);
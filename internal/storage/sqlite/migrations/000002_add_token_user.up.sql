@@ -0,0 +1,3 @@
+-- Токен участника может быть выдан конкретному пользователю. SQLite не добавляет
+-- составной внешний ключ к существующей таблице, поэтому пользователь проверяется при выдаче токена.
+ALTER TABLE organization_tokens ADD COLUMN user_id TEXT;
@@ -0,0 +1,180 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+// OrgRepository хранит организации и их токены.
+// В отличие от остальных хранилищ, запросы не ограничиваются организацией субъекта:
+// именно здесь субъект запроса и определяется.
+type OrgRepository struct {
+	db sqlitePkg.DB
+}
+
+func NewOrgRepository(db sqlitePkg.DB) *OrgRepository {
+	return &OrgRepository{
+		db: db,
+	}
+}
+
+// Create создаёт организацию вместе с её репозиторием по умолчанию и токеном администратора.
+// Если организация с таким именем уже существует, возвращается ошибка repoErr.ErrOrganizationExists.
+func (r *OrgRepository) Create(ctx context.Context, name, adminTokenHash string) (*domain.Organization, error) {
+	const op = "sqlite.organization.Create"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var org domain.Organization
+	const createQuery = `
+		INSERT INTO organizations (org_name)
+		VALUES ($1) RETURNING org_id, org_name
+	`
+	err = tx.QueryRowContext(ctx, createQuery, name).Scan(&org.ID, &org.Name)
+	if sqlitePkg.IsUniqueViolationError(err) {
+		return nil, repoErr.ErrOrganizationExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: create organization: %w", op, err)
+	}
+
+	const createRepositoryQuery = `
+		INSERT INTO repositories (org_id, repository_name)
+		VALUES ($1, $2)
+	`
+	_, err = tx.ExecContext(ctx, createRepositoryQuery, org.ID, domain.DefaultRepositoryName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: create default repository: %w", op, err)
+	}
+
+	err = addToken(ctx, tx, org.ID, adminTokenHash, domain.RoleAdmin, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &org, nil
+}
+
+// AddToken сохраняет хеш нового токена организации с указанной ролью.
+// Если userID не пуст, токен выдаётся этому пользователю организации.
+// Если организация не найдена, возвращается ошибка repoErr.ErrOrganizationNotFound,
+// если не найден пользователь — repoErr.ErrUserNotFound.
+func (r *OrgRepository) AddToken(ctx context.Context, orgID, tokenHash string, role domain.Role, userID string) error {
+	const op = "sqlite.organization.AddToken"
+
+	err := addToken(ctx, r.db, orgID, tokenHash, role, userID)
+	if sqlitePkg.IsForeignKeyErr(err) {
+		return repoErr.ErrOrganizationNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetPrincipalByTokenHash возвращает субъекта, которому выдан токен с указанным хешем.
+// Если токен не найден, возвращается ошибка repoErr.ErrTokenNotFound.
+func (r *OrgRepository) GetPrincipalByTokenHash(ctx context.Context, tokenHash string) (*domain.Principal, error) {
+	const op = "sqlite.organization.GetPrincipalByTokenHash"
+
+	const getQuery = `
+		SELECT org_id, role, COALESCE(user_id, '')
+		FROM organization_tokens
+		WHERE token_hash = $1
+	`
+	var (
+		principal domain.Principal
+		role      string
+	)
+	err := r.db.QueryRowContext(ctx, getQuery, tokenHash).Scan(&principal.OrgID, &role, &principal.UserID)
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	principal.Role = domain.Role(role)
+
+	return &principal, nil
+}
+
+// ListIDs возвращает идентификаторы всех организаций.
+// Используется фоновыми задачами, которые обходят организации по очереди.
+func (r *OrgRepository) ListIDs(ctx context.Context) ([]string, error) {
+	const op = "sqlite.organization.ListIDs"
+
+	const query = `SELECT org_id FROM organizations ORDER BY org_id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
+// addToken сохраняет токен. Внешнего ключа на пользователя в SQLite нет,
+// поэтому токен не сохраняется, если пользователь userID не найден в организации.
+func addToken(
+	ctx context.Context,
+	q sqlitePkg.Querier,
+	orgID, tokenHash string,
+	role domain.Role,
+	userID string,
+) error {
+	const query = `
+		INSERT INTO organization_tokens (token_hash, org_id, role, user_id)
+		SELECT $1, $2, $3, NULLIF($4, '')
+		WHERE $4 = '' OR EXISTS (SELECT 1 FROM users WHERE org_id = $2 AND user_id = $4)
+	`
+	res, err := q.ExecContext(ctx, query, tokenHash, orgID, string(role), userID)
+	if err != nil {
+		return err
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if inserted == 0 {
+		return repoErr.ErrUserNotFound
+	}
+
+	return nil
+}
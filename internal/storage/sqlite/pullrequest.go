@@ -0,0 +1,850 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+// pullRequestColumns — список колонок Pull Request'а в порядке scanPullRequest вместе с именем
+// репозитория и ревьюверами, собранными json_group_array. Запрос должен содержать псевдоним pr
+// для pull_requests и соединение с repositories r.
+const pullRequestColumns = `
+	pr.repository_id, r.repository_name, pr.pull_request_id, pr.pull_request_name,
+	pr.author_id, pr.created_at, pr.status, pr.merged_at, pr.is_need_more_reviewers,
+	(
+		SELECT json_group_array(prr.reviewer_id ORDER BY prr.reviewer_id)
+		FROM pull_request_reviewers prr
+		WHERE prr.org_id = pr.org_id
+		  AND prr.repository_id = pr.repository_id
+		  AND prr.pull_request_id = pr.pull_request_id
+	) AS reviewer_ids
+`
+
+// pendingAssignments — назначения на открытые Pull Request'ы, по которым ревьювер
+// ещё не оставил ревью после назначения, вместе с командой ревьювера и её SLA.
+const pendingAssignments = `
+	SELECT prr.repository_id, r.repository_name, prr.pull_request_id, pr.pull_request_name,
+		   pr.author_id, prr.reviewer_id, t.team_name, prr.assigned_at, prr.overdue_at,
+		   t.review_sla_hours, t.sla_action, t.lead_id
+	FROM pull_request_reviewers prr
+	JOIN pull_requests pr
+		ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+	JOIN repositories r ON r.repository_id = prr.repository_id
+	JOIN users u ON u.org_id = prr.org_id AND u.user_id = prr.reviewer_id
+	JOIN teams t ON t.org_id = u.org_id AND t.team_id = u.team_id
+	WHERE prr.org_id = @org_id
+		AND pr.status = 'OPEN'
+		AND NOT EXISTS (
+			SELECT 1 FROM pull_request_reviews rv
+			WHERE rv.repository_id = prr.repository_id
+				AND rv.pull_request_id = prr.pull_request_id
+				AND rv.reviewer_id = prr.reviewer_id
+				AND rv.submitted_at >= prr.assigned_at
+		)
+`
+
+type PullRequestRepository struct {
+	db sqlitePkg.DB
+}
+
+func NewPullRequestRepository(db sqlitePkg.DB) *PullRequestRepository {
+	return &PullRequestRepository{
+		db: db,
+	}
+}
+
+// Create создаёт новый Pull Request в репозитории pr.RepositoryID.
+// Если Pull Request с таким ID уже существует в репозитории, возвращается ошибка repoErr.ErrPRExists.
+func (r *PullRequestRepository) Create(ctx context.Context, pr *domain.PullRequest) (*domain.PullRequest, error) {
+	const op = "sqlite.pullrequest.Create"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	now := sqlitePkg.FormatTime(time.Now())
+
+	const insertQuery = `
+		INSERT INTO pull_requests (
+			org_id,
+			repository_id,
+			pull_request_id,
+			pull_request_name,
+			author_id,
+			created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err = tx.ExecContext(ctx, insertQuery, orgID, pr.RepositoryID, pr.ID, pr.Name, pr.AuthorID, now)
+	if sqlitePkg.IsUniqueViolationError(err) {
+		return nil, repoErr.ErrPRExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, reviewerID := range pr.Reviewers {
+		err = addReviewer(ctx, tx, orgID, pr.RepositoryID, pr.ID, reviewerID, now)
+		if sqlitePkg.IsForeignKeyErr(err) {
+			return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	created, err := r.getByID(ctx, tx, orgID, pr.RepositoryID, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	// Ревьюверы возвращаются в переданном порядке, как и в хранилище PostgreSQL.
+	created.Reviewers = pr.Reviewers
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+// GetByID возвращает обогащенный ревьюверами и статусом Pull Request по его ID в репозитории.
+// Если Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
+func (r *PullRequestRepository) GetByID(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error) {
+	const op = "sqlite.pullrequest.GetByID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pullRequest, err := r.getByID(ctx, r.db, orgID, repositoryID, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequest, nil
+}
+
+// GetByIDs возвращает обогащенные ревьюверами и статусом Pull Request'ы репозитория по их ID
+// одним запросом, упорядоченные по времени создания. Ненайденные Pull Request'ы в результат не попадают.
+func (r *PullRequestRepository) GetByIDs(
+	ctx context.Context,
+	repositoryID string,
+	prIDs []string,
+) ([]domain.PullRequest, error) {
+	const op = "sqlite.pullrequest.GetByIDs"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		WHERE pr.org_id = $1 AND pr.repository_id = $2
+		  AND pr.pull_request_id IN (SELECT value FROM json_each($3))
+		ORDER BY pr.created_at, pr.pull_request_id
+	`
+
+	pullRequests, err := r.list(ctx, query, orgID, repositoryID, jsonList(prIDs))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// ListByRepository возвращает обогащенные ревьюверами и статусом Pull Request'ы репозитория
+// одним запросом, упорядоченные по времени создания. Пустой status не ограничивает выборку.
+// Если в репозитории нет Pull Request'ов, возвращается пустой слайс.
+func (r *PullRequestRepository) ListByRepository(
+	ctx context.Context,
+	repositoryID string,
+	status domain.PRStatus,
+) ([]domain.PullRequest, error) {
+	const op = "sqlite.pullrequest.ListByRepository"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		WHERE pr.org_id = $1 AND pr.repository_id = $2 AND ($3 = '' OR pr.status = $3)
+		ORDER BY pr.created_at, pr.pull_request_id
+	`
+
+	pullRequests, err := r.list(ctx, query, orgID, repositoryID, string(status))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// GetReviewerIDs возвращает список ID ревьюеров, назначенных на указанный Pull Request.
+// Метод не возвращает ошибку, если Pull Request не найден или у него нет назначенных ревьюеров.
+func (r *PullRequestRepository) GetReviewerIDs(ctx context.Context, repositoryID, prID string) ([]string, error) {
+	const op = "sqlite.pullrequest.GetReviewerIDs"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT reviewer_id
+		FROM pull_request_reviewers
+		WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orgID, repositoryID, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var reviewers []string
+	for rows.Next() {
+		var reviewerID string
+		err := rows.Scan(&reviewerID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		reviewers = append(reviewers, reviewerID)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reviewers, nil
+}
+
+// ReassignReviewer заменяет старого ревьюера новым, которого выбирает choose.
+// Блокировка строки не нужна: транзакция начинается с BEGIN IMMEDIATE и держит блокировку
+// записи всей базы до конца, поэтому параллельные переназначения и слияние ждут её завершения,
+// а choose получает Pull Request, прочитанный уже под блокировкой.
+// Ошибка choose возвращается без изменений.
+// Если указанный Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
+// Если указанный старый ревьюер не назначен на этот Pull Request, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *PullRequestRepository) ReassignReviewer(
+	ctx context.Context,
+	repositoryID, prID, oldReviewerID string,
+	choose func(pr *domain.PullRequest) (string, error),
+) (*domain.PullRequest, string, error) {
+	const op = "sqlite.pullrequest.ReassignReviewer"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	current, err := r.getByID(ctx, tx, orgID, repositoryID, prID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	newReviewerID, err := choose(current)
+	if err != nil {
+		return nil, "", err
+	}
+
+	const deleteQuery = `
+		DELETE FROM pull_request_reviewers
+		WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3 AND reviewer_id = $4
+	`
+	res, err := tx.ExecContext(ctx, deleteQuery, orgID, repositoryID, prID, oldReviewerID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	if deleted == 0 {
+		err = repoErr.ErrUserNotFound
+
+		return nil, "", err
+	}
+
+	err = addReviewer(ctx, tx, orgID, repositoryID, prID, newReviewerID, sqlitePkg.FormatTime(time.Now()))
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	updatedPR, err := r.getByID(ctx, tx, orgID, repositoryID, prID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updatedPR, newReviewerID, nil
+}
+
+// SetMerged помечает указанный Pull Request как merged.
+// Возвращается обновлённый Pull Request, обогащенный списком назначенных ревьюеров и статусом.
+// Если Pull Request не найден, возвращается ошибка repoErr.ErrPRNotFound.
+// Операция не является идемпотентной. Нужно вызывать только если Pull Request ещё не был помечен как merged.
+func (r *PullRequestRepository) SetMerged(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error) {
+	const op = "sqlite.pullrequest.SetMerged"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const updateQuery = `
+		UPDATE pull_requests
+		SET status = 'MERGED', merged_at = $4
+		WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3
+	`
+	_, err = r.db.ExecContext(ctx, updateQuery, orgID, repositoryID, prID, sqlitePkg.FormatTime(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updated, err := r.getByID(ctx, r.db, orgID, repositoryID, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+// ListByReviewer возвращает Pull Request'ы всех репозиториев, на которые назначен ревьювер.
+// Список ревьюверов в возвращаемых Pull Request'ах не заполняется.
+// Если ревьювер не найден или ни на что не назначен, возвращается пустой слайс.
+func (r *PullRequestRepository) ListByReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequest, error) {
+	const op = "sqlite.pullrequest.ListByReviewer"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT pr.repository_id, r.repository_name, pr.pull_request_id,
+			   pr.pull_request_name, pr.author_id, pr.status
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr
+			ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		WHERE prr.org_id = $1 AND prr.reviewer_id = $2
+		ORDER BY pr.created_at, r.repository_name, pr.pull_request_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orgID, reviewerID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var pullRequests []domain.PullRequest
+	for rows.Next() {
+		var (
+			pr     domain.PullRequest
+			status string
+		)
+		err := rows.Scan(&pr.RepositoryID, &pr.Repository, &pr.ID, &pr.Name, &pr.AuthorID, &status)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		pr.Status = domain.PRStatus(status)
+		pullRequests = append(pullRequests, pr)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// ListByReviewerIDs возвращает Pull Request'ы всех репозиториев, на которые назначены ревьюверы
+// reviewerIDs, одним запросом, сгруппированные по ревьюверу. В отличие от ListByReviewer,
+// список ревьюверов в Pull Request'ах заполняется. Ревьюверы без назначений в результат не попадают.
+func (r *PullRequestRepository) ListByReviewerIDs(
+	ctx context.Context,
+	reviewerIDs []string,
+) (map[string][]domain.PullRequest, error) {
+	const op = "sqlite.pullrequest.ListByReviewerIDs"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT assigned.reviewer_id, ` + pullRequestColumns + `
+		FROM pull_request_reviewers assigned
+		JOIN pull_requests pr
+			ON pr.repository_id = assigned.repository_id AND pr.pull_request_id = assigned.pull_request_id
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		WHERE assigned.org_id = $1 AND assigned.reviewer_id IN (SELECT value FROM json_each($2))
+		ORDER BY pr.created_at, r.repository_name, pr.pull_request_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orgID, jsonList(reviewerIDs))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	byReviewer := make(map[string][]domain.PullRequest, len(reviewerIDs))
+	for rows.Next() {
+		var assignedReviewerID string
+		pr, err := scanPullRequest(rows, &assignedReviewerID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		// Как и в хранилище PostgreSQL, признак нехватки ревьюверов здесь не читается.
+		pr.InNeedMoreReviewers = false
+		byReviewer[assignedReviewerID] = append(byReviewer[assignedReviewerID], *pr)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return byReviewer, nil
+}
+
+// AddReview сохраняет ревью Pull Request'а с текущим временем.
+// Если ревьювер не назначен на Pull Request, возвращается ошибка repoErr.ErrReviewerNotAssigned.
+func (r *PullRequestRepository) AddReview(ctx context.Context, review *domain.Review) (*domain.Review, error) {
+	const op = "sqlite.pullrequest.AddReview"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		INSERT INTO pull_request_reviews (org_id, repository_id, pull_request_id, reviewer_id, state, submitted_at)
+		SELECT org_id, repository_id, pull_request_id, reviewer_id, @state, @submitted_at
+		FROM pull_request_reviewers
+		WHERE org_id = @org_id
+			AND repository_id = @repository_id
+			AND pull_request_id = @pull_request_id
+			AND reviewer_id = @reviewer_id
+		RETURNING repository_id, pull_request_id, reviewer_id, state, submitted_at
+	`
+
+	var (
+		created     domain.Review
+		state       string
+		submittedAt sqlitePkg.NullTime
+	)
+	err = r.db.QueryRowContext(ctx, query,
+		sql.Named("org_id", orgID),
+		sql.Named("repository_id", review.RepositoryID),
+		sql.Named("pull_request_id", review.PullRequestID),
+		sql.Named("reviewer_id", review.ReviewerID),
+		sql.Named("state", string(review.State)),
+		sql.Named("submitted_at", sqlitePkg.FormatTime(time.Now())),
+	).Scan(&created.RepositoryID, &created.PullRequestID, &created.ReviewerID, &state, &submittedAt)
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrReviewerNotAssigned
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created.State = domain.ReviewState(state)
+	created.SubmittedAt = submittedAt.Time
+
+	return &created, nil
+}
+
+// IsApproved сообщает, одобрен ли Pull Request всеми назначенными ревьюверами:
+// последнее ревью каждого из них после назначения — APPROVED.
+// Pull Request без ревьюверов одобренным не считается.
+func (r *PullRequestRepository) IsApproved(ctx context.Context, repositoryID, prID string) (bool, error) {
+	const op = "sqlite.pullrequest.IsApproved"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT COUNT(*) > 0 AND COALESCE(MIN(COALESCE((
+			SELECT rv.state
+			FROM pull_request_reviews rv
+			WHERE rv.repository_id = prr.repository_id
+				AND rv.pull_request_id = prr.pull_request_id
+				AND rv.reviewer_id = prr.reviewer_id
+				AND rv.submitted_at >= prr.assigned_at
+			ORDER BY rv.submitted_at DESC, rv.review_id DESC
+			LIMIT 1
+		) = 'APPROVED', FALSE)), FALSE)
+		FROM pull_request_reviewers prr
+		WHERE prr.org_id = $1 AND prr.repository_id = $2 AND prr.pull_request_id = $3
+	`
+
+	var approved bool
+	err = r.db.QueryRowContext(ctx, query, orgID, repositoryID, prID).Scan(&approved)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return approved, nil
+}
+
+// ListPendingAssignments возвращает ещё не помеченные просроченными назначения без ревью
+// у ревьюверов из команд с заданным SLA.
+func (r *PullRequestRepository) ListPendingAssignments(ctx context.Context) ([]domain.ReviewAssignment, error) {
+	const op = "sqlite.pullrequest.ListPendingAssignments"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := pendingAssignments + `
+		AND prr.overdue_at IS NULL
+		AND t.review_sla_hours IS NOT NULL
+		ORDER BY prr.assigned_at
+	`
+
+	assignments, err := r.listAssignments(ctx, query, sql.Named("org_id", orgID))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return assignments, nil
+}
+
+// ListOverdue возвращает просроченные назначения, по которым всё ещё нет ревью,
+// начиная с самых старых. Если teamName не пуст, возвращаются только назначения
+// ревьюверов этой команды.
+func (r *PullRequestRepository) ListOverdue(ctx context.Context, teamName string) ([]domain.ReviewAssignment, error) {
+	const op = "sqlite.pullrequest.ListOverdue"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := pendingAssignments + `
+		AND prr.overdue_at IS NOT NULL
+		AND (@team_name = '' OR t.team_name = @team_name)
+		ORDER BY prr.overdue_at, prr.assigned_at
+	`
+
+	assignments, err := r.listAssignments(ctx, query, sql.Named("org_id", orgID), sql.Named("team_name", teamName))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return assignments, nil
+}
+
+// ListPendingReviews возвращает открытые Pull Request'ы, ожидающие ревью активных пользователей
+// с заданной частотой напоминаний, упорядоченные по ревьюверу и времени создания Pull Request'а.
+func (r *PullRequestRepository) ListPendingReviews(
+	ctx context.Context,
+	frequency domain.ReminderFrequency,
+) ([]domain.PendingReview, error) {
+	const op = "sqlite.pullrequest.ListPendingReviews"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT prr.reviewer_id, u.username, COALESCE(u.email, ''),
+			   r.repository_name,
+			   prr.pull_request_id, pr.pull_request_name, pr.author_id,
+			   pr.created_at, prr.assigned_at
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr
+			ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+		JOIN repositories r ON r.repository_id = prr.repository_id
+		JOIN users u ON u.org_id = prr.org_id AND u.user_id = prr.reviewer_id
+		WHERE prr.org_id = @org_id
+			AND pr.status = 'OPEN'
+			AND u.is_active = TRUE
+			AND (u.away_until IS NULL OR u.away_until <= @now)
+			AND u.reminder_frequency = @frequency
+			AND NOT EXISTS (
+				SELECT 1 FROM pull_request_reviews rv
+				WHERE rv.repository_id = prr.repository_id
+					AND rv.pull_request_id = prr.pull_request_id
+					AND rv.reviewer_id = prr.reviewer_id
+					AND rv.submitted_at >= prr.assigned_at
+			)
+		ORDER BY prr.reviewer_id, pr.created_at, r.repository_name, prr.pull_request_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		sql.Named("org_id", orgID),
+		sql.Named("frequency", string(frequency)),
+		sql.Named("now", sqlitePkg.FormatTime(time.Now())),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	pending := make([]domain.PendingReview, 0)
+	for rows.Next() {
+		var (
+			p                     domain.PendingReview
+			createdAt, assignedAt sqlitePkg.NullTime
+		)
+		err := rows.Scan(&p.ReviewerID, &p.ReviewerName, &p.ReviewerEmail, &p.Repository,
+			&p.PullRequestID, &p.PullRequestName, &p.AuthorID, &createdAt, &assignedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		p.CreatedAt = createdAt.Time
+		p.AssignedAt = assignedAt.Time
+		pending = append(pending, p)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pending, nil
+}
+
+// MarkOverdue помечает назначение просроченным. Повторная пометка не меняет время.
+// Если ревьювер не назначен на Pull Request, возвращается ошибка repoErr.ErrReviewerNotAssigned.
+func (r *PullRequestRepository) MarkOverdue(ctx context.Context, repositoryID, prID, reviewerID string) error {
+	const op = "sqlite.pullrequest.MarkOverdue"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		UPDATE pull_request_reviewers
+		SET overdue_at = COALESCE(overdue_at, $5)
+		WHERE org_id = $1 AND repository_id = $2 AND pull_request_id = $3 AND reviewer_id = $4
+	`
+
+	res, err := r.db.ExecContext(ctx, query, orgID, repositoryID, prID, reviewerID, sqlitePkg.FormatTime(time.Now()))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if updated == 0 {
+		return repoErr.ErrReviewerNotAssigned
+	}
+
+	return nil
+}
+
+// AddReviewer назначает дополнительного ревьювера на Pull Request.
+// Если ревьювер уже назначен, ничего не происходит.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *PullRequestRepository) AddReviewer(ctx context.Context, repositoryID, prID, reviewerID string) error {
+	const op = "sqlite.pullrequest.AddReviewer"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = addReviewer(ctx, r.db, orgID, repositoryID, prID, reviewerID, sqlitePkg.FormatTime(time.Now()))
+	if sqlitePkg.IsForeignKeyErr(err) {
+		return repoErr.ErrUserNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// addReviewer назначает ревьювера на Pull Request со временем назначения assignedAt.
+// Повторное назначение того же ревьювера ничего не меняет.
+func addReviewer(
+	ctx context.Context,
+	q sqlitePkg.Querier,
+	orgID, repositoryID, prID, reviewerID, assignedAt string,
+) error {
+	const query = `
+		INSERT INTO pull_request_reviewers (org_id, repository_id, pull_request_id, reviewer_id, assigned_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING
+	`
+	_, err := q.ExecContext(ctx, query, orgID, repositoryID, prID, reviewerID, assignedAt)
+
+	return err
+}
+
+func (r *PullRequestRepository) listAssignments(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]domain.ReviewAssignment, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := make([]domain.ReviewAssignment, 0)
+	for rows.Next() {
+		var (
+			a                     domain.ReviewAssignment
+			assignedAt, overdueAt sqlitePkg.NullTime
+			slaHours              sql.NullInt64
+			slaAction             string
+			leadID                sql.NullString
+		)
+		err := rows.Scan(&a.RepositoryID, &a.Repository, &a.PullRequestID, &a.PullRequestName,
+			&a.AuthorID, &a.ReviewerID, &a.TeamName, &assignedAt, &overdueAt,
+			&slaHours, &slaAction, &leadID)
+		if err != nil {
+			return nil, fmt.Errorf("map row: %w", err)
+		}
+
+		a.AssignedAt = assignedAt.Time
+		a.OverdueAt = overdueAt.Ptr()
+		a.SLA = domain.TeamSLA{
+			ReviewHours: int(slaHours.Int64),
+			Action:      domain.SLAAction(slaAction),
+			LeadID:      leadID.String,
+		}
+		assignments = append(assignments, a)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (r *PullRequestRepository) getByID(
+	ctx context.Context,
+	q sqlitePkg.Querier,
+	orgID, repositoryID, prID string,
+) (*domain.PullRequest, error) {
+	const op = "sqlite.pullrequest.getByID"
+
+	query := `
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		WHERE pr.org_id = $1 AND pr.repository_id = $2 AND pr.pull_request_id = $3
+	`
+
+	found, err := scanPullRequest(q.QueryRowContext(ctx, query, orgID, repositoryID, prID))
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrPRNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return found, nil
+}
+
+func (r *PullRequestRepository) list(ctx context.Context, query string, args ...any) ([]domain.PullRequest, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pullRequests := make([]domain.PullRequest, 0)
+	for rows.Next() {
+		pr, err := scanPullRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("map row: %w", err)
+		}
+		pullRequests = append(pullRequests, *pr)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return pullRequests, nil
+}
+
+// scanPullRequest читает колонки pullRequestColumns, перед которыми, если переданы,
+// идут колонки prefix, и проверяет статус Pull Request'а.
+func scanPullRequest(row scanner, prefix ...any) (*domain.PullRequest, error) {
+	var (
+		pr                  domain.PullRequest
+		status              string
+		createdAt, mergedAt sqlitePkg.NullTime
+		reviewers           stringList
+	)
+	dest := append(prefix,
+		&pr.RepositoryID, &pr.Repository, &pr.ID, &pr.Name, &pr.AuthorID,
+		&createdAt, &status, &mergedAt, &pr.InNeedMoreReviewers, &reviewers,
+	)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	pr.Status = domain.PRStatus(status)
+	if !pr.Status.IsValid() {
+		return nil, repoErr.ErrInvalidStatus
+	}
+	pr.CreatedAt = createdAt.Time
+	pr.MergedAt = mergedAt.Ptr()
+	pr.Reviewers = reviewers
+
+	return &pr, nil
+}
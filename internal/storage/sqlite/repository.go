@@ -0,0 +1,150 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+// repositoryColumns — список колонок для чтения репозитория вместе с именем команды-владельца.
+// Запрос должен содержать псевдоним r для repositories и LEFT JOIN teams t.
+const repositoryColumns = `r.repository_id, r.repository_name, r.team_id, t.team_name, r.max_reviewers`
+
+type RepoRepository struct {
+	db sqlitePkg.DB
+}
+
+func NewRepoRepository(db sqlitePkg.DB) *RepoRepository {
+	return &RepoRepository{
+		db: db,
+	}
+}
+
+// Create создаёт репозиторий в организации субъекта запроса.
+// Если репозиторий с таким именем уже существует, возвращается ошибка repoErr.ErrRepositoryExists.
+// Если команда-владелец не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *RepoRepository) Create(ctx context.Context, repo *domain.Repository) (*domain.Repository, error) {
+	const op = "sqlite.repository.Create"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var teamID *string
+	if repo.TeamID != "" {
+		teamID = &repo.TeamID
+	}
+
+	const insertQuery = `
+		INSERT INTO repositories (org_id, repository_name, team_id, max_reviewers)
+		VALUES ($1, $2, $3, $4)
+		RETURNING repository_id
+	`
+	var repositoryID string
+	err = r.db.QueryRowContext(ctx, insertQuery, orgID, repo.Name, teamID, repo.Settings.MaxReviewers).
+		Scan(&repositoryID)
+	if sqlitePkg.IsUniqueViolationError(err) {
+		return nil, repoErr.ErrRepositoryExists
+	}
+	if sqlitePkg.IsForeignKeyErr(err) {
+		return nil, repoErr.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := r.get(ctx, `r.repository_id = $2`, orgID, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+// GetByName возвращает репозиторий по его имени.
+// Если репозиторий не найден, возвращается ошибка repoErr.ErrRepositoryNotFound.
+func (r *RepoRepository) GetByName(ctx context.Context, name string) (*domain.Repository, error) {
+	const op = "sqlite.repository.GetByName"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	found, err := r.get(ctx, `r.repository_name = $2`, orgID, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return found, nil
+}
+
+// UpdateSettings сохраняет настройки репозитория.
+// Если репозиторий не найден, возвращается ошибка repoErr.ErrRepositoryNotFound.
+func (r *RepoRepository) UpdateSettings(
+	ctx context.Context,
+	repositoryID string,
+	settings domain.RepositorySettings,
+) (*domain.Repository, error) {
+	const op = "sqlite.repository.UpdateSettings"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const updateQuery = `
+		UPDATE repositories
+		SET max_reviewers = $3
+		WHERE org_id = $1 AND repository_id = $2
+	`
+	_, err = r.db.ExecContext(ctx, updateQuery, orgID, repositoryID, settings.MaxReviewers)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updated, err := r.get(ctx, `r.repository_id = $2`, orgID, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+// get возвращает репозиторий организации orgID, подходящий под условие where с параметром $2.
+func (r *RepoRepository) get(ctx context.Context, where, orgID string, value any) (*domain.Repository, error) {
+	query := `
+		SELECT ` + repositoryColumns + `
+		FROM repositories r
+		LEFT JOIN teams t ON t.team_id = r.team_id
+		WHERE r.org_id = $1 AND ` + where
+
+	var (
+		repo             domain.Repository
+		teamID, teamName sql.NullString
+		maxReviewers     sql.NullInt64
+	)
+	err := r.db.QueryRowContext(ctx, query, orgID, value).
+		Scan(&repo.ID, &repo.Name, &teamID, &teamName, &maxReviewers)
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrRepositoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	repo.TeamID = teamID.String
+	repo.TeamName = teamName.String
+	if maxReviewers.Valid {
+		v := int(maxReviewers.Int64)
+		repo.Settings.MaxReviewers = &v
+	}
+
+	return &repo, nil
+}
@@ -0,0 +1,106 @@
+// Package sqlite реализует хранилища сервиса поверх SQLite для установок на одном узле,
+// которым не нужен PostgreSQL. Схема создаётся собственными миграциями из каталога migrations,
+// а ошибки ограничений переводятся в те же ошибки repoErr, что и в хранилищах PostgreSQL.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Open открывает базу SQLite в файле path и применяет к ней миграции.
+func Open(ctx context.Context, path string) (*sql.DB, error) {
+	const op = "sqlite.Open"
+
+	db, err := sqlitePkg.Open(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = sqlitePkg.Migrate(ctx, db, fsys)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("%s: migrate: %w", op, err)
+	}
+
+	return db, nil
+}
+
+// CheckMigrations проверяет, что к базе применены все встроенные миграции.
+func CheckMigrations(ctx context.Context, db *sql.DB) error {
+	fsys, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return err
+	}
+
+	pending, err := sqlitePkg.Pending(ctx, db, fsys)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("migrations are not applied: %s", strings.Join(pending, ", "))
+	}
+
+	return nil
+}
+
+// stringList читает JSON-массив строк, собранный json_group_array.
+// Пустой массив читается как nil, как пустой array_agg в PostgreSQL.
+type stringList []string
+
+func (l *stringList) Scan(value any) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*l = nil
+
+		return nil
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		return fmt.Errorf("unsupported list value %T", value)
+	}
+
+	var items []string
+	err := json.Unmarshal(raw, &items)
+	if err != nil {
+		return fmt.Errorf("parse list: %w", err)
+	}
+	if len(items) == 0 {
+		items = nil
+	}
+	*l = items
+
+	return nil
+}
+
+// jsonList кодирует строки в JSON-массив для сравнения через json_each.
+func jsonList(items []string) string {
+	if items == nil {
+		items = []string{}
+	}
+
+	b, _ := json.Marshal(items)
+
+	return string(b)
+}
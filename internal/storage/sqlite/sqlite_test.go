@@ -0,0 +1,112 @@
+package sqlite_test
+
+import (
+	"context"
+	"crypto/rand"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/storage/sqlite"
+	"avitotech-pr-reviewer/internal/storage/storagetest"
+	"avitotech-pr-reviewer/internal/tenant"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+func TestContract(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Backend {
+		db, err := sqlite.Open(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		tm := sqlitePkg.NewTxManager(db)
+		orgs := sqlite.NewOrgRepository(tm)
+
+		return storagetest.Backend{
+			Teams:        sqlite.NewTeamRepository(tm),
+			Users:        sqlite.NewUserRepository(tm),
+			PullRequests: sqlite.NewPullRequestRepository(tm),
+			Repositories: sqlite.NewRepoRepository(tm),
+			Stats:        sqlite.NewStatsRepository(tm),
+			NewOrganization: func(t *testing.T) context.Context {
+				org, err := orgs.Create(context.Background(), t.Name()+"-"+rand.Text(), rand.Text())
+				require.NoError(t, err)
+
+				return tenant.WithPrincipal(context.Background(), domain.Principal{OrgID: org.ID, Role: domain.RoleAdmin})
+			},
+		}
+	})
+}
+
+// TestOpen_Reopen проверяет, что повторное открытие базы не применяет миграции заново.
+func TestOpen_Reopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	db, err := sqlite.Open(context.Background(), path)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	db, err = sqlite.Open(context.Background(), path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	files, err := filepath.Glob(filepath.Join("migrations", "*.up.sql"))
+	require.NoError(t, err)
+
+	var migrations int
+	err = db.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM schema_migrations`).Scan(&migrations)
+	require.NoError(t, err)
+	require.Equal(t, len(files), migrations)
+}
+
+func TestOrgRepository_AddToken(t *testing.T) {
+	db, err := sqlite.Open(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	tm := sqlitePkg.NewTxManager(db)
+	orgs := sqlite.NewOrgRepository(tm)
+
+	org, err := orgs.Create(context.Background(), "payments", rand.Text())
+	require.NoError(t, err)
+
+	ctx := tenant.WithPrincipal(context.Background(), domain.Principal{OrgID: org.ID, Role: domain.RoleAdmin})
+	_, err = sqlite.NewTeamRepository(tm).CreateWithMembers(ctx, "backend",
+		[]domain.Member{{ID: "u1", Username: "alice", IsActive: true}})
+	require.NoError(t, err)
+
+	tokenHash := rand.Text()
+	require.NoError(t, orgs.AddToken(context.Background(), org.ID, tokenHash, domain.RoleMember, "u1"))
+
+	principal, err := orgs.GetPrincipalByTokenHash(context.Background(), tokenHash)
+	require.NoError(t, err)
+	require.Equal(t, &domain.Principal{OrgID: org.ID, Role: domain.RoleMember, UserID: "u1"}, principal)
+
+	err = orgs.AddToken(context.Background(), org.ID, rand.Text(), domain.RoleMember, "u404")
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+}
+
+func TestCheckMigrations(t *testing.T) {
+	db, err := sqlite.Open(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, sqlite.CheckMigrations(context.Background(), db))
+
+	files, err := filepath.Glob(filepath.Join("migrations", "*.up.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	latest := strings.TrimSuffix(filepath.Base(files[len(files)-1]), ".up.sql")
+
+	_, err = db.ExecContext(context.Background(), `DELETE FROM schema_migrations WHERE version = ?`, latest)
+	require.NoError(t, err)
+
+	err = sqlite.CheckMigrations(context.Background(), db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), latest)
+}
@@ -0,0 +1,321 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"avitotech-pr-reviewer/internal/domain"
+	"avitotech-pr-reviewer/internal/storage/cycletime"
+	"avitotech-pr-reviewer/internal/tenant"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+// assignmentCounts — CTE с числом назначений каждого пользователя организации
+// по Pull Request'ам, прошедшим фильтр. Пользователи без назначений попадают в выборку с нулём.
+const assignmentCounts = `
+	WITH assignments AS (
+		SELECT prr.reviewer_id
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr
+			ON pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id
+		WHERE prr.org_id = @org_id
+			AND (@from IS NULL OR pr.created_at >= @from)
+			AND (@to IS NULL OR pr.created_at < @to)
+			AND (@status IS NULL OR pr.status = @status)
+	), counts AS (
+		SELECT u.user_id, u.username, u.is_active, u.team_id, COUNT(a.reviewer_id) AS assignments
+		FROM users u
+		LEFT JOIN assignments a ON a.reviewer_id = u.user_id
+		WHERE u.org_id = @org_id
+		GROUP BY u.user_id, u.username, u.is_active, u.team_id
+	)
+`
+
+// filteredPullRequests — CTE с Pull Request'ами организации, прошедшими фильтр,
+// вместе с именем репозитория и командой автора.
+const filteredPullRequests = `
+	WITH prs AS (
+		SELECT pr.repository_id, r.repository_name, pr.pull_request_id,
+			   COALESCE(t.team_name, '') AS author_team, pr.created_at, pr.merged_at
+		FROM pull_requests pr
+		JOIN repositories r ON r.repository_id = pr.repository_id
+		LEFT JOIN users u ON u.org_id = pr.org_id AND u.user_id = pr.author_id
+		LEFT JOIN teams t ON t.org_id = pr.org_id AND t.team_id = u.team_id
+		WHERE pr.org_id = @org_id
+			AND (@from IS NULL OR pr.created_at >= @from)
+			AND (@to IS NULL OR pr.created_at < @to)
+			AND (@status IS NULL OR pr.status = @status)
+			AND (@team_name IS NULL OR t.team_name = @team_name)
+	)
+`
+
+// pullRequestFacts — моменты первого ревью и первого одобрения каждого Pull Request'а.
+// Время отсчитывается от создания Pull Request'а. Ключ группы подставляется через fmt.
+const pullRequestFacts = `
+	SELECT %s AS group_key, p.created_at, p.created_at AS started_at, p.merged_at,
+		   (
+			   SELECT MIN(submitted_at) FROM pull_request_reviews
+			   WHERE repository_id = p.repository_id AND pull_request_id = p.pull_request_id
+		   ) AS first_review_at,
+		   (
+			   SELECT MIN(submitted_at) FROM pull_request_reviews
+			   WHERE repository_id = p.repository_id AND pull_request_id = p.pull_request_id
+				   AND state = 'APPROVED'
+		   ) AS first_approval_at
+	FROM prs p
+`
+
+// reviewerFacts — моменты первого ревью и первого одобрения каждого назначенного ревьювера.
+// Время отсчитывается от назначения ревьювера.
+const reviewerFacts = `
+	SELECT prr.reviewer_id AS group_key, p.created_at, prr.assigned_at AS started_at, p.merged_at,
+		   (
+			   SELECT MIN(submitted_at) FROM pull_request_reviews
+			   WHERE repository_id = prr.repository_id AND pull_request_id = prr.pull_request_id
+				   AND reviewer_id = prr.reviewer_id AND submitted_at >= prr.assigned_at
+		   ) AS first_review_at,
+		   (
+			   SELECT MIN(submitted_at) FROM pull_request_reviews
+			   WHERE repository_id = prr.repository_id AND pull_request_id = prr.pull_request_id
+				   AND reviewer_id = prr.reviewer_id AND submitted_at >= prr.assigned_at
+				   AND state = 'APPROVED'
+		   ) AS first_approval_at
+	FROM prs p
+	JOIN pull_request_reviewers prr
+		ON prr.repository_id = p.repository_id AND prr.pull_request_id = p.pull_request_id
+`
+
+type StatsRepository struct {
+	db sqlitePkg.DB
+}
+
+func NewStatsRepository(db sqlitePkg.DB) *StatsRepository {
+	return &StatsRepository{
+		db: db,
+	}
+}
+
+// ReviewerAssignments возвращает число назначений ревьювером для каждого пользователя организации,
+// начиная с самых загруженных. Если в фильтре задана команда, учитываются только её участники.
+func (r *StatsRepository) ReviewerAssignments(
+	ctx context.Context,
+	filter domain.StatsFilter,
+) ([]domain.ReviewerStats, error) {
+	const op = "sqlite.stats.ReviewerAssignments"
+
+	args, err := filterArgs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := assignmentCounts + `
+		SELECT c.user_id, c.username, t.team_name, c.is_active, c.assignments
+		FROM counts c
+		LEFT JOIN teams t ON t.org_id = @org_id AND t.team_id = c.team_id
+		WHERE (@team_name IS NULL OR t.team_name = @team_name)
+		ORDER BY c.assignments DESC, c.user_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	stats := make([]domain.ReviewerStats, 0)
+	for rows.Next() {
+		var (
+			s        domain.ReviewerStats
+			teamName sql.NullString
+		)
+		err := rows.Scan(&s.UserID, &s.Username, &teamName, &s.IsActive, &s.Assignments)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		s.TeamName = teamName.String
+		stats = append(stats, s)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// TeamAssignments возвращает распределение назначений по командам организации.
+// Учитываются только активные участники: неактивные не могут получать назначения
+// и исказили бы показатели равномерности. Команды упорядочены по имени.
+func (r *StatsRepository) TeamAssignments(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error) {
+	const op = "sqlite.stats.TeamAssignments"
+
+	args, err := filterArgs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := assignmentCounts + `
+		SELECT t.team_name,
+			   COUNT(c.user_id) AS members,
+			   COALESCE(SUM(c.assignments), 0) AS assignments,
+			   COALESCE(MAX(c.assignments), 0) AS max_assignments,
+			   COALESCE(MIN(c.assignments), 0) AS min_assignments,
+			   COALESCE(AVG(c.assignments), 0.0) AS avg_assignments,
+			   COUNT(CASE WHEN c.assignments = 0 THEN 1 END) AS idle_members
+		FROM teams t
+		LEFT JOIN counts c ON c.team_id = t.team_id AND c.is_active
+		WHERE t.org_id = @org_id
+			AND (@team_name IS NULL OR t.team_name = @team_name)
+		GROUP BY t.team_name
+		ORDER BY t.team_name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	stats := make([]domain.TeamStats, 0)
+	for rows.Next() {
+		var s domain.TeamStats
+		err := rows.Scan(&s.TeamName, &s.Members, &s.Assignments,
+			&s.MaxAssignments, &s.MinAssignments, &s.AvgAssignments, &s.IdleMembers)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		stats = append(stats, s)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// PullRequestCounts возвращает число открытых Pull Request'ов организации
+// и тех из них, на которые не назначено ни одного ревьювера.
+func (r *StatsRepository) PullRequestCounts(ctx context.Context) (domain.PullRequestCounts, error) {
+	const op = "sqlite.stats.PullRequestCounts"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return domain.PullRequestCounts{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT COUNT(*) AS open,
+			   COUNT(*) FILTER (WHERE NOT EXISTS (
+				   SELECT 1 FROM pull_request_reviewers prr
+				   WHERE prr.repository_id = pr.repository_id AND prr.pull_request_id = pr.pull_request_id
+			   )) AS without_reviewers
+		FROM pull_requests pr
+		WHERE pr.org_id = ? AND pr.status = 'OPEN'
+	`
+
+	var counts domain.PullRequestCounts
+	err = r.db.QueryRowContext(ctx, query, orgID).Scan(&counts.Open, &counts.WithoutReviewers)
+	if err != nil {
+		return domain.PullRequestCounts{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return counts, nil
+}
+
+// CycleTime возвращает медиану и 90-й перцентиль времени до первого ревью, до одобрения
+// и до merge по группам и неделям создания Pull Request'а.
+// Фильтр по команде ограничивает Pull Request'ы командой автора.
+// В SQLite нет percentile_cont, поэтому запрос возвращает факты, а перцентили считает cycletime.
+func (r *StatsRepository) CycleTime(
+	ctx context.Context,
+	group domain.CycleTimeGroup,
+	filter domain.StatsFilter,
+) ([]domain.CycleTimeStats, error) {
+	const op = "sqlite.stats.CycleTime"
+
+	args, err := filterArgs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var facts string
+	switch group {
+	case domain.CycleTimeByTeam:
+		facts = fmt.Sprintf(pullRequestFacts, "p.author_team")
+	case domain.CycleTimeByRepository:
+		facts = fmt.Sprintf(pullRequestFacts, "p.repository_name")
+	case domain.CycleTimeByReviewer:
+		facts = reviewerFacts
+	default:
+		return nil, fmt.Errorf("%s: unknown group %q", op, group)
+	}
+
+	rows, err := r.db.QueryContext(ctx, filteredPullRequests+facts, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var collected []cycletime.Fact
+	for rows.Next() {
+		var (
+			f                                    cycletime.Fact
+			createdAt, startedAt                 sqlitePkg.NullTime
+			mergedAt, firstReview, firstApproval sqlitePkg.NullTime
+		)
+		err := rows.Scan(&f.Group, &createdAt, &startedAt, &mergedAt, &firstReview, &firstApproval)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+
+		f.CreatedAt = createdAt.Time
+		f.StartedAt = startedAt.Time
+		f.MergedAt = mergedAt.Ptr()
+		f.FirstReviewAt = firstReview.Ptr()
+		f.FirstApprovalAt = firstApproval.Ptr()
+		collected = append(collected, f)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return cycletime.Aggregate(collected), nil
+}
+
+func filterArgs(ctx context.Context, filter domain.StatsFilter) ([]any, error) {
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var from, to, status, teamName *string
+	if filter.From != nil {
+		f := sqlitePkg.FormatTime(*filter.From)
+		from = &f
+	}
+	if filter.To != nil {
+		t := sqlitePkg.FormatTime(*filter.To)
+		to = &t
+	}
+	if filter.Status != nil {
+		s := string(*filter.Status)
+		status = &s
+	}
+	if filter.TeamName != "" {
+		teamName = &filter.TeamName
+	}
+
+	return []any{
+		sql.Named("org_id", orgID),
+		sql.Named("from", from),
+		sql.Named("to", to),
+		sql.Named("status", status),
+		sql.Named("team_name", teamName),
+	}, nil
+}
@@ -0,0 +1,427 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+// teamColumns — список колонок команды вместе с настройками SLA.
+const teamColumns = `team_id, team_name, review_sla_hours, sla_action, lead_id`
+
+// upsertMemberQuery создаёт участника команды или переводит и обновляет существующего.
+// Пустой email не затирает сохранённый.
+const upsertMemberQuery = `
+	INSERT INTO users (org_id, user_id, username, is_active, team_id, email)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''))
+	ON CONFLICT (org_id, user_id)
+	DO UPDATE SET
+		username = excluded.username,
+		is_active = excluded.is_active,
+		team_id = excluded.team_id,
+		email = COALESCE(excluded.email, users.email)
+`
+
+type TeamRepository struct {
+	db sqlitePkg.DB
+}
+
+func NewTeamRepository(db sqlitePkg.DB) *TeamRepository {
+	return &TeamRepository{
+		db: db,
+	}
+}
+
+// CreateWithMembers создает команду и пользователей, принадлежащих к этой команде,
+// назначает их в эту команду. Данные существующих пользователей обновляются.
+// Если команда с таким именем уже существует, возвращается ошибка repoErr.ErrTeamExists.
+func (r *TeamRepository) CreateWithMembers(
+	ctx context.Context,
+	teamName string,
+	members []domain.Member,
+) (*domain.Team, error) {
+	const op = "sqlite.team.CreateWithMembers"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var team domain.Team
+	const createTeamQuery = `
+		INSERT INTO teams (org_id, team_name)
+		VALUES ($1, $2) RETURNING team_id, team_name
+	`
+	err = tx.QueryRowContext(ctx, createTeamQuery, orgID, teamName).Scan(&team.ID, &team.Name)
+	if sqlitePkg.IsUniqueViolationError(err) {
+		return nil, repoErr.ErrTeamExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: create team: %w", op, err)
+	}
+
+	for _, member := range members {
+		_, err = tx.ExecContext(ctx, upsertMemberQuery,
+			orgID, member.ID, member.Username, member.IsActive, team.ID, member.Email)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	team.Members = members
+
+	return &team, nil
+}
+
+// GetByName возвращает команду по ее имени.
+// Если команда с таким именем не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *TeamRepository) GetByName(ctx context.Context, teamName string) (*domain.Team, error) {
+	const op = "sqlite.team.GetByName"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + teamColumns + ` FROM teams
+		WHERE org_id = $1 AND team_name = $2
+	`
+	team, err := scanTeam(r.db.QueryRowContext(ctx, query, orgID, teamName))
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return team, nil
+}
+
+// GetActiveMembersByTeamID возвращает список активных участников команды по идентификатору команды.
+// Участники, отметившие отсутствие (away_until в будущем), в список не попадают.
+// Если команда с таким идентификатором не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *TeamRepository) GetActiveMembersByTeamID(ctx context.Context, teamID string) ([]domain.Member, error) {
+	const op = "sqlite.team.GetActiveMembersByTeamID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const existsQuery = `
+		SELECT 1 FROM teams
+		WHERE org_id = $1 AND team_id = $2
+	`
+	var exists int
+	err = r.db.QueryRowContext(ctx, existsQuery, orgID, teamID).Scan(&exists)
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const listQuery = `
+		SELECT user_id, username, is_active
+		FROM users
+		WHERE org_id = $1 AND team_id = $2 AND is_active = 1
+			AND (away_until IS NULL OR away_until <= $3)
+	`
+	rows, err := r.db.QueryContext(ctx, listQuery, orgID, teamID, sqlitePkg.FormatTime(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var members []domain.Member
+	for rows.Next() {
+		var m domain.Member
+		err := rows.Scan(&m.ID, &m.Username, &m.IsActive)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		members = append(members, m)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return members, nil
+}
+
+// GetByID возвращает команду по ее идентификатору.
+// Если команда с таким идентификатором не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+func (r *TeamRepository) GetByID(ctx context.Context, teamID string) (*domain.Team, error) {
+	const op = "sqlite.team.GetByID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + teamColumns + ` FROM teams
+		WHERE org_id = $1 AND team_id = $2
+	`
+	team, err := scanTeam(r.db.QueryRowContext(ctx, query, orgID, teamID))
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return team, nil
+}
+
+// UpdateSLA заменяет SLA команды. Нулевой sla.ReviewHours отключает SLA.
+// Если команда не найдена, возвращается ошибка repoErr.ErrTeamNotFound.
+// Если лид не найден в организации, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *TeamRepository) UpdateSLA(ctx context.Context, teamID string, sla domain.TeamSLA) (*domain.Team, error) {
+	const op = "sqlite.team.UpdateSLA"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var hours *int
+	if sla.Enabled() {
+		hours = &sla.ReviewHours
+	}
+	var leadID *string
+	if sla.LeadID != "" {
+		leadID = &sla.LeadID
+	}
+
+	query := `
+		UPDATE teams
+		SET review_sla_hours = @hours, sla_action = @action, lead_id = @lead_id
+		WHERE org_id = @org_id AND team_id = @team_id
+		RETURNING ` + teamColumns
+
+	team, err := scanTeam(r.db.QueryRowContext(ctx, query,
+		sql.Named("org_id", orgID),
+		sql.Named("team_id", teamID),
+		sql.Named("hours", hours),
+		sql.Named("action", string(sla.Action)),
+		sql.Named("lead_id", leadID),
+	))
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrTeamNotFound
+	}
+	if sqlitePkg.IsForeignKeyErr(err) {
+		return nil, repoErr.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return team, nil
+}
+
+// ListWithMembers возвращает все команды организации, упорядоченные по имени,
+// вместе со всеми участниками, включая неактивных.
+func (r *TeamRepository) ListWithMembers(ctx context.Context) ([]domain.Team, error) {
+	const op = "sqlite.team.ListWithMembers"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const listQuery = `
+		SELECT t.team_id, t.team_name, u.user_id, u.username, u.is_active, u.email
+		FROM teams t
+		LEFT JOIN users u ON u.org_id = t.org_id AND u.team_id = t.team_id
+		WHERE t.org_id = $1
+		ORDER BY t.team_name, u.user_id
+	`
+	rows, err := r.db.QueryContext(ctx, listQuery, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var teams []domain.Team
+	for rows.Next() {
+		var (
+			teamID, teamName        string
+			userID, username, email sql.NullString
+			isActive                sql.NullBool
+		)
+		err := rows.Scan(&teamID, &teamName, &userID, &username, &isActive, &email)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+
+		if len(teams) == 0 || teams[len(teams)-1].ID != teamID {
+			teams = append(teams, domain.Team{ID: teamID, Name: teamName, Members: []domain.Member{}})
+		}
+		if userID.Valid {
+			last := &teams[len(teams)-1]
+			last.Members = append(last.Members, domain.Member{
+				ID:       userID.String,
+				Username: username.String,
+				IsActive: isActive.Bool,
+				Email:    email.String,
+			})
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return teams, nil
+}
+
+// ApplyPlan применяет план импорта команд в одной транзакции: создаёт команды,
+// создаёт, переводит и обновляет пользователей, деактивирует отсутствующих.
+// Пустой email участника не затирает сохранённый.
+// Если имя пользователя занято другим пользователем, возвращается ошибка repoErr.ErrUsernameTaken.
+// Если команда пользователя или деактивируемый пользователь не найдены, возвращаются
+// ошибки repoErr.ErrTeamNotFound и repoErr.ErrUserNotFound соответственно.
+func (r *TeamRepository) ApplyPlan(ctx context.Context, plan domain.TeamPlan) error {
+	const op = "sqlite.team.ApplyPlan"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, change := range plan.Changes {
+		err = applyChange(ctx, tx, orgID, change)
+		if sqlitePkg.IsUniqueViolationError(err) {
+			err = repoErr.ErrUsernameTaken
+
+			return err
+		}
+		if errors.Is(err, repoErr.ErrTeamNotFound) || errors.Is(err, repoErr.ErrUserNotFound) {
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// applyChange применяет одно изменение плана импорта в транзакции tx.
+func applyChange(ctx context.Context, tx sqlitePkg.Tx, orgID string, change domain.TeamChange) error {
+	m := change.Member
+
+	switch change.Action {
+	case domain.TeamChangeCreateTeam:
+		const createTeamQuery = `
+			INSERT INTO teams (org_id, team_name)
+			VALUES ($1, $2)
+			ON CONFLICT (org_id, team_name) DO NOTHING
+		`
+		_, err := tx.ExecContext(ctx, createTeamQuery, orgID, change.TeamName)
+
+		return err
+	case domain.TeamChangeAddUser, domain.TeamChangeMoveUser, domain.TeamChangeUpdateUser:
+		const teamIDQuery = `
+			SELECT team_id FROM teams
+			WHERE org_id = $1 AND team_name = $2
+		`
+		var teamID string
+		err := tx.QueryRowContext(ctx, teamIDQuery, orgID, change.TeamName).Scan(&teamID)
+		// Команда могла быть удалена после построения плана.
+		if sqlitePkg.IsNoRowsError(err) {
+			return repoErr.ErrTeamNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, upsertMemberQuery, orgID, m.ID, m.Username, m.IsActive, teamID, m.Email)
+
+		return err
+	case domain.TeamChangeDeactivateUser:
+		const deactivateQuery = `
+			UPDATE users SET is_active = 0
+			WHERE org_id = $1 AND user_id = $2
+		`
+		res, err := tx.ExecContext(ctx, deactivateQuery, orgID, m.ID)
+		if err != nil {
+			return err
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return repoErr.ErrUserNotFound
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown change action %q", change.Action)
+	}
+}
+
+func scanTeam(row *sql.Row) (*domain.Team, error) {
+	var (
+		team   domain.Team
+		hours  sql.NullInt32
+		action string
+		leadID sql.NullString
+	)
+	err := row.Scan(&team.ID, &team.Name, &hours, &action, &leadID)
+	if err != nil {
+		return nil, err
+	}
+
+	team.SLA = domain.TeamSLA{
+		ReviewHours: int(hours.Int32),
+		Action:      domain.SLAAction(action),
+		LeadID:      leadID.String,
+	}
+
+	return &team, nil
+}
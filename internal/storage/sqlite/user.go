@@ -0,0 +1,340 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+	sqlitePkg "avitotech-pr-reviewer/pkg/sqlite"
+)
+
+// userColumns — список колонок пользователя в порядке scanUser.
+const userColumns = `user_id, username, is_active, team_id, email, reminder_frequency, chat_user_id, away_until`
+
+type UserRepository struct {
+	db sqlitePkg.DB
+}
+
+func NewUserRepository(db sqlitePkg.DB) *UserRepository {
+	return &UserRepository{
+		db: db,
+	}
+}
+
+// ListByTeamID возвращает список участников по идентификатору команды.
+// Если команда не найдена или у команды нет участников, возвращается пустой слайс.
+func (r *UserRepository) ListByTeamID(ctx context.Context, teamID string) ([]domain.Member, error) {
+	const op = "sqlite.user.ListByTeamID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE org_id = $1 AND team_id = $2
+	`
+	rows, err := r.db.QueryContext(ctx, query, orgID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var members []domain.Member
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		members = append(members, domain.Member{ID: u.ID, Username: u.Username, IsActive: u.IsActive})
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return members, nil
+}
+
+// SetIsActive обновляет статус активности пользователя.
+// Возвращает обновленного пользователя с именем команды.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Может вернуть repoErr.ErrTeamNotFound, если команда пользователя не найдена.
+func (r *UserRepository) SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
+	const op = "sqlite.user.SetIsActive"
+
+	user, err := r.update(ctx, userID, `is_active = $1`, isActive)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// GetByID возвращает пользователя по его идентификатору.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Может вернуть repoErr.ErrTeamNotFound, если команда пользователя не найдена.
+func (r *UserRepository) GetByID(ctx context.Context, userID string) (*domain.User, error) {
+	const op = "sqlite.user.GetByID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE org_id = $1 AND user_id = $2
+	`
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, orgID, userID))
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: scan row: %w", op, err)
+	}
+
+	user.TeamName, err = r.getUsersTeamName(ctx, orgID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// GetByIDs возвращает пользователей с указанными идентификаторами вместе с именами их команд
+// одним запросом. Ненайденные идентификаторы пропускаются, порядок результата не определён.
+func (r *UserRepository) GetByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	const op = "sqlite.user.GetByIDs"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	const query = `
+		SELECT u.user_id, u.username, u.is_active, u.team_id, u.email, u.reminder_frequency,
+			   u.chat_user_id, u.away_until, COALESCE(t.team_name, '') AS team_name
+		FROM users u
+		LEFT JOIN teams t ON t.org_id = u.org_id AND t.team_id = u.team_id
+		WHERE u.org_id = $1 AND u.user_id IN (SELECT value FROM json_each($2))
+	`
+	rows, err := r.db.QueryContext(ctx, query, orgID, jsonList(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0, len(userIDs))
+	for rows.Next() {
+		var teamName string
+		u, err := scanUser(rows, &teamName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: map row: %w", op, err)
+		}
+		u.TeamName = teamName
+		users = append(users, *u)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, nil
+}
+
+// SetReminderFrequency обновляет частоту напоминаний пользователя.
+// Возвращает обновленного пользователя с именем команды.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Может вернуть repoErr.ErrTeamNotFound, если команда пользователя не найдена.
+func (r *UserRepository) SetReminderFrequency(
+	ctx context.Context,
+	userID string,
+	frequency domain.ReminderFrequency,
+) (*domain.User, error) {
+	const op = "sqlite.user.SetReminderFrequency"
+
+	user, err := r.update(ctx, userID, `reminder_frequency = $1`, string(frequency))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// SetEmail обновляет адрес для уведомлений пользователя. Пустой адрес удаляет его.
+// Возвращает обновленного пользователя с именем команды.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Может вернуть repoErr.ErrTeamNotFound, если команда пользователя не найдена.
+func (r *UserRepository) SetEmail(ctx context.Context, userID, email string) (*domain.User, error) {
+	const op = "sqlite.user.SetEmail"
+
+	user, err := r.update(ctx, userID, `email = NULLIF($1, '')`, email)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// GetByChatID возвращает пользователя по его идентификатору в чате.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *UserRepository) GetByChatID(ctx context.Context, chatUserID string) (*domain.User, error) {
+	const op = "sqlite.user.GetByChatID"
+
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE org_id = $1 AND chat_user_id = $2
+	`
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, orgID, chatUserID))
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrUserNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: scan row: %w", op, err)
+	}
+
+	user.TeamName, err = r.getUsersTeamName(ctx, orgID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user's team name: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// SetChatUserID привязывает пользователя к идентификатору в чате. Пустой идентификатор удаляет привязку.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+// Если идентификатор уже привязан к другому пользователю, возвращается ошибка repoErr.ErrChatUserLinked.
+func (r *UserRepository) SetChatUserID(ctx context.Context, userID, chatUserID string) (*domain.User, error) {
+	const op = "sqlite.user.SetChatUserID"
+
+	user, err := r.update(ctx, userID, `chat_user_id = NULLIF($1, '')`, chatUserID)
+	if sqlitePkg.IsUniqueViolationError(err) {
+		return nil, fmt.Errorf("%s: %w", op, repoErr.ErrChatUserLinked)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// SetAwayUntil отмечает пользователя отсутствующим до указанного времени. Nil снимает отметку.
+// Отсутствующие пользователи не назначаются ревьюверами и не получают напоминаний.
+// Если пользователь не найден, возвращается ошибка repoErr.ErrUserNotFound.
+func (r *UserRepository) SetAwayUntil(ctx context.Context, userID string, until *time.Time) (*domain.User, error) {
+	const op = "sqlite.user.SetAwayUntil"
+
+	var untilUTC *string
+	if until != nil {
+		u := sqlitePkg.FormatTime(*until)
+		untilUTC = &u
+	}
+
+	user, err := r.update(ctx, userID, `away_until = $1`, untilUTC)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// update изменяет пользователя выражением set с единственным параметром $1 и возвращает его
+// вместе с именем команды. Как и в PostgreSQL, сначала ищется команда пользователя, поэтому
+// для неизвестного пользователя возвращается repoErr.ErrTeamNotFound.
+func (r *UserRepository) update(ctx context.Context, userID, set string, value any) (*domain.User, error) {
+	orgID, err := tenant.OrgID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	teamName, err := r.getUsersTeamName(ctx, orgID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user's team name: %w", err)
+	}
+
+	query := `
+		UPDATE users
+		SET ` + set + `
+		WHERE org_id = $2 AND user_id = $3
+		RETURNING ` + userColumns
+
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, value, orgID, userID))
+	if sqlitePkg.IsNoRowsError(err) {
+		return nil, repoErr.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user.TeamName = teamName
+
+	return user, nil
+}
+
+func (r *UserRepository) getUsersTeamName(ctx context.Context, orgID, userID string) (string, error) {
+	const op = "sqlite.user.getUsersTeamName"
+
+	const query = `
+		SELECT t.team_name
+		FROM users u
+		JOIN teams t ON t.org_id = u.org_id AND t.team_id = u.team_id
+		WHERE u.org_id = $1 AND u.user_id = $2
+	`
+
+	var teamName string
+	err := r.db.QueryRowContext(ctx, query, orgID, userID).Scan(&teamName)
+	if sqlitePkg.IsNoRowsError(err) {
+		return "", fmt.Errorf("%s: %w", op, repoErr.ErrTeamNotFound)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: scan row: %w", op, err)
+	}
+
+	return teamName, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanUser читает колонки userColumns и, если переданы, дополнительные колонки в extra.
+func scanUser(row scanner, extra ...any) (*domain.User, error) {
+	var (
+		user                    domain.User
+		teamID, email, chatUser sql.NullString
+		frequency               string
+		awayUntil               sqlitePkg.NullTime
+	)
+	dest := append([]any{
+		&user.ID, &user.Username, &user.IsActive, &teamID, &email, &frequency, &chatUser, &awayUntil,
+	}, extra...)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return nil, err
+	}
+
+	user.TeamID = teamID.String
+	user.Email = email.String
+	user.ReminderFrequency = domain.ReminderFrequency(frequency)
+	user.ChatUserID = chatUser.String
+	user.AwayUntil = awayUntil.Ptr()
+
+	return &user, nil
+}
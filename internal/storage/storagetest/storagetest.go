@@ -0,0 +1,573 @@
+// Package storagetest содержит общий набор тестов хранилищ команд, пользователей
+// и Pull Request'ов. Его должна проходить каждая реализация хранилищ, чтобы сервисы
+// получали одинаковые результаты и ошибки repoErr независимо от выбранного бэкенда.
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+	repoErr "avitotech-pr-reviewer/internal/storage/errors"
+	"avitotech-pr-reviewer/internal/tenant"
+)
+
+// unknownID — корректный UUID, которого нет в хранилище.
+const unknownID = "00000000-0000-0000-0000-0000000000ff"
+
+type TeamRepository interface {
+	CreateWithMembers(ctx context.Context, teamName string, members []domain.Member) (*domain.Team, error)
+	GetByName(ctx context.Context, teamName string) (*domain.Team, error)
+	GetByID(ctx context.Context, teamID string) (*domain.Team, error)
+	GetActiveMembersByTeamID(ctx context.Context, teamID string) ([]domain.Member, error)
+	UpdateSLA(ctx context.Context, teamID string, sla domain.TeamSLA) (*domain.Team, error)
+	ListWithMembers(ctx context.Context) ([]domain.Team, error)
+	ApplyPlan(ctx context.Context, plan domain.TeamPlan) error
+}
+
+type UserRepository interface {
+	ListByTeamID(ctx context.Context, teamID string) ([]domain.Member, error)
+	SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
+	GetByID(ctx context.Context, userID string) (*domain.User, error)
+	GetByIDs(ctx context.Context, userIDs []string) ([]domain.User, error)
+	SetEmail(ctx context.Context, userID, email string) (*domain.User, error)
+	GetByChatID(ctx context.Context, chatUserID string) (*domain.User, error)
+	SetChatUserID(ctx context.Context, userID, chatUserID string) (*domain.User, error)
+	SetAwayUntil(ctx context.Context, userID string, until *time.Time) (*domain.User, error)
+}
+
+type PullRequestRepository interface {
+	Create(ctx context.Context, pr *domain.PullRequest) (*domain.PullRequest, error)
+	GetByID(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error)
+	ListByRepository(ctx context.Context, repositoryID string, status domain.PRStatus) ([]domain.PullRequest, error)
+	ReassignReviewer(
+		ctx context.Context,
+		repositoryID, prID, oldReviewerID string,
+		choose func(pr *domain.PullRequest) (string, error),
+	) (*domain.PullRequest, string, error)
+	SetMerged(ctx context.Context, repositoryID, prID string) (*domain.PullRequest, error)
+	ListByReviewer(ctx context.Context, reviewerID string) ([]domain.PullRequest, error)
+	AddReview(ctx context.Context, review *domain.Review) (*domain.Review, error)
+	IsApproved(ctx context.Context, repositoryID, prID string) (bool, error)
+	MarkOverdue(ctx context.Context, repositoryID, prID, reviewerID string) error
+	AddReviewer(ctx context.Context, repositoryID, prID, reviewerID string) error
+}
+
+// RepoRepository нужен, чтобы найти репозиторий организации по умолчанию.
+type RepoRepository interface {
+	GetByName(ctx context.Context, name string) (*domain.Repository, error)
+}
+
+type StatsRepository interface {
+	TeamAssignments(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamStats, error)
+	PullRequestCounts(ctx context.Context) (domain.PullRequestCounts, error)
+}
+
+// Backend — хранилища одной реализации, работающие с общими данными.
+type Backend struct {
+	Teams        TeamRepository
+	Users        UserRepository
+	PullRequests PullRequestRepository
+	Repositories RepoRepository
+	Stats        StatsRepository
+	// NewOrganization создаёт пустую организацию с репозиторием по умолчанию
+	// и возвращает контекст её администратора.
+	NewOrganization func(t *testing.T) context.Context
+}
+
+// Run запускает набор тестов для хранилищ, которые создаёт newBackend.
+// newBackend вызывается в каждом подтесте и может пропустить тест, если бэкенд недоступен.
+func Run(t *testing.T, newBackend func(t *testing.T) Backend) {
+	t.Run("Team", func(t *testing.T) { testTeam(t, newBackend(t)) })
+	t.Run("TeamApplyPlan", func(t *testing.T) { testTeamApplyPlan(t, newBackend(t)) })
+	t.Run("User", func(t *testing.T) { testUser(t, newBackend(t)) })
+	t.Run("PullRequest", func(t *testing.T) { testPullRequest(t, newBackend(t)) })
+	t.Run("PullRequestReviews", func(t *testing.T) { testPullRequestReviews(t, newBackend(t)) })
+	t.Run("PullRequestCounts", func(t *testing.T) { testPullRequestCounts(t, newBackend(t)) })
+	t.Run("TeamAssignments", func(t *testing.T) { testTeamAssignments(t, newBackend(t)) })
+	t.Run("TenantIsolation", func(t *testing.T) { testTenantIsolation(t, newBackend(t)) })
+	t.Run("RequiresPrincipal", func(t *testing.T) { testRequiresPrincipal(t, newBackend(t)) })
+}
+
+func testTeam(t *testing.T, b Backend) {
+	ctx := b.NewOrganization(t)
+
+	members := []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: false},
+	}
+	created, err := b.Teams.CreateWithMembers(ctx, "backend", members)
+	require.NoError(t, err)
+	assert.Equal(t, "backend", created.Name)
+	assert.Equal(t, members, created.Members)
+
+	_, err = b.Teams.CreateWithMembers(ctx, "backend", nil)
+	require.ErrorIs(t, err, repoErr.ErrTeamExists)
+
+	byName, err := b.Teams.GetByName(ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byName.ID)
+	assert.False(t, byName.SLA.Enabled())
+
+	byID, err := b.Teams.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "backend", byID.Name)
+
+	_, err = b.Teams.GetByName(ctx, "mobile")
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	_, err = b.Teams.GetByID(ctx, unknownID)
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	_, err = b.Teams.GetActiveMembersByTeamID(ctx, unknownID)
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	// Неактивные и отсутствующие участники не считаются активными.
+	until := time.Now().Add(time.Hour)
+	_, err = b.Users.SetAwayUntil(ctx, "u2", &until)
+	require.NoError(t, err)
+
+	active, err := b.Teams.GetActiveMembersByTeamID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Member{{ID: "u1", Username: "alice", IsActive: true}}, active)
+
+	updated, err := b.Teams.UpdateSLA(ctx, created.ID,
+		domain.TeamSLA{ReviewHours: 8, Action: domain.SLAActionEscalate, LeadID: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.TeamSLA{ReviewHours: 8, Action: domain.SLAActionEscalate, LeadID: "u1"}, updated.SLA)
+
+	_, err = b.Teams.UpdateSLA(ctx, created.ID, domain.TeamSLA{ReviewHours: 8, Action: domain.SLAActionEscalate, LeadID: "ghost"})
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	_, err = b.Teams.UpdateSLA(ctx, unknownID, domain.TeamSLA{Action: domain.SLAActionNone})
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	// Повторное добавление пользователя переводит его в новую команду и не затирает email пустым.
+	_, err = b.Users.SetEmail(ctx, "u1", "alice@example.com")
+	require.NoError(t, err)
+	_, err = b.Teams.CreateWithMembers(ctx, "mobile", []domain.Member{{ID: "u1", Username: "alice", IsActive: true}})
+	require.NoError(t, err)
+
+	teams, err := b.Teams.ListWithMembers(ctx)
+	require.NoError(t, err)
+	require.Len(t, teams, 2)
+	assert.Equal(t, "backend", teams[0].Name)
+	assert.Equal(t, []domain.Member{
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: false},
+	}, teams[0].Members)
+	assert.Equal(t, "mobile", teams[1].Name)
+	assert.Equal(t, []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+	}, teams[1].Members)
+}
+
+func testTeamApplyPlan(t *testing.T, b Backend) {
+	ctx := b.NewOrganization(t)
+
+	_, err := b.Teams.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	err = b.Teams.ApplyPlan(ctx, domain.TeamPlan{Changes: []domain.TeamChange{
+		{Action: domain.TeamChangeCreateTeam, TeamName: "mobile"},
+		{Action: domain.TeamChangeMoveUser, TeamName: "mobile", FromTeam: "backend",
+			Member: domain.Member{ID: "u1", Username: "alice", IsActive: true}},
+		{Action: domain.TeamChangeAddUser, TeamName: "mobile",
+			Member: domain.Member{ID: "u3", Username: "bob", IsActive: true}},
+	}})
+	require.ErrorIs(t, err, repoErr.ErrUsernameTaken)
+
+	// Ошибка откатывает план целиком.
+	_, err = b.Teams.GetByName(ctx, "mobile")
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	user, err := b.Users.GetByID(ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, "backend", user.TeamName)
+
+	err = b.Teams.ApplyPlan(ctx, domain.TeamPlan{Changes: []domain.TeamChange{
+		{Action: domain.TeamChangeCreateTeam, TeamName: "mobile"},
+		{Action: domain.TeamChangeMoveUser, TeamName: "mobile", FromTeam: "backend",
+			Member: domain.Member{ID: "u1", Username: "alice", IsActive: true}},
+		{Action: domain.TeamChangeDeactivateUser, TeamName: "backend",
+			Member: domain.Member{ID: "u2", Username: "bob", IsActive: true}},
+	}})
+	require.NoError(t, err)
+
+	user, err = b.Users.GetByID(ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, "mobile", user.TeamName)
+
+	user, err = b.Users.GetByID(ctx, "u2")
+	require.NoError(t, err)
+	assert.False(t, user.IsActive)
+
+	err = b.Teams.ApplyPlan(ctx, domain.TeamPlan{Changes: []domain.TeamChange{
+		{Action: domain.TeamChangeDeactivateUser, TeamName: "backend", Member: domain.Member{ID: "ghost"}},
+	}})
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+}
+
+func testUser(t *testing.T, b Backend) {
+	ctx := b.NewOrganization(t)
+
+	team, err := b.Teams.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true, Email: "alice@example.com"},
+		{ID: "u2", Username: "bob", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	user, err := b.Users.GetByID(ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, &domain.User{
+		ID:                "u1",
+		Username:          "alice",
+		IsActive:          true,
+		TeamID:            team.ID,
+		TeamName:          "backend",
+		Email:             "alice@example.com",
+		ReminderFrequency: domain.ReminderDaily,
+	}, user)
+
+	_, err = b.Users.GetByID(ctx, "ghost")
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	members, err := b.Users.ListByTeamID(ctx, team.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+	}, members)
+
+	users, err := b.Users.GetByIDs(ctx, []string{"u2", "ghost"})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "backend", users[0].TeamName)
+
+	user, err = b.Users.SetIsActive(ctx, "u2", false)
+	require.NoError(t, err)
+	assert.False(t, user.IsActive)
+	assert.Equal(t, "backend", user.TeamName)
+
+	user, err = b.Users.SetEmail(ctx, "u1", "")
+	require.NoError(t, err)
+	assert.Empty(t, user.Email)
+
+	_, err = b.Users.SetChatUserID(ctx, "u1", "U100")
+	require.NoError(t, err)
+
+	_, err = b.Users.SetChatUserID(ctx, "u2", "U100")
+	require.ErrorIs(t, err, repoErr.ErrChatUserLinked)
+
+	user, err = b.Users.GetByChatID(ctx, "U100")
+	require.NoError(t, err)
+	assert.Equal(t, "u1", user.ID)
+
+	_, err = b.Users.SetChatUserID(ctx, "u1", "")
+	require.NoError(t, err)
+
+	_, err = b.Users.GetByChatID(ctx, "U100")
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	until := time.Date(2030, time.January, 2, 15, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60))
+	user, err = b.Users.SetAwayUntil(ctx, "u1", &until)
+	require.NoError(t, err)
+	require.NotNil(t, user.AwayUntil)
+	assert.True(t, until.Equal(*user.AwayUntil))
+
+	user, err = b.Users.SetAwayUntil(ctx, "u1", nil)
+	require.NoError(t, err)
+	assert.Nil(t, user.AwayUntil)
+}
+
+func testPullRequest(t *testing.T, b Backend) {
+	ctx := b.NewOrganization(t)
+	repositoryID := seedTeam(t, ctx, b)
+
+	created, err := b.PullRequests.Create(ctx, &domain.PullRequest{
+		RepositoryID: repositoryID,
+		ID:           "pr-1",
+		Name:         "Add search",
+		AuthorID:     "u1",
+		Reviewers:    []string{"u3", "u2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.PRStatusOpen, created.Status)
+	assert.Equal(t, domain.DefaultRepositoryName, created.Repository)
+	assert.Equal(t, []string{"u3", "u2"}, created.Reviewers)
+
+	_, err = b.PullRequests.Create(ctx, &domain.PullRequest{RepositoryID: repositoryID, ID: "pr-1", AuthorID: "u1"})
+	require.ErrorIs(t, err, repoErr.ErrPRExists)
+
+	pr, err := b.PullRequests.GetByID(ctx, repositoryID, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Add search", pr.Name)
+	assert.Equal(t, []string{"u2", "u3"}, pr.Reviewers)
+	assert.True(t, pr.InNeedMoreReviewers)
+	assert.Nil(t, pr.MergedAt)
+
+	_, err = b.PullRequests.GetByID(ctx, repositoryID, "pr-404")
+	require.ErrorIs(t, err, repoErr.ErrPRNotFound)
+
+	_, err = b.PullRequests.Create(ctx, &domain.PullRequest{
+		RepositoryID: repositoryID, ID: "pr-2", Name: "Fix login", AuthorID: "u2",
+	})
+	require.NoError(t, err)
+
+	pr, err = b.PullRequests.GetByID(ctx, repositoryID, "pr-2")
+	require.NoError(t, err)
+	assert.Empty(t, pr.Reviewers)
+
+	errChoose := errors.New("no candidates")
+	_, _, err = b.PullRequests.ReassignReviewer(ctx, repositoryID, "pr-1", "u2",
+		func(*domain.PullRequest) (string, error) { return "", errChoose })
+	require.ErrorIs(t, err, errChoose)
+
+	_, _, err = b.PullRequests.ReassignReviewer(ctx, repositoryID, "pr-404", "u2",
+		func(*domain.PullRequest) (string, error) { return "u4", nil })
+	require.ErrorIs(t, err, repoErr.ErrPRNotFound)
+
+	_, _, err = b.PullRequests.ReassignReviewer(ctx, repositoryID, "pr-1", "u1",
+		func(*domain.PullRequest) (string, error) { return "u4", nil })
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	reassigned, newReviewerID, err := b.PullRequests.ReassignReviewer(ctx, repositoryID, "pr-1", "u2",
+		func(current *domain.PullRequest) (string, error) {
+			assert.Equal(t, []string{"u2", "u3"}, current.Reviewers)
+
+			return "u4", nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "u4", newReviewerID)
+	assert.Equal(t, []string{"u3", "u4"}, reassigned.Reviewers)
+
+	err = b.PullRequests.AddReviewer(ctx, repositoryID, "pr-2", "u3")
+	require.NoError(t, err)
+	err = b.PullRequests.AddReviewer(ctx, repositoryID, "pr-2", "u3")
+	require.NoError(t, err)
+	err = b.PullRequests.AddReviewer(ctx, repositoryID, "pr-2", "ghost")
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	assigned, err := b.PullRequests.ListByReviewer(ctx, "u3")
+	require.NoError(t, err)
+	require.Len(t, assigned, 2)
+	assert.Equal(t, "pr-1", assigned[0].ID)
+	assert.Equal(t, "pr-2", assigned[1].ID)
+
+	merged, err := b.PullRequests.SetMerged(ctx, repositoryID, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.PRStatusMerged, merged.Status)
+	assert.NotNil(t, merged.MergedAt)
+
+	_, err = b.PullRequests.SetMerged(ctx, repositoryID, "pr-404")
+	require.ErrorIs(t, err, repoErr.ErrPRNotFound)
+
+	open, err := b.PullRequests.ListByRepository(ctx, repositoryID, domain.PRStatusOpen)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	assert.Equal(t, "pr-2", open[0].ID)
+
+	all, err := b.PullRequests.ListByRepository(ctx, repositoryID, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+}
+
+func testPullRequestReviews(t *testing.T, b Backend) {
+	ctx := b.NewOrganization(t)
+	repositoryID := seedTeam(t, ctx, b)
+
+	_, err := b.PullRequests.Create(ctx, &domain.PullRequest{
+		RepositoryID: repositoryID, ID: "pr-1", Name: "Add search", AuthorID: "u1", Reviewers: []string{"u2", "u3"},
+	})
+	require.NoError(t, err)
+
+	_, err = b.PullRequests.AddReview(ctx, &domain.Review{
+		RepositoryID: repositoryID, PullRequestID: "pr-1", ReviewerID: "u4", State: domain.ReviewStateApproved,
+	})
+	require.ErrorIs(t, err, repoErr.ErrReviewerNotAssigned)
+
+	approved, err := b.PullRequests.IsApproved(ctx, repositoryID, "pr-1")
+	require.NoError(t, err)
+	assert.False(t, approved)
+
+	for _, reviewerID := range []string{"u2", "u3"} {
+		review, err := b.PullRequests.AddReview(ctx, &domain.Review{
+			RepositoryID: repositoryID, PullRequestID: "pr-1", ReviewerID: reviewerID, State: domain.ReviewStateApproved,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, reviewerID, review.ReviewerID)
+		assert.False(t, review.SubmittedAt.IsZero())
+	}
+
+	approved, err = b.PullRequests.IsApproved(ctx, repositoryID, "pr-1")
+	require.NoError(t, err)
+	assert.True(t, approved)
+
+	// Последнее ревью ревьювера отменяет его одобрение.
+	_, err = b.PullRequests.AddReview(ctx, &domain.Review{
+		RepositoryID: repositoryID, PullRequestID: "pr-1", ReviewerID: "u3", State: domain.ReviewStateChangesRequested,
+	})
+	require.NoError(t, err)
+
+	approved, err = b.PullRequests.IsApproved(ctx, repositoryID, "pr-1")
+	require.NoError(t, err)
+	assert.False(t, approved)
+
+	err = b.PullRequests.MarkOverdue(ctx, repositoryID, "pr-1", "u2")
+	require.NoError(t, err)
+
+	err = b.PullRequests.MarkOverdue(ctx, repositoryID, "pr-1", "u4")
+	require.ErrorIs(t, err, repoErr.ErrReviewerNotAssigned)
+}
+
+func testPullRequestCounts(t *testing.T, b Backend) {
+	ctx := b.NewOrganization(t)
+
+	counts, err := b.Stats.PullRequestCounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PullRequestCounts{}, counts)
+
+	_, err = b.Teams.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repo, err := b.Repositories.GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	prs := []domain.PullRequest{
+		{ID: "pr-1", AuthorID: "u1", Reviewers: []string{"u2"}},
+		{ID: "pr-2", AuthorID: "u1"},
+		{ID: "pr-3", AuthorID: "u1"},
+		{ID: "pr-4", AuthorID: "u2", Reviewers: []string{"u1"}},
+	}
+	for _, pr := range prs {
+		pr.RepositoryID = repo.ID
+		pr.Name = pr.ID
+		_, err = b.PullRequests.Create(ctx, &pr)
+		require.NoError(t, err)
+	}
+
+	// Смердженные Pull Request'ы не считаются открытыми, даже если ревьюверов у них нет.
+	_, err = b.PullRequests.SetMerged(ctx, repo.ID, "pr-3")
+	require.NoError(t, err)
+	_, err = b.PullRequests.SetMerged(ctx, repo.ID, "pr-4")
+	require.NoError(t, err)
+
+	counts, err = b.Stats.PullRequestCounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PullRequestCounts{Open: 2, WithoutReviewers: 1}, counts)
+
+	// Данные другой организации не учитываются.
+	counts, err = b.Stats.PullRequestCounts(b.NewOrganization(t))
+	require.NoError(t, err)
+	assert.Equal(t, domain.PullRequestCounts{}, counts)
+}
+
+func testTeamAssignments(t *testing.T, b Backend) {
+	ctx := b.NewOrganization(t)
+	repoID := seedTeam(t, ctx, b)
+
+	prs := []domain.PullRequest{
+		{ID: "pr-1", AuthorID: "u1", Reviewers: []string{"u2", "u3"}},
+		{ID: "pr-2", AuthorID: "u1", Reviewers: []string{"u2"}},
+	}
+	for _, pr := range prs {
+		pr.RepositoryID = repoID
+		pr.Name = pr.ID
+		_, err := b.PullRequests.Create(ctx, &pr)
+		require.NoError(t, err)
+	}
+
+	teams, err := b.Stats.TeamAssignments(ctx, domain.StatsFilter{})
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+
+	// u1 и u4 не получили назначений: отношение не определено, но видно, сколько участников простаивает.
+	assert.Equal(t, domain.TeamStats{
+		TeamName:       "backend",
+		Members:        4,
+		Assignments:    3,
+		MaxAssignments: 2,
+		MinAssignments: 0,
+		AvgAssignments: 0.75,
+		IdleMembers:    2,
+	}, teams[0])
+	assert.Nil(t, teams[0].MaxMinRatio())
+}
+
+func testTenantIsolation(t *testing.T, b Backend) {
+	orgA := b.NewOrganization(t)
+	orgB := b.NewOrganization(t)
+	repositoryA := seedTeam(t, orgA, b)
+
+	_, err := b.PullRequests.Create(orgA, &domain.PullRequest{
+		RepositoryID: repositoryA, ID: "pr-1", Name: "Add search", AuthorID: "u1", Reviewers: []string{"u2"},
+	})
+	require.NoError(t, err)
+
+	_, err = b.Teams.GetByName(orgB, "backend")
+	require.ErrorIs(t, err, repoErr.ErrTeamNotFound)
+
+	_, err = b.Users.GetByID(orgB, "u1")
+	require.ErrorIs(t, err, repoErr.ErrUserNotFound)
+
+	_, err = b.PullRequests.GetByID(orgB, repositoryA, "pr-1")
+	require.ErrorIs(t, err, repoErr.ErrPRNotFound)
+
+	_, err = b.PullRequests.SetMerged(orgB, repositoryA, "pr-1")
+	require.ErrorIs(t, err, repoErr.ErrPRNotFound)
+
+	listed, err := b.PullRequests.ListByReviewer(orgB, "u2")
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	// Имена команд и идентификаторы пользователей уникальны только в пределах организации.
+	_, err = b.Teams.CreateWithMembers(orgB, "backend", []domain.Member{{ID: "u1", Username: "bob", IsActive: true}})
+	require.NoError(t, err)
+
+	userA, err := b.Users.GetByID(orgA, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", userA.Username)
+}
+
+func testRequiresPrincipal(t *testing.T, b Backend) {
+	ctx := context.Background()
+
+	_, err := b.Teams.CreateWithMembers(ctx, "backend", nil)
+	require.ErrorIs(t, err, tenant.ErrNoPrincipal)
+
+	_, err = b.Users.GetByID(ctx, "u1")
+	require.ErrorIs(t, err, tenant.ErrNoPrincipal)
+
+	_, err = b.PullRequests.GetByID(ctx, unknownID, "pr-1")
+	require.ErrorIs(t, err, tenant.ErrNoPrincipal)
+}
+
+// seedTeam создаёт команду backend с участниками u1–u4 и возвращает ID репозитория по умолчанию.
+func seedTeam(t *testing.T, ctx context.Context, b Backend) string {
+	t.Helper()
+
+	_, err := b.Teams.CreateWithMembers(ctx, "backend", []domain.Member{
+		{ID: "u1", Username: "alice", IsActive: true},
+		{ID: "u2", Username: "bob", IsActive: true},
+		{ID: "u3", Username: "carol", IsActive: true},
+		{ID: "u4", Username: "dave", IsActive: true},
+	})
+	require.NoError(t, err)
+
+	repository, err := b.Repositories.GetByName(ctx, domain.DefaultRepositoryName)
+	require.NoError(t, err)
+
+	return repository.ID
+}
@@ -0,0 +1,38 @@
+// Package tenant передаёт аутентифицированного субъекта запроса через context.Context.
+// Хранилища берут из контекста организацию и ограничивают ею каждый запрос к данным,
+// поэтому контекст без субъекта не даёт доступа ни к одной организации.
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+var ErrNoPrincipal = errors.New("principal is missing in context")
+
+type principalKey struct{}
+
+// WithPrincipal возвращает копию контекста с субъектом запроса.
+func WithPrincipal(ctx context.Context, principal domain.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom возвращает субъекта запроса из контекста.
+func PrincipalFrom(ctx context.Context) (domain.Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(domain.Principal)
+
+	return principal, ok
+}
+
+// OrgID возвращает идентификатор организации субъекта запроса.
+// Если субъект в контексте отсутствует, возвращается ошибка ErrNoPrincipal.
+func OrgID(ctx context.Context) (string, error) {
+	principal, ok := PrincipalFrom(ctx)
+	if !ok || principal.OrgID == "" {
+		return "", ErrNoPrincipal
+	}
+
+	return principal.OrgID, nil
+}
@@ -0,0 +1,24 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/domain"
+)
+
+func TestOrgID(t *testing.T) {
+	_, err := OrgID(context.Background())
+	require.ErrorIs(t, err, ErrNoPrincipal)
+
+	_, err = OrgID(WithPrincipal(context.Background(), domain.Principal{Role: domain.RoleAdmin}))
+	require.ErrorIs(t, err, ErrNoPrincipal)
+
+	ctx := WithPrincipal(context.Background(), domain.Principal{OrgID: "org-1", Role: domain.RoleMember})
+	orgID, err := OrgID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "org-1", orgID)
+}
@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LogHandler добавляет к записям лога trace_id и span_id span'а из контекста записи,
+// чтобы строки одного запроса можно было найти по трассировке.
+//
+// Идентификаторы пишутся на верхний уровень записи, а не в группу логгера:
+// для этого вызовы WithAttrs и WithGroup запоминаются и повторяются поверх
+// обработчика, в который уже добавлены идентификаторы.
+type LogHandler struct {
+	base    slog.Handler
+	steps   []func(slog.Handler) slog.Handler
+	handler slog.Handler
+}
+
+func NewLogHandler(h slog.Handler) *LogHandler {
+	return &LogHandler{
+		base:    h,
+		handler: h,
+	}
+}
+
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *LogHandler) Handle(ctx context.Context, r slog.Record) error {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return h.handler.Handle(ctx, r)
+	}
+
+	handler := h.base.WithAttrs([]slog.Attr{
+		slog.String("trace_id", sc.TraceID().String()),
+		slog.String("span_id", sc.SpanID().String()),
+	})
+	for _, step := range h.steps {
+		handler = step(handler)
+	}
+
+	return handler.Handle(ctx, r)
+}
+
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(next slog.Handler) slog.Handler {
+		return next.WithAttrs(attrs)
+	})
+}
+
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return h.with(func(next slog.Handler) slog.Handler {
+		return next.WithGroup(name)
+	})
+}
+
+func (h *LogHandler) with(step func(slog.Handler) slog.Handler) *LogHandler {
+	steps := make([]func(slog.Handler) slog.Handler, 0, len(h.steps)+1)
+	steps = append(steps, h.steps...)
+	steps = append(steps, step)
+
+	return &LogHandler{
+		base:    h.base,
+		steps:   steps,
+		handler: step(h.handler),
+	}
+}
@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLogHandler(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		wantTrace bool
+	}{
+		{
+			name:      "span in context",
+			ctx:       trace.ContextWithSpanContext(context.Background(), sc),
+			wantTrace: true,
+		},
+		{
+			name:      "no span",
+			ctx:       context.Background(),
+			wantTrace: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			lgr := slog.New(NewLogHandler(slog.NewJSONHandler(&buf, nil))).
+				WithGroup("service").
+				With(slog.String("op", "team.CreateTeam"))
+
+			lgr.InfoContext(tt.ctx, "team created", slog.String("team", "backend"))
+
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+
+			// Атрибуты логгера остаются в его группе, а идентификаторы трассировки — на верхнем уровне.
+			assert.Equal(t, map[string]any{"op": "team.CreateTeam", "team": "backend"}, got["service"])
+			if tt.wantTrace {
+				assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", got["trace_id"])
+				assert.Equal(t, "00f067aa0ba902b7", got["span_id"])
+			} else {
+				assert.NotContains(t, got, "trace_id")
+				assert.NotContains(t, got, "span_id")
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Package tracing связывает HTTP-запросы, методы сервисов и запросы к базе в трассировки
+// OpenTelemetry и добавляет идентификаторы трассировки в логи.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "avitotech-pr-reviewer"
+
+// Start начинает дочерний span с именем op — тем же, что метод сервиса пишет в логи.
+// Если экспорт трассировок выключен, span ничего не записывает, но сохраняет
+// идентификаторы трассировки вызывающего сервиса.
+func Start(ctx context.Context, op string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, op)
+}
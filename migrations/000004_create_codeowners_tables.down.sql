@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS codeowners_owners;
+DROP TABLE IF EXISTS codeowners_rules;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS codeowners_rules (
+    repository VARCHAR(100) NOT NULL,
+    position INT NOT NULL,
+    pattern TEXT NOT NULL,
+    CONSTRAINT pk_codeowners_rules PRIMARY KEY (repository, position)
+);
+
+CREATE TABLE IF NOT EXISTS codeowners_owners (
+    repository VARCHAR(100) NOT NULL,
+    position INT NOT NULL,
+    user_id VARCHAR(50),
+    team_id UUID,
+    CONSTRAINT fk_codeowners_owners_rule FOREIGN KEY (repository, position)
+        REFERENCES codeowners_rules(repository, position) ON DELETE CASCADE,
+    CONSTRAINT fk_codeowners_owners_user FOREIGN KEY (user_id)
+        REFERENCES users(user_id) ON DELETE CASCADE,
+    CONSTRAINT fk_codeowners_owners_team FOREIGN KEY (team_id)
+        REFERENCES teams(team_id) ON DELETE CASCADE,
+    CONSTRAINT chk_codeowners_owner CHECK ((user_id IS NULL) <> (team_id IS NULL))
+);
+
+CREATE INDEX idx_codeowners_owners_rule ON codeowners_owners(repository, position);
@@ -0,0 +1,51 @@
+ALTER TABLE codeowners_owners DROP CONSTRAINT fk_codeowners_owners_rule;
+ALTER TABLE codeowners_rules DROP CONSTRAINT pk_codeowners_rules;
+DROP INDEX IF EXISTS idx_codeowners_owners_rule;
+
+ALTER TABLE codeowners_rules ADD COLUMN repository VARCHAR(100);
+UPDATE codeowners_rules cr
+SET repository = r.repository_name
+FROM repositories r
+WHERE r.repository_id = cr.repository_id;
+
+ALTER TABLE codeowners_owners ADD COLUMN repository VARCHAR(100);
+UPDATE codeowners_owners co
+SET repository = r.repository_name
+FROM repositories r
+WHERE r.repository_id = co.repository_id;
+
+ALTER TABLE codeowners_rules
+    DROP COLUMN repository_id,
+    ALTER COLUMN repository SET NOT NULL,
+    ADD CONSTRAINT pk_codeowners_rules PRIMARY KEY (repository, position);
+
+ALTER TABLE codeowners_owners
+    DROP COLUMN repository_id,
+    ALTER COLUMN repository SET NOT NULL,
+    ADD CONSTRAINT fk_codeowners_owners_rule FOREIGN KEY (repository, position)
+        REFERENCES codeowners_rules(repository, position) ON DELETE CASCADE;
+
+CREATE INDEX idx_codeowners_owners_rule ON codeowners_owners(repository, position);
+
+-- Вне репозитория по умолчанию идентификаторы PR могут повторяться,
+-- такие PR не переживут откат.
+DELETE FROM pull_requests
+WHERE repository_id <> (SELECT repository_id FROM repositories WHERE repository_name = 'default');
+
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer;
+
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT fk_pull_request_reviewers_pr,
+    DROP CONSTRAINT pk_pull_request_reviews;
+ALTER TABLE pull_requests DROP CONSTRAINT pk_pull_requests;
+
+ALTER TABLE pull_requests
+    DROP COLUMN repository_id,
+    ADD CONSTRAINT pull_requests_pkey PRIMARY KEY (pull_request_id);
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN repository_id,
+    ADD CONSTRAINT pk_pull_request_reviews PRIMARY KEY (pull_request_id, reviewer_id),
+    ADD CONSTRAINT pull_request_reviewers_pull_request_id_fkey FOREIGN KEY (pull_request_id)
+        REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE;
+
+DROP TABLE IF EXISTS repositories;
@@ -0,0 +1,75 @@
+CREATE TABLE IF NOT EXISTS repositories (
+    repository_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    repository_name VARCHAR(100) UNIQUE NOT NULL,
+    team_id UUID REFERENCES teams(team_id) ON DELETE SET NULL,
+    max_reviewers INT CHECK (max_reviewers >= 0)
+);
+
+-- Репозиторий по умолчанию: в него попадают PR, созданные без указания репозитория.
+INSERT INTO repositories (repository_name) VALUES ('default');
+
+INSERT INTO repositories (repository_name)
+SELECT DISTINCT repository FROM codeowners_rules
+ON CONFLICT (repository_name) DO NOTHING;
+
+-- Pull Request идентифицируется парой (репозиторий, pull_request_id).
+ALTER TABLE pull_requests ADD COLUMN repository_id UUID;
+UPDATE pull_requests
+SET repository_id = (SELECT repository_id FROM repositories WHERE repository_name = 'default');
+ALTER TABLE pull_requests
+    ALTER COLUMN repository_id SET NOT NULL,
+    ADD CONSTRAINT fk_pull_requests_repository FOREIGN KEY (repository_id)
+        REFERENCES repositories(repository_id) ON DELETE CASCADE;
+
+ALTER TABLE pull_request_reviewers ADD COLUMN repository_id UUID;
+UPDATE pull_request_reviewers prr
+SET repository_id = pr.repository_id
+FROM pull_requests pr
+WHERE pr.pull_request_id = prr.pull_request_id;
+ALTER TABLE pull_request_reviewers ALTER COLUMN repository_id SET NOT NULL;
+
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT pull_request_reviewers_pull_request_id_fkey,
+    DROP CONSTRAINT pk_pull_request_reviews;
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_pkey;
+
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pk_pull_requests PRIMARY KEY (repository_id, pull_request_id);
+ALTER TABLE pull_request_reviewers
+    ADD CONSTRAINT pk_pull_request_reviews PRIMARY KEY (repository_id, pull_request_id, reviewer_id),
+    ADD CONSTRAINT fk_pull_request_reviewers_pr FOREIGN KEY (repository_id, pull_request_id)
+        REFERENCES pull_requests(repository_id, pull_request_id) ON DELETE CASCADE;
+
+CREATE INDEX idx_pr_reviewers_reviewer ON pull_request_reviewers(reviewer_id);
+
+-- Правила CODEOWNERS привязываются к репозиторию по идентификатору.
+ALTER TABLE codeowners_owners DROP CONSTRAINT fk_codeowners_owners_rule;
+ALTER TABLE codeowners_rules DROP CONSTRAINT pk_codeowners_rules;
+DROP INDEX IF EXISTS idx_codeowners_owners_rule;
+
+ALTER TABLE codeowners_rules ADD COLUMN repository_id UUID;
+UPDATE codeowners_rules cr
+SET repository_id = r.repository_id
+FROM repositories r
+WHERE r.repository_name = cr.repository;
+
+ALTER TABLE codeowners_owners ADD COLUMN repository_id UUID;
+UPDATE codeowners_owners co
+SET repository_id = r.repository_id
+FROM repositories r
+WHERE r.repository_name = co.repository;
+
+ALTER TABLE codeowners_rules
+    DROP COLUMN repository,
+    ALTER COLUMN repository_id SET NOT NULL,
+    ADD CONSTRAINT pk_codeowners_rules PRIMARY KEY (repository_id, position),
+    ADD CONSTRAINT fk_codeowners_rules_repository FOREIGN KEY (repository_id)
+        REFERENCES repositories(repository_id) ON DELETE CASCADE;
+
+ALTER TABLE codeowners_owners
+    DROP COLUMN repository,
+    ALTER COLUMN repository_id SET NOT NULL,
+    ADD CONSTRAINT fk_codeowners_owners_rule FOREIGN KEY (repository_id, position)
+        REFERENCES codeowners_rules(repository_id, position) ON DELETE CASCADE;
+
+CREATE INDEX idx_codeowners_owners_rule ON codeowners_owners(repository_id, position);
@@ -0,0 +1,53 @@
+-- Данные организаций, кроме организации по умолчанию, не переживут откат.
+DELETE FROM organizations WHERE org_id <> '00000000-0000-0000-0000-000000000001';
+
+ALTER TABLE codeowners_owners DROP CONSTRAINT fk_codeowners_owners_user;
+ALTER TABLE pull_request_reviewers DROP CONSTRAINT fk_pull_request_reviewers_reviewer;
+ALTER TABLE pull_requests
+    DROP CONSTRAINT fk_pull_requests_author,
+    DROP CONSTRAINT fk_pull_requests_org_repository;
+ALTER TABLE users DROP CONSTRAINT fk_users_team;
+
+DROP INDEX IF EXISTS idx_pr_author;
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer;
+
+ALTER TABLE codeowners_owners DROP COLUMN org_id;
+ALTER TABLE pull_request_reviewers DROP COLUMN org_id;
+ALTER TABLE pull_requests DROP COLUMN org_id;
+
+ALTER TABLE repositories
+    DROP CONSTRAINT uq_repositories_org_id,
+    DROP CONSTRAINT uq_repositories_org_name,
+    DROP COLUMN org_id,
+    ADD CONSTRAINT repositories_repository_name_key UNIQUE (repository_name);
+
+ALTER TABLE users
+    DROP CONSTRAINT pk_users,
+    DROP CONSTRAINT uq_users_org_username,
+    DROP COLUMN org_id,
+    ADD CONSTRAINT users_pkey PRIMARY KEY (user_id),
+    ADD CONSTRAINT users_username_key UNIQUE (username);
+
+ALTER TABLE teams
+    DROP CONSTRAINT uq_teams_org_id,
+    DROP CONSTRAINT uq_teams_org_name,
+    DROP COLUMN org_id,
+    ADD CONSTRAINT teams_team_name_key UNIQUE (team_name);
+
+ALTER TABLE users
+    ADD CONSTRAINT users_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(team_id);
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pull_requests_author_id_fkey FOREIGN KEY (author_id)
+        REFERENCES users(user_id) ON DELETE CASCADE;
+ALTER TABLE pull_request_reviewers
+    ADD CONSTRAINT pull_request_reviewers_reviewer_id_fkey FOREIGN KEY (reviewer_id)
+        REFERENCES users(user_id) ON DELETE CASCADE;
+ALTER TABLE codeowners_owners
+    ADD CONSTRAINT fk_codeowners_owners_user FOREIGN KEY (user_id)
+        REFERENCES users(user_id) ON DELETE CASCADE;
+
+CREATE INDEX idx_pr_author ON pull_requests(author_id);
+CREATE INDEX idx_pr_reviewers_reviewer ON pull_request_reviewers(reviewer_id);
+
+DROP TABLE IF EXISTS organization_tokens;
+DROP TABLE IF EXISTS organizations;
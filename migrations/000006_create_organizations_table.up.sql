@@ -0,0 +1,86 @@
+CREATE TABLE IF NOT EXISTS organizations (
+    org_id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    org_name VARCHAR(100) UNIQUE NOT NULL
+);
+
+-- Организация по умолчанию: в неё попадают данные, созданные до появления организаций.
+INSERT INTO organizations (org_id, org_name)
+VALUES ('00000000-0000-0000-0000-000000000001', 'default');
+
+-- Токены хранятся только в виде SHA-256 хеша.
+CREATE TABLE IF NOT EXISTS organization_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    org_id UUID NOT NULL REFERENCES organizations(org_id) ON DELETE CASCADE,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('admin', 'member')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Внешние ключи на пользователей пересоздаются составными (org_id, user_id).
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_author_id_fkey;
+ALTER TABLE pull_request_reviewers DROP CONSTRAINT pull_request_reviewers_reviewer_id_fkey;
+ALTER TABLE codeowners_owners DROP CONSTRAINT fk_codeowners_owners_user;
+ALTER TABLE users DROP CONSTRAINT users_team_id_fkey;
+
+-- Команды: имя уникально в пределах организации.
+ALTER TABLE teams ADD COLUMN org_id UUID;
+UPDATE teams SET org_id = '00000000-0000-0000-0000-000000000001';
+ALTER TABLE teams
+    ALTER COLUMN org_id SET NOT NULL,
+    DROP CONSTRAINT teams_team_name_key,
+    ADD CONSTRAINT fk_teams_org FOREIGN KEY (org_id)
+        REFERENCES organizations(org_id) ON DELETE CASCADE,
+    ADD CONSTRAINT uq_teams_org_name UNIQUE (org_id, team_name),
+    ADD CONSTRAINT uq_teams_org_id UNIQUE (org_id, team_id);
+
+-- Пользователи: идентификатор и имя уникальны в пределах организации.
+ALTER TABLE users ADD COLUMN org_id UUID;
+UPDATE users SET org_id = '00000000-0000-0000-0000-000000000001';
+ALTER TABLE users
+    ALTER COLUMN org_id SET NOT NULL,
+    DROP CONSTRAINT users_pkey,
+    DROP CONSTRAINT users_username_key,
+    ADD CONSTRAINT pk_users PRIMARY KEY (org_id, user_id),
+    ADD CONSTRAINT uq_users_org_username UNIQUE (org_id, username),
+    ADD CONSTRAINT fk_users_org FOREIGN KEY (org_id)
+        REFERENCES organizations(org_id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_users_team FOREIGN KEY (org_id, team_id)
+        REFERENCES teams(org_id, team_id);
+
+-- Репозитории: имя уникально в пределах организации.
+ALTER TABLE repositories ADD COLUMN org_id UUID;
+UPDATE repositories SET org_id = '00000000-0000-0000-0000-000000000001';
+ALTER TABLE repositories
+    ALTER COLUMN org_id SET NOT NULL,
+    DROP CONSTRAINT repositories_repository_name_key,
+    ADD CONSTRAINT fk_repositories_org FOREIGN KEY (org_id)
+        REFERENCES organizations(org_id) ON DELETE CASCADE,
+    ADD CONSTRAINT uq_repositories_org_name UNIQUE (org_id, repository_name),
+    ADD CONSTRAINT uq_repositories_org_id UNIQUE (org_id, repository_id);
+
+ALTER TABLE pull_requests ADD COLUMN org_id UUID;
+UPDATE pull_requests SET org_id = '00000000-0000-0000-0000-000000000001';
+ALTER TABLE pull_requests
+    ALTER COLUMN org_id SET NOT NULL,
+    ADD CONSTRAINT fk_pull_requests_org_repository FOREIGN KEY (org_id, repository_id)
+        REFERENCES repositories(org_id, repository_id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_pull_requests_author FOREIGN KEY (org_id, author_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+
+ALTER TABLE pull_request_reviewers ADD COLUMN org_id UUID;
+UPDATE pull_request_reviewers SET org_id = '00000000-0000-0000-0000-000000000001';
+ALTER TABLE pull_request_reviewers
+    ALTER COLUMN org_id SET NOT NULL,
+    ADD CONSTRAINT fk_pull_request_reviewers_reviewer FOREIGN KEY (org_id, reviewer_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_pr_author;
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer;
+CREATE INDEX idx_pr_author ON pull_requests(org_id, author_id);
+CREATE INDEX idx_pr_reviewers_reviewer ON pull_request_reviewers(org_id, reviewer_id);
+
+ALTER TABLE codeowners_owners ADD COLUMN org_id UUID;
+UPDATE codeowners_owners SET org_id = '00000000-0000-0000-0000-000000000001';
+ALTER TABLE codeowners_owners
+    ALTER COLUMN org_id SET NOT NULL,
+    ADD CONSTRAINT fk_codeowners_owners_user FOREIGN KEY (org_id, user_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
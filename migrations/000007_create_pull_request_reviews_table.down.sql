@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS pull_request_reviews;
+
+ALTER TABLE pull_request_reviewers DROP COLUMN assigned_at;
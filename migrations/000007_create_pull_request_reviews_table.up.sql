@@ -0,0 +1,24 @@
+ALTER TABLE pull_request_reviewers ADD COLUMN assigned_at TIMESTAMP;
+UPDATE pull_request_reviewers prr
+SET assigned_at = pr.created_at
+FROM pull_requests pr
+WHERE pr.repository_id = prr.repository_id AND pr.pull_request_id = prr.pull_request_id;
+ALTER TABLE pull_request_reviewers
+    ALTER COLUMN assigned_at SET NOT NULL,
+    ALTER COLUMN assigned_at SET DEFAULT CURRENT_TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS pull_request_reviews (
+    review_id BIGSERIAL PRIMARY KEY,
+    org_id UUID NOT NULL,
+    repository_id UUID NOT NULL,
+    pull_request_id VARCHAR(50) NOT NULL,
+    reviewer_id VARCHAR(50) NOT NULL,
+    state VARCHAR(20) NOT NULL CHECK (state IN ('COMMENTED', 'CHANGES_REQUESTED', 'APPROVED')),
+    submitted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_pull_request_reviews_pr FOREIGN KEY (repository_id, pull_request_id)
+        REFERENCES pull_requests(repository_id, pull_request_id) ON DELETE CASCADE,
+    CONSTRAINT fk_pull_request_reviews_reviewer FOREIGN KEY (org_id, reviewer_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_pr_reviews_pr ON pull_request_reviews(repository_id, pull_request_id, submitted_at);
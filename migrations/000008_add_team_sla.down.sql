@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_overdue;
+
+ALTER TABLE pull_request_reviewers DROP COLUMN overdue_at;
+
+ALTER TABLE teams
+    DROP CONSTRAINT fk_teams_lead,
+    DROP COLUMN lead_id,
+    DROP COLUMN sla_action,
+    DROP COLUMN review_sla_hours;
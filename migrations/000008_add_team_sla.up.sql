@@ -0,0 +1,12 @@
+ALTER TABLE teams
+    ADD COLUMN review_sla_hours INT CHECK (review_sla_hours > 0),
+    ADD COLUMN sla_action VARCHAR(16) NOT NULL DEFAULT 'none'
+        CHECK (sla_action IN ('none', 'reassign', 'escalate')),
+    ADD COLUMN lead_id VARCHAR(50),
+    ADD CONSTRAINT fk_teams_lead FOREIGN KEY (org_id, lead_id)
+        REFERENCES users(org_id, user_id);
+
+ALTER TABLE pull_request_reviewers ADD COLUMN overdue_at TIMESTAMP;
+
+CREATE INDEX idx_pr_reviewers_overdue ON pull_request_reviewers(org_id, overdue_at)
+    WHERE overdue_at IS NOT NULL;
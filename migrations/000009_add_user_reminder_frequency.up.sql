@@ -0,0 +1,3 @@
+ALTER TABLE users
+    ADD COLUMN reminder_frequency VARCHAR(16) NOT NULL DEFAULT 'daily'
+        CHECK (reminder_frequency IN ('off', 'daily', 'weekly'));
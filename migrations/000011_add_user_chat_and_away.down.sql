@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_users_chat_user_id;
+
+ALTER TABLE users
+    DROP COLUMN away_until,
+    DROP COLUMN chat_user_id;
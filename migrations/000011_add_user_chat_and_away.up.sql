@@ -0,0 +1,6 @@
+ALTER TABLE users
+    ADD COLUMN chat_user_id VARCHAR(64),
+    ADD COLUMN away_until TIMESTAMP;
+
+CREATE UNIQUE INDEX idx_users_chat_user_id ON users(org_id, chat_user_id)
+    WHERE chat_user_id IS NOT NULL;
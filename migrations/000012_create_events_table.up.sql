@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS events (
+    event_id BIGSERIAL PRIMARY KEY,
+    org_id UUID NOT NULL REFERENCES organizations(org_id) ON DELETE CASCADE,
+    event_type VARCHAR(64) NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    repository_name VARCHAR(100) NOT NULL DEFAULT '',
+    pull_request_id VARCHAR(50) NOT NULL DEFAULT '',
+    user_ids TEXT[] NOT NULL DEFAULT '{}',
+    payload JSONB NOT NULL
+);
+
+CREATE INDEX idx_events_org ON events(org_id, event_id);
+CREATE INDEX idx_events_occurred_at ON events(org_id, occurred_at);
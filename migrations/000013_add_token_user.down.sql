@@ -0,0 +1,2 @@
+ALTER TABLE organization_tokens
+    DROP COLUMN user_id;
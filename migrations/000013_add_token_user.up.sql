@@ -0,0 +1,6 @@
+-- Токен участника может быть выдан конкретному пользователю: с таким токеном
+-- пользователь меняет собственные настройки, например частоту напоминаний.
+ALTER TABLE organization_tokens
+    ADD COLUMN user_id VARCHAR(50),
+    ADD CONSTRAINT fk_organization_tokens_user FOREIGN KEY (org_id, user_id)
+        REFERENCES users(org_id, user_id) ON DELETE CASCADE;
@@ -0,0 +1,37 @@
+// Package migrations встраивает SQL-миграции PostgreSQL в бинарник сервиса.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// FS содержит файлы миграций в формате golang-migrate.
+//
+//go:embed *.sql
+var FS embed.FS
+
+// Latest возвращает версию последней встроенной миграции — версию схемы,
+// которую ожидает этот бинарник.
+func Latest() (uint, error) {
+	ups, err := fs.Glob(FS, "*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint
+	for _, up := range ups {
+		prefix, _, _ := strings.Cut(up, "_")
+		version, err := strconv.ParseUint(prefix, 10, 0)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s: invalid version: %w", up, err)
+		}
+
+		latest = max(latest, uint(version))
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,43 @@
+package migrations_test
+
+import (
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/migrations"
+)
+
+// TestFS проверяет, что у каждой встроенной миграции есть откат и версии идут без пропусков.
+func TestFS(t *testing.T) {
+	ups, err := fs.Glob(migrations.FS, "*.up.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, ups)
+
+	for i, up := range ups {
+		prefix, _, _ := strings.Cut(up, "_")
+		version, err := strconv.Atoi(prefix)
+		require.NoError(t, err, up)
+		assert.Equal(t, i+1, version, up)
+
+		_, err = fs.Stat(migrations.FS, strings.TrimSuffix(up, ".up.sql")+".down.sql")
+		assert.NoError(t, err, up)
+	}
+
+	downs, err := fs.Glob(migrations.FS, "*.down.sql")
+	require.NoError(t, err)
+	assert.Len(t, downs, len(ups))
+}
+
+func TestLatest(t *testing.T) {
+	ups, err := fs.Glob(migrations.FS, "*.up.sql")
+	require.NoError(t, err)
+
+	latest, err := migrations.Latest()
+	require.NoError(t, err)
+	assert.EqualValues(t, len(ups), latest)
+}
@@ -0,0 +1,2268 @@
+// gRPC API сервиса назначения ревьюверов. Повторяет операции HTTP API над командами,
+// пользователями и Pull Request'ами (см. openapi.yml) и использует тот же сервисный слой.
+//
+// Аутентификация передаётся в метаданных запроса: "authorization: Bearer <token>"
+// или "x-admin-token: <token>". Ошибки сервиса возвращаются статусами gRPC, а код ошибки
+// HTTP API (например, TEAM_EXISTS) — в сообщении google.rpc.ErrorInfo в поле reason.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: reviewer/v1/reviewer.proto
+
+package reviewerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeamMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Задаётся только при создании команды.
+	Email         string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type TeamSLA struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReviewSlaHours int32                  `protobuf:"varint,1,opt,name=review_sla_hours,json=reviewSlaHours,proto3" json:"review_sla_hours,omitempty"`
+	// Одно из: "none", "reassign", "escalate".
+	Action        string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	LeadId        string `protobuf:"bytes,3,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamSLA) Reset() {
+	*x = TeamSLA{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSLA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSLA) ProtoMessage() {}
+
+func (x *TeamSLA) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSLA.ProtoReflect.Descriptor instead.
+func (*TeamSLA) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TeamSLA) GetReviewSlaHours() int32 {
+	if x != nil {
+		return x.ReviewSlaHours
+	}
+	return 0
+}
+
+func (x *TeamSLA) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TeamSLA) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+type Team struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members  []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// Отсутствует, если SLA не задан.
+	Sla           *TeamSLA `protobuf:"bytes,3,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Team) GetSla() *TeamSLA {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type User struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username          string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName          string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive          bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Email             string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	ReminderFrequency string                 `protobuf:"bytes,6,opt,name=reminder_frequency,json=reminderFrequency,proto3" json:"reminder_frequency,omitempty"`
+	ChatUserId        string                 `protobuf:"bytes,7,opt,name=chat_user_id,json=chatUserId,proto3" json:"chat_user_id,omitempty"`
+	AwayUntil         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=away_until,json=awayUntil,proto3" json:"away_until,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetReminderFrequency() string {
+	if x != nil {
+		return x.ReminderFrequency
+	}
+	return ""
+}
+
+func (x *User) GetChatUserId() string {
+	if x != nil {
+		return x.ChatUserId
+	}
+	return ""
+}
+
+func (x *User) GetAwayUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AwayUntil
+	}
+	return nil
+}
+
+type PullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Repository      string                 `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId   string                 `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,3,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Одно из: "OPEN", "MERGED".
+	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,6,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PullRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Repository      string                 `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId   string                 `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,3,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PullRequestShort) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Review) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *Review) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *Review) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Review) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+type OverdueAssignment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Repository      string                 `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId   string                 `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,3,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewerId      string                 `protobuf:"bytes,5,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	TeamName        string                 `protobuf:"bytes,6,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	AssignedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	OverdueAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=overdue_at,json=overdueAt,proto3" json:"overdue_at,omitempty"`
+	ReviewSlaHours  int32                  `protobuf:"varint,9,opt,name=review_sla_hours,json=reviewSlaHours,proto3" json:"review_sla_hours,omitempty"`
+	LeadId          string                 `protobuf:"bytes,10,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OverdueAssignment) Reset() {
+	*x = OverdueAssignment{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverdueAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverdueAssignment) ProtoMessage() {}
+
+func (x *OverdueAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverdueAssignment.ProtoReflect.Descriptor instead.
+func (*OverdueAssignment) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OverdueAssignment) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *OverdueAssignment) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *OverdueAssignment) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *OverdueAssignment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *OverdueAssignment) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *OverdueAssignment) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *OverdueAssignment) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *OverdueAssignment) GetOverdueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OverdueAt
+	}
+	return nil
+}
+
+func (x *OverdueAssignment) GetReviewSlaHours() int32 {
+	if x != nil {
+		return x.ReviewSlaHours
+	}
+	return 0
+}
+
+func (x *OverdueAssignment) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AddTeamRequest) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type SetTeamSLARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Sla           *TeamSLA               `protobuf:"bytes,2,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamSLARequest) Reset() {
+	*x = SetTeamSLARequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamSLARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamSLARequest) ProtoMessage() {}
+
+func (x *SetTeamSLARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamSLARequest.ProtoReflect.Descriptor instead.
+func (*SetTeamSLARequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetTeamSLARequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamSLARequest) GetSla() *TeamSLA {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type SetTeamSLAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamSLAResponse) Reset() {
+	*x = SetTeamSLAResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamSLAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamSLAResponse) ProtoMessage() {}
+
+func (x *SetTeamSLAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamSLAResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamSLAResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetTeamSLAResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type SetIsActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveRequest) Reset() {
+	*x = SetIsActiveRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveRequest) ProtoMessage() {}
+
+func (x *SetIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetIsActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveResponse) Reset() {
+	*x = SetIsActiveResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveResponse) ProtoMessage() {}
+
+func (x *SetIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SetRemindersRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Одно из: "off", "daily", "weekly".
+	Frequency     string `protobuf:"bytes,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRemindersRequest) Reset() {
+	*x = SetRemindersRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRemindersRequest) ProtoMessage() {}
+
+func (x *SetRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRemindersRequest.ProtoReflect.Descriptor instead.
+func (*SetRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetRemindersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetRemindersRequest) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+type SetRemindersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRemindersResponse) Reset() {
+	*x = SetRemindersResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRemindersResponse) ProtoMessage() {}
+
+func (x *SetRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRemindersResponse.ProtoReflect.Descriptor instead.
+func (*SetRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetRemindersResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SetEmailRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Пустая строка удаляет email.
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEmailRequest) Reset() {
+	*x = SetEmailRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEmailRequest) ProtoMessage() {}
+
+func (x *SetEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEmailRequest.ProtoReflect.Descriptor instead.
+func (*SetEmailRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetEmailRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type SetEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEmailResponse) Reset() {
+	*x = SetEmailResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEmailResponse) ProtoMessage() {}
+
+func (x *SetEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEmailResponse.ProtoReflect.Descriptor instead.
+func (*SetEmailResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetEmailResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type LinkChatRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Пустая строка удаляет связь.
+	ChatUserId    string `protobuf:"bytes,2,opt,name=chat_user_id,json=chatUserId,proto3" json:"chat_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkChatRequest) Reset() {
+	*x = LinkChatRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkChatRequest) ProtoMessage() {}
+
+func (x *LinkChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkChatRequest.ProtoReflect.Descriptor instead.
+func (*LinkChatRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LinkChatRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkChatRequest) GetChatUserId() string {
+	if x != nil {
+		return x.ChatUserId
+	}
+	return ""
+}
+
+type LinkChatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkChatResponse) Reset() {
+	*x = LinkChatResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkChatResponse) ProtoMessage() {}
+
+func (x *LinkChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkChatResponse.ProtoReflect.Descriptor instead.
+func (*LinkChatResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LinkChatResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRequest) ProtoMessage() {}
+
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewResponse) Reset() {
+	*x = GetReviewResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewResponse) ProtoMessage() {}
+
+func (x *GetReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetReviewResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type CreatePullRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пустое значение означает репозиторий по умолчанию.
+	Repository      string   `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId   string   `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string   `protobuf:"bytes,3,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string   `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ChangedFiles    []string `protobuf:"bytes,5,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreatePullRequestRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreatePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    string                 `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId string                 `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MergePullRequestRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MergePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    string                 `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId string                 `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldReviewerId string                 `protobuf:"bytes,3,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReassignReviewerRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type SubmitReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    string                 `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId string                 `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// Одно из: "COMMENTED", "CHANGES_REQUESTED", "APPROVED".
+	State         string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewRequest) Reset() {
+	*x = SubmitReviewRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewRequest) ProtoMessage() {}
+
+func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubmitReviewRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type SubmitReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewResponse) Reset() {
+	*x = SubmitReviewResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewResponse) ProtoMessage() {}
+
+func (x *SubmitReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewResponse.ProtoReflect.Descriptor instead.
+func (*SubmitReviewResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubmitReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type ListOverdueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пустое значение — все команды.
+	TeamName      string `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOverdueRequest) Reset() {
+	*x = ListOverdueRequest{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverdueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverdueRequest) ProtoMessage() {}
+
+func (x *ListOverdueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverdueRequest.ProtoReflect.Descriptor instead.
+func (*ListOverdueRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListOverdueRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type ListOverdueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*OverdueAssignment   `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOverdueResponse) Reset() {
+	*x = ListOverdueResponse{}
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverdueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverdueResponse) ProtoMessage() {}
+
+func (x *ListOverdueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_v1_reviewer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverdueResponse.ProtoReflect.Descriptor instead.
+func (*ListOverdueResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_v1_reviewer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListOverdueResponse) GetAssignments() []*OverdueAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+var File_reviewer_v1_reviewer_proto protoreflect.FileDescriptor
+
+const file_reviewer_v1_reviewer_proto_rawDesc = "" +
+	"\n" +
+	"\x1areviewer/v1/reviewer.proto\x12\vreviewer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"t\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\"d\n" +
+	"\aTeamSLA\x12(\n" +
+	"\x10review_sla_hours\x18\x01 \x01(\x05R\x0ereviewSlaHours\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x17\n" +
+	"\alead_id\x18\x03 \x01(\tR\x06leadId\"~\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.reviewer.v1.TeamMemberR\amembers\x12&\n" +
+	"\x03sla\x18\x03 \x01(\v2\x14.reviewer.v1.TeamSLAR\x03sla\"\x97\x02\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12-\n" +
+	"\x12reminder_frequency\x18\x06 \x01(\tR\x11reminderFrequency\x12 \n" +
+	"\fchat_user_id\x18\a \x01(\tR\n" +
+	"chatUserId\x129\n" +
+	"\n" +
+	"away_until\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tawayUntil\"\x9e\x02\n" +
+	"\vPullRequest\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x03 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x06 \x03(\tR\x11assignedReviewers\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\xbb\x01\n" +
+	"\x10PullRequestShort\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x03 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\xa6\x01\n" +
+	"\x06Review\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12=\n" +
+	"\fsubmitted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\x9d\x03\n" +
+	"\x11OverdueAssignment\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x03 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x05 \x01(\tR\n" +
+	"reviewerId\x12\x1b\n" +
+	"\tteam_name\x18\x06 \x01(\tR\bteamName\x12;\n" +
+	"\vassigned_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x129\n" +
+	"\n" +
+	"overdue_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\toverdueAt\x12(\n" +
+	"\x10review_sla_hours\x18\t \x01(\x05R\x0ereviewSlaHours\x12\x17\n" +
+	"\alead_id\x18\n" +
+	" \x01(\tR\x06leadId\"`\n" +
+	"\x0eAddTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.reviewer.v1.TeamMemberR\amembers\"8\n" +
+	"\x0fAddTeamResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"8\n" +
+	"\x0fGetTeamResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"X\n" +
+	"\x11SetTeamSLARequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12&\n" +
+	"\x03sla\x18\x02 \x01(\v2\x14.reviewer.v1.TeamSLAR\x03sla\";\n" +
+	"\x12SetTeamSLAResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\"J\n" +
+	"\x12SetIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"<\n" +
+	"\x13SetIsActiveResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.reviewer.v1.UserR\x04user\"L\n" +
+	"\x13SetRemindersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tfrequency\x18\x02 \x01(\tR\tfrequency\"=\n" +
+	"\x14SetRemindersResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.reviewer.v1.UserR\x04user\"@\n" +
+	"\x0fSetEmailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"9\n" +
+	"\x10SetEmailResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.reviewer.v1.UserR\x04user\"L\n" +
+	"\x0fLinkChatRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\fchat_user_id\x18\x02 \x01(\tR\n" +
+	"chatUserId\"9\n" +
+	"\x10LinkChatResponse\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.reviewer.v1.UserR\x04user\"+\n" +
+	"\x10GetReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"p\n" +
+	"\x11GetReviewResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1d.reviewer.v1.PullRequestShortR\fpullRequests\"\xd0\x01\n" +
+	"\x18CreatePullRequestRequest\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x03 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12#\n" +
+	"\rchanged_files\x18\x05 \x03(\tR\fchangedFiles\"E\n" +
+	"\x19CreatePullRequestResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\"a\n" +
+	"\x17MergePullRequestRequest\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\"D\n" +
+	"\x18MergePullRequestResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\"\x89\x01\n" +
+	"\x17ReassignReviewerRequest\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\x12&\n" +
+	"\x0fold_reviewer_id\x18\x03 \x01(\tR\roldReviewerId\"e\n" +
+	"\x18ReassignReviewerResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"\x94\x01\n" +
+	"\x13SubmitReviewRequest\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\"C\n" +
+	"\x14SubmitReviewResponse\x12+\n" +
+	"\x06review\x18\x01 \x01(\v2\x13.reviewer.v1.ReviewR\x06review\"1\n" +
+	"\x12ListOverdueRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"W\n" +
+	"\x13ListOverdueResponse\x12@\n" +
+	"\vassignments\x18\x01 \x03(\v2\x1e.reviewer.v1.OverdueAssignmentR\vassignments2\xe8\x01\n" +
+	"\vTeamService\x12D\n" +
+	"\aAddTeam\x12\x1b.reviewer.v1.AddTeamRequest\x1a\x1c.reviewer.v1.AddTeamResponse\x12D\n" +
+	"\aGetTeam\x12\x1b.reviewer.v1.GetTeamRequest\x1a\x1c.reviewer.v1.GetTeamResponse\x12M\n" +
+	"\n" +
+	"SetTeamSLA\x12\x1e.reviewer.v1.SetTeamSLARequest\x1a\x1f.reviewer.v1.SetTeamSLAResponse2\x92\x03\n" +
+	"\vUserService\x12P\n" +
+	"\vSetIsActive\x12\x1f.reviewer.v1.SetIsActiveRequest\x1a .reviewer.v1.SetIsActiveResponse\x12S\n" +
+	"\fSetReminders\x12 .reviewer.v1.SetRemindersRequest\x1a!.reviewer.v1.SetRemindersResponse\x12G\n" +
+	"\bSetEmail\x12\x1c.reviewer.v1.SetEmailRequest\x1a\x1d.reviewer.v1.SetEmailResponse\x12G\n" +
+	"\bLinkChat\x12\x1c.reviewer.v1.LinkChatRequest\x1a\x1d.reviewer.v1.LinkChatResponse\x12J\n" +
+	"\tGetReview\x12\x1d.reviewer.v1.GetReviewRequest\x1a\x1e.reviewer.v1.GetReviewResponse2\xe1\x03\n" +
+	"\x12PullRequestService\x12b\n" +
+	"\x11CreatePullRequest\x12%.reviewer.v1.CreatePullRequestRequest\x1a&.reviewer.v1.CreatePullRequestResponse\x12_\n" +
+	"\x10MergePullRequest\x12$.reviewer.v1.MergePullRequestRequest\x1a%.reviewer.v1.MergePullRequestResponse\x12_\n" +
+	"\x10ReassignReviewer\x12$.reviewer.v1.ReassignReviewerRequest\x1a%.reviewer.v1.ReassignReviewerResponse\x12S\n" +
+	"\fSubmitReview\x12 .reviewer.v1.SubmitReviewRequest\x1a!.reviewer.v1.SubmitReviewResponse\x12P\n" +
+	"\vListOverdue\x12\x1f.reviewer.v1.ListOverdueRequest\x1a .reviewer.v1.ListOverdueResponseB6Z4avitotech-pr-reviewer/pkg/api/reviewer/v1;reviewerv1b\x06proto3"
+
+var (
+	file_reviewer_v1_reviewer_proto_rawDescOnce sync.Once
+	file_reviewer_v1_reviewer_proto_rawDescData []byte
+)
+
+func file_reviewer_v1_reviewer_proto_rawDescGZIP() []byte {
+	file_reviewer_v1_reviewer_proto_rawDescOnce.Do(func() {
+		file_reviewer_v1_reviewer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reviewer_v1_reviewer_proto_rawDesc), len(file_reviewer_v1_reviewer_proto_rawDesc)))
+	})
+	return file_reviewer_v1_reviewer_proto_rawDescData
+}
+
+var file_reviewer_v1_reviewer_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_reviewer_v1_reviewer_proto_goTypes = []any{
+	(*TeamMember)(nil),                // 0: reviewer.v1.TeamMember
+	(*TeamSLA)(nil),                   // 1: reviewer.v1.TeamSLA
+	(*Team)(nil),                      // 2: reviewer.v1.Team
+	(*User)(nil),                      // 3: reviewer.v1.User
+	(*PullRequest)(nil),               // 4: reviewer.v1.PullRequest
+	(*PullRequestShort)(nil),          // 5: reviewer.v1.PullRequestShort
+	(*Review)(nil),                    // 6: reviewer.v1.Review
+	(*OverdueAssignment)(nil),         // 7: reviewer.v1.OverdueAssignment
+	(*AddTeamRequest)(nil),            // 8: reviewer.v1.AddTeamRequest
+	(*AddTeamResponse)(nil),           // 9: reviewer.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),            // 10: reviewer.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),           // 11: reviewer.v1.GetTeamResponse
+	(*SetTeamSLARequest)(nil),         // 12: reviewer.v1.SetTeamSLARequest
+	(*SetTeamSLAResponse)(nil),        // 13: reviewer.v1.SetTeamSLAResponse
+	(*SetIsActiveRequest)(nil),        // 14: reviewer.v1.SetIsActiveRequest
+	(*SetIsActiveResponse)(nil),       // 15: reviewer.v1.SetIsActiveResponse
+	(*SetRemindersRequest)(nil),       // 16: reviewer.v1.SetRemindersRequest
+	(*SetRemindersResponse)(nil),      // 17: reviewer.v1.SetRemindersResponse
+	(*SetEmailRequest)(nil),           // 18: reviewer.v1.SetEmailRequest
+	(*SetEmailResponse)(nil),          // 19: reviewer.v1.SetEmailResponse
+	(*LinkChatRequest)(nil),           // 20: reviewer.v1.LinkChatRequest
+	(*LinkChatResponse)(nil),          // 21: reviewer.v1.LinkChatResponse
+	(*GetReviewRequest)(nil),          // 22: reviewer.v1.GetReviewRequest
+	(*GetReviewResponse)(nil),         // 23: reviewer.v1.GetReviewResponse
+	(*CreatePullRequestRequest)(nil),  // 24: reviewer.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil), // 25: reviewer.v1.CreatePullRequestResponse
+	(*MergePullRequestRequest)(nil),   // 26: reviewer.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),  // 27: reviewer.v1.MergePullRequestResponse
+	(*ReassignReviewerRequest)(nil),   // 28: reviewer.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),  // 29: reviewer.v1.ReassignReviewerResponse
+	(*SubmitReviewRequest)(nil),       // 30: reviewer.v1.SubmitReviewRequest
+	(*SubmitReviewResponse)(nil),      // 31: reviewer.v1.SubmitReviewResponse
+	(*ListOverdueRequest)(nil),        // 32: reviewer.v1.ListOverdueRequest
+	(*ListOverdueResponse)(nil),       // 33: reviewer.v1.ListOverdueResponse
+	(*timestamppb.Timestamp)(nil),     // 34: google.protobuf.Timestamp
+}
+var file_reviewer_v1_reviewer_proto_depIdxs = []int32{
+	0,  // 0: reviewer.v1.Team.members:type_name -> reviewer.v1.TeamMember
+	1,  // 1: reviewer.v1.Team.sla:type_name -> reviewer.v1.TeamSLA
+	34, // 2: reviewer.v1.User.away_until:type_name -> google.protobuf.Timestamp
+	34, // 3: reviewer.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	34, // 4: reviewer.v1.Review.submitted_at:type_name -> google.protobuf.Timestamp
+	34, // 5: reviewer.v1.OverdueAssignment.assigned_at:type_name -> google.protobuf.Timestamp
+	34, // 6: reviewer.v1.OverdueAssignment.overdue_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: reviewer.v1.AddTeamRequest.members:type_name -> reviewer.v1.TeamMember
+	2,  // 8: reviewer.v1.AddTeamResponse.team:type_name -> reviewer.v1.Team
+	2,  // 9: reviewer.v1.GetTeamResponse.team:type_name -> reviewer.v1.Team
+	1,  // 10: reviewer.v1.SetTeamSLARequest.sla:type_name -> reviewer.v1.TeamSLA
+	2,  // 11: reviewer.v1.SetTeamSLAResponse.team:type_name -> reviewer.v1.Team
+	3,  // 12: reviewer.v1.SetIsActiveResponse.user:type_name -> reviewer.v1.User
+	3,  // 13: reviewer.v1.SetRemindersResponse.user:type_name -> reviewer.v1.User
+	3,  // 14: reviewer.v1.SetEmailResponse.user:type_name -> reviewer.v1.User
+	3,  // 15: reviewer.v1.LinkChatResponse.user:type_name -> reviewer.v1.User
+	5,  // 16: reviewer.v1.GetReviewResponse.pull_requests:type_name -> reviewer.v1.PullRequestShort
+	4,  // 17: reviewer.v1.CreatePullRequestResponse.pr:type_name -> reviewer.v1.PullRequest
+	4,  // 18: reviewer.v1.MergePullRequestResponse.pr:type_name -> reviewer.v1.PullRequest
+	4,  // 19: reviewer.v1.ReassignReviewerResponse.pr:type_name -> reviewer.v1.PullRequest
+	6,  // 20: reviewer.v1.SubmitReviewResponse.review:type_name -> reviewer.v1.Review
+	7,  // 21: reviewer.v1.ListOverdueResponse.assignments:type_name -> reviewer.v1.OverdueAssignment
+	8,  // 22: reviewer.v1.TeamService.AddTeam:input_type -> reviewer.v1.AddTeamRequest
+	10, // 23: reviewer.v1.TeamService.GetTeam:input_type -> reviewer.v1.GetTeamRequest
+	12, // 24: reviewer.v1.TeamService.SetTeamSLA:input_type -> reviewer.v1.SetTeamSLARequest
+	14, // 25: reviewer.v1.UserService.SetIsActive:input_type -> reviewer.v1.SetIsActiveRequest
+	16, // 26: reviewer.v1.UserService.SetReminders:input_type -> reviewer.v1.SetRemindersRequest
+	18, // 27: reviewer.v1.UserService.SetEmail:input_type -> reviewer.v1.SetEmailRequest
+	20, // 28: reviewer.v1.UserService.LinkChat:input_type -> reviewer.v1.LinkChatRequest
+	22, // 29: reviewer.v1.UserService.GetReview:input_type -> reviewer.v1.GetReviewRequest
+	24, // 30: reviewer.v1.PullRequestService.CreatePullRequest:input_type -> reviewer.v1.CreatePullRequestRequest
+	26, // 31: reviewer.v1.PullRequestService.MergePullRequest:input_type -> reviewer.v1.MergePullRequestRequest
+	28, // 32: reviewer.v1.PullRequestService.ReassignReviewer:input_type -> reviewer.v1.ReassignReviewerRequest
+	30, // 33: reviewer.v1.PullRequestService.SubmitReview:input_type -> reviewer.v1.SubmitReviewRequest
+	32, // 34: reviewer.v1.PullRequestService.ListOverdue:input_type -> reviewer.v1.ListOverdueRequest
+	9,  // 35: reviewer.v1.TeamService.AddTeam:output_type -> reviewer.v1.AddTeamResponse
+	11, // 36: reviewer.v1.TeamService.GetTeam:output_type -> reviewer.v1.GetTeamResponse
+	13, // 37: reviewer.v1.TeamService.SetTeamSLA:output_type -> reviewer.v1.SetTeamSLAResponse
+	15, // 38: reviewer.v1.UserService.SetIsActive:output_type -> reviewer.v1.SetIsActiveResponse
+	17, // 39: reviewer.v1.UserService.SetReminders:output_type -> reviewer.v1.SetRemindersResponse
+	19, // 40: reviewer.v1.UserService.SetEmail:output_type -> reviewer.v1.SetEmailResponse
+	21, // 41: reviewer.v1.UserService.LinkChat:output_type -> reviewer.v1.LinkChatResponse
+	23, // 42: reviewer.v1.UserService.GetReview:output_type -> reviewer.v1.GetReviewResponse
+	25, // 43: reviewer.v1.PullRequestService.CreatePullRequest:output_type -> reviewer.v1.CreatePullRequestResponse
+	27, // 44: reviewer.v1.PullRequestService.MergePullRequest:output_type -> reviewer.v1.MergePullRequestResponse
+	29, // 45: reviewer.v1.PullRequestService.ReassignReviewer:output_type -> reviewer.v1.ReassignReviewerResponse
+	31, // 46: reviewer.v1.PullRequestService.SubmitReview:output_type -> reviewer.v1.SubmitReviewResponse
+	33, // 47: reviewer.v1.PullRequestService.ListOverdue:output_type -> reviewer.v1.ListOverdueResponse
+	35, // [35:48] is the sub-list for method output_type
+	22, // [22:35] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_reviewer_v1_reviewer_proto_init() }
+func file_reviewer_v1_reviewer_proto_init() {
+	if File_reviewer_v1_reviewer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviewer_v1_reviewer_proto_rawDesc), len(file_reviewer_v1_reviewer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   34,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_reviewer_v1_reviewer_proto_goTypes,
+		DependencyIndexes: file_reviewer_v1_reviewer_proto_depIdxs,
+		MessageInfos:      file_reviewer_v1_reviewer_proto_msgTypes,
+	}.Build()
+	File_reviewer_v1_reviewer_proto = out.File
+	file_reviewer_v1_reviewer_proto_goTypes = nil
+	file_reviewer_v1_reviewer_proto_depIdxs = nil
+}
@@ -0,0 +1,258 @@
+// Package client — Go клиент HTTP API сервиса назначения ревьюверов.
+//
+// Методы клиента соответствуют эндпоинтам API и принимают контекст, который
+// ограничивает весь вызов вместе с повторными попытками. Ошибки API возвращаются
+// как *APIError и сравниваются с ошибками-сентинелами пакета через errors.Is:
+//
+//	team, err := c.AddTeam(ctx, "backend", members)
+//	if errors.Is(err, client.ErrTeamExists) {
+//		...
+//	}
+//
+// Идемпотентные вызовы (чтения и установка значений) повторяются с экспоненциальной
+// задержкой при сетевых ошибках и ответах 429 и 5xx. Создающие вызовы не повторяются,
+// чтобы не выполнить операцию дважды.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	adminTokenHeader    = "X-Admin-Token"
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+
+	defaultMaxAttempts = 3
+	defaultBaseBackoff = 100 * time.Millisecond
+	defaultMaxBackoff  = 2 * time.Second
+	defaultTimeout     = 30 * time.Second
+)
+
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+
+	adminToken string
+	token      string
+
+	maxAttempts int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+type Option func(*Client)
+
+// WithHTTPClient задаёт HTTP клиент для запросов, например с собственным транспортом.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithAdminToken передаёт токен администратора в заголовке X-Admin-Token.
+func WithAdminToken(token string) Option {
+	return func(c *Client) {
+		c.adminToken = token
+	}
+}
+
+// WithToken передаёт токен организации в заголовке Authorization: Bearer.
+// Права определяются ролью токена; без токена клиент читает данные
+// организации по умолчанию.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRetry задаёт число попыток идемпотентных вызовов и начальную задержку между ними.
+// Задержка удваивается с каждой попыткой; maxAttempts, равное 1, отключает повторы.
+func WithRetry(maxAttempts int, baseBackoff time.Duration) Option {
+	return func(c *Client) {
+		if maxAttempts > 0 {
+			c.maxAttempts = maxAttempts
+		}
+		if baseBackoff > 0 {
+			c.baseBackoff = baseBackoff
+		}
+	}
+}
+
+// New создаёт клиент API, доступного по адресу baseURL (например, http://localhost:8080).
+func New(baseURL string, opts ...Option) (*Client, error) {
+	const op = "client.New"
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("%s: base URL must be absolute: %q", op, baseURL)
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/")
+
+	c := &Client{
+		baseURL:     u,
+		httpClient:  &http.Client{Timeout: defaultTimeout},
+		maxAttempts: defaultMaxAttempts,
+		baseBackoff: defaultBaseBackoff,
+		maxBackoff:  defaultMaxBackoff,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// call описывает один вызов API.
+type call struct {
+	method string
+	path   string
+	query  url.Values
+	body   any
+	// contentType задаётся для тела, уже сериализованного в []byte;
+	// иначе body кодируется в JSON.
+	contentType string
+	// idempotent разрешает повтор вызова после сбоя.
+	idempotent bool
+}
+
+func (c *Client) do(ctx context.Context, cl call, out any) error {
+	var body []byte
+	if raw, ok := cl.body.([]byte); ok && cl.contentType != "" {
+		body = raw
+	} else if cl.body != nil {
+		var err error
+
+		body, err = json.Marshal(cl.body)
+		if err != nil {
+			return fmt.Errorf("marshal request: %w", err)
+		}
+	}
+
+	attempts := 1
+	if cl.idempotent {
+		attempts = c.maxAttempts
+	}
+
+	var err error
+	for attempt := range attempts {
+		if attempt > 0 {
+			err = c.wait(ctx, attempt)
+			if err != nil {
+				return err
+			}
+		}
+
+		var retry bool
+
+		retry, err = c.send(ctx, cl, body, out)
+		if err == nil || !retry {
+			return err
+		}
+	}
+
+	return err
+}
+
+// send выполняет одну попытку вызова и сообщает, имеет ли смысл её повторить.
+func (c *Client) send(ctx context.Context, cl call, body []byte, out any) (bool, error) {
+	u := c.baseURL.JoinPath(cl.path)
+	u.RawQuery = cl.query.Encode()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, cl.method, u.String(), reader)
+	if err != nil {
+		return false, fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		contentType := cl.contentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.token != "" {
+		req.Header.Set(authorizationHeader, bearerPrefix+c.token)
+	}
+	if c.adminToken != "" {
+		req.Header.Set(adminTokenHeader, c.adminToken)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// Ошибки отменённого контекста не повторяются: следующая попытка завершится так же.
+		return ctx.Err() == nil, fmt.Errorf("%s %s: %w", cl.method, cl.path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return true, fmt.Errorf("%s %s: read response: %w", cl.method, cl.path, err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return retryable(resp.StatusCode), newAPIError(resp.StatusCode, data)
+	}
+
+	if out == nil {
+		return false, nil
+	}
+	if raw, ok := out.(*[]byte); ok {
+		*raw = data
+
+		return false, nil
+	}
+
+	err = json.Unmarshal(data, out)
+	if err != nil {
+		return false, fmt.Errorf("%s %s: decode response: %w", cl.method, cl.path, err)
+	}
+
+	return false, nil
+}
+
+// wait ждёт перед повторной попыткой attempt: задержка растёт экспоненциально
+// и случайно уменьшается до половины, чтобы клиенты не повторяли запросы синхронно.
+func (c *Client) wait(ctx context.Context, attempt int) error {
+	backoff := c.baseBackoff << (attempt - 1)
+	if backoff > c.maxBackoff || backoff <= 0 {
+		backoff = c.maxBackoff
+	}
+	backoff = backoff/2 + rand.N(backoff/2+1)
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
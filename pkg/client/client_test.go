@@ -0,0 +1,345 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/api/response"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	opts = append([]Option{WithRetry(3, time.Millisecond)}, opts...)
+
+	c, err := New(srv.URL, opts...)
+	require.NoError(t, err)
+
+	return c
+}
+
+func writeError(w http.ResponseWriter, code response.ErrorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.Status(code))
+	_ = json.NewEncoder(w).Encode(response.ErrorResponse{
+		Error: response.Error{Code: code, Message: message},
+	})
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		baseURL   string
+		expectErr bool
+	}{
+		{name: "success", baseURL: "http://localhost:8080"},
+		{name: "success - path prefix", baseURL: "https://reviewer.example.com/api/"},
+		{name: "error - relative URL", baseURL: "localhost:8080", expectErr: true},
+		{name: "error - invalid URL", baseURL: "http://[::1", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.baseURL)
+
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Nil(t, c)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, c)
+		})
+	}
+}
+
+func TestClient_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		code     response.ErrorCode
+		expected error
+	}{
+		{code: response.TeamExists, expected: ErrTeamExists},
+		{code: response.PrExists, expected: ErrPRExists},
+		{code: response.RepositoryExists, expected: ErrRepositoryExists},
+		{code: response.OrganizationExists, expected: ErrOrganizationExists},
+		{code: response.ChatUserLinked, expected: ErrChatUserLinked},
+		{code: response.PrMerged, expected: ErrPRMerged},
+		{code: response.NotFound, expected: ErrNotFound},
+		{code: response.BadRequest, expected: ErrBadRequest},
+		{code: response.NoCandidatesForNewReviewer, expected: ErrNoCandidates},
+		{code: response.Unauthorized, expected: ErrUnauthorized},
+		{code: response.Forbidden, expected: ErrForbidden},
+		{code: response.InternalError, expected: ErrInternal},
+		{code: response.Timeout, expected: ErrTimeout},
+		{code: response.Unavailable, expected: ErrServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.code), func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				writeError(w, tt.code, "something went wrong")
+			}, WithRetry(1, 0))
+
+			_, err := c.AddTeam(context.Background(), "backend", nil)
+
+			require.ErrorIs(t, err, tt.expected)
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, response.Status(tt.code), apiErr.StatusCode)
+			assert.Equal(t, string(tt.code), apiErr.Code)
+			assert.Equal(t, "something went wrong", apiErr.Message)
+
+			for _, other := range codeErrors {
+				if other != tt.expected {
+					assert.NotErrorIs(t, err, other)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_ErrorWithoutBody(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}, WithRetry(1, 0))
+
+	_, err := c.GetTeam(context.Background(), "backend")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	assert.Empty(t, apiErr.Code)
+	assert.NotErrorIs(t, err, ErrInternal)
+}
+
+func TestClient_Auth(t *testing.T) {
+	tests := []struct {
+		name                  string
+		opts                  []Option
+		expectedAuthorization string
+		expectedAdminToken    string
+	}{
+		{
+			name: "no token",
+		},
+		{
+			name:               "admin token",
+			opts:               []Option{WithAdminToken("admin-secret")},
+			expectedAdminToken: "admin-secret",
+		},
+		{
+			name:                  "user token",
+			opts:                  []Option{WithToken("member-secret")},
+			expectedAuthorization: "Bearer member-secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var header http.Header
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header.Clone()
+				_, _ = w.Write([]byte(`{"user_id":"u1","pull_requests":[]}`))
+			}, tt.opts...)
+
+			_, err := c.GetReview(context.Background(), "u1")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAuthorization, header.Get(authorizationHeader))
+			assert.Equal(t, tt.expectedAdminToken, header.Get(adminTokenHeader))
+		})
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	tests := []struct {
+		name             string
+		do               func(c *Client) error
+		failures         int32
+		failStatus       response.ErrorCode
+		expectedAttempts int32
+		expectedErr      error
+	}{
+		{
+			name: "success - idempotent call retried after server error",
+			do: func(c *Client) error {
+				_, err := c.GetTeam(context.Background(), "backend")
+				return err
+			},
+			failures:         2,
+			failStatus:       response.InternalError,
+			expectedAttempts: 3,
+		},
+		{
+			name: "error - idempotent call gives up after max attempts",
+			do: func(c *Client) error {
+				_, err := c.SetIsActive(context.Background(), "u1", false)
+				return err
+			},
+			failures:         5,
+			failStatus:       response.InternalError,
+			expectedAttempts: 3,
+			expectedErr:      ErrInternal,
+		},
+		{
+			name: "error - client error is not retried",
+			do: func(c *Client) error {
+				_, err := c.GetTeam(context.Background(), "backend")
+				return err
+			},
+			failures:         5,
+			failStatus:       response.NotFound,
+			expectedAttempts: 1,
+			expectedErr:      ErrNotFound,
+		},
+		{
+			name: "error - create call is not retried",
+			do: func(c *Client) error {
+				_, err := c.CreatePullRequest(context.Background(), CreatePullRequestRequest{PullRequestID: "pr-1"})
+				return err
+			},
+			failures:         5,
+			failStatus:       response.InternalError,
+			expectedAttempts: 1,
+			expectedErr:      ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+
+			c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				if attempts.Add(1) <= tt.failures {
+					writeError(w, tt.failStatus, "failure")
+					return
+				}
+
+				_, _ = w.Write([]byte(`{}`))
+			})
+
+			err := tt.do(c)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestClient_ContextCancelledDuringBackoff(t *testing.T) {
+	var attempts atomic.Int32
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetry(5, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetTeam(ctx, "backend")
+
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/pullRequest/create", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]any{
+			"pull_request_id":   "pr-1",
+			"pull_request_name": "Add search",
+			"author_id":         "u1",
+		}, req)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"pr":{"repository":"default","pull_request_id":"pr-1",` +
+			`"pull_request_name":"Add search","author_id":"u1","status":"OPEN","assigned_reviewers":["u2","u3"]}}`))
+	})
+	c.baseURL = c.baseURL.JoinPath("/api")
+
+	pr, err := c.CreatePullRequest(context.Background(), CreatePullRequestRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{
+		Repository:        "default",
+		PullRequestID:     "pr-1",
+		PullRequestName:   "Add search",
+		AuthorID:          "u1",
+		Status:            StatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+	}, pr)
+}
+
+func TestClient_ImportTeams(t *testing.T) {
+	const body = "team_name,user_id,username\nbackend,u1,alice\n"
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/team/import", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("apply"))
+		assert.Equal(t, "text/csv", r.Header.Get("Content-Type"))
+
+		data, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, body, string(data))
+
+		_, _ = w.Write([]byte(`{"applied":true,"changes":[` +
+			`{"action":"create_team","team_name":"backend"},` +
+			`{"action":"add_user","team_name":"backend","user_id":"u1","username":"alice","is_active":true}]}`))
+	})
+
+	got, err := c.ImportTeams(context.Background(), []byte(body), TeamsFormatCSV, true)
+
+	require.NoError(t, err)
+	active := true
+	assert.Equal(t, &TeamImport{Applied: true, Changes: []TeamChange{
+		{Action: TeamChangeCreateTeam, TeamName: "backend"},
+		{Action: TeamChangeAddUser, TeamName: "backend", UserID: "u1", Username: "alice", IsActive: &active},
+	}}, got)
+}
+
+func TestClient_ExportTeams(t *testing.T) {
+	const body = "teams:\n  - team_name: backend\n    members: []\n"
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/team/export", r.URL.Path)
+		assert.Equal(t, TeamsFormatYAML, r.URL.Query().Get("format"))
+
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write([]byte(body))
+	})
+
+	got, err := c.ExportTeams(context.Background(), TeamsFormatYAML)
+
+	require.NoError(t, err)
+	assert.Equal(t, body, string(got))
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CodeOwnersRule — правило CODEOWNERS. Владельцы записываются как @user_id или @org/team.
+type CodeOwnersRule struct {
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+}
+
+type CodeOwners struct {
+	Repository string           `json:"repository"`
+	Rules      []CodeOwnersRule `json:"rules"`
+}
+
+// UploadCodeOwners заменяет правила CODEOWNERS репозитория содержимым файла content.
+// Требует токен администратора.
+func (c *Client) UploadCodeOwners(ctx context.Context, repository, content string) (*CodeOwners, error) {
+	req := struct {
+		Repository string `json:"repository"`
+		Content    string `json:"content"`
+	}{
+		Repository: repository,
+		Content:    content,
+	}
+
+	var resp struct {
+		CodeOwners CodeOwners `json:"codeowners"`
+	}
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/codeowners/upload", body: req, idempotent: true}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.CodeOwners, nil
+}
+
+// GetCodeOwners возвращает правила CODEOWNERS репозитория.
+func (c *Client) GetCodeOwners(ctx context.Context, repository string) (*CodeOwners, error) {
+	var resp CodeOwners
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/codeowners/get",
+		query:      url.Values{"repository": {repository}},
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
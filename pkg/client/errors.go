@@ -0,0 +1,87 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Ошибки-сентинелы соответствуют кодам ошибок API (поле error.code ответа).
+var (
+	ErrTeamExists         = errors.New("team already exists")
+	ErrPRExists           = errors.New("pull request already exists")
+	ErrRepositoryExists   = errors.New("repository already exists")
+	ErrOrganizationExists = errors.New("organization already exists")
+	ErrChatUserLinked     = errors.New("chat user is already linked to another user")
+	ErrPRMerged           = errors.New("pull request is already merged")
+	ErrNotFound           = errors.New("resource not found")
+	ErrBadRequest         = errors.New("bad request")
+	ErrNoCandidates       = errors.New("no candidates available for reviewer reassignment")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrForbidden          = errors.New("forbidden")
+	ErrInternal           = errors.New("internal server error")
+	ErrTimeout            = errors.New("request timed out")
+	ErrServiceUnavailable = errors.New("service unavailable")
+)
+
+var codeErrors = map[string]error{
+	"TEAM_EXISTS":                    ErrTeamExists,
+	"PR_EXISTS":                      ErrPRExists,
+	"REPOSITORY_EXISTS":              ErrRepositoryExists,
+	"ORGANIZATION_EXISTS":            ErrOrganizationExists,
+	"CHAT_USER_LINKED":               ErrChatUserLinked,
+	"PR_MERGED":                      ErrPRMerged,
+	"NOT_FOUND":                      ErrNotFound,
+	"BAD_REQUEST":                    ErrBadRequest,
+	"NO_CANDIDATES_FOR_NEW_REVIEWER": ErrNoCandidates,
+	"UNAUTHORIZED":                   ErrUnauthorized,
+	"FORBIDDEN":                      ErrForbidden,
+	"INTERNAL_ERROR":                 ErrInternal,
+	"TIMEOUT":                        ErrTimeout,
+	"SERVICE_UNAVAILABLE":            ErrServiceUnavailable,
+}
+
+// APIError — ошибка, которую вернул API. Сравнивается с ошибкой-сентинелом своего кода
+// через errors.Is.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("api error: status %d: %s", e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("api error: %s: %s", e.Code, e.Message)
+}
+
+func (e *APIError) Is(target error) bool {
+	sentinel, ok := codeErrors[e.Code]
+
+	return ok && sentinel == target
+}
+
+// newAPIError разбирает тело ответа с ошибкой. Ответы без тела в формате API
+// (например, от прокси) сохраняют только HTTP статус.
+func newAPIError(status int, body []byte) *APIError {
+	var resp struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	err := json.Unmarshal(body, &resp)
+	if err != nil || resp.Error.Code == "" {
+		return &APIError{StatusCode: status, Message: http.StatusText(status)}
+	}
+
+	return &APIError{
+		StatusCode: status,
+		Code:       resp.Error.Code,
+		Message:    resp.Error.Message,
+	}
+}
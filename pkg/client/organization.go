@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Роли токенов организации.
+const (
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+type Organization struct {
+	OrganizationID   string `json:"organization_id"`
+	OrganizationName string `json:"organization_name"`
+}
+
+// NewOrganization — созданная организация и токен её администратора.
+// Токен возвращается только один раз.
+type NewOrganization struct {
+	Organization Organization `json:"organization"`
+	AdminToken   string       `json:"admin_token"`
+}
+
+type Token struct {
+	Token string `json:"token"`
+	Role  string `json:"role"`
+	// UserID — пользователь, которому выдан токен участника; пусто для токенов без привязки.
+	UserID string `json:"user_id,omitempty"`
+}
+
+// AddOrganization создаёт организацию. Требует корневой токен из конфигурации сервиса.
+func (c *Client) AddOrganization(ctx context.Context, name string) (*NewOrganization, error) {
+	req := struct {
+		OrganizationName string `json:"organization_name"`
+	}{
+		OrganizationName: name,
+	}
+
+	var resp NewOrganization
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/organization/add", body: req}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// IssueToken выпускает токен организации вызывающего с ролью RoleAdmin или RoleMember.
+// Требует токен администратора.
+func (c *Client) IssueToken(ctx context.Context, role string) (*Token, error) {
+	req := struct {
+		Role string `json:"role"`
+	}{
+		Role: role,
+	}
+
+	var resp Token
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/organization/issueToken", body: req}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// IssueUserToken выпускает токен участника, привязанный к пользователю userID: с ним
+// пользователь может менять собственные настройки, например частоту напоминаний.
+// Требует токен администратора.
+func (c *Client) IssueUserToken(ctx context.Context, userID string) (*Token, error) {
+	req := struct {
+		Role   string `json:"role"`
+		UserID string `json:"user_id"`
+	}{
+		Role:   "member",
+		UserID: userID,
+	}
+
+	var resp Token
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/organization/issueToken", body: req}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
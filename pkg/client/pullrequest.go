@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Статусы Pull Request'а.
+const (
+	StatusOpen   = "OPEN"
+	StatusMerged = "MERGED"
+)
+
+// Итоги ревью.
+const (
+	ReviewCommented        = "COMMENTED"
+	ReviewChangesRequested = "CHANGES_REQUESTED"
+	ReviewApproved         = "APPROVED"
+)
+
+type PullRequest struct {
+	Repository        string     `json:"repository"`
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	MergedAt          *time.Time `json:"merged_at,omitempty"`
+}
+
+// CreatePullRequestRequest — параметры нового Pull Request'а. Пустой Repository означает
+// репозиторий по умолчанию; ChangedFiles используются для подбора ревьюверов по CODEOWNERS.
+type CreatePullRequestRequest struct {
+	Repository      string   `json:"repository,omitempty"`
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	ChangedFiles    []string `json:"changed_files,omitempty"`
+}
+
+type Review struct {
+	Repository    string    `json:"repository"`
+	PullRequestID string    `json:"pull_request_id"`
+	ReviewerID    string    `json:"reviewer_id"`
+	State         string    `json:"state"`
+	SubmittedAt   time.Time `json:"submitted_at"`
+}
+
+// OverdueAssignment — назначение ревьювера, просроченное по SLA команды.
+type OverdueAssignment struct {
+	Repository      string    `json:"repository"`
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	ReviewerID      string    `json:"reviewer_id"`
+	TeamName        string    `json:"team_name"`
+	AssignedAt      time.Time `json:"assigned_at"`
+	OverdueAt       time.Time `json:"overdue_at"`
+	ReviewSLAHours  int       `json:"review_sla_hours"`
+	LeadID          string    `json:"lead_id,omitempty"`
+}
+
+type prResponse struct {
+	PR PullRequest `json:"pr"`
+}
+
+// CreatePullRequest создаёт Pull Request и назначает ревьюверов. Не повторяется при сбое,
+// потому что повтор после успешного создания вернул бы ErrPRExists.
+// Требует токен администратора.
+func (c *Client) CreatePullRequest(ctx context.Context, req CreatePullRequestRequest) (*PullRequest, error) {
+	var resp prResponse
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/pullRequest/create", body: req}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.PR, nil
+}
+
+// MergePullRequest помечает Pull Request как merged. Повторный вызов возвращает
+// текущее состояние. Требует токен администратора.
+func (c *Client) MergePullRequest(ctx context.Context, repository, pullRequestID string) (*PullRequest, error) {
+	req := struct {
+		Repository    string `json:"repository,omitempty"`
+		PullRequestID string `json:"pull_request_id"`
+	}{
+		Repository:    repository,
+		PullRequestID: pullRequestID,
+	}
+
+	var resp prResponse
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/pullRequest/merge", body: req, idempotent: true}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.PR, nil
+}
+
+// ReassignReviewer заменяет ревьювера oldReviewerID и возвращает Pull Request вместе
+// с идентификатором нового ревьювера. Требует токен администратора.
+func (c *Client) ReassignReviewer(
+	ctx context.Context,
+	repository, pullRequestID, oldReviewerID string,
+) (*PullRequest, string, error) {
+	req := struct {
+		Repository    string `json:"repository,omitempty"`
+		PullRequestID string `json:"pull_request_id"`
+		OldReviewerID string `json:"old_reviewer_id"`
+	}{
+		Repository:    repository,
+		PullRequestID: pullRequestID,
+		OldReviewerID: oldReviewerID,
+	}
+
+	var resp struct {
+		PR         PullRequest `json:"pr"`
+		ReplacedBy string      `json:"replaced_by"`
+	}
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/pullRequest/reassign", body: req}, &resp)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &resp.PR, resp.ReplacedBy, nil
+}
+
+// SubmitReview сохраняет итог ревью: ReviewCommented, ReviewChangesRequested
+// или ReviewApproved. Требует токен администратора.
+func (c *Client) SubmitReview(
+	ctx context.Context,
+	repository, pullRequestID, reviewerID, state string,
+) (*Review, error) {
+	req := struct {
+		Repository    string `json:"repository,omitempty"`
+		PullRequestID string `json:"pull_request_id"`
+		ReviewerID    string `json:"reviewer_id"`
+		State         string `json:"state"`
+	}{
+		Repository:    repository,
+		PullRequestID: pullRequestID,
+		ReviewerID:    reviewerID,
+		State:         state,
+	}
+
+	var resp struct {
+		Review Review `json:"review"`
+	}
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/pullRequest/review", body: req}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Review, nil
+}
+
+// OverdueAssignments возвращает просроченные назначения; пустой teamName — по всем командам.
+// Требует токен администратора.
+func (c *Client) OverdueAssignments(ctx context.Context, teamName string) ([]OverdueAssignment, error) {
+	query := url.Values{}
+	if teamName != "" {
+		query.Set("team_name", teamName)
+	}
+
+	var resp struct {
+		Assignments []OverdueAssignment `json:"assignments"`
+	}
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/pullRequest/overdue",
+		query:      query,
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Assignments, nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// RepositorySettings — настройки репозитория. MaxReviewers, равный nil, означает
+// значение из конфигурации сервиса.
+type RepositorySettings struct {
+	MaxReviewers *int `json:"max_reviewers"`
+}
+
+type Repository struct {
+	RepositoryName string             `json:"repository_name"`
+	TeamName       string             `json:"team_name,omitempty"`
+	Settings       RepositorySettings `json:"settings"`
+}
+
+type repositoryResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+// AddRepository регистрирует репозиторий; teamName может быть пустым.
+// Требует токен администратора.
+func (c *Client) AddRepository(
+	ctx context.Context,
+	name, teamName string,
+	settings RepositorySettings,
+) (*Repository, error) {
+	req := Repository{
+		RepositoryName: name,
+		TeamName:       teamName,
+		Settings:       settings,
+	}
+
+	var resp repositoryResponse
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/repository/add", body: req}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Repository, nil
+}
+
+// GetRepository возвращает репозиторий по имени.
+func (c *Client) GetRepository(ctx context.Context, name string) (*Repository, error) {
+	var resp Repository
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/repository/get",
+		query:      url.Values{"repository_name": {name}},
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// SetRepositorySettings заменяет настройки репозитория. Требует токен администратора.
+func (c *Client) SetRepositorySettings(
+	ctx context.Context,
+	name string,
+	settings RepositorySettings,
+) (*Repository, error) {
+	req := struct {
+		RepositoryName string             `json:"repository_name"`
+		Settings       RepositorySettings `json:"settings"`
+	}{
+		RepositoryName: name,
+		Settings:       settings,
+	}
+
+	var resp repositoryResponse
+
+	err := c.do(ctx, call{
+		method:     http.MethodPost,
+		path:       "/repository/setSettings",
+		body:       req,
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Repository, nil
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Группировка статистики времени цикла.
+const (
+	CycleTimeByTeam       = "team"
+	CycleTimeByRepository = "repository"
+	CycleTimeByReviewer   = "reviewer"
+)
+
+// StatsFilter ограничивает статистику. Пустые поля не ограничивают выборку.
+type StatsFilter struct {
+	TeamName string
+	From     time.Time
+	To       time.Time
+	// Status — StatusOpen или StatusMerged.
+	Status string
+}
+
+func (f StatsFilter) query() url.Values {
+	query := url.Values{}
+	if f.TeamName != "" {
+		query.Set("team_name", f.TeamName)
+	}
+	if !f.From.IsZero() {
+		query.Set("from", f.From.Format(time.RFC3339))
+	}
+	if !f.To.IsZero() {
+		query.Set("to", f.To.Format(time.RFC3339))
+	}
+	if f.Status != "" {
+		query.Set("status", f.Status)
+	}
+
+	return query
+}
+
+type ReviewerStats struct {
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	TeamName    string `json:"team_name"`
+	IsActive    bool   `json:"is_active"`
+	Assignments int    `json:"assignments"`
+}
+
+type TeamStats struct {
+	TeamName       string  `json:"team_name"`
+	Members        int     `json:"members"`
+	Assignments    int     `json:"assignments"`
+	MaxAssignments int     `json:"max_assignments"`
+	MinAssignments int     `json:"min_assignments"`
+	AvgAssignments float64 `json:"avg_assignments"`
+	// MaxMinRatio равен nil, если у кого-то из участников нет назначений.
+	MaxMinRatio *float64 `json:"max_min_ratio"`
+	// IdleMembers — число участников без назначений.
+	IdleMembers int `json:"idle_members"`
+}
+
+// DurationStats — распределение длительности этапа в секундах; nil, если этап
+// не завершился ни у одного Pull Request'а.
+type DurationStats struct {
+	Count         int      `json:"count"`
+	MedianSeconds *float64 `json:"median_seconds"`
+	P90Seconds    *float64 `json:"p90_seconds"`
+}
+
+type CycleTimeStats struct {
+	Group string `json:"group"`
+	// WeekStart — понедельник недели в формате YYYY-MM-DD.
+	WeekStart         string        `json:"week_start"`
+	PullRequests      int           `json:"pull_requests"`
+	TimeToFirstReview DurationStats `json:"time_to_first_review"`
+	TimeToApproval    DurationStats `json:"time_to_approval"`
+	TimeToMerge       DurationStats `json:"time_to_merge"`
+}
+
+// ReviewerStats возвращает число назначений по ревьюверам.
+func (c *Client) ReviewerStats(ctx context.Context, filter StatsFilter) ([]ReviewerStats, error) {
+	var resp struct {
+		Reviewers []ReviewerStats `json:"reviewers"`
+	}
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/stats/reviewers",
+		query:      filter.query(),
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Reviewers, nil
+}
+
+// TeamStats возвращает распределение назначений внутри команд.
+func (c *Client) TeamStats(ctx context.Context, filter StatsFilter) ([]TeamStats, error) {
+	var resp struct {
+		Teams []TeamStats `json:"teams"`
+	}
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/stats/teams",
+		query:      filter.query(),
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Teams, nil
+}
+
+// CycleTime возвращает понедельную статистику времени цикла Pull Request'ов,
+// сгруппированную по CycleTimeByTeam, CycleTimeByRepository или CycleTimeByReviewer.
+func (c *Client) CycleTime(ctx context.Context, groupBy string, filter StatsFilter) ([]CycleTimeStats, error) {
+	query := filter.query()
+	if groupBy != "" {
+		query.Set("group_by", groupBy)
+	}
+
+	var resp struct {
+		Weeks []CycleTimeStats `json:"weeks"`
+	}
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/stats/cycleTime",
+		query:      query,
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Weeks, nil
+}
@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Member struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+	// Email передаётся только при создании команды и в ответах не возвращается.
+	Email string `json:"email,omitempty"`
+}
+
+// Действия с просроченным назначением.
+const (
+	SLAActionNone     = "none"
+	SLAActionReassign = "reassign"
+	SLAActionEscalate = "escalate"
+)
+
+// TeamSLA — срок ревью команды в рабочих часах. Action — действие с просроченным назначением,
+// LeadID — лид команды, которого добавляет действие SLAActionEscalate.
+type TeamSLA struct {
+	ReviewSLAHours int    `json:"review_sla_hours"`
+	Action         string `json:"action,omitempty"`
+	LeadID         string `json:"lead_id,omitempty"`
+}
+
+type Team struct {
+	TeamName string   `json:"team_name"`
+	Members  []Member `json:"members"`
+	// SLA равен nil, если срок ревью для команды не задан.
+	SLA *TeamSLA `json:"sla,omitempty"`
+}
+
+type teamResponse struct {
+	Team Team `json:"team"`
+}
+
+// AddTeam создаёт команду с участниками. Требует токен администратора.
+func (c *Client) AddTeam(ctx context.Context, teamName string, members []Member) (*Team, error) {
+	req := struct {
+		TeamName string   `json:"team_name"`
+		Members  []Member `json:"members"`
+	}{
+		TeamName: teamName,
+		Members:  members,
+	}
+
+	var resp teamResponse
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/team/add", body: req}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Team, nil
+}
+
+// GetTeam возвращает команду с участниками.
+func (c *Client) GetTeam(ctx context.Context, teamName string) (*Team, error) {
+	var resp Team
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/team/get",
+		query:      url.Values{"team_name": {teamName}},
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// SetTeamSLA задаёт срок ревью команды; ReviewSLAHours, равный 0, отключает его.
+// Требует токен администратора.
+func (c *Client) SetTeamSLA(ctx context.Context, teamName string, sla TeamSLA) (*Team, error) {
+	req := struct {
+		TeamName string `json:"team_name"`
+		TeamSLA
+	}{
+		TeamName: teamName,
+		TeamSLA:  sla,
+	}
+
+	var resp teamResponse
+
+	err := c.do(ctx, call{method: http.MethodPost, path: "/team/setSla", body: req, idempotent: true}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Team, nil
+}
+
+// Форматы файла с составом команд для ImportTeams и ExportTeams.
+const (
+	TeamsFormatYAML = "yaml"
+	TeamsFormatCSV  = "csv"
+	TeamsFormatJSON = "json"
+)
+
+// Действия плана импорта команд.
+const (
+	TeamChangeCreateTeam     = "create_team"
+	TeamChangeAddUser        = "add_user"
+	TeamChangeMoveUser       = "move_user"
+	TeamChangeUpdateUser     = "update_user"
+	TeamChangeDeactivateUser = "deactivate_user"
+)
+
+// TeamChange — одно изменение плана импорта. Для TeamChangeCreateTeam поля пользователя пусты,
+// FromTeam заполнен только для TeamChangeMoveUser.
+type TeamChange struct {
+	Action   string `json:"action"`
+	TeamName string `json:"team_name"`
+	FromTeam string `json:"from_team,omitempty"`
+	UserID   string `json:"user_id,omitempty"`
+	Username string `json:"username,omitempty"`
+	IsActive *bool  `json:"is_active,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+// TeamImport — план импорта команд; Applied сообщает, был ли он применён.
+type TeamImport struct {
+	Applied bool         `json:"applied"`
+	Changes []TeamChange `json:"changes"`
+}
+
+var teamsContentTypes = map[string]string{
+	TeamsFormatYAML: "application/yaml",
+	TeamsFormatCSV:  "text/csv",
+	TeamsFormatJSON: "application/json",
+}
+
+// ImportTeams сравнивает желаемый состав команд организации из data в формате format
+// с текущим и возвращает план изменений; при apply план применяется в одной транзакции.
+// Требует токен администратора.
+func (c *Client) ImportTeams(ctx context.Context, data []byte, format string, apply bool) (*TeamImport, error) {
+	contentType, ok := teamsContentTypes[format]
+	if !ok {
+		return nil, fmt.Errorf("client: unsupported teams format %q", format)
+	}
+
+	var resp TeamImport
+
+	// Повтор безопасен: импорт приводит команды к одному и тому же состоянию.
+	err := c.do(ctx, call{
+		method:      http.MethodPost,
+		path:        "/team/import",
+		query:       url.Values{"apply": {strconv.FormatBool(apply)}},
+		body:        data,
+		contentType: contentType,
+		idempotent:  true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ExportTeams возвращает состав всех команд организации в формате format,
+// пригодном для ImportTeams. Требует токен администратора.
+func (c *Client) ExportTeams(ctx context.Context, format string) ([]byte, error) {
+	var data []byte
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/team/export",
+		query:      url.Values{"format": {format}},
+		idempotent: true,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
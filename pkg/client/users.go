@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Частота дайджеста ожидающих ревью.
+const (
+	ReminderOff    = "off"
+	ReminderDaily  = "daily"
+	ReminderWeekly = "weekly"
+)
+
+type User struct {
+	UserID            string     `json:"user_id"`
+	Username          string     `json:"username"`
+	TeamName          string     `json:"team_name"`
+	IsActive          bool       `json:"is_active"`
+	Email             string     `json:"email,omitempty"`
+	ReminderFrequency string     `json:"reminder_frequency"`
+	ChatUserID        string     `json:"chat_user_id,omitempty"`
+	AwayUntil         *time.Time `json:"away_until,omitempty"`
+}
+
+// PullRequestShort — Pull Request в списке ревью пользователя.
+type PullRequestShort struct {
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	AuthorID        string `json:"author_id"`
+	Status          string `json:"status"`
+	Repository      string `json:"repository"`
+}
+
+// SetIsActive включает или выключает пользователя. Неактивные пользователи не назначаются
+// ревьюверами. Требует токен администратора.
+func (c *Client) SetIsActive(ctx context.Context, userID string, isActive bool) (*User, error) {
+	req := struct {
+		UserID   string `json:"user_id"`
+		IsActive bool   `json:"is_active"`
+	}{
+		UserID:   userID,
+		IsActive: isActive,
+	}
+
+	return c.updateUser(ctx, "/users/setIsActive", req)
+}
+
+// SetReminders задаёт частоту дайджеста ожидающих ревью: ReminderOff, ReminderDaily
+// или ReminderWeekly.
+func (c *Client) SetReminders(ctx context.Context, userID, frequency string) (*User, error) {
+	req := struct {
+		UserID    string `json:"user_id"`
+		Frequency string `json:"frequency"`
+	}{
+		UserID:    userID,
+		Frequency: frequency,
+	}
+
+	return c.updateUser(ctx, "/users/setReminders", req)
+}
+
+// SetEmail задаёт адрес для уведомлений; пустая строка отключает письма.
+// Требует токен администратора.
+func (c *Client) SetEmail(ctx context.Context, userID, email string) (*User, error) {
+	req := struct {
+		UserID string `json:"user_id"`
+		Email  string `json:"email"`
+	}{
+		UserID: userID,
+		Email:  email,
+	}
+
+	return c.updateUser(ctx, "/users/setEmail", req)
+}
+
+// LinkChat связывает пользователя с пользователем чата; пустой chatUserID удаляет связь.
+// Требует токен администратора.
+func (c *Client) LinkChat(ctx context.Context, userID, chatUserID string) (*User, error) {
+	req := struct {
+		UserID     string `json:"user_id"`
+		ChatUserID string `json:"chat_user_id"`
+	}{
+		UserID:     userID,
+		ChatUserID: chatUserID,
+	}
+
+	return c.updateUser(ctx, "/users/linkChat", req)
+}
+
+// GetReview возвращает Pull Request'ы, на которые назначен пользователь.
+func (c *Client) GetReview(ctx context.Context, userID string) ([]PullRequestShort, error) {
+	var resp struct {
+		PullRequests []PullRequestShort `json:"pull_requests"`
+	}
+
+	err := c.do(ctx, call{
+		method:     http.MethodGet,
+		path:       "/users/getReview",
+		query:      url.Values{"user_id": {userID}},
+		idempotent: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.PullRequests, nil
+}
+
+func (c *Client) updateUser(ctx context.Context, path string, req any) (*User, error) {
+	var resp User
+
+	err := c.do(ctx, call{method: http.MethodPost, path: path, body: req, idempotent: true}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,210 @@
+// Package codeowners реализует разбор файлов в формате CODEOWNERS
+// и сопоставление путей с шаблонами владельцев.
+//
+// Поддерживается подмножество синтаксиса gitignore, используемое в CODEOWNERS:
+// ведущий "/" привязывает шаблон к корню репозитория, завершающий "/"
+// означает директорию, "*" и "?" совпадают в пределах одного сегмента пути,
+// а "**" — с любым количеством сегментов.
+package codeowners
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+var (
+	ErrInvalidLine  = errors.New("invalid codeowners line")
+	ErrInvalidOwner = errors.New("invalid codeowners owner")
+)
+
+const (
+	ownerPrefix   = "@"
+	teamSeparator = "/"
+	globstar      = "**"
+)
+
+// OwnerKind определяет вид владельца.
+type OwnerKind int
+
+const (
+	OwnerUser OwnerKind = iota
+	OwnerTeam
+)
+
+// Owner описывает владельца из правила CODEOWNERS.
+// Для "@user" Name содержит идентификатор пользователя,
+// для "@org/team" — имя команды.
+type Owner struct {
+	Kind OwnerKind
+	Name string
+}
+
+// String возвращает владельца в синтаксисе CODEOWNERS.
+func (o Owner) String() string {
+	if o.Kind == OwnerTeam {
+		return ownerPrefix + "org" + teamSeparator + o.Name
+	}
+
+	return ownerPrefix + o.Name
+}
+
+// Rule — одна строка CODEOWNERS: шаблон и список его владельцев.
+type Rule struct {
+	Pattern string
+	Owners  []Owner
+}
+
+// Parse разбирает содержимое файла CODEOWNERS.
+// Пустые строки и комментарии пропускаются. Правило без владельцев допустимо
+// и снимает владение с путей, совпавших с более ранними правилами.
+func Parse(content string) ([]Rule, error) {
+	var rules []Rule
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		if strings.HasPrefix(pattern, ownerPrefix) {
+			return nil, fmt.Errorf("%w: line %d: pattern is missing", ErrInvalidLine, lineNum)
+		}
+
+		owners := make([]Owner, 0, len(fields)-1)
+		for _, field := range fields[1:] {
+			owner, err := parseOwner(field)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+
+			owners = append(owners, owner)
+		}
+
+		rules = append(rules, Rule{Pattern: pattern, Owners: owners})
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLine, err.Error())
+	}
+
+	return rules, nil
+}
+
+func parseOwner(field string) (Owner, error) {
+	name, ok := strings.CutPrefix(field, ownerPrefix)
+	if !ok || name == "" {
+		return Owner{}, fmt.Errorf("%w: %q", ErrInvalidOwner, field)
+	}
+
+	org, team, isTeam := strings.Cut(name, teamSeparator)
+	if !isTeam {
+		return Owner{Kind: OwnerUser, Name: name}, nil
+	}
+
+	if org == "" || team == "" {
+		return Owner{}, fmt.Errorf("%w: %q", ErrInvalidOwner, field)
+	}
+
+	return Owner{Kind: OwnerTeam, Name: team}, nil
+}
+
+// MatchLast возвращает индекс последнего шаблона, совпавшего с путём.
+// Как и в CODEOWNERS, более поздние правила имеют приоритет.
+// Если ни один шаблон не совпал, возвращается -1.
+func MatchLast(patterns []string, filePath string) int {
+	for i := len(patterns) - 1; i >= 0; i-- {
+		if Match(patterns[i], filePath) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Match сообщает, совпадает ли путь к файлу с шаблоном CODEOWNERS.
+// Шаблон директории ("docs/") и шаблон без подстановок ("/internal/app")
+// распространяются на всё содержимое совпавшей директории.
+func Match(pattern, filePath string) bool {
+	filePath = strings.Trim(filePath, "/")
+	if pattern == "" || filePath == "" {
+		return false
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	patSegs := strings.Split(pattern, "/")
+	if !anchored {
+		patSegs = append([]string{globstar}, patSegs...)
+	}
+
+	pathSegs := strings.Split(filePath, "/")
+
+	// Шаблон директории должен совпасть со строгим префиксом пути. Шаблон без подстановок
+	// в последнем сегменте может назвать директорию и тогда распространяется на её содержимое.
+	// Остальные шаблоны совпадают только с самим путём: "docs/*" не включает "docs/a/b.go",
+	// вглубь директорий проходит только "**".
+	maxLen, minLen := len(pathSegs), 1
+	switch {
+	case dirOnly:
+		maxLen--
+	case hasWildcard(patSegs[len(patSegs)-1]):
+		minLen = maxLen
+	}
+
+	for n := maxLen; n >= minLen; n-- {
+		if matchSegments(patSegs, pathSegs[:n]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchSegments(pattern, segs []string) bool {
+	if len(pattern) == 0 {
+		return len(segs) == 0
+	}
+
+	if pattern[0] == globstar {
+		for i := 0; i <= len(segs); i++ {
+			if matchSegments(pattern[1:], segs[i:]) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(segs) == 0 {
+		return false
+	}
+
+	ok, err := path.Match(pattern[0], segs[0])
+	if err != nil || !ok {
+		return false
+	}
+
+	return matchSegments(pattern[1:], segs[1:])
+}
+
+func hasWildcard(seg string) bool {
+	return strings.ContainsAny(seg, "*?[")
+}
@@ -0,0 +1,114 @@
+package codeowners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedRules []Rule
+		expectedError error
+	}{
+		{
+			name: "success - users, teams and comments",
+			content: `# global owners
+*       @u1
+
+/docs/  @acme/backend @u2 # inline comment
+
+*.go    @u3
+`,
+			expectedRules: []Rule{
+				{Pattern: "*", Owners: []Owner{{Kind: OwnerUser, Name: "u1"}}},
+				{Pattern: "/docs/", Owners: []Owner{
+					{Kind: OwnerTeam, Name: "backend"},
+					{Kind: OwnerUser, Name: "u2"},
+				}},
+				{Pattern: "*.go", Owners: []Owner{{Kind: OwnerUser, Name: "u3"}}},
+			},
+		},
+		{
+			name:          "success - rule without owners",
+			content:       "/vendor/",
+			expectedRules: []Rule{{Pattern: "/vendor/", Owners: []Owner{}}},
+		},
+		{
+			name:          "error - owner without prefix",
+			content:       "*.go u1",
+			expectedError: ErrInvalidOwner,
+		},
+		{
+			name:          "error - team without name",
+			content:       "*.go @acme/",
+			expectedError: ErrInvalidOwner,
+		},
+		{
+			name:          "error - line without pattern",
+			content:       "@u1 @u2",
+			expectedError: ErrInvalidLine,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := Parse(tt.content)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, rules)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedRules, rules)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{pattern: "*", path: "main.go", expected: true},
+		{pattern: "*", path: "internal/app/app.go", expected: true},
+		{pattern: "*.go", path: "internal/app/app.go", expected: true},
+		{pattern: "*.go", path: "README.md", expected: false},
+		{pattern: "/docs/", path: "docs/api/openapi.yml", expected: true},
+		{pattern: "/docs/", path: "internal/docs/readme.md", expected: false},
+		{pattern: "/docs/", path: "docs", expected: false},
+		{pattern: "docs/", path: "internal/docs/readme.md", expected: true},
+		{pattern: "docs", path: "internal/docs/readme.md", expected: true},
+		{pattern: "/internal/app", path: "internal/app/http/httpapp.go", expected: true},
+		{pattern: "internal/*.go", path: "internal/main.go", expected: true},
+		{pattern: "internal/*.go", path: "internal/app/app.go", expected: false},
+		{pattern: "internal/**/*.go", path: "internal/app/http/httpapp.go", expected: true},
+		{pattern: "**/mocks/", path: "internal/service/user/mocks/user_mock.go", expected: true},
+		{pattern: "/migrations/*.sql", path: "migrations/000001_create_teams_table.up.sql", expected: true},
+		{pattern: "docs/*", path: "docs/getting-started.md", expected: true},
+		{pattern: "docs/*", path: "docs/build-app/troubleshooting.md", expected: false},
+		{pattern: "/docs/**", path: "docs/build-app/troubleshooting.md", expected: true},
+		{pattern: "docs/*/", path: "docs/build-app/troubleshooting.md", expected: true},
+		{pattern: "/migrations/*.sql", path: "migrations/old/000001_init.up.sql", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Match(tt.pattern, tt.path))
+		})
+	}
+}
+
+func TestMatchLast(t *testing.T) {
+	patterns := []string{"*", "/internal/", "*.sql"}
+
+	assert.Equal(t, 0, MatchLast(patterns, "README.md"))
+	assert.Equal(t, 1, MatchLast(patterns, "internal/app/app.go"))
+	assert.Equal(t, 2, MatchLast(patterns, "migrations/000001.up.sql"))
+	assert.Equal(t, -1, MatchLast(patterns[1:], "README.md"))
+}
@@ -10,6 +10,8 @@ import (
 const (
 	UniqueViolationCode        = "23505"
 	ErrForeignKeyViolationCode = "23503"
+	SerializationFailureCode   = "40001"
+	DeadlockDetectedCode       = "40P01"
 )
 
 func IsUniqueViolationError(err error) bool {
@@ -31,6 +33,27 @@ func IsForeignKeyErr(err error) bool {
 	return false
 }
 
+// ConstraintName возвращает имя ограничения, нарушение которого привело к ошибке.
+// Если ошибка не является ошибкой PostgreSQL, возвращается пустая строка.
+func ConstraintName(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName
+	}
+	return ""
+}
+
 func IsNoRowsError(err error) bool {
 	return errors.Is(err, pgx.ErrNoRows)
 }
+
+// IsRetryableTxError сообщает, прервана ли транзакция конфликтом сериализации
+// или взаимоблокировкой: такую транзакцию можно повторить целиком.
+func IsRetryableTxError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == SerializationFailureCode || pgErr.Code == DeadlockDetectedCode
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package pgPkg
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// leaderCheckTimeout ограничивает проверку лидерства, чтобы потерянное подключение
+// не задерживало задачу до тайм-аута TCP.
+const leaderCheckTimeout = 5 * time.Second
+
+// LeaderLock выбирает среди реплик, подключённых к одной базе, лидера — реплику,
+// которая держит сессионную advisory-блокировку с именем name. Блокировка удерживается
+// на отдельном подключении пула до Resign или до потери подключения: тогда PostgreSQL
+// снимает её сам, и лидером становится следующая реплика, вызвавшая IsLeader.
+type LeaderLock struct {
+	pool *pgxpool.Pool
+	name string
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+func NewLeaderLock(pool *pgxpool.Pool, name string) *LeaderLock {
+	return &LeaderLock{
+		pool: pool,
+		name: name,
+	}
+}
+
+// IsLeader сообщает, является ли реплика лидером, и пытается захватить блокировку,
+// если она ещё не захвачена.
+func (l *LeaderLock) IsLeader(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, leaderCheckTimeout)
+	defer cancel()
+
+	if l.conn != nil {
+		err := l.conn.Ping(ctx)
+		if err == nil {
+			return true, nil
+		}
+
+		// Подключение потеряно, а вместе с ним и блокировка.
+		l.drop(ctx)
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var locked bool
+	err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, l.name).Scan(&locked)
+	if err != nil || !locked {
+		conn.Release()
+
+		return false, err
+	}
+
+	l.conn = conn
+
+	return true, nil
+}
+
+// Resign снимает блокировку, если реплика является лидером, и возвращает подключение в пул.
+func (l *LeaderLock) Resign(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return
+	}
+
+	_, err := l.conn.Exec(ctx, `SELECT pg_advisory_unlock(hashtext($1))`, l.name)
+	if err != nil {
+		l.drop(ctx)
+
+		return
+	}
+
+	l.conn.Release()
+	l.conn = nil
+}
+
+// drop закрывает подключение лидера: пул не вернёт закрытое подключение другим запросам,
+// а сервер снимет блокировку, если она ещё удерживается.
+func (l *LeaderLock) drop(ctx context.Context) {
+	_ = l.conn.Conn().Close(ctx)
+	l.conn.Release()
+	l.conn = nil
+}
@@ -0,0 +1,46 @@
+package pgPkg_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/internal/storage/postgres/pgtest"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+func TestLeaderLock(t *testing.T) {
+	pool := pgtest.Pool(t)
+	ctx := context.Background()
+	name := fmt.Sprintf("test.leader.%d", time.Now().UnixNano())
+
+	first := pgPkg.NewLeaderLock(pool, name)
+	second := pgPkg.NewLeaderLock(pool, name)
+	t.Cleanup(func() {
+		first.Resign(ctx)
+		second.Resign(ctx)
+	})
+
+	leader, err := first.IsLeader(ctx)
+	require.NoError(t, err)
+	assert.True(t, leader)
+
+	// Лидер остаётся лидером при повторной проверке, другая реплика блокировку не получает.
+	leader, err = first.IsLeader(ctx)
+	require.NoError(t, err)
+	assert.True(t, leader)
+
+	leader, err = second.IsLeader(ctx)
+	require.NoError(t, err)
+	assert.False(t, leader)
+
+	first.Resign(ctx)
+
+	leader, err = second.IsLeader(ctx)
+	require.NoError(t, err)
+	assert.True(t, leader)
+}
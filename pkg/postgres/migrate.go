@@ -0,0 +1,137 @@
+package pgPkg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	pgxMigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	_ "github.com/jackc/pgx/v5/stdlib" // регистрирует драйвер pgx для database/sql
+)
+
+// migrateLockTimeout — сколько ждать advisory lock, пока миграции применяет другая реплика.
+const migrateLockTimeout = 5 * time.Minute
+
+var ErrMigrate = errors.New("failed to run migrations")
+
+// Migrator применяет миграции golang-migrate из встроенной файловой системы.
+// Версия схемы хранится в той же таблице schema_migrations, что и у утилиты migrate,
+// поэтому базы, размеченные ею раньше, продолжают работать.
+// Каждая операция выполняется под advisory lock PostgreSQL: если несколько реплик
+// запускаются одновременно, миграции применяет одна, остальные ждут и видят актуальную версию.
+type Migrator struct {
+	m *migrate.Migrate
+}
+
+// NewMigrator подключается к базе по dsn и читает миграции из каталога dir в fsys.
+func NewMigrator(dsn string, fsys fs.FS, dir string) (*Migrator, error) {
+	src, err := iofs.New(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+
+	driver, err := pgxMigrate.WithInstance(db, &pgxMigrate.Config{})
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, "pgx5", driver)
+	if err != nil {
+		_ = driver.Close()
+
+		return nil, fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+	m.LockTimeout = migrateLockTimeout
+
+	return &Migrator{
+		m: m,
+	}, nil
+}
+
+// Up применяет все ещё не применённые миграции. Отсутствие новых миграций не считается ошибкой.
+func (m *Migrator) Up() error {
+	err := m.m.Up()
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+
+	return nil
+}
+
+// Down откатывает steps последних применённых миграций.
+func (m *Migrator) Down(steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("%w: steps must be positive", ErrMigrate)
+	}
+
+	err := m.m.Steps(-steps)
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+
+	return nil
+}
+
+// Status возвращает текущую версию схемы и признак dirty: миграция упала на середине
+// и схему нужно поправить вручную, а затем выполнить Force.
+// Для пустой базы возвращается нулевая версия.
+func (m *Migrator) Status() (uint, bool, error) {
+	version, dirty, err := m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+
+	return version, dirty, nil
+}
+
+// Force записывает версию схемы и снимает признак dirty, не выполняя миграций.
+func (m *Migrator) Force(version int) error {
+	err := m.m.Force(version)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+
+	return nil
+}
+
+// SchemaVersion читает версию схемы, записанную Migrator, через обычное подключение сервиса.
+// Если миграции ещё не применялись, возвращает версию 0.
+func SchemaVersion(ctx context.Context, q Querier) (uint, bool, error) {
+	const query = `SELECT version, dirty FROM schema_migrations LIMIT 1`
+
+	var (
+		version int64
+		dirty   bool
+	)
+	err := q.QueryRow(ctx, query).Scan(&version, &dirty)
+	if IsNoRowsError(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %s", ErrMigrate, err.Error())
+	}
+
+	return uint(version), dirty, nil
+}
+
+// Close закрывает подключение к базе.
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+
+	return errors.Join(srcErr, dbErr)
+}
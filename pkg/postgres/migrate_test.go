@@ -0,0 +1,72 @@
+package pgPkg_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"avitotech-pr-reviewer/migrations"
+	pgPkg "avitotech-pr-reviewer/pkg/postgres"
+)
+
+// TestMigrator_ConcurrentUp проверяет, что одновременный запуск миграций из нескольких реплик
+// не приводит к ошибкам и оставляет схему в последней версии.
+// Выполняется, только если задана TEST_POSTGRES_DSN.
+func TestMigrator_ConcurrentUp(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+
+	const replicas = 4
+
+	var wg sync.WaitGroup
+	errs := make([]error, replicas)
+	for i := range replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			m, err := pgPkg.NewMigrator(dsn, migrations.FS, ".")
+			if err != nil {
+				errs[i] = err
+
+				return
+			}
+			defer m.Close()
+
+			errs[i] = m.Up()
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	m, err := pgPkg.NewMigrator(dsn, migrations.FS, ".")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = m.Close() })
+
+	ups, err := fs.Glob(migrations.FS, "*.up.sql")
+	require.NoError(t, err)
+
+	version, dirty, err := m.Status()
+	require.NoError(t, err)
+	assert.False(t, dirty)
+	assert.EqualValues(t, len(ups), version)
+
+	pool, err := pgPkg.NewPool(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	poolVersion, poolDirty, err := pgPkg.SchemaVersion(context.Background(), pool)
+	require.NoError(t, err)
+	assert.Equal(t, version, poolVersion)
+	assert.Equal(t, dirty, poolDirty)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgconn/ctxwatch"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -22,6 +24,25 @@ func WithMaxConns(maxConns int32) Option {
 	}
 }
 
+// cancelDeadlineDelay — сколько ждать прерывания запроса после cancel request,
+// прежде чем закрыть подключение.
+const cancelDeadlineDelay = time.Second
+
+// WithQueryCancel прерывает запрос на сервере, когда истекает или отменяется его контекст:
+// pgx отправляет PostgreSQL cancel request и закрывает подключение, только если запрос
+// не прервался за cancelDeadlineDelay. По умолчанию pgx сразу закрывает подключение,
+// а запрос продолжает выполняться на сервере и занимать его ресурсы.
+func WithQueryCancel() Option {
+	return func(cfg *pgxpool.Config) {
+		cfg.ConnConfig.BuildContextWatcherHandler = func(conn *pgconn.PgConn) ctxwatch.Handler {
+			return &pgconn.CancelRequestContextWatcherHandler{
+				Conn:          conn,
+				DeadlineDelay: cancelDeadlineDelay,
+			}
+		}
+	}
+}
+
 // NewPool создает новый пул подключений к базе данных PostgreSQL.
 func NewPool(ctx context.Context, dsn string, opts ...Option) (*pgxpool.Pool, error) {
 	config, err := pgxpool.ParseConfig(dsn)
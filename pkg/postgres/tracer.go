@@ -0,0 +1,107 @@
+package pgPkg
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "avitotech-pr-reviewer/pkg/postgres"
+
+// WithTracing создаёт span OpenTelemetry на каждый запрос и пакет запросов пула.
+// Span'ы пишутся через глобальный TracerProvider, поэтому пул можно создать до настройки экспорта.
+func WithTracing() Option {
+	return func(cfg *pgxpool.Config) {
+		cfg.ConnConfig.Tracer = newQueryTracer(otel.Tracer(tracerName))
+	}
+}
+
+// queryTracer реализует pgx.QueryTracer и pgx.BatchTracer.
+type queryTracer struct {
+	tracer trace.Tracer
+}
+
+func newQueryTracer(tracer trace.Tracer) *queryTracer {
+	return &queryTracer{
+		tracer: tracer,
+	}
+}
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = t.tracer.Start(ctx, spanName(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system.name", "postgresql"),
+			attribute.String("db.query.text", data.SQL),
+		),
+	)
+
+	return ctx
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil {
+		recordError(span, data.Err)
+
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.response.returned_rows", data.CommandTag.RowsAffected()))
+}
+
+func (t *queryTracer) TraceBatchStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceBatchStartData) context.Context {
+	ctx, _ = t.tracer.Start(ctx, "BATCH",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system.name", "postgresql"),
+			attribute.Int("db.operation.batch.size", data.Batch.Len()),
+		),
+	)
+
+	return ctx
+}
+
+// TraceBatchQuery записывает каждый запрос пакета событием span'а пакета:
+// pgx отправляет их серверу одним обменом, отдельные span'ы не показали бы ничего нового.
+func (t *queryTracer) TraceBatchQuery(ctx context.Context, _ *pgx.Conn, data pgx.TraceBatchQueryData) {
+	attrs := []attribute.KeyValue{attribute.String("db.query.text", data.SQL)}
+	if data.Err != nil {
+		attrs = append(attrs, attribute.String("error.message", data.Err.Error()))
+	}
+
+	trace.SpanFromContext(ctx).AddEvent("query", trace.WithAttributes(attrs...))
+}
+
+func (t *queryTracer) TraceBatchEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceBatchEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil {
+		recordError(span, data.Err)
+	}
+}
+
+func recordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// spanName называет span по первому слову запроса (SELECT, INSERT, WITH и т.д.),
+// чтобы имена не зависели от параметров и их было немного.
+func spanName(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "QUERY"
+	}
+
+	return strings.ToUpper(fields[0])
+}
@@ -0,0 +1,78 @@
+package pgPkg
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestQueryTracer_Query(t *testing.T) {
+	tests := []struct {
+		name       string
+		sql        string
+		err        error
+		wantName   string
+		wantStatus codes.Code
+	}{
+		{
+			name:       "success",
+			sql:        "\n\t\tselect id from users where id = $1",
+			wantName:   "SELECT",
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "error",
+			sql:        "INSERT INTO users (id) VALUES ($1)",
+			err:        errors.New("duplicate key"),
+			wantName:   "INSERT",
+			wantStatus: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := tracetest.NewSpanRecorder()
+			tracer := newQueryTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)).Tracer("test"))
+
+			ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: tt.sql})
+			tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{
+				CommandTag: pgconn.NewCommandTag("SELECT 1"),
+				Err:        tt.err,
+			})
+
+			spans := rec.Ended()
+			require.Len(t, spans, 1)
+			assert.Equal(t, tt.wantName, spans[0].Name())
+			assert.Equal(t, tt.wantStatus, spans[0].Status().Code)
+			assert.Contains(t, spans[0].Attributes(), attribute.String("db.query.text", tt.sql))
+		})
+	}
+}
+
+func TestQueryTracer_Batch(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tracer := newQueryTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)).Tracer("test"))
+
+	batch := &pgx.Batch{}
+	batch.Queue("SELECT 1")
+	batch.Queue("SELECT 2")
+
+	ctx := tracer.TraceBatchStart(context.Background(), nil, pgx.TraceBatchStartData{Batch: batch})
+	tracer.TraceBatchQuery(ctx, nil, pgx.TraceBatchQueryData{SQL: "SELECT 1"})
+	tracer.TraceBatchQuery(ctx, nil, pgx.TraceBatchQueryData{SQL: "SELECT 2"})
+	tracer.TraceBatchEnd(ctx, nil, pgx.TraceBatchEndData{})
+
+	spans := rec.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "BATCH", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.Int("db.operation.batch.size", 2))
+	assert.Len(t, spans[0].Events(), 2)
+}
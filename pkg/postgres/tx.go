@@ -0,0 +1,230 @@
+package pgPkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	defaultTxMaxAttempts = 3
+	defaultTxBaseBackoff = 10 * time.Millisecond
+)
+
+// TxDB — пул подключений, умеющий открывать транзакции с заданными параметрами.
+type TxDB interface {
+	DB
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+type (
+	txKey          struct{}
+	afterCommitKey struct{}
+)
+
+// afterCommit — действия, отложенные до фиксации транзакции верхнего уровня.
+type afterCommit struct {
+	fns []func(ctx context.Context)
+}
+
+// TxManager выполняет функции в транзакции, которую передаёт через контекст.
+// TxManager реализует DB, поэтому репозитории, созданные поверх него, работают
+// в транзакции из контекста, если она есть, и в пуле, если нет. Begin внутри
+// транзакции создаёт точку сохранения, так что собственные транзакции
+// репозиториев становятся частью внешней.
+type TxManager struct {
+	db          TxDB
+	txOptions   pgx.TxOptions
+	maxAttempts int
+	baseBackoff time.Duration
+}
+
+type TxOption func(*TxManager)
+
+// WithIsoLevel задаёт уровень изоляции транзакций WithinTx.
+// По умолчанию используется уровень изоляции базы данных.
+func WithIsoLevel(level pgx.TxIsoLevel) TxOption {
+	return func(m *TxManager) {
+		m.txOptions.IsoLevel = level
+	}
+}
+
+// WithTxRetry задаёт число попыток WithinTx при конфликте сериализации или взаимоблокировке
+// и начальную задержку между ними. maxAttempts, равный 1, отключает повторы.
+func WithTxRetry(maxAttempts int, baseBackoff time.Duration) TxOption {
+	return func(m *TxManager) {
+		if maxAttempts > 0 {
+			m.maxAttempts = maxAttempts
+		}
+		if baseBackoff > 0 {
+			m.baseBackoff = baseBackoff
+		}
+	}
+}
+
+func NewTxManager(db TxDB, opts ...TxOption) *TxManager {
+	m := &TxManager{
+		db:          db,
+		maxAttempts: defaultTxMaxAttempts,
+		baseBackoff: defaultTxBaseBackoff,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// WithinTx выполняет fn в транзакции: при ошибке или панике fn транзакция
+// откатывается, иначе фиксируется. Все запросы fn через контекст ctx и репозитории
+// поверх TxManager выполняются в этой транзакции.
+//
+// Вложенный вызов (ctx уже содержит транзакцию) выполняет fn в точке сохранения
+// внешней транзакции: ошибка fn откатывает только изменения fn, а фиксация
+// происходит вместе с внешней транзакцией.
+//
+// Транзакция верхнего уровня, прерванная конфликтом сериализации или взаимоблокировкой,
+// повторяется целиком, поэтому fn должна быть готова к повторному выполнению.
+// Побочные действия, которые нельзя повторять или откатывать, fn откладывает через AfterCommit.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := txFrom(ctx); ok {
+		return m.run(ctx, fn, func() (pgx.Tx, error) { return tx.Begin(ctx) })
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = m.run(ctx, fn, func() (pgx.Tx, error) { return m.db.BeginTx(ctx, m.txOptions) })
+		if err == nil || attempt >= m.maxAttempts || !IsRetryableTxError(err) {
+			return err
+		}
+
+		waitErr := m.wait(ctx, attempt)
+		if waitErr != nil {
+			return errors.Join(err, waitErr)
+		}
+	}
+}
+
+// run выполняет fn в транзакции, которую открывает begin.
+func (m *TxManager) run(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+	begin func() (pgx.Tx, error),
+) (err error) {
+	tx, err := begin()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(context.WithoutCancel(ctx))
+			panic(p)
+		}
+	}()
+
+	hooks := &afterCommit{}
+	txCtx := context.WithValue(context.WithValue(ctx, txKey{}, tx), afterCommitKey{}, hooks)
+
+	err = fn(txCtx)
+	if err != nil {
+		// Откат выполняется и после отмены ctx, чтобы не оставлять подключение в транзакции.
+		rollbackErr := tx.Rollback(context.WithoutCancel(ctx))
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return errors.Join(err, fmt.Errorf("rollback transaction: %w", rollbackErr))
+		}
+
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	// Точка сохранения передаёт отложенные действия внешней транзакции,
+	// а транзакция верхнего уровня выполняет их после фиксации.
+	if parent, ok := ctx.Value(afterCommitKey{}).(*afterCommit); ok {
+		parent.fns = append(parent.fns, hooks.fns...)
+
+		return nil
+	}
+
+	for _, hook := range hooks.fns {
+		hook(ctx)
+	}
+
+	return nil
+}
+
+// AfterCommit откладывает fn до фиксации транзакции из контекста: при откате или повторе
+// транзакции fn не выполняется, а после фиксации выполняется один раз с контекстом,
+// переданным в WithinTx верхнего уровня. Если транзакции в контексте нет, fn выполняется сразу.
+func (m *TxManager) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommit)
+	if !ok {
+		fn(ctx)
+
+		return
+	}
+
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// wait ждёт перед повторной попыткой attempt: задержка растёт экспоненциально
+// и случайно уменьшается до половины, чтобы конфликтующие транзакции разошлись.
+func (m *TxManager) wait(ctx context.Context, attempt int) error {
+	backoff := m.baseBackoff << (attempt - 1)
+	backoff = backoff/2 + rand.N(backoff/2+1)
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Begin открывает транзакцию в пуле или точку сохранения в транзакции из контекста.
+func (m *TxManager) Begin(ctx context.Context) (pgx.Tx, error) {
+	if tx, ok := txFrom(ctx); ok {
+		return tx.Begin(ctx)
+	}
+
+	return m.db.Begin(ctx)
+}
+
+func (m *TxManager) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	return m.querier(ctx).Exec(ctx, sql, arguments...)
+}
+
+func (m *TxManager) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return m.querier(ctx).Query(ctx, sql, args...)
+}
+
+func (m *TxManager) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return m.querier(ctx).QueryRow(ctx, sql, args...)
+}
+
+func (m *TxManager) querier(ctx context.Context) Querier {
+	if tx, ok := txFrom(ctx); ok {
+		return tx
+	}
+
+	return m.db
+}
+
+func txFrom(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+
+	return tx, ok
+}
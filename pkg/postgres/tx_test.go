@@ -0,0 +1,317 @@
+package pgPkg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTx записывает выполненные запросы и исход транзакции.
+// Begin открывает вложенную fakeTx, как точку сохранения в pgx.
+type fakeTx struct {
+	pgx.Tx
+
+	name      string
+	log       *[]string
+	commitErr error
+}
+
+func (tx *fakeTx) Begin(context.Context) (pgx.Tx, error) {
+	return &fakeTx{name: tx.name + "/savepoint", log: tx.log}, nil
+}
+
+func (tx *fakeTx) Commit(context.Context) error {
+	*tx.log = append(*tx.log, tx.name+": commit")
+
+	return tx.commitErr
+}
+
+func (tx *fakeTx) Rollback(context.Context) error {
+	*tx.log = append(*tx.log, tx.name+": rollback")
+
+	return nil
+}
+
+func (tx *fakeTx) Exec(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+	*tx.log = append(*tx.log, tx.name+": "+sql)
+
+	return pgconn.CommandTag{}, nil
+}
+
+type fakeDB struct {
+	DB
+
+	log        []string
+	begun      int
+	commitErrs []error
+}
+
+func (db *fakeDB) BeginTx(context.Context, pgx.TxOptions) (pgx.Tx, error) {
+	tx := &fakeTx{name: "tx", log: &db.log}
+	if db.begun < len(db.commitErrs) {
+		tx.commitErr = db.commitErrs[db.begun]
+	}
+	db.begun++
+
+	return tx, nil
+}
+
+func (db *fakeDB) Exec(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+	db.log = append(db.log, "pool: "+sql)
+
+	return pgconn.CommandTag{}, nil
+}
+
+var errFn = errors.New("fn failed")
+
+func TestTxManager_WithinTx(t *testing.T) {
+	serializationErr := &pgconn.PgError{Code: SerializationFailureCode}
+
+	tests := []struct {
+		name        string
+		commitErrs  []error
+		fn          func(m *TxManager) func(ctx context.Context) error
+		expectedLog []string
+		expectedErr error
+	}{
+		{
+			name: "success - queries run in transaction",
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					_, err := m.Exec(ctx, "UPDATE users")
+
+					return err
+				}
+			},
+			expectedLog: []string{"tx: UPDATE users", "tx: commit"},
+		},
+		{
+			name: "error - fn error rolls back",
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					_, _ = m.Exec(ctx, "UPDATE users")
+
+					return errFn
+				}
+			},
+			expectedLog: []string{"tx: UPDATE users", "tx: rollback"},
+			expectedErr: errFn,
+		},
+		{
+			name: "success - nested call uses savepoint and keeps outer transaction",
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					_, _ = m.Exec(ctx, "UPDATE users")
+
+					err := m.WithinTx(ctx, func(ctx context.Context) error {
+						_, _ = m.Exec(ctx, "INSERT INTO audit")
+
+						return errFn
+					})
+					if !errors.Is(err, errFn) {
+						return err
+					}
+
+					return m.WithinTx(ctx, func(ctx context.Context) error {
+						_, err := m.Exec(ctx, "INSERT INTO events")
+
+						return err
+					})
+				}
+			},
+			expectedLog: []string{
+				"tx: UPDATE users",
+				"tx/savepoint: INSERT INTO audit",
+				"tx/savepoint: rollback",
+				"tx/savepoint: INSERT INTO events",
+				"tx/savepoint: commit",
+				"tx: commit",
+			},
+		},
+		{
+			name:       "success - serialization failure is retried",
+			commitErrs: []error{serializationErr},
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					_, err := m.Exec(ctx, "UPDATE users")
+
+					return err
+				}
+			},
+			expectedLog: []string{"tx: UPDATE users", "tx: commit", "tx: UPDATE users", "tx: commit"},
+		},
+		{
+			name:       "error - retries are limited",
+			commitErrs: []error{serializationErr, serializationErr},
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(context.Context) error { return nil }
+			},
+			expectedLog: []string{"tx: commit", "tx: commit"},
+			expectedErr: serializationErr,
+		},
+		{
+			name: "error - nested serialization failure retries the whole outer transaction",
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					return m.WithinTx(ctx, func(context.Context) error { return serializationErr })
+				}
+			},
+			expectedLog: []string{
+				"tx/savepoint: rollback", "tx: rollback",
+				"tx/savepoint: rollback", "tx: rollback",
+			},
+			expectedErr: serializationErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &fakeDB{commitErrs: tt.commitErrs}
+			m := NewTxManager(db, WithTxRetry(2, time.Millisecond))
+
+			err := m.WithinTx(context.Background(), tt.fn(m))
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLog, db.log)
+		})
+	}
+}
+
+func TestTxManager_OutsideTxUsesPool(t *testing.T) {
+	db := &fakeDB{}
+	m := NewTxManager(db)
+
+	_, err := m.Exec(context.Background(), "UPDATE users")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pool: UPDATE users"}, db.log)
+}
+
+func TestTxManager_PanicRollsBack(t *testing.T) {
+	db := &fakeDB{}
+	m := NewTxManager(db)
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = m.WithinTx(context.Background(), func(context.Context) error {
+			panic("boom")
+		})
+	})
+	assert.Equal(t, []string{"tx: rollback"}, db.log)
+}
+
+func TestTxManager_AfterCommit(t *testing.T) {
+	serializationErr := &pgconn.PgError{Code: SerializationFailureCode}
+
+	tests := []struct {
+		name        string
+		commitErrs  []error
+		fn          func(m *TxManager, hook func(name string) func(context.Context)) func(ctx context.Context) error
+		expectedLog []string
+		expectedErr error
+	}{
+		{
+			name: "success - action runs after commit",
+			fn: func(m *TxManager, hook func(string) func(context.Context)) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					m.AfterCommit(ctx, hook("publish"))
+					_, err := m.Exec(ctx, "UPDATE users")
+
+					return err
+				}
+			},
+			expectedLog: []string{"tx: UPDATE users", "tx: commit", "hook: publish"},
+		},
+		{
+			name: "error - action is dropped on rollback",
+			fn: func(m *TxManager, hook func(string) func(context.Context)) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					m.AfterCommit(ctx, hook("publish"))
+
+					return errFn
+				}
+			},
+			expectedLog: []string{"tx: rollback"},
+			expectedErr: errFn,
+		},
+		{
+			name:       "success - action runs once after retry",
+			commitErrs: []error{serializationErr},
+			fn: func(m *TxManager, hook func(string) func(context.Context)) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					m.AfterCommit(ctx, hook("publish"))
+
+					return nil
+				}
+			},
+			expectedLog: []string{"tx: commit", "tx: commit", "hook: publish"},
+		},
+		{
+			name: "success - nested actions wait for outer commit, failed savepoint drops its actions",
+			fn: func(m *TxManager, hook func(string) func(context.Context)) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					_ = m.WithinTx(ctx, func(ctx context.Context) error {
+						m.AfterCommit(ctx, hook("failed"))
+
+						return errFn
+					})
+
+					return m.WithinTx(ctx, func(ctx context.Context) error {
+						m.AfterCommit(ctx, hook("reassigned"))
+
+						return nil
+					})
+				}
+			},
+			expectedLog: []string{
+				"tx/savepoint: rollback",
+				"tx/savepoint: commit",
+				"tx: commit",
+				"hook: reassigned",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &fakeDB{commitErrs: tt.commitErrs}
+			m := NewTxManager(db, WithTxRetry(2, time.Millisecond))
+
+			hook := func(name string) func(context.Context) {
+				return func(ctx context.Context) {
+					_, inTx := txFrom(ctx)
+					assert.False(t, inTx, "action must not run in the committed transaction")
+
+					db.log = append(db.log, "hook: "+name)
+				}
+			}
+
+			err := m.WithinTx(context.Background(), tt.fn(m, hook))
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLog, db.log)
+		})
+	}
+}
+
+func TestTxManager_AfterCommitOutsideTx(t *testing.T) {
+	m := NewTxManager(&fakeDB{})
+
+	ran := false
+	m.AfterCommit(context.Background(), func(context.Context) { ran = true })
+
+	assert.True(t, ran)
+}
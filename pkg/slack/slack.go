@@ -0,0 +1,67 @@
+// Package slack реализует проверку подписи запросов, которые Slack и совместимые
+// с ним чаты (например, Mattermost) отправляют в slash-командах.
+//
+// Подпись вычисляется по схеме v0: HMAC-SHA256 от строки "v0:<timestamp>:<body>"
+// с секретом подписи приложения, результат передаётся в заголовке
+// X-Slack-Signature в виде "v0=<hex>".
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderSignature = "X-Slack-Signature"
+	HeaderTimestamp = "X-Slack-Request-Timestamp"
+
+	// MaxSkew — максимальное расхождение времени запроса и текущего времени.
+	MaxSkew = 5 * time.Minute
+
+	version = "v0"
+)
+
+var (
+	ErrInvalidTimestamp = errors.New("invalid request timestamp")
+	ErrStaleTimestamp   = errors.New("request timestamp is too old")
+	ErrInvalidSignature = errors.New("invalid request signature")
+)
+
+// Sign возвращает подпись тела запроса в формате заголовка X-Slack-Signature.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(version + ":" + timestamp + ":"))
+	mac.Write(body)
+
+	return version + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись запроса и свежесть его временной метки относительно now.
+// Запросы старше MaxSkew отклоняются, чтобы исключить их повторное воспроизведение.
+func Verify(secret, timestamp string, body []byte, signature string, now time.Time) error {
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	skew := now.Sub(time.Unix(sec, 0))
+	if skew > MaxSkew || skew < -MaxSkew {
+		return ErrStaleTimestamp
+	}
+
+	if !strings.HasPrefix(signature, version+"=") {
+		return ErrInvalidSignature
+	}
+
+	expected := Sign(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package slack
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	// Пример из документации Slack по проверке запросов.
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&" +
+		"channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&" +
+		"command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F" +
+		"397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c")
+
+	got := Sign("8f742231b10e8888abcd99yyyzzz85a5", "1531420618", body)
+
+	assert.Equal(t, "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503", got)
+}
+
+func TestVerify(t *testing.T) {
+	const secret = "secret"
+
+	now := time.Unix(1_700_000_000, 0)
+	body := []byte("command=%2Freview&text=mine&user_id=U1")
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	tests := []struct {
+		name          string
+		timestamp     string
+		signature     string
+		expectedError error
+	}{
+		{
+			name:      "success - valid signature",
+			timestamp: ts,
+			signature: Sign(secret, ts, body),
+		},
+		{
+			name:          "error - signed with another secret",
+			timestamp:     ts,
+			signature:     Sign("other", ts, body),
+			expectedError: ErrInvalidSignature,
+		},
+		{
+			name:          "error - missing version prefix",
+			timestamp:     ts,
+			signature:     Sign(secret, ts, body)[3:],
+			expectedError: ErrInvalidSignature,
+		},
+		{
+			name:          "error - malformed timestamp",
+			timestamp:     "yesterday",
+			signature:     Sign(secret, "yesterday", body),
+			expectedError: ErrInvalidTimestamp,
+		},
+		{
+			name:          "error - stale timestamp",
+			timestamp:     strconv.FormatInt(now.Add(-MaxSkew-time.Second).Unix(), 10),
+			signature:     Sign(secret, strconv.FormatInt(now.Add(-MaxSkew-time.Second).Unix(), 10), body),
+			expectedError: ErrStaleTimestamp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(secret, tt.timestamp, body, tt.signature, now)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package sqlitePkg
+
+import (
+	"database/sql"
+	"errors"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// IsUniqueViolationError сообщает, нарушено ли ограничение уникальности или первичный ключ.
+func IsUniqueViolationError(err error) bool {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		code := sqliteErr.Code()
+
+		return code == sqlite3.SQLITE_CONSTRAINT_UNIQUE || code == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+	}
+
+	return false
+}
+
+// IsForeignKeyErr сообщает, нарушен ли внешний ключ. В отличие от PostgreSQL,
+// SQLite не сообщает, какой именно внешний ключ нарушен.
+func IsForeignKeyErr(err error) bool {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY
+	}
+
+	return false
+}
+
+func IsNoRowsError(err error) bool {
+	return errors.Is(err, sql.ErrNoRows)
+}
@@ -0,0 +1,128 @@
+package sqlitePkg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+const migrationSuffix = ".up.sql"
+
+// Migrate применяет к базе ещё не применённые миграции *.up.sql из fsys в порядке имён.
+// Каждая миграция выполняется в своей транзакции, применённые версии запоминаются
+// в таблице schema_migrations.
+func Migrate(ctx context.Context, db *sql.DB, fsys fs.FS) error {
+	const createQuery = `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version TEXT PRIMARY KEY,
+			applied_at TEXT NOT NULL
+		)
+	`
+	_, err := db.ExecContext(ctx, createQuery)
+	if err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	names, err := fs.Glob(fsys, "*"+migrationSuffix)
+	if err != nil {
+		return fmt.Errorf("list migrations: %w", err)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		err = migrate(ctx, db, fsys, name)
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Pending возвращает в порядке имён версии миграций *.up.sql из fsys, которые ещё
+// не применены к базе. Версии, применённые к базе, но отсутствующие в fsys, не учитываются:
+// более новая схема допустима.
+func Pending(ctx context.Context, db *sql.DB, fsys fs.FS) ([]string, error) {
+	names, err := fs.Glob(fsys, "*"+migrationSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("list migrations: %w", err)
+	}
+	slices.Sort(names)
+
+	rows, err := db.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[string]struct{})
+	for rows.Next() {
+		var version string
+		err = rows.Scan(&version)
+		if err != nil {
+			return nil, fmt.Errorf("read schema_migrations: %w", err)
+		}
+		applied[version] = struct{}{}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+
+	var pending []string
+	for _, name := range names {
+		version := strings.TrimSuffix(path.Base(name), migrationSuffix)
+		if _, ok := applied[version]; !ok {
+			pending = append(pending, version)
+		}
+	}
+
+	return pending, nil
+}
+
+func migrate(ctx context.Context, db *sql.DB, fsys fs.FS, name string) (err error) {
+	version := strings.TrimSuffix(path.Base(name), migrationSuffix)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var applied int
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations WHERE version = ?`, version).
+		Scan(&applied)
+	if err != nil {
+		return err
+	}
+	if applied > 0 {
+		return tx.Rollback()
+	}
+
+	script, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, string(script))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO schema_migrations (version, applied_at) VALUES (?, datetime('now'))`, version)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,122 @@
+package sqlitePkg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+var ErrOpen = errors.New("failed to open sqlite database")
+
+// timeLayout — формат хранения меток времени. Время хранится в UTC с фиксированным
+// числом знаков, поэтому строки сравниваются в SQL так же, как сами метки.
+const timeLayout = "2006-01-02 15:04:05.000000000"
+
+const (
+	busyTimeout = 5 * time.Second
+	// maxConns ограничивает число подключений. В режиме WAL читатели не ждут писателя,
+	// поэтому чтения вне транзакции не блокируются открытой транзакцией.
+	maxConns = 4
+)
+
+// Open открывает базу SQLite в файле path. Включаются внешние ключи, журнал WAL
+// и ожидание снятия блокировки. SQLite допускает одного писателя, поэтому транзакции
+// начинаются с BEGIN IMMEDIATE: блокировка записи берётся сразу, и параллельные
+// транзакции ждут её в пределах busy_timeout, а не получают SQLITE_BUSY посреди работы.
+func Open(ctx context.Context, path string) (*sql.DB, error) {
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeout.Milliseconds()))
+	params.Add("_txlock", "immediate")
+
+	db, err := sql.Open("sqlite", "file:"+path+"?"+params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOpen, err.Error())
+	}
+
+	db.SetMaxOpenConns(maxConns)
+
+	err = db.PingContext(ctx)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("%w: %s", ErrOpen, err.Error())
+	}
+
+	return db, nil
+}
+
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type Tx interface {
+	Querier
+	Commit() error
+	Rollback() error
+}
+
+type DB interface {
+	Querier
+	Begin(ctx context.Context) (Tx, error)
+}
+
+// FormatTime переводит время в формат хранения.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// NullTime читает метку времени, сохранённую FormatTime. NULL читается как Valid == false.
+type NullTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+func (t *NullTime) Scan(value any) error {
+	if value == nil {
+		t.Time, t.Valid = time.Time{}, false
+
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case time.Time:
+		t.Time, t.Valid = v.UTC(), true
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported time value %T", value)
+	}
+
+	parsed, err := time.ParseInLocation(timeLayout, s, time.UTC)
+	if err != nil {
+		return fmt.Errorf("parse time %q: %w", s, err)
+	}
+	t.Time, t.Valid = parsed, true
+
+	return nil
+}
+
+// Ptr возвращает время или nil для NULL.
+func (t NullTime) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	v := t.Time
+
+	return &v
+}
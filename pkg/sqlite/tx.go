@@ -0,0 +1,191 @@
+package sqlitePkg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+type (
+	txKey          struct{}
+	afterCommitKey struct{}
+)
+
+// afterCommit — действия, отложенные до фиксации транзакции верхнего уровня.
+type afterCommit struct {
+	fns []func(ctx context.Context)
+}
+
+// TxManager выполняет функции в транзакции, которую передаёт через контекст.
+// TxManager реализует DB, поэтому репозитории, созданные поверх него, работают
+// в транзакции из контекста, если она есть, и в базе, если нет. Begin внутри
+// транзакции создаёт точку сохранения, так что собственные транзакции
+// репозиториев становятся частью внешней.
+type TxManager struct {
+	db         *sql.DB
+	savepoints atomic.Int64
+}
+
+func NewTxManager(db *sql.DB) *TxManager {
+	return &TxManager{
+		db: db,
+	}
+}
+
+// WithinTx выполняет fn в транзакции: при ошибке или панике fn транзакция
+// откатывается, иначе фиксируется. Все запросы fn через контекст ctx и репозитории
+// поверх TxManager выполняются в этой транзакции.
+//
+// Вложенный вызов (ctx уже содержит транзакцию) выполняет fn в точке сохранения
+// внешней транзакции: ошибка fn откатывает только изменения fn, а фиксация
+// происходит вместе с внешней транзакцией.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	tx, err := m.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	hooks := &afterCommit{}
+	txCtx := context.WithValue(context.WithValue(ctx, txKey{}, m.querier(ctx, tx)), afterCommitKey{}, hooks)
+
+	err = fn(txCtx)
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		if rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			return errors.Join(err, fmt.Errorf("rollback transaction: %w", rollbackErr))
+		}
+
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	// Точка сохранения передаёт отложенные действия внешней транзакции,
+	// а транзакция верхнего уровня выполняет их после фиксации.
+	if parent, ok := ctx.Value(afterCommitKey{}).(*afterCommit); ok {
+		parent.fns = append(parent.fns, hooks.fns...)
+
+		return nil
+	}
+
+	for _, hook := range hooks.fns {
+		hook(ctx)
+	}
+
+	return nil
+}
+
+// AfterCommit откладывает fn до фиксации транзакции из контекста: при откате транзакции
+// fn не выполняется, а после фиксации выполняется один раз с контекстом, переданным
+// в WithinTx верхнего уровня. Если транзакции в контексте нет, fn выполняется сразу.
+func (m *TxManager) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommit)
+	if !ok {
+		fn(ctx)
+
+		return
+	}
+
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// Begin открывает транзакцию в базе или точку сохранения в транзакции из контекста.
+func (m *TxManager) Begin(ctx context.Context) (Tx, error) {
+	if tx, ok := txFrom(ctx); ok {
+		name := fmt.Sprintf("sp_%d", m.savepoints.Add(1))
+		_, err := tx.ExecContext(ctx, "SAVEPOINT "+name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &savepoint{ctx: context.WithoutCancel(ctx), tx: tx, name: name}, nil
+	}
+
+	return m.db.BeginTx(ctx, nil)
+}
+
+func (m *TxManager) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return m.querier(ctx, m.db).ExecContext(ctx, query, args...)
+}
+
+func (m *TxManager) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return m.querier(ctx, m.db).QueryContext(ctx, query, args...)
+}
+
+func (m *TxManager) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return m.querier(ctx, m.db).QueryRowContext(ctx, query, args...)
+}
+
+// querier возвращает транзакцию из контекста или fallback, если её нет.
+func (m *TxManager) querier(ctx context.Context, fallback Querier) Querier {
+	if tx, ok := txFrom(ctx); ok {
+		return tx
+	}
+
+	return fallback
+}
+
+func txFrom(ctx context.Context) (Querier, bool) {
+	tx, ok := ctx.Value(txKey{}).(Querier)
+
+	return tx, ok
+}
+
+// savepoint — точка сохранения во внешней транзакции, которая ведёт себя как транзакция.
+type savepoint struct {
+	ctx  context.Context
+	tx   Querier
+	name string
+	done bool
+}
+
+func (s *savepoint) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return s.tx.ExecContext(ctx, query, args...)
+}
+
+func (s *savepoint) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return s.tx.QueryContext(ctx, query, args...)
+}
+
+func (s *savepoint) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return s.tx.QueryRowContext(ctx, query, args...)
+}
+
+func (s *savepoint) Commit() error {
+	if s.done {
+		return sql.ErrTxDone
+	}
+	s.done = true
+
+	_, err := s.tx.ExecContext(s.ctx, "RELEASE "+s.name)
+
+	return err
+}
+
+func (s *savepoint) Rollback() error {
+	if s.done {
+		return sql.ErrTxDone
+	}
+	s.done = true
+
+	_, err := s.tx.ExecContext(s.ctx, "ROLLBACK TO "+s.name)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.tx.ExecContext(s.ctx, "RELEASE "+s.name)
+
+	return err
+}
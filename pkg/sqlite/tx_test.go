@@ -0,0 +1,216 @@
+package sqlitePkg
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errFn = errors.New("fn failed")
+
+func openTestDB(t *testing.T) *TxManager {
+	t.Helper()
+
+	db, err := Open(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.ExecContext(context.Background(), `
+		CREATE TABLE parents (id TEXT PRIMARY KEY);
+		CREATE TABLE children (id TEXT PRIMARY KEY, parent_id TEXT NOT NULL REFERENCES parents(id));
+	`)
+	require.NoError(t, err)
+
+	return NewTxManager(db)
+}
+
+func parentIDs(t *testing.T, m *TxManager) []string {
+	t.Helper()
+
+	rows, err := m.QueryContext(context.Background(), `SELECT id FROM parents ORDER BY id`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+
+	return ids
+}
+
+func TestTxManager_WithinTx(t *testing.T) {
+	tests := []struct {
+		name        string
+		fn          func(m *TxManager) func(ctx context.Context) error
+		expectedIDs []string
+		expectedErr error
+	}{
+		{
+			name: "success - queries run in transaction",
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					_, err := m.ExecContext(ctx, `INSERT INTO parents VALUES ('a')`)
+
+					return err
+				}
+			},
+			expectedIDs: []string{"a"},
+		},
+		{
+			name: "error - fn error rolls back",
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					_, _ = m.ExecContext(ctx, `INSERT INTO parents VALUES ('a')`)
+
+					return errFn
+				}
+			},
+			expectedErr: errFn,
+		},
+		{
+			name: "success - nested call uses savepoint and keeps outer transaction",
+			fn: func(m *TxManager) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					_, _ = m.ExecContext(ctx, `INSERT INTO parents VALUES ('a')`)
+
+					err := m.WithinTx(ctx, func(ctx context.Context) error {
+						_, _ = m.ExecContext(ctx, `INSERT INTO parents VALUES ('b')`)
+
+						return errFn
+					})
+					if !errors.Is(err, errFn) {
+						return err
+					}
+
+					return m.WithinTx(ctx, func(ctx context.Context) error {
+						_, err := m.ExecContext(ctx, `INSERT INTO parents VALUES ('c')`)
+
+						return err
+					})
+				}
+			},
+			expectedIDs: []string{"a", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := openTestDB(t)
+
+			err := m.WithinTx(context.Background(), tt.fn(m))
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedIDs, parentIDs(t, m))
+		})
+	}
+}
+
+func TestTxManager_PanicRollsBack(t *testing.T) {
+	m := openTestDB(t)
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = m.WithinTx(context.Background(), func(ctx context.Context) error {
+			_, _ = m.ExecContext(ctx, `INSERT INTO parents VALUES ('a')`)
+			panic("boom")
+		})
+	})
+	assert.Empty(t, parentIDs(t, m))
+}
+
+// TestTxManager_ReadOutsideTx проверяет, что чтение вне транзакции не ждёт открытую транзакцию.
+func TestTxManager_ReadOutsideTx(t *testing.T) {
+	m := openTestDB(t)
+
+	err := m.WithinTx(context.Background(), func(ctx context.Context) error {
+		_, err := m.ExecContext(ctx, `INSERT INTO parents VALUES ('a')`)
+		if err != nil {
+			return err
+		}
+
+		assert.Empty(t, parentIDs(t, m))
+
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, parentIDs(t, m))
+}
+
+func TestErrors(t *testing.T) {
+	m := openTestDB(t)
+	ctx := context.Background()
+
+	_, err := m.ExecContext(ctx, `INSERT INTO parents VALUES ('a')`)
+	require.NoError(t, err)
+
+	_, err = m.ExecContext(ctx, `INSERT INTO parents VALUES ('a')`)
+	assert.True(t, IsUniqueViolationError(err))
+	assert.False(t, IsForeignKeyErr(err))
+
+	_, err = m.ExecContext(ctx, `INSERT INTO children VALUES ('c', 'ghost')`)
+	assert.True(t, IsForeignKeyErr(err))
+	assert.False(t, IsUniqueViolationError(err))
+
+	err = m.QueryRowContext(ctx, `SELECT id FROM parents WHERE id = 'ghost'`).Scan(new(string))
+	assert.True(t, IsNoRowsError(err))
+}
+
+func TestTxManager_AfterCommit(t *testing.T) {
+	m := openTestDB(t)
+
+	var ran []string
+	hook := func(name string) func(context.Context) {
+		return func(ctx context.Context) {
+			_, inTx := txFrom(ctx)
+			assert.False(t, inTx, "action must not run in the committed transaction")
+			// Действие видит зафиксированные изменения.
+			assert.Equal(t, []string{"a"}, parentIDs(t, m))
+
+			ran = append(ran, name)
+		}
+	}
+
+	err := m.WithinTx(context.Background(), func(ctx context.Context) error {
+		_, err := m.ExecContext(ctx, `INSERT INTO parents VALUES ('a')`)
+		if err != nil {
+			return err
+		}
+
+		_ = m.WithinTx(ctx, func(ctx context.Context) error {
+			m.AfterCommit(ctx, hook("failed"))
+
+			return errFn
+		})
+
+		return m.WithinTx(ctx, func(ctx context.Context) error {
+			m.AfterCommit(ctx, hook("nested"))
+			assert.Empty(t, ran)
+
+			return nil
+		})
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nested"}, ran)
+
+	err = m.WithinTx(context.Background(), func(ctx context.Context) error {
+		m.AfterCommit(ctx, hook("rolled back"))
+
+		return errFn
+	})
+
+	require.ErrorIs(t, err, errFn)
+	assert.Equal(t, []string{"nested"}, ran)
+}
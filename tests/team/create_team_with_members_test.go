@@ -1,66 +1,45 @@
 package team_test
 
 import (
-	"net/url"
+	"context"
 	"os"
 	"testing"
 
-	"github.com/gavv/httpexpect/v2"
+	"avitotech-pr-reviewer/pkg/client"
 )
 
-const (
-	adminTokenHeader = "X-Admin-Token"
-	adminToken       = "supersecrettoken"
-)
+const adminToken = "supersecrettoken"
 
-var baseURL url.URL
+func newClient(t *testing.T) *client.Client {
+	t.Helper()
 
-func init() {
-	port := os.Getenv("HTTP_PORT")
-	baseURL = url.URL{
-		Scheme: "http",
-		Host:   "localhost:" + port,
-		Path:   "/team/",
+	c, err := client.New("http://localhost:"+os.Getenv("HTTP_PORT"), client.WithAdminToken(adminToken))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
 	}
-}
-
-type member struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
-}
 
-type team struct {
-	TeamName string   `json:"team_name"`
-	Members  []member `json:"members"`
-}
-
-type addTeamResponse struct {
-	Team team `json:"team"`
+	return c
 }
 
 func TestAddTeam_OK(t *testing.T) {
 	t.Parallel()
 
-	e := httpexpect.Default(t, baseURL.String())
+	c := newClient(t)
 
-	members := []member{
+	members := []client.Member{
 		{UserID: "1", Username: "alice", IsActive: true},
 		{UserID: "2", Username: "bob", IsActive: false},
 	}
-	teamData := team{
-		TeamName: "Dev Team",
-		Members:  members,
-	}
 
-	var resp addTeamResponse
-	e.POST("/add").WithJSON(teamData).WithHeader(adminTokenHeader, adminToken).Expect().
-		Status(201).JSON().Object().ContainsKey("team").Decode(&resp)
+	team, err := c.AddTeam(context.Background(), "Dev Team", members)
+	if err != nil {
+		t.Fatalf("failed to add team: %v", err)
+	}
 
-	if resp.Team.TeamName != teamData.TeamName {
-		t.Errorf("expected team name 'Dev Team', got '%s'", resp.Team.TeamName)
+	if team.TeamName != "Dev Team" {
+		t.Errorf("expected team name 'Dev Team', got '%s'", team.TeamName)
 	}
-	if len(resp.Team.Members) != len(members) {
-		t.Errorf("expected 2 members, got %d", len(resp.Team.Members))
+	if len(team.Members) != len(members) {
+		t.Errorf("expected 2 members, got %d", len(team.Members))
 	}
 }